@@ -14,7 +14,11 @@ import (
 	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
 )
 
-var ErrPlaceOrdersGenerator = fmt.Errorf("invalid message received for dex module")
+var (
+	ErrPlaceOrdersGenerator   = fmt.Errorf("invalid message received for dex module")
+	ErrCancelAllGenerator     = fmt.Errorf("invalid message received for dex cancel all")
+	ErrReplaceOrdersGenerator = fmt.Errorf("invalid message received for dex replace orders")
+)
 
 func GetDexDependencyGenerators() aclkeeper.DependencyGeneratorMap {
 	dependencyGeneratorMap := make(aclkeeper.DependencyGeneratorMap)
@@ -24,6 +28,10 @@ func GetDexDependencyGenerators() aclkeeper.DependencyGeneratorMap {
 	cancelOrdersKey := acltypes.GenerateMessageKey(&dextypes.MsgCancelOrders{})
 	dependencyGeneratorMap[placeOrdersKey] = DexPlaceOrdersDependencyGenerator
 	dependencyGeneratorMap[cancelOrdersKey] = DexCancelOrdersDependencyGenerator
+	cancelAllKey := acltypes.GenerateMessageKey(&dextypes.MsgCancelAll{})
+	dependencyGeneratorMap[cancelAllKey] = DexCancelAllDependencyGenerator
+	replaceOrdersKey := acltypes.GenerateMessageKey(&dextypes.MsgReplaceOrders{})
+	dependencyGeneratorMap[replaceOrdersKey] = DexReplaceOrdersDependencyGenerator
 
 	return dependencyGeneratorMap
 }
@@ -71,6 +79,12 @@ func DexPlaceOrdersDependencyGenerator(keeper aclkeeper.Keeper, _ sdk.Context, m
 			ResourceType:       sdkacltypes.ResourceType_KV_DEX_REGISTERED_PAIR,
 			IdentifierTemplate: hex.EncodeToString(dextypes.RegisteredPairPrefix(contractAddr)),
 		},
+		// Checks whether trading of the pairs is halted. Pair halts have no dedicated resource type
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_PAIR_PREFIX,
+			IdentifierTemplate: hex.EncodeToString(dextypes.PairHaltContractPrefix(contractAddr)),
+		},
 		{
 			AccessType:   sdkacltypes.AccessType_READ,
 			ResourceType: sdkacltypes.ResourceType_KV_DEX_MEM_DEPOSIT,
@@ -189,8 +203,70 @@ func DexCancelOrdersDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg s
 		priceDenom := order.GetPriceDenom()
 		assetDenom := order.GetAssetDenom()
 		aclOps = append(aclOps, GetLongShortOrderBookOps(contractAddr, priceDenom, assetDenom)...)
+		// untriggered stop loss/limit orders are looked up in the trigger book
+		aclOps = append(aclOps, sdkacltypes.AccessOperation{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_ORDER_BOOK,
+			IdentifierTemplate: hex.EncodeToString(dextypes.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom)),
+		})
+	}
+
+	// Last Operation should always be a commit
+	aclOps = append(aclOps, *acltypes.CommitAccessOp())
+	return aclOps, nil
+}
+
+// Matching orders are only resolved at EndBlock, so a cancel-all only touches the in-memory
+// requests of the contract and, if a pair is specified, the registered pairs.
+func DexCancelAllDependencyGenerator(_ aclkeeper.Keeper, _ sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+	cancelAllMsg, ok := msg.(*dextypes.MsgCancelAll)
+	if !ok {
+		return []sdkacltypes.AccessOperation{}, ErrCancelAllGenerator
+	}
+	contractAddr := cancelAllMsg.ContractAddr
+
+	aclOps := []sdkacltypes.AccessOperation{
+		{
+			AccessType:         sdkacltypes.AccessType_WRITE,
+			ResourceType:       sdkacltypes.ResourceType_DexMem,
+			IdentifierTemplate: hex.EncodeToString(dextypes.MemCancelAllPrefix(contractAddr)),
+		},
+		{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_CONTRACT,
+			IdentifierTemplate: hex.EncodeToString([]byte(dexkeeper.ContractPrefixKey)),
+		},
+	}
+	if filter := cancelAllMsg.Filter; filter != nil && len(filter.PriceDenom) > 0 {
+		aclOps = append(aclOps, sdkacltypes.AccessOperation{
+			AccessType:         sdkacltypes.AccessType_READ,
+			ResourceType:       sdkacltypes.ResourceType_KV_DEX_REGISTERED_PAIR,
+			IdentifierTemplate: hex.EncodeToString(dextypes.RegisteredPairPrefix(contractAddr)),
+		})
+	}
+
+	// Last Operation should always be a commit
+	aclOps = append(aclOps, *acltypes.CommitAccessOp())
+	return aclOps, nil
+}
+
+// A replacement accesses the union of what placing and cancelling the orders access
+func DexReplaceOrdersDependencyGenerator(keeper aclkeeper.Keeper, ctx sdk.Context, msg sdk.Msg) ([]sdkacltypes.AccessOperation, error) {
+	replaceOrdersMsg, ok := msg.(*dextypes.MsgReplaceOrders)
+	if !ok {
+		return []sdkacltypes.AccessOperation{}, ErrReplaceOrdersGenerator
+	}
+	placeOps, err := DexPlaceOrdersDependencyGenerator(keeper, ctx, replaceOrdersMsg.GetPlaceOrders())
+	if err != nil {
+		return []sdkacltypes.AccessOperation{}, err
+	}
+	cancelOps, err := DexCancelOrdersDependencyGenerator(keeper, ctx, replaceOrdersMsg.GetCancelOrders())
+	if err != nil {
+		return []sdkacltypes.AccessOperation{}, err
 	}
 
+	// drop the commits of the individual generators
+	aclOps := append(placeOps[:len(placeOps)-1], cancelOps[:len(cancelOps)-1]...)
 	// Last Operation should always be a commit
 	aclOps = append(aclOps, *acltypes.CommitAccessOp())
 	return aclOps, nil
@@ -2,6 +2,7 @@ package acldexmapping_test
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"time"
@@ -239,6 +240,144 @@ func (suite *KeeperTestSuite) TestMsgCancelOrder() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgCancelAll() {
+	suite.PrepareTest()
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           *dextypes.MsgCancelAll
+		dynamicDep    bool
+	}{
+		{
+			name: "cancel all for pair",
+			msg: &types.MsgCancelAll{
+				Creator:      suite.creator,
+				ContractAddr: suite.contract,
+				Filter: &types.CancelAllFilter{
+					PriceDenom: keepertest.TestPriceDenom,
+					AssetDenom: keepertest.TestAssetDenom,
+				},
+			},
+			expectedError: nil,
+			dynamicDep:    true,
+		},
+		{
+			name: "cancel all without filter",
+			msg: &types.MsgCancelAll{
+				Creator:      suite.creator,
+				ContractAddr: suite.contract,
+			},
+			expectedError: nil,
+			dynamicDep:    true,
+		},
+	}
+	for _, tc := range tests {
+		suite.Run(fmt.Sprintf("Test Case: %s", tc.name), func() {
+			goCtx := context.WithValue(suite.Ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(suite.App.GetMemKey(dextypes.MemStoreKey)))
+			suite.Ctx = suite.Ctx.WithContext(goCtx)
+
+			handlerCtx, cms := aclutils.CacheTxContext(suite.Ctx)
+			_, err := suite.msgServer.CancelAll(
+				sdk.WrapSDKContext(handlerCtx),
+				tc.msg,
+			)
+
+			depdenencies, _ := dexacl.DexCancelAllDependencyGenerator(
+				suite.App.AccessControlKeeper,
+				handlerCtx,
+				tc.msg,
+			)
+
+			if !tc.dynamicDep {
+				depdenencies = sdkacltypes.SynchronousAccessOps()
+			}
+
+			if tc.expectedError != nil {
+				suite.Require().EqualError(err, tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
+			suite.Require().Empty(missing)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgReplaceOrders() {
+	suite.PrepareTest()
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           *dextypes.MsgReplaceOrders
+		dynamicDep    bool
+	}{
+		{
+			name: "default replace orders",
+			msg: &types.MsgReplaceOrders{
+				Creator:       suite.creator,
+				ContractAddr:  suite.contract,
+				Cancellations: suite.msgCancelOrders.Cancellations[:1],
+				Orders:        suite.msgPlaceOrders.Orders,
+			},
+			expectedError: nil,
+			dynamicDep:    true,
+		},
+		{
+			name: "dont check synchronous",
+			msg: &types.MsgReplaceOrders{
+				Creator:       suite.creator,
+				ContractAddr:  suite.contract,
+				Cancellations: suite.msgCancelOrders.Cancellations[:1],
+				Orders:        suite.msgPlaceOrders.Orders,
+			},
+			expectedError: nil,
+			dynamicDep:    false,
+		},
+	}
+	suite.App.DexKeeper.SetLongBook(suite.Ctx, suite.contract, types.LongBook{
+		Price: sdk.MustNewDecFromStr("10"),
+		Entry: &types.OrderEntry{
+			Price:       sdk.MustNewDecFromStr("10"),
+			Quantity:    sdk.MustNewDecFromStr("10"),
+			Allocations: []*types.Allocation{{OrderId: 1, Account: suite.creator, Quantity: sdk.MustNewDecFromStr("10")}},
+			PriceDenom:  keepertest.TestPriceDenom,
+			AssetDenom:  keepertest.TestAssetDenom,
+		},
+	})
+	for _, tc := range tests {
+		suite.Run(fmt.Sprintf("Test Case: %s", tc.name), func() {
+			goCtx := context.WithValue(suite.Ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(suite.App.GetMemKey(dextypes.MemStoreKey)))
+			suite.Ctx = suite.Ctx.WithContext(goCtx)
+
+			handlerCtx, cms := aclutils.CacheTxContext(suite.Ctx)
+			_, err := suite.msgServer.ReplaceOrders(
+				sdk.WrapSDKContext(handlerCtx),
+				tc.msg,
+			)
+
+			depdenencies, _ := dexacl.DexReplaceOrdersDependencyGenerator(
+				suite.App.AccessControlKeeper,
+				handlerCtx,
+				tc.msg,
+			)
+
+			if !tc.dynamicDep {
+				depdenencies = sdkacltypes.SynchronousAccessOps()
+			}
+
+			if tc.expectedError != nil {
+				suite.Require().EqualError(err, tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			missing := handlerCtx.MsgValidator().ValidateAccessOperations(depdenencies, cms.GetEvents())
+			suite.Require().Empty(missing)
+		})
+	}
+}
+
 func TestGeneratorInvalidMessageTypes(t *testing.T) {
 	tm := time.Now().UTC()
 	valPub := secp256k1.GenPrivKey().PubKey()
@@ -263,6 +402,20 @@ func TestGeneratorInvalidMessageTypes(t *testing.T) {
 		&oracleVote,
 	)
 	require.Error(t, err)
+
+	_, err = dexacl.DexCancelAllDependencyGenerator(
+		testWrapper.App.AccessControlKeeper,
+		testWrapper.Ctx,
+		&oracleVote,
+	)
+	require.ErrorIs(t, err, dexacl.ErrCancelAllGenerator)
+
+	_, err = dexacl.DexReplaceOrdersDependencyGenerator(
+		testWrapper.App.AccessControlKeeper,
+		testWrapper.Ctx,
+		&oracleVote,
+	)
+	require.ErrorIs(t, err, dexacl.ErrReplaceOrdersGenerator)
 }
 
 func (suite *KeeperTestSuite) TestMsgPlaceOrderGenerator() {
@@ -276,6 +429,20 @@ func (suite *KeeperTestSuite) TestMsgPlaceOrderGenerator() {
 	require.NoError(suite.T(), err)
 	err = acltypes.ValidateAccessOps(accessOps)
 	require.NoError(suite.T(), err)
+
+	// the pair halts the handler reads are only covered by the pair halt op
+	pairHaltOp := sdkacltypes.AccessOperation{
+		AccessType:         sdkacltypes.AccessType_READ,
+		ResourceType:       sdkacltypes.ResourceType_KV_DEX_PAIR_PREFIX,
+		IdentifierTemplate: hex.EncodeToString(dextypes.PairHaltContractPrefix(suite.contract)),
+	}
+	require.Contains(suite.T(), accessOps, pairHaltOp)
+	goCtx := context.WithValue(suite.Ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(suite.App.GetMemKey(dextypes.MemStoreKey)))
+	handlerCtx, cms := aclutils.CacheTxContext(suite.Ctx.WithContext(goCtx))
+	_, err = suite.msgServer.PlaceOrders(sdk.WrapSDKContext(handlerCtx), suite.msgPlaceOrders)
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), handlerCtx.MsgValidator().ValidateAccessOperations(accessOps, cms.GetEvents()))
+	require.NotEmpty(suite.T(), handlerCtx.MsgValidator().ValidateAccessOperations(withoutAccessOp(accessOps, pairHaltOp), cms.GetEvents()))
 }
 
 func (suite *KeeperTestSuite) TestMsgCancelOrderGenerator() {
@@ -289,3 +456,64 @@ func (suite *KeeperTestSuite) TestMsgCancelOrderGenerator() {
 	err = acltypes.ValidateAccessOps(accessOps)
 	require.NoError(suite.T(), err)
 }
+
+func (suite *KeeperTestSuite) TestMsgCancelTriggeredOrderGenerator() {
+	suite.PrepareTest()
+	suite.App.DexKeeper.SetTriggeredOrder(suite.Ctx, suite.contract, types.Order{
+		Id:                3,
+		Account:           suite.creator,
+		ContractAddr:      suite.contract,
+		Price:             sdk.MustNewDecFromStr("10"),
+		Quantity:          sdk.MustNewDecFromStr("1"),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.MustNewDecFromStr("12"),
+	})
+	msg := &types.MsgCancelOrders{
+		Creator:      suite.creator,
+		ContractAddr: suite.contract,
+		Cancellations: []*types.Cancellation{
+			{
+				Id:                3,
+				Price:             sdk.MustNewDecFromStr("10"),
+				Creator:           suite.creator,
+				PositionDirection: types.PositionDirection_LONG,
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+			},
+		},
+	}
+	accessOps, err := dexacl.DexCancelOrdersDependencyGenerator(
+		suite.App.AccessControlKeeper,
+		suite.Ctx,
+		msg,
+	)
+	require.NoError(suite.T(), err)
+
+	// the untriggered order the handler looks up is only covered by the trigger book op
+	triggerBookOp := sdkacltypes.AccessOperation{
+		AccessType:         sdkacltypes.AccessType_READ,
+		ResourceType:       sdkacltypes.ResourceType_KV_DEX_ORDER_BOOK,
+		IdentifierTemplate: hex.EncodeToString(dextypes.TriggerOrderBookPrefix(suite.contract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)),
+	}
+	require.Contains(suite.T(), accessOps, triggerBookOp)
+	goCtx := context.WithValue(suite.Ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(suite.App.GetMemKey(dextypes.MemStoreKey)))
+	handlerCtx, cms := aclutils.CacheTxContext(suite.Ctx.WithContext(goCtx))
+	_, err = suite.msgServer.CancelOrders(sdk.WrapSDKContext(handlerCtx), msg)
+	require.NoError(suite.T(), err)
+	require.Empty(suite.T(), handlerCtx.MsgValidator().ValidateAccessOperations(accessOps, cms.GetEvents()))
+	require.NotEmpty(suite.T(), handlerCtx.MsgValidator().ValidateAccessOperations(withoutAccessOp(accessOps, triggerBookOp), cms.GetEvents()))
+}
+
+// withoutAccessOp returns the access ops other than the given one
+func withoutAccessOp(accessOps []sdkacltypes.AccessOperation, op sdkacltypes.AccessOperation) []sdkacltypes.AccessOperation {
+	res := []sdkacltypes.AccessOperation{}
+	for _, accessOp := range accessOps {
+		if accessOp != op {
+			res = append(res, accessOp)
+		}
+	}
+	return res
+}
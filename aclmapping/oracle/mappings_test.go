@@ -125,6 +125,58 @@ func (suite *KeeperTestSuite) TestMsgBurnDependencies() {
 		})
 	}
 }
+func (suite *KeeperTestSuite) TestMsgCommitRevealDependencies() {
+	suite.PrepareTest()
+	params := suite.App.OracleKeeper.GetParams(suite.Ctx)
+	params.CommitRevealEnabled = true
+	suite.App.OracleKeeper.SetParams(suite.Ctx, params)
+
+	salt := "salt"
+	hash := oracletypes.GetAggregateVoteHash(salt, suite.defaultExchangeRate, suite.validator)
+	prevote := oracletypes.NewMsgAggregateExchangeRatePrevote(hash, suite.TestAccs[0], suite.validator)
+
+	handlerCtx, cms := utils.CacheTxContext(suite.Ctx)
+	_, err := suite.msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(handlerCtx), prevote)
+	suite.Require().NoError(err)
+	dependencies, err := oracleacl.MsgPrevoteDependencyGenerator(suite.App.AccessControlKeeper, handlerCtx, prevote)
+	suite.Require().NoError(err)
+	missing := handlerCtx.MsgValidator().ValidateAccessOperations(dependencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+	cms.Write()
+
+	// reveal the prevote in the next vote period
+	vote := oracletypes.NewMsgAggregateExchangeRateVote(suite.defaultExchangeRate, suite.TestAccs[0], suite.validator)
+	vote.Salt = salt
+	handlerCtx, cms = utils.CacheTxContext(suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + int64(params.VotePeriod)))
+	_, err = suite.msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(handlerCtx), vote)
+	suite.Require().NoError(err)
+	dependencies, err = oracleacl.MsgVoteDependencyGenerator(suite.App.AccessControlKeeper, handlerCtx, vote)
+	suite.Require().NoError(err)
+	missing = handlerCtx.MsgValidator().ValidateAccessOperations(dependencies, cms.GetEvents())
+	suite.Require().Empty(missing)
+}
+
+func TestMsgPrevoteDependencyGenerator(t *testing.T) {
+	tm := time.Now().UTC()
+	valPub := secp256k1.GenPrivKey().PubKey()
+
+	testWrapper := app.NewTestWrapper(t, tm, valPub)
+
+	oraclePrevote := oracletypes.MsgAggregateExchangeRatePrevote{
+		Hash:      "hash",
+		Feeder:    "test",
+		Validator: "validator",
+	}
+
+	accessOps, err := oracleacl.MsgPrevoteDependencyGenerator(testWrapper.App.AccessControlKeeper, testWrapper.Ctx, &oraclePrevote)
+	require.NoError(t, err)
+	err = acltypes.ValidateAccessOps(accessOps)
+	require.NoError(t, err)
+
+	_, err = oracleacl.MsgPrevoteDependencyGenerator(testWrapper.App.AccessControlKeeper, testWrapper.Ctx, &banktypes.MsgSend{})
+	require.Error(t, err)
+}
+
 func TestMsgVoteDependencyGenerator(t *testing.T) {
 	tm := time.Now().UTC()
 	valPub := secp256k1.GenPrivKey().PubKey()
@@ -154,9 +206,12 @@ func TestMsgVoteDependencyGeneratorInvalidMsgType(t *testing.T) {
 
 func TestOracleDependencyGenerator(t *testing.T) {
 	oracleDependencyGenerator := oracleacl.GetOracleDependencyGenerator()
-	// verify that there's one entry, for oracle aggregate vote
-	require.Equal(t, 1, len(oracleDependencyGenerator))
+	// verify that there are two entries, for oracle aggregate prevote and vote
+	require.Equal(t, 2, len(oracleDependencyGenerator))
 	// check that oracle vote dep generator is in the map
 	_, ok := oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRateVote{})]
 	require.True(t, ok)
+	// check that oracle prevote dep generator is in the map
+	_, ok = oracleDependencyGenerator[acltypes.GenerateMessageKey(&oracletypes.MsgAggregateExchangeRatePrevote{})]
+	require.True(t, ok)
 }
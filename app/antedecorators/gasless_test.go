@@ -200,6 +200,37 @@ func TestOracleVoteGasless(t *testing.T) {
 	require.True(t, gasless)
 }
 
+func TestOraclePrevoteGasless(t *testing.T) {
+	input := oraclekeeper.CreateTestInput(t)
+
+	addr := oraclekeeper.Addrs[0]
+	valAddr, val := oraclekeeper.ValAddrs[0], oraclekeeper.ValPubKeys[0]
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	votePeriod := input.OracleKeeper.VotePeriod(input.Ctx)
+	ctx := input.Ctx.WithIsCheckTx(true).WithBlockHeight(int64(votePeriod))
+
+	// Validator created
+	_, err := sh(ctx, oraclekeeper.NewTestMsgCreateValidator(valAddr, val, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	hash := oracletypes.GetAggregateVoteHash("salt", "1usei", valAddr)
+	prevote := oracletypes.NewMsgAggregateExchangeRatePrevote(hash, addr, valAddr)
+
+	// a prevote of the previous vote period waiting to be revealed doesn't prevent a gasless prevote
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, valAddr, oracletypes.NewAggregateExchangeRatePrevote(hash, valAddr, 0))
+	gasless = true
+	err = CallGaslessDecoratorWithMsg(ctx, prevote, input.OracleKeeper)
+	require.NoError(t, err)
+	require.True(t, gasless)
+
+	// but only one prevote per vote period is gasless
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, valAddr, oracletypes.NewAggregateExchangeRatePrevote(hash, valAddr, votePeriod))
+	err = CallGaslessDecoratorWithMsg(ctx, prevote, input.OracleKeeper)
+	require.ErrorIs(t, err, oracletypes.ErrAggregatePrevoteExist)
+}
+
 func TestDexPlaceOrderGasless(t *testing.T) {
 	// this needs to be updated if its changed from constant true
 	// reset gasless
@@ -64,6 +64,7 @@ func TestPriorityAnteDecoratorOracleMsg(t *testing.T) {
 		FakeTx{
 			FakeMsgs: []sdk.Msg{
 				&oracletypes.MsgAggregateExchangeRateVote{},
+				&oracletypes.MsgAggregateExchangeRatePrevote{},
 			},
 		},
 		false,
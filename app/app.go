@@ -44,6 +44,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	gogogrpc "github.com/gogo/protobuf/grpc"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	aclmodule "github.com/cosmos/cosmos-sdk/x/accesscontrol"
@@ -125,6 +126,7 @@ import (
 	dexmodule "github.com/sei-protocol/sei-chain/x/dex"
 	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	dexmodulekeeper "github.com/sei-protocol/sei-chain/x/dex/keeper"
+	dexquery "github.com/sei-protocol/sei-chain/x/dex/keeper/query"
 	dexmoduletypes "github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 
@@ -201,18 +203,19 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		acltypes.ModuleName:            nil,
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		oracletypes.ModuleName:         nil,
-		wasm.ModuleName:                {authtypes.Burner},
-		dexmoduletypes.ModuleName:      nil,
-		tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+		acltypes.ModuleName:             nil,
+		authtypes.FeeCollectorName:      nil,
+		distrtypes.ModuleName:           nil,
+		minttypes.ModuleName:            {authtypes.Minter},
+		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:             {authtypes.Burner},
+		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+		oracletypes.ModuleName:          nil,
+		wasm.ModuleName:                 {authtypes.Burner},
+		dexmoduletypes.ModuleName:       nil,
+		dexmoduletypes.FeeCollectorName: nil,
+		tokenfactorytypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
@@ -570,6 +573,7 @@ func New(
 		wasmOpts...,
 	)
 	app.DexKeeper.SetWasmKeeper(&app.WasmKeeper)
+	app.DexKeeper.SetOracleKeeper(app.OracleKeeper)
 	dexModule := dexmodule.NewAppModule(appCodec, app.DexKeeper, app.AccountKeeper, app.BankKeeper, app.WasmKeeper, app.GetBaseApp().TracingInfo)
 	epochModule := epochmodule.NewAppModule(appCodec, app.EpochKeeper, app.AccountKeeper, app.BankKeeper)
 
@@ -583,6 +587,7 @@ func New(
 		AddRoute(dexmoduletypes.RouterKey, dexmodule.NewProposalHandler(app.DexKeeper)).
 		AddRoute(minttypes.RouterKey, mint.NewProposalHandler(app.MintKeeper)).
 		AddRoute(tokenfactorytypes.RouterKey, tokenfactorymodule.NewProposalHandler(app.TokenFactoryKeeper)).
+		AddRoute(oracletypes.RouterKey, oraclemodule.NewProposalHandler(app.OracleKeeper)).
 		AddRoute(acltypes.ModuleName, aclmodule.NewProposalHandler(app.AccessControlKeeper))
 	if len(enabledProposals) != 0 {
 		govRouter.AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.WasmKeeper, enabledProposals))
@@ -1260,7 +1265,7 @@ func (app *App) PartitionPrioritizedTxs(ctx sdk.Context, txs [][]byte) (prioriti
 	msgLoop:
 		for _, msg := range decodedTx.GetMsgs() {
 			switch msg.(type) {
-			case *oracletypes.MsgAggregateExchangeRateVote:
+			case *oracletypes.MsgAggregateExchangeRatePrevote, *oracletypes.MsgAggregateExchangeRateVote:
 				prioritized = true
 			case *dexmoduletypes.MsgRegisterContract:
 				prioritized = true
@@ -1509,6 +1514,24 @@ func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
 }
 
+// RegisterGRPCServer registers the routed gRPC services along with the dex streaming service,
+// which the query router can't serve since it only handles unary methods.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	dexmoduletypes.RegisterStreamServer(server, dexquery.NewStreamKeeperWrapper(
+		&app.DexKeeper, app.createCommittedContext, app.LastBlockHeight, dexquery.DefaultStreamPollInterval,
+	))
+}
+
+// createCommittedContext returns a read-only context on the committed state at the given height
+func (app *App) createCommittedContext(height int64) (sdk.Context, error) {
+	cacheMS, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	return sdk.NewContext(cacheMS, tmproto.Header{Height: height}, false, app.Logger()), nil
+}
+
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	dextypes "github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CheckDexInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-dex-invariants [exported-genesis-file]",
+		Short: "Check the dex invariants against an exported state",
+		Long: fmt.Sprintf(`Check the dex invariants against an exported state, e.g. one written by the export command
+
+Example:
+$ %s debug check-dex-invariants exported_genesis.json
+			`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: checkDexInvariantsCmdHandler,
+	}
+	return cmd
+}
+
+func checkDexInvariantsCmdHandler(cmd *cobra.Command, args []string) error {
+	cdc := client.GetClientContextFromCmd(cmd).Codec
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal exported state: %w", err)
+	}
+	dexGenState := dextypes.GenesisState{}
+	if err := cdc.UnmarshalJSON(appState[dextypes.ModuleName], &dexGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal dex state: %w", err)
+	}
+	moduleAddr := authtypes.NewModuleAddress(dextypes.ModuleName).String()
+	moduleBalance := sdk.ZeroInt()
+	for _, balance := range banktypes.GetGenesisStateFromAppState(cdc, appState).Balances {
+		if balance.Address == moduleAddr {
+			moduleBalance = balance.Coins.AmountOf(appparams.BaseCoinUnit)
+		}
+	}
+
+	broken := dexGenState.BrokenInvariants(moduleBalance)
+	names := []string{}
+	for name := range broken {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		cmd.Printf("%s: %d broken\n", name, len(broken[name]))
+		for _, msg := range broken[name] {
+			cmd.Printf("  %s\n", msg)
+		}
+	}
+	if len(broken) > 0 {
+		return fmt.Errorf("%d dex invariants are broken", len(broken))
+	}
+	cmd.Println("all dex invariants hold")
+	return nil
+}
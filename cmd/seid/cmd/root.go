@@ -115,6 +115,7 @@ func initRootCmd(
 	// extend debug command
 	debugCmd := debug.Cmd()
 	debugCmd.AddCommand(DumpIavlCmd())
+	debugCmd.AddCommand(CheckDexInvariantsCmd())
 
 	rootCmd.AddCommand(
 		InitCmd(app.ModuleBasics, app.DefaultNodeHome),
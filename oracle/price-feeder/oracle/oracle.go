@@ -2,6 +2,8 @@ package oracle
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -50,6 +52,20 @@ type Oracle struct {
 	jailCache       JailCache
 	healthchecks    map[string]http.Client
 	mockSetPrices   func(ctx context.Context) error
+
+	mockCommitRevealState func(ctx context.Context) (*oracletypes.QueryCommitRevealStateResponse, error)
+
+	// pendingPrevote is the last prevote broadcast while commit-reveal is enabled,
+	// which is revealed in the following vote period
+	pendingPrevote *pendingPrevote
+}
+
+// pendingPrevote holds what's needed to reveal a prevote in the vote period
+// after the one it was broadcast in.
+type pendingPrevote struct {
+	salt          string
+	exchangeRates string
+	votePeriod    float64
 }
 
 // createMappingsFromPairs is a helper function to initialize maps from currencyPairs
@@ -522,6 +538,37 @@ func (o *Oracle) GetParams(ctx context.Context) (oracletypes.Params, error) {
 	return queryResponse.Params, nil
 }
 
+// GetCommitRevealState returns whether votes of the current vote period must reveal a prevote,
+// and whether they will have to from the next vote period on. It isn't cached like the params,
+// since it can change with every vote period.
+func (o *Oracle) GetCommitRevealState(ctx context.Context) (*oracletypes.QueryCommitRevealStateResponse, error) {
+	if o.mockCommitRevealState != nil {
+		return o.mockCommitRevealState(ctx)
+	}
+	grpcConn, err := grpc.Dial(
+		o.oracleClient.GRPCEndpoint,
+		// the Cosmos SDK doesn't support any transport security mechanism
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialerFunc),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial Cosmos gRPC service: %w", err)
+	}
+
+	defer grpcConn.Close()
+	queryClient := oracletypes.NewQueryClient(grpcConn)
+
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	queryResponse, err := queryClient.CommitRevealState(ctx, &oracletypes.QueryCommitRevealStateRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get x/oracle commit-reveal state: %w", err)
+	}
+
+	return queryResponse, nil
+}
+
 func (o *Oracle) getOrSetProvider(ctx context.Context, providerName string) (provider.Provider, error) {
 	var (
 		priceProvider provider.Provider
@@ -680,27 +727,41 @@ func (o *Oracle) tick(
 	filteredPrices := filterPricesByDenomList(prices, oracleParams.Whitelist)
 	exchangeRatesStr := GenerateExchangeRatesString(filteredPrices)
 
-	// otherwise, we're in the next voting period and thus we vote
-	voteMsg := &oracletypes.MsgAggregateExchangeRateVote{
-		ExchangeRates: exchangeRatesStr,
-		Feeder:        o.oracleClient.OracleAddrString,
-		Validator:     valAddr.String(),
-	}
-
 	o.logger.Debug().
 		Str("exchange_rates", GenerateExchangeRatesString(prices)).
 		Msg("pre-filtered prices")
 
+	// otherwise, we're in the next voting period and thus we vote
+	commitReveal, err := o.GetCommitRevealState(ctx)
+	if err != nil {
+		return err
+	}
+	msgs, err := o.voteMsgs(valAddr, exchangeRatesStr, currentVotePeriod, commitReveal)
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		// commit-reveal is being disabled and there is no prevote from the previous period to reveal
+		o.logger.Info().
+			Float64("vote_period", currentVotePeriod).
+			Msg("skipping vote period without a prevote to reveal")
+		o.previousVotePeriod = currentVotePeriod
+		return nil
+	}
+
 	o.logger.Info().
-		Str("exchange_rates", voteMsg.ExchangeRates).
-		Str("validator", voteMsg.Validator).
-		Str("feeder", voteMsg.Feeder).
+		Str("exchange_rates", exchangeRatesStr).
+		Str("validator", valAddr.String()).
+		Str("feeder", o.oracleClient.OracleAddrString).
+		Bool("commit_reveal", commitReveal.Active).
 		Float64("vote_period", currentVotePeriod).
 		Int64("tick_duration", time.Since(startTime).Milliseconds()).
 		Msg("Going to broadcast vote")
 
-	resp, err := o.oracleClient.BroadcastTx(clientCtx, voteMsg)
+	resp, err := o.oracleClient.BroadcastTx(clientCtx, msgs...)
 	if err != nil {
+		// the prevote didn't make it on chain, so there is nothing to reveal next period
+		o.pendingPrevote = nil
 		o.logResponseError(err, resp, startTime, blockHeight)
 		telemetry.IncrCounter(1, "failure", "broadcast")
 		return err
@@ -720,6 +781,65 @@ func (o *Oracle) tick(
 	return nil
 }
 
+// voteMsgs returns the messages to broadcast in a vote period. While commit-reveal is active, the
+// vote reveals the prevote from the previous vote period, if there is one, and it's a plain vote
+// otherwise. As long as commit-reveal is enabled, a prevote of the current exchange rates follows,
+// which is remembered to be revealed next period. That includes the vote period in which it has
+// just been enabled, since the chain only requires votes to be revealed from the next one on.
+func (o *Oracle) voteMsgs(
+	valAddr sdk.ValAddress,
+	exchangeRatesStr string,
+	currentVotePeriod float64,
+	commitReveal *oracletypes.QueryCommitRevealStateResponse,
+) ([]sdk.Msg, error) {
+	msgs := []sdk.Msg{}
+	pending := o.pendingPrevote
+	o.pendingPrevote = nil
+	if !commitReveal.Active {
+		msgs = append(msgs, &oracletypes.MsgAggregateExchangeRateVote{
+			ExchangeRates: exchangeRatesStr,
+			Feeder:        o.oracleClient.OracleAddrString,
+			Validator:     valAddr.String(),
+		})
+	} else if pending != nil && pending.votePeriod == currentVotePeriod-1 {
+		msgs = append(msgs, &oracletypes.MsgAggregateExchangeRateVote{
+			ExchangeRates: pending.exchangeRates,
+			Feeder:        o.oracleClient.OracleAddrString,
+			Validator:     valAddr.String(),
+			Salt:          pending.salt,
+		})
+	}
+	if !commitReveal.Enabled {
+		return msgs, nil
+	}
+
+	salt, err := generateSalt()
+	if err != nil {
+		return nil, err
+	}
+	hash := oracletypes.GetAggregateVoteHash(salt, exchangeRatesStr, valAddr)
+	msgs = append(msgs, &oracletypes.MsgAggregateExchangeRatePrevote{
+		Hash:      hash.String(),
+		Feeder:    o.oracleClient.OracleAddrString,
+		Validator: valAddr.String(),
+	})
+	o.pendingPrevote = &pendingPrevote{
+		salt:          salt,
+		exchangeRates: exchangeRatesStr,
+		votePeriod:    currentVotePeriod,
+	}
+	return msgs, nil
+}
+
+// generateSalt returns a random hex encoded salt for a prevote hash.
+func generateSalt() (string, error) {
+	bz := make([]byte, 16)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bz), nil
+}
+
 func (o *Oracle) logResponseError(err error, resp *sdk.TxResponse, startTime time.Time, blockHeight int64) {
 	responseCode := -1 // success is 0
 	var txHash string
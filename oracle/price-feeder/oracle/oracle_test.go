@@ -682,6 +682,9 @@ func TestTickScenarios(t *testing.T) {
 					setPriceCount++
 					return nil
 				},
+				mockCommitRevealState: func(ctx context.Context) (*oracletypes.QueryCommitRevealStateResponse, error) {
+					return &oracletypes.QueryCommitRevealStateResponse{}, nil
+				},
 				previousVotePeriod: test.previousVotePeriod,
 				chainDenomMapping:  cdm,
 				prices:             test.prices,
@@ -739,6 +742,104 @@ func TestTickScenarios(t *testing.T) {
 	}
 }
 
+func TestTickCommitReveal(t *testing.T) {
+	validatorAddr := generateValidatorAddr()
+	feederAddr := generateAcctAddr()
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	require.NoError(t, err)
+	pairs := []config.CurrencyPair{{Base: "BTC", ChainDenom: "ubtc", Quote: "USD"}}
+	cdm, _ := createMappingsFromPairs(pairs)
+
+	var broadcasted [][]sdk.Msg
+	var broadcastErr error
+	commitReveal := &oracletypes.QueryCommitRevealStateResponse{Active: false, Enabled: true}
+	oracle := &Oracle{
+		mockSetPrices: func(ctx context.Context) error {
+			return nil
+		},
+		mockCommitRevealState: func(ctx context.Context) (*oracletypes.QueryCommitRevealStateResponse, error) {
+			return commitReveal, nil
+		},
+		chainDenomMapping: cdm,
+		prices:            map[string]sdk.Dec{"BTC": sdk.MustNewDecFromStr("2.2")},
+		paramCache: ParamCache{
+			params: &oracletypes.Params{
+				Whitelist:  denomList("ubtc"),
+				VotePeriod: 2,
+			},
+		},
+		oracleClient: client.OracleClient{
+			OracleAddrString:    feederAddr,
+			ValidatorAddrString: validatorAddr,
+			MockBroadcastTx: func(ctx sdkclient.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+				broadcasted = append(broadcasted, msgs)
+				return &sdk.TxResponse{TxHash: "0xhash"}, broadcastErr
+			},
+		},
+	}
+	ctx := context.Background()
+	exchangeRates := "2.200000000000000000ubtc"
+
+	// in the vote period commit-reveal is enabled in, votes aren't revealed yet, so a plain vote
+	// goes along with the first prevote
+	require.NoError(t, oracle.tick(ctx, sdkclient.Context{}, 1))
+	require.Len(t, broadcasted, 1)
+	require.Len(t, broadcasted[0], 2)
+	vote, ok := broadcasted[0][0].(*oracletypes.MsgAggregateExchangeRateVote)
+	require.True(t, ok)
+	require.Equal(t, exchangeRates, vote.ExchangeRates)
+	require.Empty(t, vote.Salt)
+	prevote, ok := broadcasted[0][1].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+	require.NoError(t, prevote.ValidateBasic())
+	salt := oracle.pendingPrevote.salt
+
+	// once it's active, the previous prevote is revealed and a new one committed
+	commitReveal = &oracletypes.QueryCommitRevealStateResponse{Active: true, Enabled: true}
+	require.NoError(t, oracle.tick(ctx, sdkclient.Context{}, 3))
+	require.Len(t, broadcasted, 2)
+	require.Len(t, broadcasted[1], 2)
+	vote, ok = broadcasted[1][0].(*oracletypes.MsgAggregateExchangeRateVote)
+	require.True(t, ok)
+	require.Equal(t, exchangeRates, vote.ExchangeRates)
+	require.Equal(t, salt, vote.Salt)
+	require.Equal(t, prevote.Hash, oracletypes.GetAggregateVoteHash(vote.Salt, vote.ExchangeRates, valAddr).String())
+	_, ok = broadcasted[1][1].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+
+	// a prevote from an older vote period can't be revealed anymore
+	require.NoError(t, oracle.tick(ctx, sdkclient.Context{}, 7))
+	require.Len(t, broadcasted, 3)
+	require.Len(t, broadcasted[2], 1)
+	_, ok = broadcasted[2][0].(*oracletypes.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+
+	// in the vote period commit-reveal is disabled in, the last prevote is still revealed
+	commitReveal = &oracletypes.QueryCommitRevealStateResponse{Active: true, Enabled: false}
+	require.NoError(t, oracle.tick(ctx, sdkclient.Context{}, 9))
+	require.Len(t, broadcasted, 4)
+	require.Len(t, broadcasted[3], 1)
+	vote, ok = broadcasted[3][0].(*oracletypes.MsgAggregateExchangeRateVote)
+	require.True(t, ok)
+	require.NotEmpty(t, vote.Salt)
+	require.Nil(t, oracle.pendingPrevote)
+
+	// and plain votes follow once it's inactive
+	commitReveal = &oracletypes.QueryCommitRevealStateResponse{Active: false, Enabled: false}
+	require.NoError(t, oracle.tick(ctx, sdkclient.Context{}, 11))
+	require.Len(t, broadcasted, 5)
+	require.Len(t, broadcasted[4], 1)
+	vote, ok = broadcasted[4][0].(*oracletypes.MsgAggregateExchangeRateVote)
+	require.True(t, ok)
+	require.Empty(t, vote.Salt)
+
+	// a failed broadcast drops the prevote
+	commitReveal = &oracletypes.QueryCommitRevealStateResponse{Active: true, Enabled: true}
+	broadcastErr = fmt.Errorf("test error")
+	require.Error(t, oracle.tick(ctx, sdkclient.Context{}, 13))
+	require.Nil(t, oracle.pendingPrevote)
+}
+
 func TestFilterPricesWithDenomList(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -51,6 +51,17 @@ func (qp QueryPlugin) HandleOracleQuery(ctx sdk.Context, queryData json.RawMessa
 			return nil, oracletypes.ErrEncodingExchangeRates
 		}
 
+		return bz, nil
+	case parsedQuery.FreshExchangeRate != nil:
+		res, err := qp.oracleHandler.GetFreshExchangeRate(ctx, parsedQuery.FreshExchangeRate)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingExchangeRates
+		}
+
 		return bz, nil
 	case parsedQuery.OracleTwaps != nil:
 		res, err := qp.oracleHandler.GetOracleTwaps(ctx, parsedQuery.OracleTwaps)
@@ -62,6 +73,17 @@ func (qp QueryPlugin) HandleOracleQuery(ctx sdk.Context, queryData json.RawMessa
 			return nil, oracletypes.ErrEncodingOracleTwaps
 		}
 
+		return bz, nil
+	case parsedQuery.PriceStats != nil:
+		res, err := qp.oracleHandler.GetPriceStats(ctx, parsedQuery.PriceStats)
+		if err != nil {
+			return nil, err
+		}
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, oracletypes.ErrEncodingPriceStats
+		}
+
 		return bz, nil
 	default:
 		return nil, oracletypes.ErrUnknownSeiOracleQuery
@@ -114,6 +114,82 @@ func TestWasmGetOracleExchangeRates(t *testing.T) {
 	require.Equal(t, oracletypes.QueryExchangeRatesResponse{DenomOracleExchangeRatePairs: oracletypes.DenomOracleExchangeRatePairs{oracletypes.NewDenomOracleExchangeRatePair(oracleutils.MicroAtomDenom, sdk.NewDec(12), sdk.NewInt(11))}}, parsedRes2)
 }
 
+func TestWasmGetOracleFreshExchangeRate(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := oraclebinding.SeiOracleQuery{FreshExchangeRate: &oracletypes.QueryExchangeRateRequest{Denom: oracleutils.MicroAtomDenom}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	// there is no rate yet
+	_, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.ErrorIs(t, err, oracletypes.ErrUnknownDenom)
+
+	params := testWrapper.App.OracleKeeper.GetParams(testWrapper.Ctx)
+	params.MaxStaleness = 5
+	testWrapper.App.OracleKeeper.SetParams(testWrapper.Ctx, params)
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(11)
+	testWrapper.App.OracleKeeper.SetBaseExchangeRate(testWrapper.Ctx, oracleutils.MicroAtomDenom, sdk.NewDec(12))
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(16)
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes oracletypes.QueryExchangeRateResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.QueryExchangeRateResponse{OracleExchangeRate: oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(12), LastUpdate: sdk.NewInt(11)}, Age: 5}, parsedRes)
+
+	// the rate is stale once it is older than the max staleness
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(17)
+	_, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.ErrorIs(t, err, oracletypes.ErrStaleExchangeRate)
+}
+
+func TestWasmGetOraclePriceStats(t *testing.T) {
+	testWrapper, customQuerier := SetupWasmbindingTest(t)
+
+	req := oraclebinding.SeiOracleQuery{PriceStats: &oracletypes.QueryPriceStatsRequest{LookbackSeconds: 200}}
+	queryData, err := json.Marshal(req)
+	require.NoError(t, err)
+	query := wasmbinding.SeiQueryWrapper{Route: wasmbinding.OracleRoute, QueryData: queryData}
+
+	rawQuery, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	// this should error because there are no snapshots
+	_, err = customQuerier(testWrapper.Ctx, rawQuery)
+	require.Error(t, err)
+
+	testWrapper.Ctx = testWrapper.Ctx.WithBlockHeight(14).WithBlockTime(time.Unix(3700, 0))
+	for i, rate := range []int64{20, 40} {
+		testWrapper.App.OracleKeeper.AddPriceSnapshot(testWrapper.Ctx, oracletypes.PriceSnapshot{SnapshotTimestamp: int64(3600 + 50*i), PriceSnapshotItems: oracletypes.PriceSnapshotItems{
+			oracletypes.NewPriceSnapshotItem(oracleutils.MicroAtomDenom, oracletypes.OracleExchangeRate{ExchangeRate: sdk.NewDec(rate), LastUpdate: sdk.NewInt(int64(10 + i))}),
+		}})
+	}
+
+	res, err := customQuerier(testWrapper.Ctx, rawQuery)
+	require.NoError(t, err)
+
+	var parsedRes oracletypes.QueryPriceStatsResponse
+	err = json.Unmarshal(res, &parsedRes)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(parsedRes.OraclePriceStats))
+	stats := parsedRes.OraclePriceStats[0]
+	require.Equal(t, oracleutils.MicroAtomDenom, stats.Denom)
+	require.Equal(t, uint64(2), stats.SampleCount)
+	require.Equal(t, int64(100), stats.LookbackSeconds)
+	require.Equal(t, sdk.NewDec(30), stats.Median)
+	require.Equal(t, sdk.NewDec(20), stats.Min)
+	require.Equal(t, sdk.NewDec(40), stats.Max)
+	// a single log return has no deviation
+	require.Equal(t, sdk.ZeroDec(), stats.Volatility)
+}
+
 func TestWasmGetOracleTwaps(t *testing.T) {
 	testWrapper, customQuerier := SetupWasmbindingTest(t)
 
@@ -47,33 +47,14 @@ func (tsmd TickSizeMultipleDecorator) CheckTickSizeMultiple(ctx sdk.Context, msg
 		switch msg.(type) { //nolint:gocritic,gosimple // the linter is telling us we can make this faster, and this should be addressed later.
 		case *types.MsgPlaceOrders:
 			msgPlaceOrders := msg.(*types.MsgPlaceOrders) //nolint:gosimple // the linter is telling us we can make this faster, and this should be addressed later.
-			contractAddr := msgPlaceOrders.ContractAddr
-			for _, order := range msgPlaceOrders.Orders {
-				priceTickSize, found := tsmd.dexKeeper.GetPriceTickSizeForPair(ctx, contractAddr,
-					types.Pair{
-						PriceDenom: order.PriceDenom,
-						AssetDenom: order.AssetDenom,
-					})
-				if !found {
-					return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no price ticksize configured", order.PriceDenom, order.AssetDenom)
-				}
-				if !IsDecimalMultipleOf(order.Price, priceTickSize) {
-					// Allow Market Orders with Price 0
-					if !(IsMarketOrder(order) && order.Price.IsZero()) {
-						return sdkerrors.Wrapf(errors.New("ErrPriceNotMultipleOfTickSize"), "price needs to be non-zero and multiple of price tick size")
-					}
-				}
-				quantityTickSize, found := tsmd.dexKeeper.GetQuantityTickSizeForPair(ctx, contractAddr,
-					types.Pair{
-						PriceDenom: order.PriceDenom,
-						AssetDenom: order.AssetDenom,
-					})
-				if !found {
-					return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no quantity ticksize configured", order.PriceDenom, order.AssetDenom)
-				}
-				if !IsDecimalMultipleOf(order.Quantity, quantityTickSize) {
-					return sdkerrors.Wrapf(errors.New("ErrQuantityNotMultipleOfTickSize"), "quantity needs to be non-zero and multiple of quantity tick size")
-				}
+			if err := tsmd.checkOrdersTickSizeMultiple(ctx, msgPlaceOrders.ContractAddr, msgPlaceOrders.Orders); err != nil {
+				return err
+			}
+			continue
+		case *types.MsgReplaceOrders:
+			msgReplaceOrders := msg.(*types.MsgReplaceOrders) //nolint:gosimple // the linter is telling us we can make this faster, and this should be addressed later.
+			if err := tsmd.checkOrdersTickSizeMultiple(ctx, msgReplaceOrders.ContractAddr, msgReplaceOrders.Orders); err != nil {
+				return err
 			}
 			continue
 		default:
@@ -85,6 +66,84 @@ func (tsmd TickSizeMultipleDecorator) CheckTickSizeMultiple(ctx sdk.Context, msg
 	return nil
 }
 
+func (tsmd TickSizeMultipleDecorator) checkOrdersTickSizeMultiple(ctx sdk.Context, contractAddr string, orders []*types.Order) error {
+	for _, order := range orders {
+		priceTickSize, found := tsmd.dexKeeper.GetPriceTickSizeForPair(ctx, contractAddr,
+			types.Pair{
+				PriceDenom: order.PriceDenom,
+				AssetDenom: order.AssetDenom,
+			})
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no price ticksize configured", order.PriceDenom, order.AssetDenom)
+		}
+		if !IsDecimalMultipleOf(order.Price, priceTickSize) {
+			// Allow Market Orders (and Stop Loss orders which become Market Orders once triggered) with Price 0
+			if !((IsMarketOrder(order) || order.OrderType == types.OrderType_STOPLOSS) && order.Price.IsZero()) {
+				return sdkerrors.Wrapf(errors.New("ErrPriceNotMultipleOfTickSize"), "price needs to be non-zero and multiple of price tick size")
+			}
+		}
+		quantityTickSize, found := tsmd.dexKeeper.GetQuantityTickSizeForPair(ctx, contractAddr,
+			types.Pair{
+				PriceDenom: order.PriceDenom,
+				AssetDenom: order.AssetDenom,
+			})
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no quantity ticksize configured", order.PriceDenom, order.AssetDenom)
+		}
+		if !IsDecimalMultipleOf(order.Quantity, quantityTickSize) {
+			return sdkerrors.Wrapf(errors.New("ErrQuantityNotMultipleOfTickSize"), "quantity needs to be non-zero and multiple of quantity tick size")
+		}
+	}
+	return nil
+}
+
+// PriceBandDecorator rejects limit orders priced further away from the pair's reference price
+// than the price band allows before they enter the mempool. Delivered orders are checked again when
+// they're placed.
+type PriceBandDecorator struct {
+	dexKeeper keeper.Keeper
+}
+
+// NewPriceBandDecorator returns new price band check decorator instance
+func NewPriceBandDecorator(dexKeeper keeper.Keeper) PriceBandDecorator {
+	return PriceBandDecorator{
+		dexKeeper: dexKeeper,
+	}
+}
+
+// AnteHandle is the interface called in RunTx() function, which CheckTx() calls with the runTxModeCheck mode
+func (pbd PriceBandDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	if !simulate {
+		if ctx.IsCheckTx() {
+			err := pbd.CheckPriceBand(ctx, tx.GetMsgs())
+			if err != nil {
+				return ctx, err
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// CheckPriceBand checks whether the msgs' orders are priced within the price band
+func (pbd PriceBandDecorator) CheckPriceBand(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *types.MsgPlaceOrders:
+			if err := pbd.dexKeeper.CheckOrdersPriceBand(ctx, m.ContractAddr, m.Orders); err != nil {
+				return err
+			}
+		case *types.MsgReplaceOrders:
+			if err := pbd.dexKeeper.CheckOrdersPriceBand(ctx, m.ContractAddr, m.Orders); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // Check whether order is market order type
 func IsMarketOrder(order *types.Order) bool {
 	return order.OrderType == types.OrderType_MARKET || order.OrderType == types.OrderType_FOKMARKET || order.OrderType == types.OrderType_FOKMARKETBYVALUE
@@ -137,6 +196,17 @@ func (d CheckDexGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		case *types.MsgCancelOrders:
 			numDependencies := len(memState.GetContractToDependencies(ctx, m.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
 			dexGasRequired += params.DefaultGasPerCancel * uint64(len(m.Cancellations)*numDependencies)
+		case *types.MsgReplaceOrders:
+			numDependencies := len(memState.GetContractToDependencies(ctx, m.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
+			dexGasRequired += params.DefaultGasPerOrder * uint64(len(m.Orders)*numDependencies)
+			for _, order := range m.Orders {
+				dexGasRequired += params.DefaultGasPerOrderDataByte * uint64(len(order.Data))
+			}
+			dexGasRequired += params.DefaultGasPerCancel * uint64(len(m.Cancellations)*numDependencies)
+		case *types.MsgCancelAll:
+			// charged as a single cancel since the matching orders are only known at EndBlock
+			numDependencies := len(memState.GetContractToDependencies(ctx, m.ContractAddr, d.dexKeeper.GetContractWithoutGasCharge))
+			dexGasRequired += params.DefaultGasPerCancel * uint64(numDependencies)
 		}
 	}
 	if dexGasRequired == 0 {
@@ -160,7 +230,7 @@ func (d CheckDexGasDecorator) AnteDeps(txDeps []sdkacltypes.AccessOperation, tx
 	for _, msg := range tx.GetMsgs() {
 		// Error checking will be handled in AnteHandler
 		switch msg.(type) {
-		case *types.MsgPlaceOrders, *types.MsgCancelOrders:
+		case *types.MsgPlaceOrders, *types.MsgCancelOrders, *types.MsgCancelAll, *types.MsgReplaceOrders:
 			deps = append(deps, []sdkacltypes.AccessOperation{
 				// read the dex contract info
 				{
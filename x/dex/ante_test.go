@@ -281,3 +281,46 @@ func TestTickSizeMultipleDecorator(t *testing.T) {
 	_, err = decorator.AnteHandle(ctx, tx, false, terminator)
 	require.NotNil(t, err)
 }
+
+func TestPriceBandDecorator(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithIsCheckTx(true)
+	decorator := dex.NewPriceBandDecorator(*keeper)
+	terminator := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) { return ctx, nil }
+	newTx := func(price string, orderType types.OrderType) TestTx {
+		return TestTx{
+			msgs: []sdk.Msg{
+				types.NewMsgPlaceOrders("someone", []*types.Order{{
+					ContractAddr: keepertest.TestContract,
+					PriceDenom:   keepertest.TestPair.PriceDenom,
+					AssetDenom:   keepertest.TestPair.AssetDenom,
+					Price:        sdk.MustNewDecFromStr(price),
+					Quantity:     sdk.OneDec(),
+					OrderType:    orderType,
+				}}, keepertest.TestContract, sdk.NewCoins())},
+		}
+	}
+	params := types.DefaultParams()
+	params.PriceBand = sdk.MustNewDecFromStr("0.1")
+	keeper.SetParams(ctx, params)
+
+	// no reference price yet
+	_, err := decorator.AnteHandle(ctx, newTx("1000", types.OrderType_LIMIT), false, terminator)
+	require.Nil(t, err)
+
+	keepertest.SeedPriceSnapshot(ctx, keeper, "100", 1)
+	_, err = decorator.AnteHandle(ctx, newTx("110", types.OrderType_LIMIT), false, terminator)
+	require.Nil(t, err)
+	_, err = decorator.AnteHandle(ctx, newTx("90", types.OrderType_LIMIT), false, terminator)
+	require.Nil(t, err)
+	_, err = decorator.AnteHandle(ctx, newTx("111", types.OrderType_LIMIT), false, terminator)
+	require.ErrorIs(t, err, types.ErrPriceOutsideBand)
+	_, err = decorator.AnteHandle(ctx, newTx("89", types.OrderType_STOPLIMIT), false, terminator)
+	require.ErrorIs(t, err, types.ErrPriceOutsideBand)
+	// market orders are exempt
+	_, err = decorator.AnteHandle(ctx, newTx("200", types.OrderType_MARKET), false, terminator)
+	require.Nil(t, err)
+	// only checked in CheckTx
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), newTx("111", types.OrderType_LIMIT), false, terminator)
+	require.Nil(t, err)
+}
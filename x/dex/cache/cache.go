@@ -78,6 +78,16 @@ func (s *MemState) GetBlockCancels(ctx sdk.Context, contractAddr types.ContractA
 	)
 }
 
+func (s *MemState) GetBlockCancelAlls(ctx sdk.Context, contractAddr types.ContractAddress) *BlockCancelAlls {
+	s.SynchronizeAccess(ctx, contractAddr)
+	return NewCancelAlls(
+		prefix.NewStore(
+			ctx.KVStore(s.storeKey),
+			types.MemCancelAllPrefix(string(contractAddr)),
+		),
+	)
+}
+
 func (s *MemState) GetDepositInfo(ctx sdk.Context, contractAddr types.ContractAddress) *DepositInfo {
 	s.SynchronizeAccess(ctx, contractAddr)
 	return NewDepositInfo(
@@ -117,6 +127,7 @@ func (s *MemState) GetContractToProcess() *datastructures.SyncSet[string] {
 func (s *MemState) Clear(ctx sdk.Context) {
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemOrderKey), func(_ []byte) bool { return true })
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemCancelKey), func(_ []byte) bool { return true })
+	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemCancelAllKey), func(_ []byte) bool { return true })
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemDepositKey), func(_ []byte) bool { return true })
 
 	newContractToDependencies := datastructures.NewSyncSet([]string{})
@@ -158,6 +169,13 @@ func (s *MemState) DeepFilterAccount(ctx sdk.Context, account string) {
 		}
 		return c.Creator == account
 	})
+	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemCancelAllKey), func(v []byte) bool {
+		var c types.MsgCancelAll
+		if err := c.Unmarshal(v); err != nil {
+			panic(err)
+		}
+		return c.Creator == account
+	})
 	DeepDelete(ctx.KVStore(s.storeKey), types.KeyPrefix(types.MemDepositKey), func(v []byte) bool {
 		var d types.DepositInfoEntry
 		if err := d.Unmarshal(v); err != nil {
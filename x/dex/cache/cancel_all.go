@@ -0,0 +1,45 @@
+package dex
+
+import (
+	"crypto/sha256"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// BlockCancelAlls holds the cancel-all requests of a contract received in the current block.
+// They are resolved into individual cancellations at EndBlock.
+type BlockCancelAlls struct {
+	cancelAllStore *prefix.Store
+}
+
+func NewCancelAlls(cancelAllStore prefix.Store) *BlockCancelAlls {
+	return &BlockCancelAlls{cancelAllStore: &cancelAllStore}
+}
+
+func (o *BlockCancelAlls) Get() (list []*types.MsgCancelAll) {
+	iterator := sdk.KVStorePrefixIterator(o.cancelAllStore, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MsgCancelAll
+		if err := val.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		list = append(list, &val)
+	}
+
+	return
+}
+
+// identical requests from the same creator are only kept once
+func (o *BlockCancelAlls) Add(newItem *types.MsgCancelAll) {
+	valbz, err := newItem.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(valbz)
+	o.cancelAllStore.Set(append([]byte(newItem.Creator), hash[:]...), valbz)
+}
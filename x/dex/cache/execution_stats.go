@@ -0,0 +1,118 @@
+package dex
+
+import (
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+const CtxKeyExecutionStats = CtxKeyType("execution-stats")
+
+type ExecutionPhase int
+
+const (
+	PlacementPhase ExecutionPhase = iota
+	CancellationPhase
+	SettlementPhase
+	DepositPhase
+)
+
+// ExecutionStats accumulates the sudo gas and time of each contract over an EndBlock iteration.
+// Contracts run concurrently, so it is safe for concurrent use.
+type ExecutionStats struct {
+	mu        sync.Mutex
+	stats     map[string]*types.ContractExecutionStats
+	wallTimes map[string]time.Duration
+}
+
+func NewExecutionStats() *ExecutionStats {
+	return &ExecutionStats{
+		stats:     map[string]*types.ContractExecutionStats{},
+		wallTimes: map[string]time.Duration{},
+	}
+}
+
+func (s *ExecutionStats) AddSudo(contractAddr string, phase ExecutionPhase, gas uint64, wallTime time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats, ok := s.stats[contractAddr]
+	if !ok {
+		stats = &types.ContractExecutionStats{}
+		s.stats[contractAddr] = stats
+	}
+	switch phase {
+	case PlacementPhase:
+		stats.PlacementGas += gas
+	case CancellationPhase:
+		stats.CancellationGas += gas
+	case SettlementPhase:
+		stats.SettlementGas += gas
+	case DepositPhase:
+		stats.DepositGas += gas
+	}
+	s.wallTimes[contractAddr] += wallTime
+}
+
+// Get returns the stats of a contract without a height or error, and the time spent in its sudo calls
+func (s *ExecutionStats) Get(contractAddr string) (types.ContractExecutionStats, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stats, ok := s.stats[contractAddr]; ok {
+		return *stats, s.wallTimes[contractAddr]
+	}
+	return types.ContractExecutionStats{}, 0
+}
+
+func GetExecutionStats(ctx sdk.Context) *ExecutionStats {
+	if ctx.Context() == nil {
+		return nil
+	}
+	stats, ok := ctx.Context().Value(CtxKeyExecutionStats).(*ExecutionStats)
+	if !ok {
+		return nil
+	}
+	return stats
+}
+
+// ExecutionWallTimes keeps the time contracts spent in sudo calls in recent blocks. Wall times
+// differ between nodes, so they are kept in memory and never written to state.
+type ExecutionWallTimes struct {
+	mu        sync.RWMutex
+	wallTimes map[string]map[uint64]time.Duration
+}
+
+func NewExecutionWallTimes() *ExecutionWallTimes {
+	return &ExecutionWallTimes{wallTimes: map[string]map[uint64]time.Duration{}}
+}
+
+func (w *ExecutionWallTimes) Set(contractAddr string, height uint64, wallTime time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.wallTimes[contractAddr]; !ok {
+		w.wallTimes[contractAddr] = map[uint64]time.Duration{}
+	}
+	w.wallTimes[contractAddr][height] = wallTime
+}
+
+func (w *ExecutionWallTimes) Get(contractAddr string, height uint64) (time.Duration, bool) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	wallTime, ok := w.wallTimes[contractAddr][height]
+	return wallTime, ok
+}
+
+// DeleteBefore removes the wall times of a contract recorded before the given height
+func (w *ExecutionWallTimes) DeleteBefore(contractAddr string, height uint64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for h := range w.wallTimes[contractAddr] {
+		if h < height {
+			delete(w.wallTimes[contractAddr], h)
+		}
+	}
+	if len(w.wallTimes[contractAddr]) == 0 {
+		delete(w.wallTimes, contractAddr)
+	}
+}
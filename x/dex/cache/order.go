@@ -27,6 +27,12 @@ func (o *BlockOrders) Add(newItem *types.Order) {
 	}
 }
 
+func (o *BlockOrders) Has(id uint64) bool {
+	keybz := make([]byte, 8)
+	binary.BigEndian.PutUint64(keybz, id)
+	return o.orderStore.Has(keybz)
+}
+
 func (o *BlockOrders) GetByID(id uint64) *types.Order {
 	keybz := make([]byte, 8)
 	binary.BigEndian.PutUint64(keybz, id)
@@ -119,6 +125,29 @@ func (o *BlockOrders) GetLimitOrders(direction types.PositionDirection) []*types
 	return o.getOrdersByCriteria(types.OrderType_LIMIT, direction)
 }
 
+// GetBracketOrders returns take-profit/stop-loss orders placed in this block, which are
+// not matched directly but stored until their parent order fills.
+func (o *BlockOrders) GetBracketOrders() []*types.Order {
+	res := []*types.Order{}
+	for _, order := range o.Get() {
+		if order.BracketRole != types.BracketRole_NO_BRACKET && order.Status != types.OrderStatus_FAILED_TO_PLACE {
+			res = append(res, order)
+		}
+	}
+	return res
+}
+
+// GetTriggeredOrders returns stop loss/limit orders placed in this block, which are
+// not matched directly but stored until their trigger price is reached.
+func (o *BlockOrders) GetTriggeredOrders() []*types.Order {
+	res := []*types.Order{}
+	for _, direction := range []types.PositionDirection{types.PositionDirection_LONG, types.PositionDirection_SHORT} {
+		res = append(res, o.getOrdersByCriteria(types.OrderType_STOPLOSS, direction)...)
+		res = append(res, o.getOrdersByCriteria(types.OrderType_STOPLIMIT, direction)...)
+	}
+	return res
+}
+
 func (o *BlockOrders) getOrdersByCriteria(orderType types.OrderType, direction types.PositionDirection) []*types.Order {
 	res := []*types.Order{}
 	iterator := sdk.KVStorePrefixIterator(o.orderStore, []byte{})
@@ -133,7 +162,7 @@ func (o *BlockOrders) getOrdersByCriteria(orderType types.OrderType, direction t
 		if val.OrderType != orderType || val.PositionDirection != direction {
 			continue
 		}
-		if val.Status == types.OrderStatus_FAILED_TO_PLACE {
+		if val.Status == types.OrderStatus_FAILED_TO_PLACE || val.BracketRole != types.BracketRole_NO_BRACKET {
 			continue
 		}
 		res = append(res, &val)
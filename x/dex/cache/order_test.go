@@ -255,3 +255,49 @@ func TestGetSortedMarketOrders(t *testing.T) {
 	require.Equal(t, uint64(13), marketSells[7].Id)
 	require.Equal(t, uint64(19), marketSells[8].Id)
 }
+
+func TestGetTriggeredOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	stateOne := dex.NewMemState(keeper.GetMemStoreKey())
+	stateOne.GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), keepertest.TestPair).Add(&types.Order{
+		Id:                1,
+		Account:           "test",
+		ContractAddr:      TEST_CONTRACT,
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_STOPLOSS,
+		TriggerPrice:      sdk.MustNewDecFromStr("150"),
+	})
+	stateOne.GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), keepertest.TestPair).Add(&types.Order{
+		Id:                2,
+		Account:           "test",
+		ContractAddr:      TEST_CONTRACT,
+		PositionDirection: types.PositionDirection_SHORT,
+		OrderType:         types.OrderType_STOPLIMIT,
+		Price:             sdk.MustNewDecFromStr("90"),
+		TriggerPrice:      sdk.MustNewDecFromStr("100"),
+	})
+	stateOne.GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), keepertest.TestPair).Add(&types.Order{
+		Id:                3,
+		Account:           "test",
+		ContractAddr:      TEST_CONTRACT,
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_LIMIT,
+		Price:             sdk.MustNewDecFromStr("100"),
+	})
+	stateOne.GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), keepertest.TestPair).Add(&types.Order{
+		Id:                4,
+		Account:           "test",
+		ContractAddr:      TEST_CONTRACT,
+		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_STOPLIMIT,
+		Status:            types.OrderStatus_FAILED_TO_PLACE,
+		Price:             sdk.MustNewDecFromStr("100"),
+		TriggerPrice:      sdk.MustNewDecFromStr("100"),
+	})
+
+	triggeredOrders := stateOne.GetBlockOrders(
+		ctx, types.ContractAddress(TEST_CONTRACT), keepertest.TestPair).GetTriggeredOrders()
+	require.Equal(t, 2, len(triggeredOrders))
+	require.Equal(t, uint64(1), triggeredOrders[0].Id)
+	require.Equal(t, uint64(2), triggeredOrders[1].Id)
+}
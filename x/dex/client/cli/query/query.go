@@ -41,6 +41,16 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetOrdersByID())
 	cmd.AddCommand(CmdGetMatchResult())
 	cmd.AddCommand(CmdGetOrderCount())
+	cmd.AddCommand(CmdGetTriggeredOrders())
+	cmd.AddCommand(CmdGetCandles())
+	cmd.AddCommand(CmdGetAccountFills())
+	cmd.AddCommand(CmdGetRentTopUp())
+	cmd.AddCommand(CmdGetPairHalts())
+	cmd.AddCommand(CmdGetRentHistory())
+	cmd.AddCommand(CmdGetContractExecutionStats())
+	cmd.AddCommand(CmdGetContractDependencyGraph())
+	cmd.AddCommand(CmdContractRegistrationDryRun())
+	cmd.AddCommand(CmdGetDepth())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,61 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const flagFromHeight = "from-height"
+
+func CmdGetAccountFills() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-account-fills [contract address] [account] [price denom] [asset denom]",
+		Short: "Query fills of an account",
+		Long: strings.TrimSpace(`
+			Lists the settled fills of an account in a pair for a given contract address, oldest first. Fills are only kept for the number of blocks set by the account_fill_retention param. Use --from-height to skip fills settled before a height.
+		`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetUint64(flagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetAccountFillsRequest{
+				ContractAddr: args[0],
+				Account:      args[1],
+				PriceDenom:   args[2],
+				AssetDenom:   args[3],
+				FromHeight:   fromHeight,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.GetAccountFills(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagFromHeight, 0, "Only list fills settled at or after this height")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-candles [contract address] [price denom] [asset denom] [interval in seconds]",
+		Short: "Query candles",
+		Long: strings.TrimSpace(`
+			Lists the OHLCV candles of a pair for a given contract address, oldest first. Supported intervals are 60, 300, 3600 and 86400 seconds. Use --reverse to list the latest candles first.
+		`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetCandlesRequest{
+				ContractAddr:      args[0],
+				PriceDenom:        args[1],
+				AssetDenom:        args[2],
+				IntervalInSeconds: interval,
+				Pagination:        pageReq,
+			}
+
+			res, err := queryClient.GetCandles(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
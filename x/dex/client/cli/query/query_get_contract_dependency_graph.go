@@ -0,0 +1,80 @@
+package query
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetContractDependencyGraph() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contract-dependency-graph",
+		Short: "Query the dependency graph of registered contracts and the order they are executed in",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetContractDependencyGraph(context.Background(), &types.QueryGetContractDependencyGraphRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdContractRegistrationDryRun() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-registration-dry-run [creator] [contract address] [code id] [need order matching] [deposit] [dependency1 dependency2 ...]",
+		Short: "Query how registering a contract with the given dependencies would change the dependency graph",
+		Args:  cobra.MinimumNArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argCodeID, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+			argNeedMatching, err := strconv.ParseBool(args[3])
+			if err != nil {
+				return err
+			}
+			argDeposit, err := cast.ToUint64E(args[4])
+			if err != nil {
+				return err
+			}
+			var dependencies []*types.ContractDependencyInfo
+			for _, dependency := range args[5:] {
+				dependencies = append(dependencies, &types.ContractDependencyInfo{Dependency: dependency})
+			}
+			params := &types.QueryContractRegistrationDryRunRequest{
+				Msg: types.NewMsgRegisterContract(args[0], argCodeID, args[1], argNeedMatching, dependencies, argDeposit),
+			}
+
+			res, err := queryClient.GetContractRegistrationDryRun(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
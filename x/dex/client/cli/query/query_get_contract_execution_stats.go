@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetContractExecutionStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-contract-execution-stats [contract address]",
+		Short: "Query EndBlock execution stats of a contract",
+		Long: strings.TrimSpace(`
+			Lists the sudo gas a contract used per block by phase, along with the error that dropped it from the block if any, oldest first. Stats are only kept for the number of blocks set by the execution_stats_retention param. Wall times are only known to the node serving the query.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetContractExecutionStatsRequest{
+				ContractAddr: args[0],
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.GetContractExecutionStats(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
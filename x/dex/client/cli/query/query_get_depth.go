@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagLevels      = "levels"
+	flagAggregation = "aggregation"
+)
+
+func CmdGetDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-depth [contract address] [price denom] [asset denom]",
+		Short: "Query aggregated order book depth",
+		Long: strings.TrimSpace(`
+			Lists the total quantity resting at each price level of a pair, best price first. Use --aggregation to bucket levels to a multiple of the pair's price tick size; bids are rounded down and asks up.
+		`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			levels, err := cmd.Flags().GetUint64(flagLevels)
+			if err != nil {
+				return err
+			}
+			aggregation, err := cmd.Flags().GetUint64(flagAggregation)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetDepthRequest{
+				ContractAddr: args[0],
+				PriceDenom:   args[1],
+				AssetDenom:   args[2],
+				Levels:       levels,
+				Aggregation:  aggregation,
+			}
+
+			res, err := queryClient.GetDepth(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagLevels, 0, "Number of levels to list per side (defaults to 20)")
+	cmd.Flags().Uint64(flagAggregation, 0, "Bucket levels to this many price ticks")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetRentHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-rent-history [contract address]",
+		Short: "Query rent charges of a contract",
+		Long: strings.TrimSpace(`
+			Lists the rent charged to a contract per block, oldest first. Charges are only kept for the number of blocks set by the rent_history_retention param.
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetRentHistoryRequest{
+				ContractAddr: args[0],
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.GetRentHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
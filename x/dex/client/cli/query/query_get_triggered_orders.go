@@ -0,0 +1,48 @@
+package query
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetTriggeredOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-triggered-orders [contract-address] [account]",
+		Short: "Query get pending stop loss/limit orders for account",
+		Long: strings.TrimSpace(`
+			Get all stop loss/limit orders of an account that are pending in the trigger book of the orderbook specified by contract address.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqContractAddr := args[0]
+			reqAccount := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetTriggeredOrdersRequest{
+				ContractAddr: reqContractAddr,
+				Account:      reqAccount,
+			}
+
+			res, err := queryClient.GetTriggeredOrders(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -58,3 +58,89 @@ func NewAddAssetProposalTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewUpdateFeeRatesProposalTxCmd returns a CLI command handler for creating
+// an update fee rates proposal governance transaction.
+func NewUpdateFeeRatesProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-fee-rates-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update fee rates proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to update the maker and taker fee rates of registered pairs.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseUpdateFeeRatesProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.UpdateFeeRatesProposal{Title: proposal.Title, Description: proposal.Description, FeeRateList: proposal.FeeRateList}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdatePairHaltsProposalTxCmd returns a CLI command handler for creating
+// an update pair halts proposal governance transaction.
+func NewUpdatePairHaltsProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pair-halts-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update pair halts proposal",
+		Long: strings.TrimSpace(`
+			Submit a proposal to halt or resume trading of registered pairs.
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := cutils.ParseUpdatePairHaltsProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.UpdatePairHaltsProposal{Title: proposal.Title, Description: proposal.Description, PairHaltList: proposal.PairHaltList}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -26,6 +26,8 @@ func GetTxCmd() *cobra.Command {
 	}
 	cmd.AddCommand(CmdPlaceOrders())
 	cmd.AddCommand(CmdCancelOrders())
+	cmd.AddCommand(CmdCancelAll())
+	cmd.AddCommand(CmdReplaceOrders())
 	cmd.AddCommand(CmdRegisterContract())
 	cmd.AddCommand(CmdRegisterPairs())
 	cmd.AddCommand(CmdUnregisterContract())
@@ -33,7 +35,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdatePriceTickSize())
 	cmd.AddCommand(CmdUpdateQuantityTickSize())
 	cmd.AddCommand(NewAddAssetProposalTxCmd())
+	cmd.AddCommand(NewUpdateFeeRatesProposalTxCmd())
+	cmd.AddCommand(NewUpdatePairHaltsProposalTxCmd())
 	cmd.AddCommand(CmdUnsuspendContract())
+	cmd.AddCommand(CmdSetRentTopUp())
+	cmd.AddCommand(CmdSetPairHalt())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
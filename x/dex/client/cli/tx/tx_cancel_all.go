@@ -0,0 +1,100 @@
+package tx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagPair      = "pair"
+	flagDirection = "direction"
+	flagMinPrice  = "min-price"
+	flagMaxPrice  = "max-price"
+)
+
+func CmdCancelAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all [contract address]",
+		Short: "Cancel all resting orders of the sender",
+		Long: strings.TrimSpace(`
+			Cancel all resting orders of the sender on the orderbook specified by contract-address, optionally
+			restricted to a pair, a direction and a price range. The orders are resolved at the end of the block.
+
+			Example: cancel-all [contract address] --pair=USDC/ATOM --direction=LONG --max-price=1.5
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			filter := types.CancelAllFilter{}
+			pairStr, err := cmd.Flags().GetString(flagPair)
+			if err != nil {
+				return err
+			}
+			if pairStr != "" {
+				denoms := strings.Split(pairStr, "/")
+				if len(denoms) != 2 {
+					return fmt.Errorf("invalid pair %s, expected PriceDenom/AssetDenom", pairStr)
+				}
+				filter.PriceDenom, filter.AssetDenom = denoms[0], denoms[1]
+			}
+			directionStr, err := cmd.Flags().GetString(flagDirection)
+			if err != nil {
+				return err
+			}
+			if directionStr != "" {
+				direction, err := types.GetPositionDirectionFromStr(directionStr)
+				if err != nil {
+					return err
+				}
+				filter.PositionDirections = []types.PositionDirection{direction}
+			}
+			if filter.MinPrice, err = getOptionalDecFlag(cmd, flagMinPrice); err != nil {
+				return err
+			}
+			if filter.MaxPrice, err = getOptionalDecFlag(cmd, flagMaxPrice); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAll(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				&filter,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPair, "", "Only cancel orders of the pair, formatted as PriceDenom/AssetDenom")
+	cmd.Flags().String(flagDirection, "", "Only cancel orders of the direction (LONG or SHORT)")
+	cmd.Flags().String(flagMinPrice, "", "Only cancel orders priced at or above this price")
+	cmd.Flags().String(flagMaxPrice, "", "Only cancel orders priced at or below this price")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getOptionalDecFlag(cmd *cobra.Command, flag string) (*sdk.Dec, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil || str == "" {
+		return nil, err
+	}
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return nil, err
+	}
+	return &dec, nil
+}
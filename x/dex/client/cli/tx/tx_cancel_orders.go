@@ -31,25 +31,11 @@ func CmdCancelOrders() *cobra.Command {
 			}
 			cancellations := []*types.Cancellation{}
 			for _, cancellation := range args[1:] {
-				newCancel := types.Cancellation{}
-				cancelDetails := strings.Split(cancellation, "?")
-				newCancel.Id, err = strconv.ParseUint(cancelDetails[0], 10, 64)
+				newCancel, err := parseCancellation(cancellation)
 				if err != nil {
 					return err
 				}
-				argPositionDir, err := types.GetPositionDirectionFromStr(cancelDetails[1])
-				if err != nil {
-					return err
-				}
-				newCancel.PositionDirection = argPositionDir
-				argPrice, err := sdk.NewDecFromStr(cancelDetails[2])
-				if err != nil {
-					return err
-				}
-				newCancel.Price = argPrice
-				newCancel.PriceDenom = cancelDetails[3]
-				newCancel.AssetDenom = cancelDetails[4]
-				cancellations = append(cancellations, &newCancel)
+				cancellations = append(cancellations, newCancel)
 			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -73,3 +59,26 @@ func CmdCancelOrders() *cobra.Command {
 
 	return cmd
 }
+
+func parseCancellation(cancellation string) (*types.Cancellation, error) {
+	newCancel := types.Cancellation{}
+	cancelDetails := strings.Split(cancellation, "?")
+	id, err := strconv.ParseUint(cancelDetails[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	newCancel.Id = id
+	argPositionDir, err := types.GetPositionDirectionFromStr(cancelDetails[1])
+	if err != nil {
+		return nil, err
+	}
+	newCancel.PositionDirection = argPositionDir
+	argPrice, err := sdk.NewDecFromStr(cancelDetails[2])
+	if err != nil {
+		return nil, err
+	}
+	newCancel.Price = argPrice
+	newCancel.PriceDenom = cancelDetails[3]
+	newCancel.AssetDenom = cancelDetails[4]
+	return &newCancel, nil
+}
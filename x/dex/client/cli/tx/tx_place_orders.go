@@ -31,39 +31,11 @@ func CmdPlaceOrders() *cobra.Command {
 			argContractAddr := args[0]
 			orders := []*types.Order{}
 			for _, order := range args[1:] {
-				newOrder := types.Order{}
-				orderDetails := strings.Split(order, "?")
-				argPositionDir, err := types.GetPositionDirectionFromStr(orderDetails[0])
+				newOrder, err := parseOrder(order)
 				if err != nil {
 					return err
 				}
-				newOrder.PositionDirection = argPositionDir
-				argPrice, err := sdk.NewDecFromStr(orderDetails[1])
-				if err != nil {
-					return err
-				}
-				newOrder.Price = argPrice
-				argQuantity, err := sdk.NewDecFromStr(orderDetails[2])
-				if err != nil {
-					return err
-				}
-				newOrder.Quantity = argQuantity
-				newOrder.PriceDenom = orderDetails[3]
-				newOrder.AssetDenom = orderDetails[4]
-				argOrderType, err := types.GetOrderTypeFromStr(orderDetails[5])
-				if err != nil {
-					return err
-				}
-				newOrder.OrderType = argOrderType
-				newOrder.Data = orderDetails[6]
-				if newOrder.OrderType == types.OrderType_FOKMARKETBYVALUE {
-					argNominal, err := sdk.NewDecFromStr(orderDetails[7])
-					if err != nil {
-						return err
-					}
-					newOrder.Nominal = argNominal
-				}
-				orders = append(orders, &newOrder)
+				orders = append(orders, newOrder)
 			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -96,3 +68,39 @@ func CmdPlaceOrders() *cobra.Command {
 
 	return cmd
 }
+
+func parseOrder(order string) (*types.Order, error) {
+	newOrder := types.Order{}
+	orderDetails := strings.Split(order, "?")
+	argPositionDir, err := types.GetPositionDirectionFromStr(orderDetails[0])
+	if err != nil {
+		return nil, err
+	}
+	newOrder.PositionDirection = argPositionDir
+	argPrice, err := sdk.NewDecFromStr(orderDetails[1])
+	if err != nil {
+		return nil, err
+	}
+	newOrder.Price = argPrice
+	argQuantity, err := sdk.NewDecFromStr(orderDetails[2])
+	if err != nil {
+		return nil, err
+	}
+	newOrder.Quantity = argQuantity
+	newOrder.PriceDenom = orderDetails[3]
+	newOrder.AssetDenom = orderDetails[4]
+	argOrderType, err := types.GetOrderTypeFromStr(orderDetails[5])
+	if err != nil {
+		return nil, err
+	}
+	newOrder.OrderType = argOrderType
+	newOrder.Data = orderDetails[6]
+	if newOrder.OrderType == types.OrderType_FOKMARKETBYVALUE {
+		argNominal, err := sdk.NewDecFromStr(orderDetails[7])
+		if err != nil {
+			return nil, err
+		}
+		newOrder.Nominal = argNominal
+	}
+	return &newOrder, nil
+}
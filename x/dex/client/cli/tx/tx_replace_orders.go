@@ -0,0 +1,87 @@
+package tx
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagCancellations = "cancellations"
+)
+
+func CmdReplaceOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-orders [contract address] [orders...] --cancellations [cancellations] --amount [coins,optional]",
+		Short: "Cancel orders and place new ones in the same block",
+		Long: strings.TrimSpace(`
+			Cancel orders and place new orders on an orderbook specified by contract-address, atomically. Orders use the same format as place-orders and cancellations use the same format as cancel-orders, separated by ",".
+			A new limit order at the same price and direction as a cancelled order keeps the cancelled order's queue position if its quantity isn't larger.
+
+			Example: replace-orders [contract address] "LONG?1.01?5?USDC?ATOM?LIMIT?{}" --cancellations "1234?LONG?1.01?USDC?ATOM"
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractAddr := args[0]
+			orders := []*types.Order{}
+			for _, order := range args[1:] {
+				newOrder, err := parseOrder(order)
+				if err != nil {
+					return err
+				}
+				orders = append(orders, newOrder)
+			}
+
+			cancellationStrs, err := cmd.Flags().GetStringSlice(flagCancellations)
+			if err != nil {
+				return err
+			}
+			cancellations := []*types.Cancellation{}
+			for _, cancellation := range cancellationStrs {
+				newCancel, err := parseCancellation(cancellation)
+				if err != nil {
+					return err
+				}
+				cancellations = append(cancellations, newCancel)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReplaceOrders(
+				clientCtx.GetFromAddress().String(),
+				argContractAddr,
+				cancellations,
+				orders,
+				amount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagCancellations, []string{}, "Orders to cancel")
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
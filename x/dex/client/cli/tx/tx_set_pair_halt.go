@@ -0,0 +1,51 @@
+package tx
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetPairHalt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pair-halt [contract address] [price denom] [asset denom] [halted]",
+		Short: "Halt or resume trading of a pair",
+		Long: strings.TrimSpace(`
+			Halts or resumes trading of a registered pair of an exchange contract. Only the contract creator can send this message.
+			A halt set by governance or tripped by the circuit breaker is not affected.
+		`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractAddr := args[0]
+			argHalted, err := cast.ToBoolE(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPairHalt(
+				clientCtx.GetFromAddress().String(),
+				argContractAddr,
+				&types.Pair{PriceDenom: args[1], AssetDenom: args[2]},
+				argHalted,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+package tx
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetRentTopUp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rent-top-up [contract address] [threshold] [amount] [spend limit]",
+		Short: "Fund a contract's rent automatically",
+		Long: strings.TrimSpace(`
+			Allows the contract to pull [amount] of rent from the sender at the end of a block whenever its rent balance is below [threshold], until a total of [spend limit] has been pulled. A spend limit of 0 removes the top-up.
+		`),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argContractAddr := args[0]
+			argThreshold, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argAmount, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+			argSpendLimit, err := cast.ToUint64E(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRentTopUp(
+				clientCtx.GetFromAddress().String(),
+				argContractAddr,
+				argThreshold,
+				argAmount,
+				argSpendLimit,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
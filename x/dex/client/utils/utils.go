@@ -26,6 +26,8 @@ type (
 	PairsJSON     []PairJSON
 	TickSizesJSON []TickSizeJSON
 	AssetListJSON []dextypes.AssetMetadata
+	FeeRatesJSON  []dextypes.FeeRate
+	PairHaltsJSON []dextypes.PairHaltUpdate
 
 	// ParamChangeJSON defines a parameter change used in JSON input. This
 	// allows values to be specified in raw JSON instead of being string encoded.
@@ -52,6 +54,20 @@ type (
 		AssetList   AssetListJSON `json:"asset_list" yaml:"asset_list"`
 		Deposit     string        `json:"deposit" yaml:"deposit"`
 	}
+
+	UpdateFeeRatesProposalJSON struct {
+		Title       string       `json:"title" yaml:"title"`
+		Description string       `json:"description" yaml:"description"`
+		FeeRateList FeeRatesJSON `json:"fee_rate_list" yaml:"fee_rate_list"`
+		Deposit     string       `json:"deposit" yaml:"deposit"`
+	}
+
+	UpdatePairHaltsProposalJSON struct {
+		Title        string        `json:"title" yaml:"title"`
+		Description  string        `json:"description" yaml:"description"`
+		PairHaltList PairHaltsJSON `json:"pair_halt_list" yaml:"pair_halt_list"`
+		Deposit      string        `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // TODO: ADD utils to convert Each type to dex/type (string to denom)
@@ -201,3 +217,52 @@ func ParseAddAssetMetadataProposalJSON(cdc *codec.LegacyAmino, proposalFile stri
 
 	return proposal, nil
 }
+
+// ParseUpdateFeeRatesProposalJSON reads and parses an UpdateFeeRatesProposalJSON from
+// a file.
+func ParseUpdateFeeRatesProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (UpdateFeeRatesProposalJSON, error) {
+	proposal := UpdateFeeRatesProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	for _, feeRate := range proposal.FeeRateList {
+		if err := dextypes.ValidateFeeRate(feeRate.MakerFeeRate); err != nil {
+			return UpdateFeeRatesProposalJSON{}, err
+		}
+		if err := dextypes.ValidateFeeRate(feeRate.TakerFeeRate); err != nil {
+			return UpdateFeeRatesProposalJSON{}, err
+		}
+	}
+
+	return proposal, nil
+}
+
+// ParseUpdatePairHaltsProposalJSON reads and parses an UpdatePairHaltsProposalJSON from
+// a file.
+func ParseUpdatePairHaltsProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (UpdatePairHaltsProposalJSON, error) {
+	proposal := UpdatePairHaltsProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	for _, update := range proposal.PairHaltList {
+		if update.Pair == nil {
+			return UpdatePairHaltsProposalJSON{}, errors.New("pair halt update has no pair")
+		}
+	}
+
+	return proposal, nil
+}
@@ -38,6 +38,7 @@ type environment struct {
 	executionTerminationSignals     *datastructures.TypedSyncMap[string, chan struct{}]
 	registeredPairs                 *datastructures.TypedSyncMap[string, []types.Pair]
 	orderBooks                      *datastructures.TypedNestedSyncMap[string, types.PairString, *types.OrderBook]
+	executionStats                  *dexcache.ExecutionStats
 
 	finalizeMsgMutex  *sync.Mutex
 	eventManagerMutex *sync.Mutex
@@ -78,6 +79,8 @@ func EndBlockerAtomic(ctx sdk.Context, keeper *keeper.Keeper, validContractsInfo
 	if env.failedContractAddressesToErrors.Len() == 0 {
 		postRunRents := keeper.GetRentsForContracts(cachedCtx, contractsToProcess)
 		TransferRentFromDexToCollector(cachedCtx, keeper.BankKeeper, preRunRents, postRunRents)
+		dexkeeperutils.RecordRentCharges(cachedCtx, keeper, preRunRents, postRunRents)
+		dexkeeperutils.RecordExecutionStats(cachedCtx, keeper, env.executionStats, getProcessedContracts(env, contractsToProcess), getExecutionErrors(env))
 		msCached.Write()
 		return env.validContractsInfo, []types.ContractInfoV2{}, map[string]string{}, ctx, true
 	}
@@ -109,6 +112,14 @@ func EndBlockerAtomic(ctx sdk.Context, keeper *keeper.Keeper, validContractsInfo
 		return true
 	})
 	TransferRentFromDexToCollector(ctx, keeper.BankKeeper, failedContractsPreRents, failedContractsPostRents)
+	dexkeeperutils.RecordRentCharges(ctx, keeper, failedContractsPreRents, failedContractsPostRents)
+	// the other contracts are rerun in the next iteration and have their stats recorded then
+	executionErrors := getExecutionErrors(env)
+	droppedContracts := []string{}
+	for contractAddr := range executionErrors {
+		droppedContracts = append(droppedContracts, contractAddr)
+	}
+	dexkeeperutils.RecordExecutionStats(ctx, keeper, env.executionStats, droppedContracts, executionErrors)
 
 	// restore keeper in-memory state
 	newGoContext := context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, memStateCopy)
@@ -137,6 +148,7 @@ func newEnv(ctx sdk.Context, validContractsInfo []types.ContractInfoV2, keeper *
 		executionTerminationSignals:     executionTerminationSignals,
 		registeredPairs:                 registeredPairs,
 		orderBooks:                      orderBooks,
+		executionStats:                  dexcache.NewExecutionStats(),
 		finalizeMsgMutex:                &sync.Mutex{},
 		eventManagerMutex:               &sync.Mutex{},
 	}
@@ -153,6 +165,7 @@ func (e *environment) addError(contractAddr string, err error) {
 func cacheContext(ctx sdk.Context, env *environment) (sdk.Context, sdk.CacheMultiStore) {
 	cachedCtx, msCached := store.GetCachedContext(ctx)
 	goCtx := context.WithValue(cachedCtx.Context(), dexcache.CtxKeyExecTermSignal, env.executionTerminationSignals)
+	goCtx = context.WithValue(goCtx, dexcache.CtxKeyExecutionStats, env.executionStats)
 	cachedCtx = cachedCtx.WithContext(goCtx)
 	return cachedCtx, msCached
 }
@@ -288,6 +301,31 @@ func filterNewValidContracts(ctx sdk.Context, env *environment) []types.Contract
 	return newValidContracts
 }
 
+// getProcessedContracts returns the contracts that went through order matching in this iteration
+func getProcessedContracts(env *environment, contractsToProcess []string) []string {
+	toProcess := datastructures.NewSyncSet(contractsToProcess)
+	processed := []string{}
+	for _, contract := range env.validContractsInfo {
+		if contract.NeedOrderMatching && toProcess.Contains(contract.ContractAddr) {
+			processed = append(processed, contract.ContractAddr)
+		}
+	}
+	return processed
+}
+
+// getExecutionErrors returns the truncated errors of the contracts dropped in this iteration
+func getExecutionErrors(env *environment) map[string]string {
+	executionErrors := map[string]string{}
+	env.failedContractAddressesToErrors.Range(func(failedContractAddress string, failedReason error) bool {
+		executionErrors[failedContractAddress] = dexutils.GetTruncatedErrors(failedReason)
+		return true
+	})
+	for _, outOfRentContractAddress := range env.outOfRentContractAddresses.ToOrderedSlice(datastructures.StringComparator) {
+		executionErrors[outOfRentContractAddress] = dexutils.GetTruncatedErrors(types.ErrInsufficientRent)
+	}
+	return executionErrors
+}
+
 func getOutOfRentContracts(env *environment) []types.ContractInfoV2 {
 	outOfRentContracts := []types.ContractInfoV2{}
 	for _, contract := range env.validContractsInfo {
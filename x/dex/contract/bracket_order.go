@@ -0,0 +1,341 @@
+package contract
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// UpdateBracketOrdersForPair stores take-profit/stop-loss orders placed in the current block,
+// activates those whose parent order has been filled and drops those whose parent order left the
+// book unfilled. Fills of an active take-profit order reduce its stop-loss order, and once the
+// take-profit order is filled in full or the stop-loss order is triggered, the other order of its
+// bracket is cancelled. Cancellations of orders that the contract has been told about are returned
+// so that they can be reported, along with take-profit top-ups that still need an order id.
+func UpdateBracketOrdersForPair(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+	totalOutcome exchange.ExecutionOutcome,
+) ([]*types.Cancellation, []types.Order) {
+	contractAddr := string(typedContractAddr)
+	memState := dexutils.GetMemState(ctx.Context())
+	cancels := memState.GetBlockCancels(ctx, typedContractAddr, pair)
+	settledQuantities := GetOrderIDToSettledQuantities(totalOutcome.Settlements)
+	droppedOrderIDs := getDroppedOrderIDs(ctx, typedContractAddr, pair, cancels, totalOutcome.Cancellations, settledQuantities)
+
+	cancellations := updateActiveBrackets(ctx, typedContractAddr, pair, dexkeeper, cancels, settledQuantities, droppedOrderIDs)
+
+	for _, order := range memState.GetBlockOrders(ctx, typedContractAddr, pair).GetBracketOrders() {
+		if cancels.Has(&types.Cancellation{Id: order.Id}) {
+			continue
+		}
+		dexkeeper.SetBracketOrder(ctx, false, contractAddr, *order)
+		emitBracketOrderEvent(ctx, typedContractAddr, *order, types.AttributeValueBracketPending)
+	}
+
+	activeOrders := map[uint64]types.Order{}
+	for _, order := range dexkeeper.GetAllBracketOrdersForPair(ctx, true, contractAddr, pair.PriceDenom, pair.AssetDenom) {
+		activeOrders[order.Id] = order
+	}
+	topUps := []types.Order{}
+	for _, order := range dexkeeper.GetAllBracketOrdersForPair(ctx, false, contractAddr, pair.PriceDenom, pair.AssetDenom) {
+		order := order
+		if cancels.Has(&types.Cancellation{Id: order.Id}) {
+			dexkeeper.RemoveBracketOrder(ctx, false, contractAddr, order)
+			emitBracketOrderEvent(ctx, typedContractAddr, order, types.AttributeValueBracketCancelled)
+			continue
+		}
+		active, isActive := activeOrders[order.Id]
+		if settledQuantity, ok := settledQuantities[order.ParentOrderId]; ok {
+			var topUp *types.Order
+			order, topUp = activateBracketOrder(ctx, typedContractAddr, dexkeeper, order, settledQuantity, active, isActive)
+			if topUp != nil {
+				topUps = append(topUps, *topUp)
+			}
+			isActive = true
+		}
+		if _, ok := droppedOrderIDs[order.ParentOrderId]; ok {
+			dexkeeper.RemoveBracketOrder(ctx, false, contractAddr, order)
+			if isActive {
+				// the part activated by the parent's fills stays in place
+				continue
+			}
+			cancellations = append(cancellations, cancellationForOrder(&order, types.CancellationInitiator_USER))
+			emitBracketOrderEvent(ctx, typedContractAddr, order, types.AttributeValueBracketCancelled)
+		}
+	}
+	return cancellations, topUps
+}
+
+// PlaceBracketTopUps assigns order ids to take-profit top-ups and marks them as triggered, so that
+// they're placed with the contract as limit orders in the next block like any other take-profit
+// order. It runs once all pairs of the contract have been executed, since order ids are allocated
+// per contract.
+func PlaceBracketTopUps(ctx sdk.Context, typedContractAddr types.ContractAddress, dexkeeper *keeper.Keeper, topUps []types.Order) {
+	if len(topUps) == 0 {
+		return
+	}
+	contractAddr := string(typedContractAddr)
+	nextID := dexkeeper.GetNextOrderID(ctx, contractAddr)
+	for _, order := range topUps {
+		order.Id = nextID
+		nextID++
+		triggered := order
+		triggered.BracketRole = types.BracketRole_NO_BRACKET
+		triggered.TriggerStatus = true
+		dexkeeper.SetTriggeredOrder(ctx, contractAddr, triggered)
+		dexkeeper.SetBracketOrder(ctx, true, contractAddr, order)
+		emitBracketOrderEvent(ctx, typedContractAddr, order, types.AttributeValueBracketActivated)
+	}
+	dexkeeper.SetNextOrderID(ctx, contractAddr, nextID)
+}
+
+// ReportBracketCancellations adds orders cancelled along with their bracket to the block
+// cancellations, so that the contract is notified of them along with unfulfilled market orders.
+func ReportBracketCancellations(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	cancellations []*types.Cancellation,
+) {
+	memState := dexutils.GetMemState(ctx.Context())
+	cancels := memState.GetBlockCancels(ctx, typedContractAddr, pair)
+	orders := memState.GetBlockOrders(ctx, typedContractAddr, pair)
+	for _, cancellation := range cancellations {
+		cancels.Add(cancellation)
+		if orders.Has(cancellation.Id) {
+			order := orders.GetByID(cancellation.Id)
+			order.Status = types.OrderStatus_CANCELLED
+			order.StatusDescription = "bracket order cancelled"
+			orders.Add(order)
+		}
+	}
+}
+
+// activateBracketOrder hands the part of a pending bracket order covered by its parent's latest
+// fill over to the triggered orders, so that the bracket never exceeds what the parent has been
+// filled for. Stop-loss orders wait for their trigger price there, while take-profit orders are
+// marked as triggered so that they're placed as limit orders in the next block. Later fills of the
+// parent grow the active order until it reaches its full quantity, and the rest of the order stays
+// pending in the meantime. A take-profit order that has already been placed isn't changed though:
+// the extra quantity is returned as a top-up, to be placed as a new order of the same bracket.
+// Also returns the pending order, whose quantity may have dropped to zero, in which case it's no
+// longer stored.
+func activateBracketOrder(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	dexkeeper *keeper.Keeper,
+	order types.Order,
+	settledQuantity sdk.Dec,
+	active types.Order,
+	isActive bool,
+) (types.Order, *types.Order) {
+	contractAddr := string(typedContractAddr)
+	quantity := sdk.MinDec(order.Quantity, settledQuantity)
+	pending := order
+	pending.Quantity = order.Quantity.Sub(quantity)
+	if pending.Quantity.IsPositive() {
+		dexkeeper.SetBracketOrder(ctx, false, contractAddr, pending)
+	} else {
+		dexkeeper.RemoveBracketOrder(ctx, false, contractAddr, order)
+	}
+
+	if !isActive {
+		active = order
+		active.Quantity = quantity
+		activated := active
+		activated.BracketRole = types.BracketRole_NO_BRACKET
+		activated.TriggerStatus = order.BracketRole == types.BracketRole_TAKE_PROFIT
+		dexkeeper.SetTriggeredOrder(ctx, contractAddr, activated)
+	} else {
+		triggered, found := dexkeeper.GetTriggeredOrderByID(ctx, contractAddr, order.Id, order.PriceDenom, order.AssetDenom)
+		if !found && order.BracketRole == types.BracketRole_TAKE_PROFIT {
+			topUp := active
+			topUp.Quantity = quantity
+			return pending, &topUp
+		}
+		if !found {
+			// take-profit fills had reduced the stop-loss order to nothing
+			triggered = active
+			triggered.BracketRole = types.BracketRole_NO_BRACKET
+		}
+		active.Quantity = active.Quantity.Add(quantity)
+		triggered.Quantity = active.Quantity
+		dexkeeper.SetTriggeredOrder(ctx, contractAddr, triggered)
+	}
+	dexkeeper.SetBracketOrder(ctx, true, contractAddr, active)
+	emitBracketOrderEvent(ctx, typedContractAddr, active, types.AttributeValueBracketActivated)
+	return pending, nil
+}
+
+// updateActiveBrackets applies the current block's fills of take-profit orders and triggers of
+// stop-loss orders to active brackets, and cancels the other order of each bracket that's done.
+func updateActiveBrackets(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+	cancels *dexcache.BlockCancellations,
+	settledQuantities map[uint64]sdk.Dec,
+	droppedOrderIDs map[uint64]struct{},
+) []*types.Cancellation {
+	contractAddr := string(typedContractAddr)
+	parentIDs := []uint64{}
+	brackets := map[uint64][]types.Order{}
+	for _, order := range dexkeeper.GetAllBracketOrdersForPair(ctx, true, contractAddr, pair.PriceDenom, pair.AssetDenom) {
+		if cancels.Has(&types.Cancellation{Id: order.Id}) {
+			// the other order of the bracket is left in place if one is cancelled by the user
+			dexkeeper.RemoveBracketOrder(ctx, true, contractAddr, order)
+			continue
+		}
+		if _, ok := brackets[order.ParentOrderId]; !ok {
+			parentIDs = append(parentIDs, order.ParentOrderId)
+		}
+		brackets[order.ParentOrderId] = append(brackets[order.ParentOrderId], order)
+	}
+	// take-profit quantity still waiting for a parent that's going to fill further
+	pendingTakeProfits := map[uint64]struct{}{}
+	for _, order := range dexkeeper.GetAllBracketOrdersForPair(ctx, false, contractAddr, pair.PriceDenom, pair.AssetDenom) {
+		if _, ok := droppedOrderIDs[order.ParentOrderId]; !ok && order.BracketRole == types.BracketRole_TAKE_PROFIT {
+			pendingTakeProfits[order.ParentOrderId] = struct{}{}
+		}
+	}
+
+	cancellations := []*types.Cancellation{}
+	for _, parentID := range parentIDs {
+		_, hasPendingTakeProfit := pendingTakeProfits[parentID]
+		cancellations = append(cancellations, updateActiveBracket(
+			ctx, typedContractAddr, pair, dexkeeper, brackets[parentID], settledQuantities, hasPendingTakeProfit,
+		)...)
+	}
+	return cancellations
+}
+
+// updateActiveBracket takes the fills of the bracket's take-profit orders off them as well as off
+// its stop-loss order, which only needs to cover the part of the position that's still open. The
+// bracket is done once its take-profit orders have been filled in full, including the part still
+// waiting for the parent to fill, or once its stop-loss order is triggered. Fills take precedence if
+// both happen in the same block. Whatever is left of the other side is then cancelled.
+func updateActiveBracket(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+	bracket []types.Order,
+	settledQuantities map[uint64]sdk.Dec,
+	hasPendingTakeProfit bool,
+) []*types.Cancellation {
+	contractAddr := string(typedContractAddr)
+	filled := sdk.ZeroDec()
+	hasTakeProfit, takeProfitLeft := false, false
+	stopLossIdx := -1
+	for i := range bracket {
+		switch bracket[i].BracketRole {
+		case types.BracketRole_TAKE_PROFIT:
+			hasTakeProfit = true
+			if settledQuantity, ok := settledQuantities[bracket[i].Id]; ok {
+				settledQuantity = sdk.MinDec(settledQuantity, bracket[i].Quantity)
+				bracket[i].Quantity = bracket[i].Quantity.Sub(settledQuantity)
+				filled = filled.Add(settledQuantity)
+				dexkeeper.SetBracketOrder(ctx, true, contractAddr, bracket[i])
+			}
+			takeProfitLeft = takeProfitLeft || bracket[i].Quantity.IsPositive()
+		case types.BracketRole_STOP_LOSS:
+			stopLossIdx = i
+		}
+	}
+	stopLossTriggered := false
+	if stopLossIdx >= 0 {
+		stopLoss := &bracket[stopLossIdx]
+		triggered, found := dexkeeper.GetTriggeredOrderByID(ctx, contractAddr, stopLoss.Id, pair.PriceDenom, pair.AssetDenom)
+		if filled.IsPositive() {
+			stopLoss.Quantity = stopLoss.Quantity.Sub(sdk.MinDec(filled, stopLoss.Quantity))
+			dexkeeper.SetBracketOrder(ctx, true, contractAddr, *stopLoss)
+			if found && stopLoss.Quantity.IsPositive() {
+				triggered.Quantity = stopLoss.Quantity
+				dexkeeper.SetTriggeredOrder(ctx, contractAddr, triggered)
+			} else if found {
+				// it's put back once the parent fills further
+				dexkeeper.RemoveTriggeredOrder(ctx, contractAddr, stopLoss.Id, pair.PriceDenom, pair.AssetDenom)
+				found = false
+			}
+		}
+		stopLossTriggered = found && triggered.TriggerStatus
+	}
+
+	var executedRole types.BracketRole
+	switch {
+	case hasTakeProfit && !takeProfitLeft && !hasPendingTakeProfit:
+		executedRole = types.BracketRole_TAKE_PROFIT
+	case stopLossTriggered:
+		executedRole = types.BracketRole_STOP_LOSS
+	default:
+		return []*types.Cancellation{}
+	}
+	cancellations := []*types.Cancellation{}
+	for _, order := range bracket {
+		order := order
+		dexkeeper.RemoveBracketOrder(ctx, true, contractAddr, order)
+		// whatever hasn't been activated yet is dropped along with the bracket
+		dexkeeper.RemoveBracketOrder(ctx, false, contractAddr, order)
+		if order.BracketRole == executedRole {
+			emitBracketOrderEvent(ctx, typedContractAddr, order, types.AttributeValueBracketTriggered)
+			continue
+		}
+		if order.BracketRole == types.BracketRole_TAKE_PROFIT && !order.Quantity.IsPositive() {
+			// filled in full before the stop-loss order was triggered
+			continue
+		}
+		cancellation := cancellationForOrder(&order, types.CancellationInitiator_OCO)
+		exchange.CancelOrders(ctx, dexkeeper, typedContractAddr, pair, []*types.Cancellation{cancellation})
+		cancellations = append(cancellations, cancellation)
+		emitBracketOrderEvent(ctx, typedContractAddr, order, types.AttributeValueBracketCancelled)
+	}
+	return cancellations
+}
+
+// getDroppedOrderIDs returns orders that were cancelled in the current block, along with orders
+// placed in the current block that were rejected or didn't rest on the book
+func getDroppedOrderIDs(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	cancels *dexcache.BlockCancellations,
+	matchingCancellations []*types.Cancellation,
+	settledQuantities map[uint64]sdk.Dec,
+) map[uint64]struct{} {
+	res := map[uint64]struct{}{}
+	for _, cancel := range cancels.Get() {
+		res[cancel.Id] = struct{}{}
+	}
+	for _, cancel := range matchingCancellations {
+		res[cancel.Id] = struct{}{}
+	}
+	for _, order := range dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Get() {
+		if order.Status == types.OrderStatus_FAILED_TO_PLACE || order.Status == types.OrderStatus_CANCELLED {
+			res[order.Id] = struct{}{}
+		}
+	}
+	for _, id := range getUnfulfilledPlacedMarketOrderIds(ctx, typedContractAddr, pair, settledQuantities) {
+		res[id] = struct{}{}
+	}
+	return res
+}
+
+func emitBracketOrderEvent(ctx sdk.Context, typedContractAddr types.ContractAddress, order types.Order, status string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBracketOrder,
+		sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(order.Id)),
+		sdk.NewAttribute(types.AttributeKeyParentOrderID, fmt.Sprint(order.ParentOrderId)),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, string(typedContractAddr)),
+		sdk.NewAttribute(types.AttributeKeyBracketRole, order.BracketRole.String()),
+		sdk.NewAttribute(types.AttributeKeyBracketStatus, status),
+	))
+}
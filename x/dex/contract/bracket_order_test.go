@@ -0,0 +1,315 @@
+package contract_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// a long limit order 1 at 100 with a take-profit order 2 at 110 and a stop-loss order 3 triggered at 90
+func addBracketOrders(ctx sdk.Context, quantity int64) {
+	orders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), TEST_PAIR())
+	orders.Add(newTimeInForceOrder(1, 100, quantity, types.PositionDirection_LONG))
+	takeProfit := newTimeInForceOrder(2, 110, quantity, types.PositionDirection_SHORT)
+	takeProfit.ParentOrderId = 1
+	takeProfit.BracketRole = types.BracketRole_TAKE_PROFIT
+	orders.Add(takeProfit)
+	stopLoss := newTimeInForceOrder(3, 0, quantity, types.PositionDirection_SHORT)
+	stopLoss.OrderType = types.OrderType_STOPLOSS
+	stopLoss.TriggerPrice = sdk.NewDec(90)
+	stopLoss.ParentOrderId = 1
+	stopLoss.BracketRole = types.BracketRole_STOP_LOSS
+	orders.Add(stopLoss)
+}
+
+func executeBlock(ctx sdk.Context, dexkeeper *keeper.Keeper) []*types.SettlementEntry {
+	pair := TEST_PAIR()
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, typedContractAddr, pair)
+	return contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+}
+
+func TestBracketOrderTakeProfit(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(100),
+			Quantity:    sdk.NewDec(2),
+			Allocations: []*types.Allocation{{OrderId: 10, Account: "abc", Quantity: sdk.NewDec(2)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+
+	// the parent fills right away, which activates both bracket orders
+	addBracketOrders(ctx, 2)
+	executeBlock(ctx, dexkeeper)
+	require.Empty(t, dexkeeper.GetAllBracketOrdersForPair(ctx, false, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom))
+	require.Equal(t, 2, len(dexkeeper.GetAllBracketOrdersForPair(ctx, true, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)))
+	takeProfit, found := dexkeeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 2, pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.True(t, takeProfit.TriggerStatus)
+	require.Equal(t, types.BracketRole_NO_BRACKET, takeProfit.BracketRole)
+	require.Equal(t, uint64(1), takeProfit.ParentOrderId)
+	stopLoss, found := dexkeeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 3, pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.False(t, stopLoss.TriggerStatus)
+	_, found = dexkeeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(110), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+
+	// the take-profit order is placed in the next block
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	require.True(t, contract.MoveTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper))
+	executeBlock(ctx, dexkeeper)
+	_, found = dexkeeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(110), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+
+	// filling the take-profit order cancels the stop-loss order
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Add(newTimeInForceOrder(4, 110, 2, types.PositionDirection_LONG))
+	settlements := executeBlock(ctx, dexkeeper)
+	require.NotEmpty(t, settlements)
+	_, found = dexkeeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 3, pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	require.Empty(t, dexkeeper.GetAllBracketOrdersForPair(ctx, true, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom))
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(3), cancels[0].Id)
+	require.Equal(t, types.CancellationInitiator_OCO, cancels[0].Initiator)
+}
+
+func TestBracketOrderStopLoss(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(100),
+			Quantity:    sdk.NewDec(2),
+			Allocations: []*types.Allocation{{OrderId: 10, Account: "abc", Quantity: sdk.NewDec(2)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	addBracketOrders(ctx, 2)
+	executeBlock(ctx, dexkeeper)
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	contract.MoveTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper)
+	executeBlock(ctx, dexkeeper)
+
+	// a trade at 90 triggers the stop-loss order, which cancels the resting take-profit order
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	orders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	orders.Add(newTimeInForceOrder(4, 90, 1, types.PositionDirection_LONG))
+	orders.Add(newTimeInForceOrder(5, 90, 1, types.PositionDirection_SHORT))
+	settlements := executeBlock(ctx, dexkeeper)
+	require.NotEmpty(t, settlements)
+	stopLoss, found := dexkeeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 3, pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.True(t, stopLoss.TriggerStatus)
+	_, found = dexkeeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(110), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	require.Empty(t, dexkeeper.GetAllBracketOrdersForPair(ctx, true, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom))
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(2), cancels[0].Id)
+	require.Equal(t, types.CancellationInitiator_OCO, cancels[0].Initiator)
+}
+
+func TestBracketOrderParentCancelled(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+
+	// the parent rests on the book, so the bracket orders stay pending
+	addBracketOrders(ctx, 1)
+	executeBlock(ctx, dexkeeper)
+	require.Equal(t, 2, len(dexkeeper.GetAllBracketOrdersForPair(ctx, false, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)))
+	require.Empty(t, dexkeeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom))
+	_, found := dexkeeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(110), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+
+	// cancelling the parent drops them
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Add(&types.Cancellation{
+		Id:                1,
+		Creator:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		PositionDirection: types.PositionDirection_LONG,
+		Price:             sdk.NewDec(100),
+	})
+	executeBlock(ctx, dexkeeper)
+	require.Empty(t, dexkeeper.GetAllBracketOrdersForPair(ctx, false, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom))
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get()
+	require.Equal(t, 2, len(cancels))
+	require.Equal(t, uint64(2), cancels[0].Id)
+	require.Equal(t, uint64(3), cancels[1].Id)
+}
+
+func TestBracketOrderPartialFill(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(100),
+			Quantity:    sdk.NewDec(1),
+			Allocations: []*types.Allocation{{OrderId: 10, Account: "abc", Quantity: sdk.NewDec(1)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+
+	// half of the parent fills, which activates half of each bracket order
+	addBracketOrders(ctx, 2)
+	executeBlock(ctx, dexkeeper)
+	for _, active := range []bool{false, true} {
+		orders := dexkeeper.GetAllBracketOrdersForPair(ctx, active, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)
+		require.Equal(t, 2, len(orders))
+		for _, order := range orders {
+			require.Equal(t, sdk.NewDec(1), order.Quantity)
+		}
+	}
+	for _, order := range dexkeeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom) {
+		require.Equal(t, sdk.NewDec(1), order.Quantity)
+	}
+
+	// the take-profit order is placed and the rest of the parent fills, which grows the stop-loss
+	// order and places the rest of the take-profit order as a new order
+	dexkeeper.SetNextOrderID(ctx, keepertest.TestContract, 5)
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	contract.MoveTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper)
+	dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Add(newTimeInForceOrder(4, 100, 1, types.PositionDirection_SHORT))
+	executeBlock(ctx, dexkeeper)
+	require.Empty(t, dexkeeper.GetAllBracketOrdersForPair(ctx, false, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom))
+	activeQuantities := map[uint64]sdk.Dec{}
+	for _, order := range dexkeeper.GetAllBracketOrdersForPair(ctx, true, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom) {
+		require.Equal(t, uint64(1), order.ParentOrderId)
+		activeQuantities[order.Id] = order.Quantity
+	}
+	require.Equal(t, map[uint64]sdk.Dec{2: sdk.NewDec(1), 3: sdk.NewDec(2), 5: sdk.NewDec(1)}, activeQuantities)
+	require.Equal(t, uint64(6), dexkeeper.GetNextOrderID(ctx, keepertest.TestContract))
+	stopLoss, found := dexkeeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 3, pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), stopLoss.Quantity)
+	topUp, found := dexkeeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 5, pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.True(t, topUp.TriggerStatus)
+	require.Equal(t, sdk.NewDec(1), topUp.Quantity)
+	require.Equal(t, sdk.NewDec(110), topUp.Price)
+	require.Equal(t, uint64(1), topUp.ParentOrderId)
+	// the resting take-profit order itself is left as the contract placed it
+	entry, found := dexkeeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(110), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(1), entry.Entry.Quantity)
+
+	// the top-up is placed in the next block next to the take-profit order
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	require.True(t, contract.MoveTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper))
+	executeBlock(ctx, dexkeeper)
+	entry, found = dexkeeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(110), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), entry.Entry.Quantity)
+	require.Equal(t, 2, len(entry.Entry.Allocations))
+}
+
+func TestBracketOrderPartialTakeProfitFill(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(100),
+			Quantity:    sdk.NewDec(2),
+			Allocations: []*types.Allocation{{OrderId: 10, Account: "abc", Quantity: sdk.NewDec(2)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	addBracketOrders(ctx, 2)
+	executeBlock(ctx, dexkeeper)
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	contract.MoveTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper)
+	executeBlock(ctx, dexkeeper)
+
+	// half of the take-profit order fills, which only reduces the stop-loss order
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Add(newTimeInForceOrder(4, 110, 1, types.PositionDirection_LONG))
+	require.NotEmpty(t, executeBlock(ctx, dexkeeper))
+	require.Empty(t, dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get())
+	for _, order := range dexkeeper.GetAllBracketOrdersForPair(ctx, true, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom) {
+		require.Equal(t, sdk.NewDec(1), order.Quantity)
+	}
+	stopLoss, found := dexkeeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 3, pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.False(t, stopLoss.TriggerStatus)
+	require.Equal(t, sdk.NewDec(1), stopLoss.Quantity)
+
+	// the stop-loss order still protects the rest of the position, and cancels the rest of the
+	// take-profit order once triggered
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	orders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	orders.Add(newTimeInForceOrder(5, 90, 1, types.PositionDirection_LONG))
+	orders.Add(newTimeInForceOrder(6, 90, 1, types.PositionDirection_SHORT))
+	executeBlock(ctx, dexkeeper)
+	stopLoss, found = dexkeeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 3, pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+	require.True(t, stopLoss.TriggerStatus)
+	require.Equal(t, sdk.NewDec(1), stopLoss.Quantity)
+	require.Empty(t, dexkeeper.GetAllBracketOrdersForPair(ctx, true, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom))
+	_, found = dexkeeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(110), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(2), cancels[0].Id)
+	require.Equal(t, types.CancellationInitiator_OCO, cancels[0].Initiator)
+}
+
+func TestBracketOrderParentCancelledAfterPartialFill(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(100),
+			Quantity:    sdk.NewDec(1),
+			Allocations: []*types.Allocation{{OrderId: 10, Account: "abc", Quantity: sdk.NewDec(1)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	addBracketOrders(ctx, 2)
+	executeBlock(ctx, dexkeeper)
+
+	// cancelling the rest of the parent only drops the parts of the bracket orders that aren't active
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Add(&types.Cancellation{
+		Id:                1,
+		Creator:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		PositionDirection: types.PositionDirection_LONG,
+		Price:             sdk.NewDec(100),
+	})
+	executeBlock(ctx, dexkeeper)
+	require.Empty(t, dexkeeper.GetAllBracketOrdersForPair(ctx, false, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom))
+	require.Equal(t, 2, len(dexkeeper.GetAllBracketOrdersForPair(ctx, true, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)))
+	require.Empty(t, dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get())
+}
@@ -0,0 +1,92 @@
+package contract
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// ResolveCancelAlls turns this block's cancel-all requests into cancellations of the matching
+// resting orders, which are then processed like any other cancellation.
+func ResolveCancelAlls(ctx sdk.Context, dexkeeper *keeper.Keeper, contracts []types.ContractInfoV2) {
+	for _, contract := range contracts {
+		if !contract.NeedOrderMatching {
+			continue
+		}
+		typedContractAddr := types.ContractAddress(contract.ContractAddr)
+		requests := dexutils.GetMemState(ctx.Context()).GetBlockCancelAlls(ctx, typedContractAddr).Get()
+		if len(requests) == 0 {
+			continue
+		}
+		for _, pair := range dexkeeper.GetAllRegisteredPairs(ctx, contract.ContractAddr) {
+			for _, request := range requests {
+				if request.Filter.MatchesPair(pair) {
+					ResolveCancelAllForPair(ctx, typedContractAddr, pair, dexkeeper, request)
+				}
+			}
+		}
+	}
+}
+
+// ResolveCancelAllForPair adds cancellations for the creator's orders on the pair's order book
+// and trigger book that are selected by the request's filter.
+func ResolveCancelAllForPair(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+	request *types.MsgCancelAll,
+) {
+	cancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair)
+	addCancellation := func(orderID uint64, direction types.PositionDirection, price sdk.Dec) {
+		cancellation := &types.Cancellation{
+			Id:                orderID,
+			Initiator:         types.CancellationInitiator_USER,
+			Creator:           request.Creator,
+			ContractAddr:      string(typedContractAddr),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			PositionDirection: direction,
+			Price:             price,
+		}
+		if cancels.Has(cancellation) {
+			return
+		}
+		cancels.Add(cancellation)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCancelOrder,
+			sdk.NewAttribute(types.AttributeKeyCancellationID, fmt.Sprint(orderID)),
+		))
+	}
+
+	// only the levels at which the creator has resting orders are loaded
+	for _, direction := range []types.PositionDirection{types.PositionDirection_LONG, types.PositionDirection_SHORT} {
+		long := direction == types.PositionDirection_LONG
+		getter := dexkeeper.GetLongOrderBookEntryByPrice
+		if !long {
+			getter = dexkeeper.GetShortOrderBookEntryByPrice
+		}
+		for _, price := range dexkeeper.GetAccountOrderPricesForPair(ctx, long, string(typedContractAddr), request.Creator, pair.PriceDenom, pair.AssetDenom) {
+			if !request.Filter.Matches(direction, price) {
+				continue
+			}
+			entry, found := getter(ctx, string(typedContractAddr), price, pair.PriceDenom, pair.AssetDenom)
+			if !found {
+				continue
+			}
+			for _, allocation := range entry.GetOrderEntry().Allocations {
+				if allocation.Account == request.Creator {
+					addCancellation(allocation.OrderId, direction, price)
+				}
+			}
+		}
+	}
+	for _, order := range dexkeeper.GetAccountTriggeredOrdersForPair(ctx, string(typedContractAddr), request.Creator, pair.PriceDenom, pair.AssetDenom) {
+		if request.Filter.Matches(order.PositionDirection, order.Price) {
+			addCancellation(order.Id, order.PositionDirection, order.Price)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package contract_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveCancelAlls(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+	for _, price := range []int64{1, 2} {
+		dexkeeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+			Price: sdk.NewDec(price),
+			Entry: &types.OrderEntry{
+				Price:    sdk.NewDec(price),
+				Quantity: sdk.NewDec(2),
+				Allocations: []*types.Allocation{
+					{OrderId: uint64(price), Account: TEST_ACCOUNT, Quantity: sdk.OneDec()},
+					{OrderId: uint64(price) + 10, Account: "other", Quantity: sdk.OneDec()},
+				},
+				PriceDenom: pair.PriceDenom,
+				AssetDenom: pair.AssetDenom,
+			},
+		})
+	}
+	dexkeeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.NewDec(3),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(3),
+			Quantity:    sdk.OneDec(),
+			Allocations: []*types.Allocation{{OrderId: 3, Account: TEST_ACCOUNT, Quantity: sdk.OneDec()}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	stopLoss := newTimeInForceOrder(4, 1, 1, types.PositionDirection_LONG)
+	stopLoss.OrderType = types.OrderType_STOPLOSS
+	dexkeeper.SetTriggeredOrder(ctx, keepertest.TestContract, *stopLoss)
+
+	maxPrice := sdk.NewDec(1)
+	dexutil.GetMemState(ctx.Context()).GetBlockCancelAlls(ctx, typedContractAddr).Add(&types.MsgCancelAll{
+		Creator:      TEST_ACCOUNT,
+		ContractAddr: keepertest.TestContract,
+		Filter: &types.CancelAllFilter{
+			PositionDirections: []types.PositionDirection{types.PositionDirection_LONG},
+			MaxPrice:           &maxPrice,
+		},
+	})
+	contracts := []types.ContractInfoV2{{ContractAddr: keepertest.TestContract, NeedOrderMatching: true}}
+	contract.ResolveCancelAlls(ctx, dexkeeper, contracts)
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get()
+	require.Equal(t, 2, len(cancels))
+	require.Equal(t, uint64(1), cancels[0].Id)
+	require.Equal(t, sdk.NewDec(1), cancels[0].Price)
+	require.Equal(t, uint64(4), cancels[1].Id)
+
+	// without a filter every resting order of the creator is cancelled
+	dexutil.GetMemState(ctx.Context()).GetBlockCancelAlls(ctx, typedContractAddr).Add(&types.MsgCancelAll{
+		Creator:      TEST_ACCOUNT,
+		ContractAddr: keepertest.TestContract,
+	})
+	contract.ResolveCancelAlls(ctx, dexkeeper, contracts)
+	cancels = dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get()
+	require.Equal(t, 4, len(cancels))
+	for _, cancel := range cancels {
+		require.Equal(t, TEST_ACCOUNT, cancel.Creator)
+	}
+}
@@ -1,6 +1,8 @@
 package contract
 
 import (
+	"sort"
+
 	"github.com/sei-protocol/sei-chain/utils/datastructures"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
@@ -12,27 +14,13 @@ type node struct {
 
 // Kahn's algorithm
 func TopologicalSortContractInfo(contracts []types.ContractInfoV2) ([]types.ContractInfoV2, error) {
-	contractAddrToContractInfo := map[string]types.ContractInfoV2{}
-	for _, contract := range contracts {
-		contractAddrToContractInfo[contract.ContractAddr] = contract
+	levels, err := FrontierLevelsContractInfo(contracts)
+	if err != nil {
+		return []types.ContractInfoV2{}, err
 	}
-
 	res := []types.ContractInfoV2{}
-	nodes := initNodes(contracts)
-	frontierNodes, nonFrontierNodes := splitNodesByFrontier(nodes)
-	for len(frontierNodes) > 0 {
-		for _, frontierNode := range frontierNodes {
-			if contract, ok := contractAddrToContractInfo[frontierNode.contractAddr]; ok {
-				res = append(res, contract)
-			}
-			for _, nonFrontierNode := range nonFrontierNodes {
-				nonFrontierNode.incomingNodes.Remove(frontierNode.contractAddr)
-			}
-		}
-		frontierNodes, nonFrontierNodes = splitNodesByFrontier(nonFrontierNodes)
-	}
-	if len(nonFrontierNodes) > 0 {
-		return []types.ContractInfoV2{}, types.ErrCircularContractDependency
+	for _, level := range levels {
+		res = append(res, level...)
 	}
 	return res, nil
 }
@@ -76,3 +64,38 @@ func splitNodesByFrontier(nodes map[string]node) (map[string]node, map[string]no
 	}
 	return frontierNodes, nonFrontierNodes
 }
+
+// FrontierLevelsContractInfo groups contracts by the frontier in which Kahn's algorithm visits
+// them. Contracts in the same level have no dependency on each other, and each level can only
+// start once the levels before it have finished, which is the initial schedule of
+// ParallelRunner. Contracts within a level are sorted by address.
+func FrontierLevelsContractInfo(contracts []types.ContractInfoV2) ([][]types.ContractInfoV2, error) {
+	contractAddrToContractInfo := map[string]types.ContractInfoV2{}
+	for _, contract := range contracts {
+		contractAddrToContractInfo[contract.ContractAddr] = contract
+	}
+
+	res := [][]types.ContractInfoV2{}
+	nodes := initNodes(contracts)
+	frontierNodes, nonFrontierNodes := splitNodesByFrontier(nodes)
+	for len(frontierNodes) > 0 {
+		level := []types.ContractInfoV2{}
+		for _, frontierNode := range frontierNodes {
+			if contract, ok := contractAddrToContractInfo[frontierNode.contractAddr]; ok {
+				level = append(level, contract)
+			}
+			for _, nonFrontierNode := range nonFrontierNodes {
+				nonFrontierNode.incomingNodes.Remove(frontierNode.contractAddr)
+			}
+		}
+		sort.Slice(level, func(i, j int) bool { return level[i].ContractAddr < level[j].ContractAddr })
+		if len(level) > 0 {
+			res = append(res, level)
+		}
+		frontierNodes, nonFrontierNodes = splitNodesByFrontier(nonFrontierNodes)
+	}
+	if len(nonFrontierNodes) > 0 {
+		return [][]types.ContractInfoV2{}, types.ErrCircularContractDependency
+	}
+	return res, nil
+}
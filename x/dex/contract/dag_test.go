@@ -108,3 +108,32 @@ func TestTopologicalSortContractInfoCircular(t *testing.T) {
 	require.NotNil(t, err)
 	require.Equal(t, 0, len(res))
 }
+
+// A -> B -> C, D -> C, E
+func TestFrontierLevelsContractInfo(t *testing.T) {
+	a := types.ContractInfoV2{
+		ContractAddr: "A",
+		Dependencies: []*types.ContractDependencyInfo{{Dependency: "B"}},
+	}
+	b := types.ContractInfoV2{
+		ContractAddr: "B",
+		Dependencies: []*types.ContractDependencyInfo{{Dependency: "C"}},
+	}
+	c := types.ContractInfoV2{
+		ContractAddr: "C",
+	}
+	d := types.ContractInfoV2{
+		ContractAddr: "D",
+		Dependencies: []*types.ContractDependencyInfo{{Dependency: "C"}},
+	}
+	e := types.ContractInfoV2{
+		ContractAddr: "E",
+	}
+	res, err := contract.FrontierLevelsContractInfo([]types.ContractInfoV2{e, d, c, b, a})
+	require.Nil(t, err)
+	require.Equal(t, [][]types.ContractInfoV2{{a, d, e}, {b}, {c}}, res)
+
+	c.Dependencies = []*types.ContractDependencyInfo{{Dependency: "A"}}
+	_, err = contract.FrontierLevelsContractInfo([]types.ContractInfoV2{a, b, c})
+	require.Equal(t, types.ErrCircularContractDependency, err)
+}
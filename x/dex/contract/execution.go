@@ -3,6 +3,7 @@ package contract
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -34,6 +35,7 @@ func CallPreExecutionHooks(
 	defer span.End()
 	span.SetAttributes(attribute.String("contract", contractAddr))
 	abciWrapper := dexkeeperabci.KeeperWrapper{Keeper: dexkeeper}
+	RejectCrossingPostOnlyOrders(sdkCtx, types.ContractAddress(contractAddr), registeredPairs, dexkeeper)
 	if err := abciWrapper.HandleEBCancelOrders(spanCtx, sdkCtx, tracer, contractAddr, registeredPairs); err != nil {
 		return err
 	}
@@ -47,24 +49,60 @@ func ExecutePair(
 	dexkeeper *keeper.Keeper,
 	orderbook *types.OrderBook,
 ) []*types.SettlementEntry {
+	outcome, bracketTopUps := executePair(ctx, contractAddr, pair, dexkeeper, orderbook)
+	PlaceBracketTopUps(ctx, types.ContractAddress(contractAddr), dexkeeper, bracketTopUps)
+	return outcome.Settlements
+}
+
+// executePair matches the pair's orders and returns the outcome along with the take-profit top-ups
+// that still need to be placed through PlaceBracketTopUps
+
+func executePair(
+	ctx sdk.Context,
+	contractAddr string,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+	orderbook *types.OrderBook,
+) (exchange.ExecutionOutcome, []types.Order) {
 	typedContractAddr := types.ContractAddress(contractAddr)
 
 	// First cancel orders
-	cancelForPair(ctx, dexkeeper, typedContractAddr, pair)
-	// Add all limit orders to the orderbook
+	replacedInPlace := cancelForPair(ctx, dexkeeper, typedContractAddr, pair)
+	// Add all limit orders to the orderbook, except for replacements already put in place of the orders they replace
 	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
-	limitBuys := orders.GetLimitOrders(types.PositionDirection_LONG)
-	limitSells := orders.GetLimitOrders(types.PositionDirection_SHORT)
+	limitBuys := excludeOrders(orders.GetLimitOrders(types.PositionDirection_LONG), replacedInPlace)
+	limitSells := excludeOrders(orders.GetLimitOrders(types.PositionDirection_SHORT), replacedInPlace)
 	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, limitBuys, limitSells)
+	StoreGoodTilTimeOrdersForPair(ctx, typedContractAddr, pair, dexkeeper)
+	if dexkeeper.IsPairHalted(ctx, contractAddr, pair) {
+		// orders placed before the halt rest on the book, but nothing is matched while the pair is halted
+		CancelUnfilledIOCOrdersForPair(ctx, typedContractAddr, pair, dexkeeper, map[uint64]sdk.Dec{})
+		bracketCancellations, bracketTopUps := UpdateBracketOrdersForPair(ctx, typedContractAddr, pair, dexkeeper, exchange.ExecutionOutcome{})
+		dexutils.GetMemState(ctx.Context()).ClearCancellationForPair(ctx, typedContractAddr, pair)
+		ReportBracketCancellations(ctx, typedContractAddr, pair, bracketCancellations)
+		return exchange.ExecutionOutcome{Settlements: []*types.SettlementEntry{}}, bracketTopUps
+	}
 	// Fill market orders
 	marketOrderOutcome := matchMarketOrderForPair(ctx, typedContractAddr, pair, orderbook)
 	// Fill limit orders
 	limitOrderOutcome := exchange.MatchLimitOrders(ctx, orderbook)
 	totalOutcome := marketOrderOutcome.Merge(&limitOrderOutcome)
+	// Drop unfilled remainders of immediate-or-cancel orders
+	CancelUnfilledIOCOrdersForPair(ctx, typedContractAddr, pair, dexkeeper, GetOrderIDToSettledQuantities(totalOutcome.Settlements))
 
+	dexkeeperutils.TripCircuitBreakerIfNeeded(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
 	dexkeeperutils.SetPriceStateFromExecutionOutcome(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
+	dexkeeperutils.UpdateCandlesFromExecutionOutcome(ctx, dexkeeper, typedContractAddr, pair, totalOutcome)
+	RemoveMovedTriggeredOrdersForPair(ctx, typedContractAddr, pair, dexkeeper)
+	UpdateTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper, totalOutcome)
+	bracketCancellations, bracketTopUps := UpdateBracketOrdersForPair(ctx, typedContractAddr, pair, dexkeeper, totalOutcome)
 
-	return totalOutcome.Settlements
+	// Cancellations have been applied; only those resulting from matching are reported from here on
+	dexutils.GetMemState(ctx.Context()).ClearCancellationForPair(ctx, typedContractAddr, pair)
+	ReportSelfTradeCancellations(ctx, typedContractAddr, pair, totalOutcome.Cancellations)
+	ReportBracketCancellations(ctx, typedContractAddr, pair, bracketCancellations)
+
+	return totalOutcome, bracketTopUps
 }
 
 func cancelForPair(
@@ -72,9 +110,37 @@ func cancelForPair(
 	keeper *keeper.Keeper,
 	contractAddress types.ContractAddress,
 	pair types.Pair,
-) {
+) map[uint64]struct{} {
 	cancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, contractAddress, pair)
-	exchange.CancelOrders(ctx, keeper, contractAddress, pair, cancels.Get())
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, contractAddress, pair)
+	replacedInPlace := map[uint64]struct{}{}
+	toCancel := []*types.Cancellation{}
+	for _, cancel := range cancels.Get() {
+		if cancel.ReplacementOrderId != 0 && orders.Has(cancel.ReplacementOrderId) {
+			replacement := orders.GetByID(cancel.ReplacementOrderId)
+			if replacement.Status != types.OrderStatus_FAILED_TO_PLACE &&
+				exchange.ReplaceOrderInPlace(ctx, keeper, contractAddress, pair, cancel, replacement) {
+				replacedInPlace[replacement.Id] = struct{}{}
+				continue
+			}
+		}
+		toCancel = append(toCancel, cancel)
+	}
+	exchange.CancelOrders(ctx, keeper, contractAddress, pair, toCancel)
+	return replacedInPlace
+}
+
+func excludeOrders(orders []*types.Order, excluded map[uint64]struct{}) []*types.Order {
+	if len(excluded) == 0 {
+		return orders
+	}
+	res := []*types.Order{}
+	for _, order := range orders {
+		if _, ok := excluded[order.Id]; !ok {
+			res = append(res, order)
+		}
+	}
+	return res
 }
 
 func matchMarketOrderForPair(
@@ -91,6 +157,7 @@ func matchMarketOrderForPair(
 		marketBuys,
 		orderbook.Shorts,
 		types.PositionDirection_LONG,
+		orderbook.Pair,
 		orders,
 	)
 	marketSellOutcome := exchange.MatchMarketOrders(
@@ -98,6 +165,7 @@ func matchMarketOrderForPair(
 		marketSells,
 		orderbook.Longs,
 		types.PositionDirection_SHORT,
+		orderbook.Pair,
 		orders,
 	)
 	return marketBuyOutcome.Merge(&marketSellOutcome)
@@ -129,6 +197,8 @@ func ExecutePairsInParallel(ctx sdk.Context, contractAddr string, dexkeeper *kee
 	orderResults := []*types.Order{}
 	cancelResults := []*types.Cancellation{}
 	settlements := []*types.SettlementEntry{}
+	clearingPrices := []*types.Price{}
+	bracketTopUps := map[types.PairString][]types.Order{}
 
 	mu := sync.Mutex{}
 	wg := sync.WaitGroup{}
@@ -146,7 +216,8 @@ func ExecutePairsInParallel(ctx sdk.Context, contractAddr string, dexkeeper *kee
 			if !found {
 				panic(fmt.Sprintf("Orderbook not found for %s", pairCopy.String()))
 			}
-			pairSettlements := ExecutePair(pairCtx, contractAddr, pair, dexkeeper, orderbook)
+			outcome, pairBracketTopUps := executePair(pairCtx, contractAddr, pair, dexkeeper, orderbook)
+			pairSettlements := outcome.Settlements
 			orderIDToSettledQuantities := GetOrderIDToSettledQuantities(pairSettlements)
 			PrepareCancelUnfulfilledMarketOrders(pairCtx, typedContractAddr, pairCopy, orderIDToSettledQuantities)
 
@@ -156,12 +227,35 @@ func ExecutePairsInParallel(ctx sdk.Context, contractAddr string, dexkeeper *kee
 			orderResults = append(orderResults, orders...)
 			cancelResults = append(cancelResults, cancels...)
 			settlements = append(settlements, pairSettlements...)
+			bracketTopUps[pairStr] = pairBracketTopUps
+			if outcome.ClearingPrice != nil {
+				clearingPrices = append(clearingPrices, &types.Price{
+					SnapshotTimestampInSeconds: uint64(ctx.BlockTime().Unix()),
+					Price:                      *outcome.ClearingPrice,
+					Pair:                       &pairCopy,
+				})
+			}
 			// ordering of events doesn't matter since events aren't part of consensus
 			ctx.EventManager().EmitEvents(pairCtx.EventManager().Events())
 		}()
 	}
 	wg.Wait()
-	dexkeeper.SetMatchResult(ctx, contractAddr, types.NewMatchResult(orderResults, cancelResults, settlements))
+	matchResult := types.NewMatchResult(orderResults, cancelResults, settlements)
+	// pairs are executed in parallel, so clearing prices are sorted for the result to be deterministic
+	sort.SliceStable(clearingPrices, func(i, j int) bool {
+		return types.GetPairString(clearingPrices[i].Pair) < types.GetPairString(clearingPrices[j].Pair)
+	})
+	matchResult.ClearingPrices = clearingPrices
+	dexkeeper.SetMatchResult(ctx, contractAddr, matchResult)
+	// top-ups share the contract's order ids, so they're placed in pair order once all pairs are done
+	pairStrs := make([]types.PairString, 0, len(bracketTopUps))
+	for pairStr := range bracketTopUps {
+		pairStrs = append(pairStrs, pairStr)
+	}
+	sort.Slice(pairStrs, func(i, j int) bool { return pairStrs[i] < pairStrs[j] })
+	for _, pairStr := range pairStrs {
+		PlaceBracketTopUps(ctx, typedContractAddr, dexkeeper, bracketTopUps[pairStr])
+	}
 
 	return settlements
 }
@@ -372,3 +372,165 @@ func TestEmitSettlementMetrics(t *testing.T) {
 	totalQuantity := contract.EmitSettlementMetrics(settlements)
 	require.Equal(t, int64(300), totalQuantity)
 }
+
+func TestExecutePairHalted(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  7,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	dexkeeper.SetPairHalt(ctx, TEST_CONTRACT, types.PairHalt{Pair: &pair, HaltedByGovernance: true})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair).Add(
+		&types.Order{
+			Id:                1,
+			Account:           TEST_ACCOUNT,
+			ContractAddr:      TEST_CONTRACT,
+			Price:             sdk.MustNewDecFromStr("200"),
+			Quantity:          sdk.MustNewDecFromStr("1"),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         types.OrderType_MARKET,
+			PositionDirection: types.PositionDirection_LONG,
+		},
+	)
+
+	settlements := contract.ExecutePair(
+		ctx,
+		TEST_CONTRACT,
+		pair,
+		dexkeeper,
+		orderbook,
+	)
+	require.Equal(t, 0, len(settlements))
+	require.Equal(t, sdk.NewDec(5), dexkeeper.GetAllShortBookForPair(ctx, TEST_CONTRACT, pair.PriceDenom, pair.AssetDenom)[0].GetOrderEntry().Quantity)
+}
+
+func TestExecutePairTripsCircuitBreaker(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom: "USDC",
+		AssetDenom: "ATOM",
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	params := types.DefaultParams()
+	params.CircuitBreakerThreshold = sdk.MustNewDecFromStr("0.1")
+	params.CircuitBreakerPauseBlocks = 2
+	dexkeeper.SetParams(ctx, params)
+	dexkeeper.SetPriceState(ctx, types.Price{
+		SnapshotTimestampInSeconds: TestTimestamp - 1,
+		Price:                      sdk.NewDec(80),
+		Pair:                       &pair,
+	}, TEST_CONTRACT)
+	dexkeeper.SetShortOrderBookEntry(ctx, TEST_CONTRACT, &types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:    sdk.NewDec(101),
+			Quantity: sdk.NewDec(5),
+			Allocations: []*types.Allocation{{
+				OrderId:  7,
+				Account:  "abc",
+				Quantity: sdk.NewDec(5),
+			}},
+			PriceDenom: "USDC",
+			AssetDenom: "ATOM",
+		},
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair).Add(
+		&types.Order{
+			Id:                1,
+			Account:           TEST_ACCOUNT,
+			ContractAddr:      TEST_CONTRACT,
+			Price:             sdk.MustNewDecFromStr("200"),
+			Quantity:          sdk.MustNewDecFromStr("1"),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         types.OrderType_MARKET,
+			PositionDirection: types.PositionDirection_LONG,
+		},
+	)
+
+	settlements := contract.ExecutePair(
+		ctx,
+		TEST_CONTRACT,
+		pair,
+		dexkeeper,
+		orderbook,
+	)
+	// fills of the tripping block stand
+	require.Equal(t, 2, len(settlements))
+	require.True(t, dexkeeper.IsPairHalted(ctx.WithBlockHeight(int64(TestHeight)+1), TEST_CONTRACT, pair))
+	require.True(t, dexkeeper.IsPairHalted(ctx.WithBlockHeight(int64(TestHeight)+2), TEST_CONTRACT, pair))
+	require.False(t, dexkeeper.IsPairHalted(ctx.WithBlockHeight(int64(TestHeight)+3), TEST_CONTRACT, pair))
+}
+
+func TestExecutePairsInParallelUniformPrice(t *testing.T) {
+	pair := types.Pair{
+		PriceDenom:   "USDC",
+		AssetDenom:   "ATOM",
+		ClearingMode: types.ClearingMode_UNIFORM_PRICE,
+	}
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	orders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TEST_CONTRACT), pair)
+	addOrder := func(id uint64, price int64, quantity int64, orderType types.OrderType, direction types.PositionDirection) {
+		orders.Add(&types.Order{
+			Id:                id,
+			Account:           TEST_ACCOUNT,
+			ContractAddr:      TEST_CONTRACT,
+			Price:             sdk.NewDec(price),
+			Quantity:          sdk.NewDec(quantity),
+			PriceDenom:        pair.PriceDenom,
+			AssetDenom:        pair.AssetDenom,
+			OrderType:         orderType,
+			PositionDirection: direction,
+		})
+	}
+	addOrder(1, 105, 3, types.OrderType_LIMIT, types.PositionDirection_LONG)
+	addOrder(2, 100, 2, types.OrderType_LIMIT, types.PositionDirection_LONG)
+	addOrder(3, 95, 2, types.OrderType_LIMIT, types.PositionDirection_SHORT)
+	addOrder(4, 102, 4, types.OrderType_LIMIT, types.PositionDirection_SHORT)
+	// the market order takes 1 from the short at 95 before limit orders are matched
+	addOrder(5, 200, 1, types.OrderType_MARKET, types.PositionDirection_LONG)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(TEST_CONTRACT), pair)
+	orderbooks := datastructures.NewTypedSyncMap[types.PairString, *types.OrderBook]()
+	orderbooks.Store(types.GetPairString(&pair), orderbook)
+
+	settlements := contract.ExecutePairsInParallel(ctx, TEST_CONTRACT, dexkeeper, []types.Pair{pair}, orderbooks)
+	clearingPrice := sdk.NewDec(102)
+	limitSettled := sdk.ZeroDec()
+	for _, settlement := range settlements {
+		if settlement.OrderId == 5 || (settlement.OrderId == 3 && settlement.ExecutionCostOrProceed.Equal(sdk.NewDec(95))) {
+			continue
+		}
+		require.Equal(t, clearingPrice, settlement.ExecutionCostOrProceed)
+		limitSettled = limitSettled.Add(settlement.Quantity)
+	}
+	require.Equal(t, sdk.NewDec(6), limitSettled)
+
+	price, found := dexkeeper.GetPriceState(ctx, TEST_CONTRACT, TestTimestamp, pair)
+	require.True(t, found)
+	require.Equal(t, clearingPrice, price.Price)
+	matchResult, found := dexkeeper.GetMatchResultState(ctx, TEST_CONTRACT)
+	require.True(t, found)
+	require.Equal(t, 1, len(matchResult.ClearingPrices))
+	require.Equal(t, clearingPrice, matchResult.ClearingPrices[0].Price)
+	require.Equal(t, TestTimestamp, matchResult.ClearingPrices[0].SnapshotTimestampInSeconds)
+	require.Equal(t, pair, *matchResult.ClearingPrices[0].Pair)
+}
@@ -18,11 +18,20 @@ func PrepareCancelUnfulfilledMarketOrders(
 	pair types.Pair,
 	orderIDToSettledQuantities map[uint64]sdk.Dec,
 ) {
-	dexutils.GetMemState(ctx.Context()).ClearCancellationForPair(ctx, typedContractAddr, pair)
+	cancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair)
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
 	for _, marketOrderID := range getUnfulfilledPlacedMarketOrderIds(ctx, typedContractAddr, pair, orderIDToSettledQuantities) {
-		dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Add(&types.Cancellation{
+		// orders cancelled by self-trade prevention are already reported
+		if cancels.Has(&types.Cancellation{Id: marketOrderID}) {
+			continue
+		}
+		initiator := types.CancellationInitiator_USER
+		if orders.GetByID(marketOrderID).TimeInForce == types.TimeInForce_IOC {
+			initiator = types.CancellationInitiator_UNFILLED_IOC
+		}
+		cancels.Add(&types.Cancellation{
 			Id:        marketOrderID,
-			Initiator: types.CancellationInitiator_USER,
+			Initiator: initiator,
 		})
 	}
 }
@@ -47,6 +56,8 @@ func getUnfulfilledPlacedMarketOrderIds(
 			if _, ok := orderIDToSettledQuantities[order.Id]; !ok {
 				res = append(res, order.Id)
 			}
+		} else if isUnfilledIOCOrder(order, orderIDToSettledQuantities) {
+			res = append(res, order.Id)
 		}
 	}
 	return res
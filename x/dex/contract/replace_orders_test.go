@@ -0,0 +1,65 @@
+package contract_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceOrdersQueuePriority(t *testing.T) {
+	for _, tc := range []struct {
+		name                string
+		replacementQuantity int64
+		expectedOrderIDs    []uint64
+		expectedQuantity    sdk.Dec
+	}{
+		{"decrease keeps priority", 3, []uint64{3, 2}, sdk.NewDec(8)},
+		{"increase loses priority", 7, []uint64{2, 3}, sdk.NewDec(12)},
+	} {
+		pair := TEST_PAIR()
+		dexkeeper, ctx := keepertest.DexKeeper(t)
+		typedContractAddr := types.ContractAddress(keepertest.TestContract)
+		dexkeeper.SetLongOrderBookEntry(ctx, keepertest.TestContract, &types.LongBook{
+			Price: sdk.NewDec(100),
+			Entry: &types.OrderEntry{
+				Price:    sdk.NewDec(100),
+				Quantity: sdk.NewDec(10),
+				Allocations: []*types.Allocation{
+					{OrderId: 1, Account: TEST_ACCOUNT, Quantity: sdk.NewDec(5)},
+					{OrderId: 2, Account: "abc", Quantity: sdk.NewDec(5)},
+				},
+				PriceDenom: pair.PriceDenom,
+				AssetDenom: pair.AssetDenom,
+			},
+		})
+		replacement := newTimeInForceOrder(3, 100, tc.replacementQuantity, types.PositionDirection_LONG)
+		dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Add(replacement)
+		dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Add(&types.Cancellation{
+			Id:                 1,
+			Creator:            TEST_ACCOUNT,
+			ContractAddr:       keepertest.TestContract,
+			Price:              sdk.NewDec(100),
+			PriceDenom:         pair.PriceDenom,
+			AssetDenom:         pair.AssetDenom,
+			PositionDirection:  types.PositionDirection_LONG,
+			ReplacementOrderId: 3,
+		})
+
+		orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, typedContractAddr, pair)
+		contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+		longBook, found := dexkeeper.GetLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(100), pair.PriceDenom, pair.AssetDenom)
+		require.True(t, found, tc.name)
+		orderIDs := []uint64{}
+		for _, allocation := range longBook.Entry.Allocations {
+			orderIDs = append(orderIDs, allocation.OrderId)
+		}
+		require.Equal(t, tc.expectedOrderIDs, orderIDs, tc.name)
+		require.Equal(t, tc.expectedQuantity, longBook.Entry.Quantity, tc.name)
+	}
+}
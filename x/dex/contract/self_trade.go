@@ -0,0 +1,31 @@
+package contract
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// ReportSelfTradeCancellations adds orders cancelled by self-trade prevention during matching to
+// the block cancellations, so that the contract is notified of them along with unfulfilled market
+// orders.
+func ReportSelfTradeCancellations(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	cancellations []*types.Cancellation,
+) {
+	memState := dexutils.GetMemState(ctx.Context())
+	cancels := memState.GetBlockCancels(ctx, typedContractAddr, pair)
+	orders := memState.GetBlockOrders(ctx, typedContractAddr, pair)
+	for _, cancellation := range cancellations {
+		cancels.Add(cancellation)
+		if orders.Has(cancellation.Id) {
+			order := orders.GetByID(cancellation.Id)
+			order.Status = types.OrderStatus_CANCELLED
+			order.StatusDescription = "self-trade prevention"
+			orders.Add(order)
+		}
+		emitOrderStatusEvent(ctx, typedContractAddr, cancellation.Id, types.OrderStatus_CANCELLED, "self-trade prevention")
+	}
+}
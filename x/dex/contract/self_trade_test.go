@@ -0,0 +1,42 @@
+package contract_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutePairReportsSelfTradeCancellations(t *testing.T) {
+	pair := TEST_PAIR()
+	pair.SelfTradePrevention = types.SelfTradePrevention_CANCEL_NEWEST
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	orders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	orders.Add(newTimeInForceOrder(1, 100, 2, types.PositionDirection_SHORT))
+	orders.Add(newTimeInForceOrder(2, 100, 2, types.PositionDirection_LONG))
+	market := newTimeInForceOrder(3, 100, 1, types.PositionDirection_LONG)
+	market.OrderType = types.OrderType_MARKET
+	orders.Add(market)
+
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, typedContractAddr, pair)
+	settlements := contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.Equal(t, 0, len(settlements))
+	contract.PrepareCancelUnfulfilledMarketOrders(ctx, typedContractAddr, pair, contract.GetOrderIDToSettledQuantities(settlements))
+
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get()
+	require.Equal(t, 2, len(cancels))
+	for _, cancel := range cancels {
+		require.Equal(t, types.CancellationInitiator_SELF_TRADE, cancel.Initiator)
+		require.Equal(t, types.OrderStatus_CANCELLED, orders.GetByID(cancel.Id).Status)
+	}
+	// the older short order keeps resting on the book
+	_, found := dexkeeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(100), pair.PriceDenom, pair.AssetDenom)
+	require.True(t, found)
+}
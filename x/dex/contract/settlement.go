@@ -1,6 +1,8 @@
 package contract
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
@@ -13,7 +15,31 @@ func HandleSettlements(
 	dexkeeper *keeper.Keeper,
 	settlements []*types.SettlementEntry,
 ) error {
-	return callSettlementHook(ctx, contractAddr, dexkeeper, settlements)
+	if err := callSettlementHook(ctx, contractAddr, dexkeeper, settlements); err != nil {
+		return err
+	}
+	collectFees(ctx, contractAddr, dexkeeper, settlements)
+	dexkeeperutils.UpdateAccountFillsFromSettlements(ctx, dexkeeper, contractAddr, settlements)
+	return nil
+}
+
+// collectFees charges the fees of the settlements to the contract. Fees that can't be collected
+// are carried over to the contract's next collection and reported with an event, rather than
+// failing the contract's settlement.
+func collectFees(
+	ctx sdk.Context,
+	contractAddr string,
+	dexkeeper *keeper.Keeper,
+	settlements []*types.SettlementEntry,
+) {
+	if err := dexkeeper.CollectFees(ctx, contractAddr, settlements); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to collect fees from %s: %s", contractAddr, err))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCollectFeesFailed,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+			sdk.NewAttribute(types.AttributeKeyStatusDescription, err.Error()),
+		))
+	}
 }
 
 func callSettlementHook(
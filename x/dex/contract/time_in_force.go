@@ -0,0 +1,149 @@
+package contract
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// RejectCrossingPostOnlyOrders marks post-only orders that would cross the book as failed, before
+// they are sent to the contract for placement.
+func RejectCrossingPostOnlyOrders(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pairs []types.Pair,
+	dexkeeper *keeper.Keeper,
+) {
+	for _, pair := range pairs {
+		orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+		rejected := exchange.GetCrossingPostOnlyOrders(ctx, dexkeeper, typedContractAddr, pair, orders)
+		if len(rejected) == 0 {
+			continue
+		}
+		orders.MarkFailedToPlace(rejected)
+		for _, order := range rejected {
+			emitOrderStatusEvent(ctx, typedContractAddr, order.ID, types.OrderStatus_FAILED_TO_PLACE, order.Reason)
+		}
+	}
+}
+
+// CancelUnfilledIOCOrdersForPair removes whatever is left of this block's immediate-or-cancel
+// orders from the book after matching. The contract is notified of the cancellation together
+// with unfulfilled market orders.
+func CancelUnfilledIOCOrdersForPair(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+	orderIDToSettledQuantities map[uint64]sdk.Dec,
+) {
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	for _, order := range orders.Get() {
+		if !isUnfilledIOCOrder(order, orderIDToSettledQuantities) {
+			continue
+		}
+		exchange.CancelOrders(ctx, dexkeeper, typedContractAddr, pair, []*types.Cancellation{
+			cancellationForOrder(order, types.CancellationInitiator_UNFILLED_IOC),
+		})
+		order.Status = types.OrderStatus_CANCELLED
+		order.StatusDescription = "unfilled remainder of immediate-or-cancel order"
+		orders.Add(order)
+		emitOrderStatusEvent(ctx, typedContractAddr, order.Id, order.Status, order.StatusDescription)
+	}
+}
+
+// StoreGoodTilTimeOrdersForPair indexes this block's good-til-time orders so that they can be
+// cancelled once expired.
+func StoreGoodTilTimeOrdersForPair(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+) {
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	for _, direction := range []types.PositionDirection{types.PositionDirection_LONG, types.PositionDirection_SHORT} {
+		for _, order := range orders.GetLimitOrders(direction) {
+			if order.TimeInForce == types.TimeInForce_GTT {
+				dexkeeper.SetGoodTilTimeOrder(ctx, string(typedContractAddr), *order)
+			}
+		}
+	}
+}
+
+// CancelExpiredOrders adds cancellations for good-til-time orders that have expired as of the
+// current block. Orders that are no longer on the book are simply dropped from the index.
+func CancelExpiredOrders(ctx sdk.Context, dexkeeper *keeper.Keeper, contracts []types.ContractInfoV2) {
+	for _, contract := range contracts {
+		if !contract.NeedOrderMatching {
+			continue
+		}
+		cancelled := false
+		for _, pair := range dexkeeper.GetAllRegisteredPairs(ctx, contract.ContractAddr) {
+			cancelled = CancelExpiredOrdersForPair(ctx, types.ContractAddress(contract.ContractAddr), pair, dexkeeper) || cancelled
+		}
+		if cancelled {
+			dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contract.ContractAddr, dexkeeper.GetContractWithoutGasCharge)
+		}
+	}
+}
+
+// CancelExpiredOrdersForPair returns true if any expired order has been added to the block cancellations
+func CancelExpiredOrdersForPair(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+) bool {
+	cancelled := false
+	cancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair)
+	for _, order := range dexkeeper.GetExpiredGoodTilTimeOrdersForPair(ctx, string(typedContractAddr), pair.PriceDenom, pair.AssetDenom) {
+		order := order
+		getter := dexkeeper.GetLongAllocationForOrderID
+		if order.PositionDirection == types.PositionDirection_SHORT {
+			getter = dexkeeper.GetShortAllocationForOrderID
+		}
+		if _, found := getter(ctx, string(typedContractAddr), pair.PriceDenom, pair.AssetDenom, order.Price, order.Id); !found {
+			dexkeeper.RemoveGoodTilTimeOrder(ctx, string(typedContractAddr), order.Id, pair.PriceDenom, pair.AssetDenom)
+			continue
+		}
+		cancels.Add(cancellationForOrder(&order, types.CancellationInitiator_EXPIRED))
+		emitOrderStatusEvent(ctx, typedContractAddr, order.Id, types.OrderStatus_CANCELLED, "good-til-time order expired")
+		cancelled = true
+	}
+	return cancelled
+}
+
+func isUnfilledIOCOrder(order *types.Order, orderIDToSettledQuantities map[uint64]sdk.Dec) bool {
+	if order.OrderType != types.OrderType_LIMIT || order.TimeInForce != types.TimeInForce_IOC || order.Status == types.OrderStatus_FAILED_TO_PLACE {
+		return false
+	}
+	settledQuantity, ok := orderIDToSettledQuantities[order.Id]
+	return !ok || settledQuantity.LT(order.Quantity)
+}
+
+func cancellationForOrder(order *types.Order, initiator types.CancellationInitiator) *types.Cancellation {
+	return &types.Cancellation{
+		Id:                order.Id,
+		Initiator:         initiator,
+		Creator:           order.Account,
+		ContractAddr:      order.ContractAddr,
+		PriceDenom:        order.PriceDenom,
+		AssetDenom:        order.AssetDenom,
+		PositionDirection: order.PositionDirection,
+		Price:             order.Price,
+	}
+}
+
+func emitOrderStatusEvent(ctx sdk.Context, typedContractAddr types.ContractAddress, orderID uint64, status types.OrderStatus, description string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOrderStatus,
+		sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(orderID)),
+		sdk.NewAttribute(types.AttributeKeyContractAddress, string(typedContractAddr)),
+		sdk.NewAttribute(types.AttributeKeyOrderStatus, status.String()),
+		sdk.NewAttribute(types.AttributeKeyStatusDescription, description),
+	))
+}
@@ -0,0 +1,139 @@
+package contract_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func newTimeInForceOrder(id uint64, price int64, quantity int64, direction types.PositionDirection) *types.Order {
+	pair := TEST_PAIR()
+	return &types.Order{
+		Id:                id,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(price),
+		Quantity:          sdk.NewDec(quantity),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: direction,
+	}
+}
+
+func TestRejectCrossingPostOnlyOrders(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(101),
+			Quantity:    sdk.NewDec(5),
+			Allocations: []*types.Allocation{{OrderId: 10, Account: "abc", Quantity: sdk.NewDec(5)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	orders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	// crosses the resting short at 101
+	crossingBook := newTimeInForceOrder(1, 101, 1, types.PositionDirection_LONG)
+	crossingBook.PostOnly = true
+	orders.Add(crossingBook)
+	// rests below the best ask
+	resting := newTimeInForceOrder(2, 99, 1, types.PositionDirection_LONG)
+	resting.PostOnly = true
+	orders.Add(resting)
+	// crosses the post-only long accepted above
+	crossingBlock := newTimeInForceOrder(3, 99, 1, types.PositionDirection_SHORT)
+	crossingBlock.PostOnly = true
+	orders.Add(crossingBlock)
+	// regular limit orders are unaffected
+	orders.Add(newTimeInForceOrder(4, 102, 1, types.PositionDirection_LONG))
+
+	contract.RejectCrossingPostOnlyOrders(ctx, typedContractAddr, []types.Pair{pair}, dexkeeper)
+	require.Equal(t, types.OrderStatus_FAILED_TO_PLACE, orders.GetByID(1).Status)
+	require.Equal(t, types.OrderStatus_PLACED, orders.GetByID(2).Status)
+	require.Equal(t, types.OrderStatus_FAILED_TO_PLACE, orders.GetByID(3).Status)
+	require.Equal(t, types.OrderStatus_PLACED, orders.GetByID(4).Status)
+	require.Equal(t, 2, len(ctx.EventManager().Events()))
+}
+
+func TestCancelUnfilledIOCOrders(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	dexkeeper.SetShortOrderBookEntry(ctx, keepertest.TestContract, &types.ShortBook{
+		Price: sdk.NewDec(101),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(101),
+			Quantity:    sdk.NewDec(5),
+			Allocations: []*types.Allocation{{OrderId: 10, Account: "abc", Quantity: sdk.NewDec(5)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, typedContractAddr, pair)
+	orders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	ioc := newTimeInForceOrder(1, 101, 8, types.PositionDirection_LONG)
+	ioc.TimeInForce = types.TimeInForce_IOC
+	orders.Add(ioc)
+
+	settlements := contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.Equal(t, 2, len(settlements))
+	// the unfilled remainder doesn't rest on the book
+	_, found := dexkeeper.GetLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(101), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	require.Equal(t, types.OrderStatus_CANCELLED, orders.GetByID(1).Status)
+
+	// the contract is notified of the cancellation
+	contract.PrepareCancelUnfulfilledMarketOrders(ctx, typedContractAddr, pair, contract.GetOrderIDToSettledQuantities(settlements))
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair)
+	require.Equal(t, 1, len(cancels.Get()))
+	require.Equal(t, uint64(1), cancels.Get()[0].Id)
+	require.Equal(t, types.CancellationInitiator_UNFILLED_IOC, cancels.Get()[0].Initiator)
+}
+
+func TestCancelExpiredOrders(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	orders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	gtt := newTimeInForceOrder(1, 99, 1, types.PositionDirection_LONG)
+	gtt.TimeInForce = types.TimeInForce_GTT
+	gtt.ExpiryHeight = 12
+	orders.Add(gtt)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, typedContractAddr, pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	require.Equal(t, 1, len(dexkeeper.GetAllGoodTilTimeOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)))
+
+	// not expired yet
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	ctx = ctx.WithBlockHeight(11)
+	require.False(t, contract.CancelExpiredOrdersForPair(ctx, typedContractAddr, pair, dexkeeper))
+
+	// expired orders are cancelled in the same block
+	ctx = ctx.WithBlockHeight(12)
+	require.True(t, contract.CancelExpiredOrdersForPair(ctx, typedContractAddr, pair, dexkeeper))
+	cancels := dexutil.GetMemState(ctx.Context()).GetBlockCancels(ctx, typedContractAddr, pair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, types.CancellationInitiator_EXPIRED, cancels[0].Initiator)
+	orderbook = keeperutil.PopulateOrderbook(ctx, dexkeeper, typedContractAddr, pair)
+	contract.ExecutePair(ctx, keepertest.TestContract, pair, dexkeeper, orderbook)
+	_, found := dexkeeper.GetLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(99), pair.PriceDenom, pair.AssetDenom)
+	require.False(t, found)
+	require.Equal(t, 0, len(dexkeeper.GetAllGoodTilTimeOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)))
+
+	// index entries for orders no longer on the book are dropped
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	dexkeeper.SetGoodTilTimeOrder(ctx, keepertest.TestContract, *gtt)
+	require.False(t, contract.CancelExpiredOrdersForPair(ctx, typedContractAddr, pair, dexkeeper))
+	require.Equal(t, 0, len(dexkeeper.GetAllGoodTilTimeOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)))
+}
@@ -0,0 +1,110 @@
+package contract
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// MoveTriggeredOrders adds stop loss/limit orders that were triggered in the previous block
+// to the current block's orders as market/limit orders respectively.
+func MoveTriggeredOrders(ctx sdk.Context, dexkeeper *keeper.Keeper, contracts []types.ContractInfoV2) {
+	for _, contract := range contracts {
+		if !contract.NeedOrderMatching {
+			continue
+		}
+		moved := false
+		for _, pair := range dexkeeper.GetAllRegisteredPairs(ctx, contract.ContractAddr) {
+			moved = MoveTriggeredOrderForPair(ctx, types.ContractAddress(contract.ContractAddr), pair, dexkeeper) || moved
+		}
+		if moved {
+			dexutils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, contract.ContractAddr, dexkeeper.GetContractWithoutGasCharge)
+		}
+	}
+}
+
+// MoveTriggeredOrderForPair returns true if any triggered order has been added to the block orders.
+// The triggered orders stay in the store until they are matched in EndBlock, so that they are placed
+// again in the next block if the contract's EndBlock run is rolled back. Nothing is moved for halted
+// pairs, where a triggered stop loss would be cancelled as an unfulfilled market order.
+func MoveTriggeredOrderForPair(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+) bool {
+	if dexkeeper.IsPairHalted(ctx, string(typedContractAddr), pair) {
+		return false
+	}
+	moved := false
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	for _, order := range dexkeeper.GetTriggerReachedOrdersForPair(ctx, string(typedContractAddr), pair.PriceDenom, pair.AssetDenom) {
+		order := order
+		switch order.OrderType {
+		case types.OrderType_STOPLOSS:
+			order.OrderType = types.OrderType_MARKET
+		case types.OrderType_STOPLIMIT:
+			order.OrderType = types.OrderType_LIMIT
+		}
+		orders.Add(&order)
+		moved = true
+	}
+	return moved
+}
+
+// RemoveMovedTriggeredOrdersForPair removes the triggered orders that were moved into the current
+// block's orders from the store, once they have been placed and matched in EndBlock.
+func RemoveMovedTriggeredOrdersForPair(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+) {
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	for _, order := range dexkeeper.GetTriggerReachedOrdersForPair(ctx, string(typedContractAddr), pair.PriceDenom, pair.AssetDenom) {
+		if orders.Has(order.Id) {
+			dexkeeper.RemoveTriggeredOrder(ctx, string(typedContractAddr), order.Id, pair.PriceDenom, pair.AssetDenom)
+		}
+	}
+}
+
+// UpdateTriggeredOrderForPair stores stop loss/limit orders placed in the current block, and marks
+// stored orders as triggered if the block's clearing price has crossed their trigger price. A long
+// stop order is triggered once the price rises to its trigger price, and a short stop order is
+// triggered once the price falls to its trigger price.
+func UpdateTriggeredOrderForPair(
+	ctx sdk.Context,
+	typedContractAddr types.ContractAddress,
+	pair types.Pair,
+	dexkeeper *keeper.Keeper,
+	totalOutcome exchange.ExecutionOutcome,
+) {
+	memState := dexutils.GetMemState(ctx.Context())
+	cancels := memState.GetBlockCancels(ctx, typedContractAddr, pair)
+	for _, order := range memState.GetBlockOrders(ctx, typedContractAddr, pair).GetTriggeredOrders() {
+		if cancels.Has(&types.Cancellation{Id: order.Id}) {
+			continue
+		}
+		dexkeeper.SetTriggeredOrder(ctx, string(typedContractAddr), *order)
+	}
+
+	if totalOutcome.TotalQuantity.IsZero() {
+		return
+	}
+	clearingPrice := totalOutcome.TotalNotional.Quo(totalOutcome.TotalQuantity)
+	for _, order := range dexkeeper.GetTriggerCrossedOrdersForPair(ctx, string(typedContractAddr), pair.PriceDenom, pair.AssetDenom, clearingPrice) {
+		order.TriggerStatus = true
+		dexkeeper.SetTriggeredOrder(ctx, string(typedContractAddr), order)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTriggerOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprint(order.Id)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, string(typedContractAddr)),
+			sdk.NewAttribute(types.AttributeKeyPriceDenom, pair.PriceDenom),
+			sdk.NewAttribute(types.AttributeKeyAssetDenom, pair.AssetDenom),
+		))
+	}
+}
@@ -0,0 +1,111 @@
+package contract_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutil "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateAndMoveTriggeredOrders(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	orders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair)
+	orders.Add(&types.Order{
+		Id:                1,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.ZeroDec(),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.NewDec(105),
+	})
+	orders.Add(&types.Order{
+		Id:                2,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(90),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLIMIT,
+		PositionDirection: types.PositionDirection_SHORT,
+		TriggerPrice:      sdk.NewDec(95),
+	})
+
+	// no trade in this block, so only store the stop orders
+	contract.UpdateTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper, exchange.ExecutionOutcome{
+		TotalNotional: sdk.ZeroDec(),
+		TotalQuantity: sdk.ZeroDec(),
+	})
+	triggeredOrders := dexkeeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 2, len(triggeredOrders))
+	require.False(t, triggeredOrders[0].TriggerStatus)
+	require.False(t, triggeredOrders[1].TriggerStatus)
+
+	// clearing price of 94 triggers the short stop limit order only
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	contract.UpdateTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper, exchange.ExecutionOutcome{
+		TotalNotional: sdk.NewDec(188),
+		TotalQuantity: sdk.NewDec(2),
+	})
+	triggeredOrders = dexkeeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 2, len(triggeredOrders))
+	require.False(t, triggeredOrders[0].TriggerStatus)
+	require.True(t, triggeredOrders[1].TriggerStatus)
+
+	// the triggered order is moved into the next block as a limit order
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	require.True(t, contract.MoveTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper))
+	blockOrders := dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Get()
+	require.Equal(t, 1, len(blockOrders))
+	require.Equal(t, uint64(2), blockOrders[0].Id)
+	require.Equal(t, types.OrderType_LIMIT, blockOrders[0].OrderType)
+	// the triggered order is only removed from the store once it's been matched
+	triggeredOrders = dexkeeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 2, len(triggeredOrders))
+	contract.RemoveMovedTriggeredOrdersForPair(ctx, typedContractAddr, pair, dexkeeper)
+	triggeredOrders = dexkeeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)
+	require.Equal(t, 1, len(triggeredOrders))
+	require.Equal(t, uint64(1), triggeredOrders[0].Id)
+	dexutil.GetMemState(ctx.Context()).Clear(ctx)
+	require.False(t, contract.MoveTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper))
+}
+
+func TestMoveTriggeredOrdersForHaltedPair(t *testing.T) {
+	pair := TEST_PAIR()
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	typedContractAddr := types.ContractAddress(keepertest.TestContract)
+	dexkeeper.SetTriggeredOrder(ctx, keepertest.TestContract, types.Order{
+		Id:                1,
+		Account:           TEST_ACCOUNT,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.ZeroDec(),
+		Quantity:          sdk.NewDec(1),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.NewDec(105),
+		TriggerStatus:     true,
+	})
+	dexkeeper.SetPairHalt(ctx, keepertest.TestContract, types.PairHalt{Pair: &pair, HaltedByGovernance: true})
+
+	// the triggered order waits in the store until the pair is resumed
+	require.False(t, contract.MoveTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper))
+	require.Equal(t, 0, len(dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Get()))
+	require.Equal(t, 1, len(dexkeeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom)))
+
+	dexkeeper.SetPairHalt(ctx, keepertest.TestContract, types.PairHalt{Pair: &pair})
+	require.True(t, contract.MoveTriggeredOrderForPair(ctx, typedContractAddr, pair, dexkeeper))
+	require.Equal(t, types.OrderType_MARKET, dexutil.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Get()[0].OrderType)
+}
@@ -12,15 +12,31 @@ import (
 var DexWhitelistedKeys = []string{
 	types.LongBookKey,
 	types.ShortBookKey,
+	types.TriggerBookKey,
+	types.LongTriggerPriceKey,
+	types.ShortTriggerPriceKey,
+	types.TriggeredOrderKey,
+	types.TriggerAccountOrderKey,
+	types.LongDepthKey,
+	types.ShortDepthKey,
+	types.LongAccountOrderKey,
+	types.ShortAccountOrderKey,
+	types.GoodTilTimeOrderKey,
+	types.GoodTilTimeExpiryKey,
+	types.PendingBracketOrderKey,
+	types.ActiveBracketOrderKey,
 	types.OrderKey,
 	types.AccountActiveOrdersKey,
 	types.CancelKey,
 	types.TwapKey,
 	types.PriceKey,
+	types.CandleKey,
 	types.NextOrderIDKey,
 	types.MatchResultKey,
 	types.LongOrderCountKey,
 	types.ShortOrderCountKey,
+	types.PairHaltKey,
+	types.UncollectedFeeKey,
 	keeper.ContractPrefixKey,
 }
 
@@ -14,6 +14,8 @@ func CancelOrders(
 ) {
 	for _, cancel := range cancels {
 		cancelOrder(ctx, keeper, cancel, contract, pair)
+		keeper.RemoveTriggeredOrder(ctx, string(contract), cancel.Id, pair.PriceDenom, pair.AssetDenom)
+		keeper.RemoveGoodTilTimeOrder(ctx, string(contract), cancel.Id, pair.PriceDenom, pair.AssetDenom)
 	}
 }
 
@@ -51,3 +53,51 @@ func cancelOrder(ctx sdk.Context, keeper *keeper.Keeper, cancellation *types.Can
 	entry.SetEntry(&newEntry)
 	setter(ctx, string(contract), entry)
 }
+
+// ReplaceOrderInPlace swaps the cancelled order's allocation for the replacement order if the
+// replacement rests at the same level and doesn't increase the quantity, so that the replacement
+// keeps the cancelled order's position in the queue. Returns false if the order book is left as is.
+func ReplaceOrderInPlace(
+	ctx sdk.Context, keeper *keeper.Keeper, contract types.ContractAddress, pair types.Pair,
+	cancellation *types.Cancellation, replacement *types.Order,
+) bool {
+	if replacement.PositionDirection != cancellation.PositionDirection || !replacement.Price.Equal(cancellation.Price) {
+		return false
+	}
+	getter, setter := keeper.GetLongOrderBookEntryByPrice, keeper.SetLongOrderBookEntry
+	if cancellation.PositionDirection == types.PositionDirection_SHORT {
+		getter, setter = keeper.GetShortOrderBookEntryByPrice, keeper.SetShortOrderBookEntry
+	}
+	entry, found := getter(ctx, string(contract), cancellation.Price, pair.PriceDenom, pair.AssetDenom)
+	if !found {
+		return false
+	}
+	newEntry := *entry.GetOrderEntry()
+	newAllocations := make([]*types.Allocation, len(newEntry.Allocations))
+	replaced := false
+	for i, allocation := range newEntry.Allocations {
+		newAllocations[i] = allocation
+		if allocation.OrderId != cancellation.Id {
+			continue
+		}
+		if allocation.Account != replacement.Account || replacement.Quantity.GT(allocation.Quantity) {
+			return false
+		}
+		newAllocations[i] = &types.Allocation{
+			OrderId:             replacement.Id,
+			Quantity:            replacement.Quantity,
+			Account:             replacement.Account,
+			SelfTradePrevention: replacement.SelfTradePrevention,
+		}
+		newEntry.Quantity = newEntry.Quantity.Sub(allocation.Quantity).Add(replacement.Quantity)
+		replaced = true
+	}
+	if !replaced {
+		return false
+	}
+	newEntry.Allocations = newAllocations
+	entry.SetEntry(&newEntry)
+	setter(ctx, string(contract), entry)
+	keeper.RemoveGoodTilTimeOrder(ctx, string(contract), cancellation.Id, pair.PriceDenom, pair.AssetDenom)
+	return true
+}
@@ -0,0 +1,88 @@
+package exchange
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+type priceLevel struct {
+	price    sdk.Dec
+	quantity sdk.Dec
+}
+
+// GetUniformClearingPrice returns the single price at which the crossing part of the book
+// executes the most quantity, and false if the book doesn't cross. Every price of a crossing
+// level is a candidate. Among the candidates that maximize the executed quantity, those that
+// leave the smallest imbalance between the demand and the supply at the price are preferred,
+// and if several remain, the one closest to the midpoint of the lowest and the highest of them
+// is returned, or the lower one of the two closest. Since it's one of the book's prices, it's a
+// multiple of the price tick size.
+// Self-trade prevention isn't taken into account. The book is only peeked at.
+func GetUniformClearingPrice(ctx sdk.Context, orderbook *types.OrderBook) (sdk.Dec, bool) {
+	bestBid, bestAsk := peekBest(ctx, orderbook.Longs), peekBest(ctx, orderbook.Shorts)
+	if bestBid == nil || bestAsk == nil || bestBid.GetPrice().LT(bestAsk.GetPrice()) {
+		return sdk.Dec{}, false
+	}
+	// longs are in descending and shorts in ascending order of price
+	longs := peekLevels(ctx, orderbook.Longs, func(price sdk.Dec) bool { return price.GTE(bestAsk.GetPrice()) })
+	shorts := peekLevels(ctx, orderbook.Shorts, func(price sdk.Dec) bool { return price.LTE(bestBid.GetPrice()) })
+
+	candidates := []sdk.Dec{}
+	for _, level := range append(append([]priceLevel{}, longs...), shorts...) {
+		candidates = append(candidates, level.price)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].LT(candidates[j]) })
+
+	demand, supply := sdk.ZeroDec(), sdk.ZeroDec()
+	for _, level := range longs {
+		demand = demand.Add(level.quantity)
+	}
+	var bestVolume, bestImbalance sdk.Dec
+	tied := []sdk.Dec{}
+	longPtr, shortPtr := len(longs)-1, 0
+	for _, candidate := range candidates {
+		// demand at a price includes all longs priced at or above it, and supply all shorts priced at or below it
+		for ; longPtr >= 0 && longs[longPtr].price.LT(candidate); longPtr-- {
+			demand = demand.Sub(longs[longPtr].quantity)
+		}
+		for ; shortPtr < len(shorts) && shorts[shortPtr].price.LTE(candidate); shortPtr++ {
+			supply = supply.Add(shorts[shortPtr].quantity)
+		}
+		volume, imbalance := sdk.MinDec(demand, supply), demand.Sub(supply).Abs()
+		switch {
+		case bestVolume.IsNil() || volume.GT(bestVolume) || (volume.Equal(bestVolume) && imbalance.LT(bestImbalance)):
+			bestVolume, bestImbalance, tied = volume, imbalance, []sdk.Dec{candidate}
+		case volume.Equal(bestVolume) && imbalance.Equal(bestImbalance):
+			tied = append(tied, candidate)
+		}
+	}
+	midpoint := tied[0].Add(tied[len(tied)-1]).Quo(sdk.NewDec(2))
+	clearingPrice := tied[0]
+	for _, candidate := range tied[1:] {
+		if candidate.Sub(midpoint).Abs().LT(clearingPrice.Sub(midpoint).Abs()) {
+			clearingPrice = candidate
+		}
+	}
+	return clearingPrice, true
+}
+
+func peekBest(ctx sdk.Context, entries *types.CachedSortedOrderBookEntries) types.OrderBookEntry {
+	for i, entry := 0, entries.Peek(ctx, 0); entry != nil; i, entry = i+1, entries.Peek(ctx, i+1) {
+		if !entry.GetOrderEntry().Quantity.IsZero() {
+			return entry
+		}
+	}
+	return nil
+}
+
+func peekLevels(ctx sdk.Context, entries *types.CachedSortedOrderBookEntries, crosses func(sdk.Dec) bool) []priceLevel {
+	levels := []priceLevel{}
+	for i, entry := 0, entries.Peek(ctx, 0); entry != nil && crosses(entry.GetPrice()); i, entry = i+1, entries.Peek(ctx, i+1) {
+		if quantity := entry.GetOrderEntry().Quantity; !quantity.IsZero() {
+			levels = append(levels, priceLevel{price: entry.GetPrice(), quantity: quantity})
+		}
+	}
+	return levels
+}
@@ -0,0 +1,141 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newUniformPriceOrder(id uint64, price int64, quantity int64, direction types.PositionDirection) *types.Order {
+	return &types.Order{
+		Id:                id,
+		Price:             sdk.NewDec(price),
+		Quantity:          sdk.NewDec(quantity),
+		Account:           "abc",
+		PositionDirection: direction,
+		ContractAddr:      "test",
+		PriceDenom:        "USDC",
+		AssetDenom:        "ATOM",
+		OrderType:         types.OrderType_LIMIT,
+	}
+}
+
+func getUniformPriceOrderbook(t *testing.T, longOrders []*types.Order, shortOrders []*types.Order) (sdk.Context, *types.OrderBook) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", ClearingMode: types.ClearingMode_UNIFORM_PRICE}
+	return ctx, keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+}
+
+func TestGetUniformClearingPriceMaximizesVolume(t *testing.T) {
+	// 99 is the only price at which 5 can be executed
+	ctx, orderbook := getUniformPriceOrderbook(t, []*types.Order{
+		newUniformPriceOrder(1, 100, 4, types.PositionDirection_LONG),
+		newUniformPriceOrder(2, 99, 1, types.PositionDirection_LONG),
+	}, []*types.Order{
+		newUniformPriceOrder(3, 98, 2, types.PositionDirection_SHORT),
+		newUniformPriceOrder(4, 99, 3, types.PositionDirection_SHORT),
+		newUniformPriceOrder(5, 100, 1, types.PositionDirection_SHORT),
+	})
+	price, ok := exchange.GetUniformClearingPrice(ctx, orderbook)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDec(99), price)
+}
+
+func TestGetUniformClearingPriceTieBreaks(t *testing.T) {
+	// 4 can be executed at both 99 and 100, but only 99 leaves no imbalance
+	ctx, orderbook := getUniformPriceOrderbook(t, []*types.Order{
+		newUniformPriceOrder(1, 100, 4, types.PositionDirection_LONG),
+	}, []*types.Order{
+		newUniformPriceOrder(2, 99, 4, types.PositionDirection_SHORT),
+		newUniformPriceOrder(3, 100, 1, types.PositionDirection_SHORT),
+	})
+	price, ok := exchange.GetUniformClearingPrice(ctx, orderbook)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDec(99), price)
+
+	// 102 and 105 tie on both volume and imbalance and are as close to their midpoint, so the lower one is used
+	ctx, orderbook = getUniformPriceOrderbook(t, []*types.Order{
+		newUniformPriceOrder(1, 105, 3, types.PositionDirection_LONG),
+		newUniformPriceOrder(2, 100, 2, types.PositionDirection_LONG),
+	}, []*types.Order{
+		newUniformPriceOrder(3, 95, 2, types.PositionDirection_SHORT),
+		newUniformPriceOrder(4, 102, 4, types.PositionDirection_SHORT),
+	})
+	price, ok = exchange.GetUniformClearingPrice(ctx, orderbook)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDec(102), price)
+}
+
+func TestGetUniformClearingPriceNotCrossing(t *testing.T) {
+	ctx, orderbook := getUniformPriceOrderbook(t, []*types.Order{
+		newUniformPriceOrder(1, 99, 4, types.PositionDirection_LONG),
+	}, []*types.Order{
+		newUniformPriceOrder(2, 100, 4, types.PositionDirection_SHORT),
+	})
+	_, ok := exchange.GetUniformClearingPrice(ctx, orderbook)
+	require.False(t, ok)
+
+	ctx, orderbook = getUniformPriceOrderbook(t, []*types.Order{
+		newUniformPriceOrder(1, 99, 4, types.PositionDirection_LONG),
+	}, []*types.Order{})
+	_, ok = exchange.GetUniformClearingPrice(ctx, orderbook)
+	require.False(t, ok)
+}
+
+func TestMatchLimitOrdersUniformPrice(t *testing.T) {
+	ctx, orderbook := getUniformPriceOrderbook(t, []*types.Order{
+		newUniformPriceOrder(1, 105, 3, types.PositionDirection_LONG),
+		newUniformPriceOrder(2, 100, 2, types.PositionDirection_LONG),
+	}, []*types.Order{
+		newUniformPriceOrder(3, 95, 2, types.PositionDirection_SHORT),
+		newUniformPriceOrder(4, 102, 4, types.PositionDirection_SHORT),
+	})
+	outcome := exchange.MatchLimitOrders(ctx, orderbook)
+
+	clearingPrice := sdk.NewDec(102)
+	require.NotNil(t, outcome.ClearingPrice)
+	require.Equal(t, clearingPrice, *outcome.ClearingPrice)
+	require.Equal(t, sdk.NewDec(6), outcome.TotalQuantity)
+	require.Equal(t, clearingPrice.MulInt64(6), outcome.TotalNotional)
+	require.Equal(t, clearingPrice, outcome.MinPrice)
+	require.Equal(t, clearingPrice, outcome.MaxPrice)
+
+	// the long at 100 doesn't cross the clearing price, even though it crosses the short at 95
+	require.Equal(t, 4, len(outcome.Settlements))
+	expected := []struct {
+		orderID  uint64
+		quantity int64
+		price    int64
+	}{{1, 2, 105}, {3, 2, 95}, {1, 1, 105}, {4, 1, 102}}
+	for i, settlement := range outcome.Settlements {
+		require.Equal(t, expected[i].orderID, settlement.OrderId)
+		require.Equal(t, sdk.NewDec(expected[i].quantity), settlement.Quantity)
+		require.Equal(t, clearingPrice, settlement.ExecutionCostOrProceed)
+		require.Equal(t, sdk.NewDec(expected[i].price), settlement.ExpectedCostOrProceed)
+	}
+	longs, shorts := orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx)
+	require.Equal(t, sdk.NewDec(100), longs.GetPrice())
+	require.Equal(t, sdk.NewDec(2), longs.GetOrderEntry().Quantity)
+	require.Equal(t, sdk.NewDec(102), shorts.GetPrice())
+	require.Equal(t, sdk.NewDec(3), shorts.GetOrderEntry().Quantity)
+}
+
+func TestMatchLimitOrdersContinuousHasNoClearingPrice(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper,
+		[]*types.Order{newUniformPriceOrder(1, 105, 3, types.PositionDirection_LONG)},
+		[]*types.Order{newUniformPriceOrder(2, 95, 3, types.PositionDirection_SHORT)},
+	)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
+	outcome := exchange.MatchLimitOrders(ctx, orderbook)
+	require.Nil(t, outcome.ClearingPrice)
+	require.Equal(t, sdk.NewDec(100), outcome.Settlements[0].ExecutionCostOrProceed)
+}
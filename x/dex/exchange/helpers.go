@@ -11,6 +11,10 @@ type ExecutionOutcome struct {
 	Settlements   []*types.SettlementEntry
 	MinPrice      sdk.Dec // deprecate?
 	MaxPrice      sdk.Dec // deprecate?
+	// orders cancelled during matching, e.g. by self-trade prevention
+	Cancellations []*types.Cancellation
+	// set if limit orders were matched at a uniform clearing price
+	ClearingPrice *sdk.Dec
 }
 
 func (o *ExecutionOutcome) Merge(other *ExecutionOutcome) ExecutionOutcome {
@@ -20,5 +24,14 @@ func (o *ExecutionOutcome) Merge(other *ExecutionOutcome) ExecutionOutcome {
 		Settlements:   append(o.Settlements, other.Settlements...),
 		MinPrice:      sdk.MinDec(o.MinPrice, other.MinPrice),
 		MaxPrice:      sdk.MaxDec(o.MaxPrice, other.MaxPrice),
+		Cancellations: append(o.Cancellations, other.Cancellations...),
+		ClearingPrice: mergeClearingPrice(o.ClearingPrice, other.ClearingPrice),
 	}
 }
+
+func mergeClearingPrice(price *sdk.Dec, other *sdk.Dec) *sdk.Dec {
+	if price != nil {
+		return price
+	}
+	return other
+}
@@ -5,53 +5,89 @@ import (
 	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
 
+// MatchLimitOrders matches the crossing part of the book. Each crossing pair of price levels
+// settles at the average of the two prices, unless the pair is in the UNIFORM_PRICE clearing
+// mode, in which case only orders crossing the uniform clearing price are matched, all at that price.
 func MatchLimitOrders(
 	ctx sdk.Context,
 	orderbook *types.OrderBook,
 ) ExecutionOutcome {
 	settlements := []*types.SettlementEntry{}
+	cancellations := []*types.Cancellation{}
 	totalExecuted, totalPrice := sdk.ZeroDec(), sdk.ZeroDec()
 	minPrice, maxPrice := sdk.NewDecFromInt(sdk.NewIntFromUint64(math.MaxInt64)), sdk.OneDec().Neg()
 
-	for longEntry, shortEntry := orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx); longEntry != nil && shortEntry != nil && longEntry.GetPrice().GTE(shortEntry.GetPrice()); longEntry, shortEntry = orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx) {
-		var executed sdk.Dec
-		if longEntry.GetOrderEntry().Quantity.LT(shortEntry.GetOrderEntry().Quantity) {
-			executed = longEntry.GetOrderEntry().Quantity
-		} else {
-			executed = shortEntry.GetOrderEntry().Quantity
+	var clearingPrice sdk.Dec
+	uniform := false
+	if orderbook.Pair.ClearingMode == types.ClearingMode_UNIFORM_PRICE {
+		clearingPrice, uniform = GetUniformClearingPrice(ctx, orderbook)
+	}
+	crosses := func(longEntry types.OrderBookEntry, shortEntry types.OrderBookEntry) bool {
+		if longEntry == nil || shortEntry == nil {
+			return false
+		}
+		if uniform {
+			return longEntry.GetPrice().GTE(clearingPrice) && shortEntry.GetPrice().LTE(clearingPrice)
+		}
+		return longEntry.GetPrice().GTE(shortEntry.GetPrice())
+	}
+
+	for longEntry, shortEntry := orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx); crosses(longEntry, shortEntry); longEntry, shortEntry = orderbook.Longs.Next(ctx), orderbook.Shorts.Next(ctx) {
+		executed, selfTradeLong, selfTradeShort := GetSelfTradeFreeQuantity(orderbook.Pair, longEntry.GetOrderEntry(), shortEntry.GetOrderEntry())
+		if executed.IsZero() && selfTradeLong != nil {
+			cancellations = append(cancellations, PreventSelfTradeFromBook(
+				ctx, orderbook, selfTradeLong, selfTradeShort, longEntry.GetPrice(), shortEntry.GetPrice(),
+			)...)
+			continue
+		}
+		// the highest and the lowest prices that the crossing levels trade at
+		highPrice, lowPrice := longEntry.GetPrice(), shortEntry.GetPrice()
+		if uniform {
+			highPrice, lowPrice = clearingPrice, clearingPrice
 		}
 		totalExecuted = totalExecuted.Add(executed).Add(executed)
 		totalPrice = totalPrice.Add(
 			executed.Mul(
-				longEntry.GetPrice().Add(shortEntry.GetPrice()),
+				highPrice.Add(lowPrice),
 			),
 		)
-		minPrice = sdk.MinDec(minPrice, shortEntry.GetPrice())
-		maxPrice = sdk.MaxDec(maxPrice, longEntry.GetPrice())
+		minPrice = sdk.MinDec(minPrice, lowPrice)
+		maxPrice = sdk.MaxDec(maxPrice, highPrice)
 
+		var executionPrice *sdk.Dec
+		if uniform {
+			executionPrice = &clearingPrice
+		}
 		newSettlements := SettleFromBook(
 			ctx,
 			orderbook,
 			executed,
 			longEntry.GetPrice(),
 			shortEntry.GetPrice(),
+			executionPrice,
 		)
 		settlements = append(settlements, newSettlements...)
 	}
 
 	orderbook.Longs.Flush(ctx)
 	orderbook.Shorts.Flush(ctx)
-	return ExecutionOutcome{
+	outcome := ExecutionOutcome{
 		TotalNotional: totalPrice,
 		TotalQuantity: totalExecuted,
 		Settlements:   settlements,
 		MinPrice:      minPrice,
 		MaxPrice:      maxPrice,
+		Cancellations: cancellations,
+	}
+	if uniform && !totalExecuted.IsZero() {
+		outcome.ClearingPrice = &clearingPrice
 	}
+	return outcome
 }
 
 func addOrderToOrderBookEntry(
@@ -74,9 +110,10 @@ func addOrderToOrderBookEntry(
 	}
 	orderEntry.Quantity = orderEntry.Quantity.Add(order.Quantity)
 	orderEntry.Allocations = append(orderEntry.Allocations, &types.Allocation{
-		OrderId:  order.Id,
-		Quantity: order.Quantity,
-		Account:  order.Account,
+		OrderId:             order.Id,
+		Quantity:            order.Quantity,
+		Account:             order.Account,
+		SelfTradePrevention: order.SelfTradePrevention,
 	})
 	entry.SetPrice(order.Price)
 	entry.SetEntry(orderEntry)
@@ -100,3 +137,60 @@ func AddOutstandingLimitOrdersToOrderbook(
 		addOrderToOrderBookEntry(ctx, keeper, order)
 	}
 }
+
+// GetCrossingPostOnlyOrders returns the post-only orders in the block that would cross either
+// the resting book or a limit order on the other side placed in the same block. Post-only
+// orders are processed by order ID, so an earlier accepted post-only order can cause a
+// later one to be rejected.
+func GetCrossingPostOnlyOrders(
+	ctx sdk.Context, keeper *keeper.Keeper,
+	contract types.ContractAddress, pair types.Pair,
+	blockOrders *cache.BlockOrders,
+) []types.UnsuccessfulOrder {
+	var bestBid, bestAsk *sdk.Dec
+	updateBest := func(order *types.Order) {
+		price := order.Price
+		if order.PositionDirection == types.PositionDirection_LONG {
+			if bestBid == nil || price.GT(*bestBid) {
+				bestBid = &price
+			}
+		} else if bestAsk == nil || price.LT(*bestAsk) {
+			bestAsk = &price
+		}
+	}
+	for _, entry := range keeper.GetTopNLongBooksForPair(ctx, string(contract), pair.PriceDenom, pair.AssetDenom, 1) {
+		price := entry.GetPrice()
+		bestBid = &price
+	}
+	for _, entry := range keeper.GetTopNShortBooksForPair(ctx, string(contract), pair.PriceDenom, pair.AssetDenom, 1) {
+		price := entry.GetPrice()
+		bestAsk = &price
+	}
+
+	postOnlyOrders := []*types.Order{}
+	for _, order := range blockOrders.Get() {
+		// bracket orders don't rest on the book until their parent fills
+		if order.OrderType != types.OrderType_LIMIT || order.Status == types.OrderStatus_FAILED_TO_PLACE || order.BracketRole != types.BracketRole_NO_BRACKET {
+			continue
+		}
+		if order.PostOnly {
+			postOnlyOrders = append(postOnlyOrders, order)
+			continue
+		}
+		updateBest(order)
+	}
+
+	crossing := []types.UnsuccessfulOrder{}
+	for _, order := range postOnlyOrders {
+		if (order.PositionDirection == types.PositionDirection_LONG && bestAsk != nil && order.Price.GTE(*bestAsk)) ||
+			(order.PositionDirection == types.PositionDirection_SHORT && bestBid != nil && order.Price.LTE(*bestBid)) {
+			crossing = append(crossing, types.UnsuccessfulOrder{
+				ID:     order.Id,
+				Reason: "post-only order would cross the book",
+			})
+			continue
+		}
+		updateBest(order)
+	}
+	return crossing
+}
@@ -75,6 +75,7 @@ func TestMatchSingleOrder(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -88,6 +89,7 @@ func TestMatchSingleOrder(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                2,
@@ -372,6 +374,7 @@ func TestMatchSingleOrderFromShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -385,6 +388,7 @@ func TestMatchSingleOrderFromShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                2,
@@ -455,6 +459,7 @@ func TestMatchSingleOrderFromLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                2,
@@ -468,6 +473,7 @@ func TestMatchSingleOrderFromLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -572,6 +578,7 @@ func TestMatchSingleOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(2),
 		ExecutionCostOrProceed: sdk.NewDec(95),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -585,6 +592,7 @@ func TestMatchSingleOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(2),
 		ExecutionCostOrProceed: sdk.NewDec(95),
 		ExpectedCostOrProceed:  sdk.NewDec(90),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                2,
@@ -598,6 +606,7 @@ func TestMatchSingleOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(3),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -611,6 +620,7 @@ func TestMatchSingleOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(3),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                3,
@@ -715,6 +725,7 @@ func TestMatchSingleOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(2),
 		ExecutionCostOrProceed: sdk.NewDec(105),
 		ExpectedCostOrProceed:  sdk.NewDec(110),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                4,
@@ -728,6 +739,7 @@ func TestMatchSingleOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(2),
 		ExecutionCostOrProceed: sdk.NewDec(105),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -741,6 +753,7 @@ func TestMatchSingleOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(3),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                2,
@@ -754,6 +767,7 @@ func TestMatchSingleOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(3),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -888,6 +902,7 @@ func TestMatchMultipleOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(97),
 		ExpectedCostOrProceed:  sdk.NewDec(104),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "jkl",
 		OrderType:              "Limit",
 		OrderId:                2,
@@ -901,6 +916,7 @@ func TestMatchMultipleOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(97),
 		ExpectedCostOrProceed:  sdk.NewDec(90),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                4,
@@ -914,6 +930,7 @@ func TestMatchMultipleOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(95),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -927,6 +944,7 @@ func TestMatchMultipleOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(95),
 		ExpectedCostOrProceed:  sdk.NewDec(90),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                4,
@@ -940,6 +958,7 @@ func TestMatchMultipleOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(4),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -953,6 +972,7 @@ func TestMatchMultipleOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(4),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                5,
@@ -1091,6 +1111,7 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(103),
 		ExpectedCostOrProceed:  sdk.NewDec(110),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                6,
@@ -1104,6 +1125,7 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(103),
 		ExpectedCostOrProceed:  sdk.NewDec(96),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "jkl",
 		OrderType:              "Limit",
 		OrderId:                2,
@@ -1117,6 +1139,7 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(105),
 		ExpectedCostOrProceed:  sdk.NewDec(110),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                6,
@@ -1130,6 +1153,7 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(105),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -1143,6 +1167,7 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(4),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                4,
@@ -1156,6 +1181,7 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(4),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                1,
@@ -1163,3 +1189,100 @@ func TestMatchMultipleOrderFromMultipleLongBook(t *testing.T) {
 		Height:                 TestHeight,
 	})
 }
+
+func TestMatchLimitOrdersChargesFees(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	makerFeeRate, takerFeeRate := sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", MakerFeeRate: &makerFeeRate, TakerFeeRate: &takerFeeRate}
+	// the short order was placed first, so it is the maker
+	longOrders := []*types.Order{
+		{
+			Id:                2,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(5),
+			Account:           "abc",
+			PositionDirection: types.PositionDirection_LONG,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		},
+	}
+	shortOrders := []*types.Order{
+		{
+			Id:                1,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(5),
+			Account:           "def",
+			PositionDirection: types.PositionDirection_SHORT,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		},
+	}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+	outcome := exchange.MatchLimitOrders(ctx, orderbook)
+	settlements := outcome.Settlements
+	assert.Equal(t, 2, len(settlements))
+	assert.Equal(t, uint64(2), settlements[0].OrderId)
+	assert.Equal(t, sdk.MustNewDecFromStr("1"), settlements[0].Fee)
+	assert.Equal(t, uint64(1), settlements[1].OrderId)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.5"), settlements[1].Fee)
+}
+
+func TestMatchLimitOrdersProRata(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	minAllocation := sdk.OneDec()
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", MatchingAlgorithm: types.MatchingAlgorithm_PRO_RATA, ProRataMinAllocation: &minAllocation}
+	shortOrder := func(id uint64, quantity int64, account string) *types.Order {
+		return &types.Order{
+			Id:                id,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(quantity),
+			Account:           account,
+			PositionDirection: types.PositionDirection_SHORT,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		}
+	}
+	shortOrders := []*types.Order{shortOrder(1, 6, "abc"), shortOrder(2, 3, "def"), shortOrder(3, 1, "ghi")}
+	longOrders := []*types.Order{
+		{
+			Id:                4,
+			Price:             sdk.NewDec(100),
+			Quantity:          sdk.NewDec(5),
+			Account:           "jkl",
+			PositionDirection: types.PositionDirection_LONG,
+			ContractAddr:      "test",
+			PriceDenom:        "USDC",
+			AssetDenom:        "ATOM",
+			OrderType:         types.OrderType_LIMIT,
+		},
+	}
+	exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+	outcome := exchange.MatchLimitOrders(ctx, orderbook)
+
+	// pro-rata shares are 3, 1.5 and 0.5, the last of which is below the minimum and is
+	// allocated to the first order instead
+	settlements := outcome.Settlements
+	assert.Equal(t, 4, len(settlements))
+	assert.Equal(t, uint64(1), settlements[1].OrderId)
+	assert.Equal(t, sdk.MustNewDecFromStr("3.5"), settlements[1].Quantity)
+	assert.Equal(t, uint64(2), settlements[3].OrderId)
+	assert.Equal(t, sdk.MustNewDecFromStr("1.5"), settlements[3].Quantity)
+
+	shortBook, found := dexkeeper.GetShortBookByPrice(ctx, "test", sdk.NewDec(100), "USDC", "ATOM")
+	assert.True(t, found)
+	assert.Equal(t, sdk.NewDec(5), shortBook.Entry.Quantity)
+	assert.Equal(t, 3, len(shortBook.Entry.Allocations))
+	assert.Equal(t, sdk.MustNewDecFromStr("2.5"), shortBook.Entry.Allocations[0].Quantity)
+	assert.Equal(t, sdk.MustNewDecFromStr("1.5"), shortBook.Entry.Allocations[1].Quantity)
+	assert.Equal(t, sdk.OneDec(), shortBook.Entry.Allocations[2].Quantity)
+}
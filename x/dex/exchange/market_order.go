@@ -13,23 +13,25 @@ func MatchMarketOrders(
 	marketOrders []*types.Order,
 	orderBookEntries *types.CachedSortedOrderBookEntries,
 	direction types.PositionDirection,
+	pair types.Pair,
 	blockOrders *cache.BlockOrders,
 ) ExecutionOutcome {
 	totalExecuted, totalPrice := sdk.ZeroDec(), sdk.ZeroDec()
 	minPrice, maxPrice := sdk.NewDecFromInt(sdk.NewIntFromUint64(math.MaxInt64)), sdk.OneDec().Neg()
 	settlements := []*types.SettlementEntry{}
 	allTakerSettlements := []*types.SettlementEntry{}
+	cancellations := []*types.Cancellation{}
 	for _, marketOrder := range marketOrders {
 		switch marketOrder.OrderType {
 		case types.OrderType_FOKMARKETBYVALUE:
 			settlements, allTakerSettlements = MatchByValueFOKMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+				ctx, marketOrder, orderBookEntries, direction, pair, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
 		case types.OrderType_FOKMARKET:
 			settlements, allTakerSettlements = MatchFOKMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+				ctx, marketOrder, orderBookEntries, direction, pair, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
 		default:
 			settlements, allTakerSettlements = MatchMarketOrder(
-				ctx, marketOrder, orderBookEntries, direction, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, blockOrders)
+				ctx, marketOrder, orderBookEntries, direction, pair, &totalExecuted, &totalPrice, &minPrice, &maxPrice, settlements, allTakerSettlements, &cancellations, blockOrders)
 		}
 	}
 
@@ -39,6 +41,13 @@ func MatchMarketOrders(
 			settlement.ExecutionCostOrProceed = clearingPrice
 		}
 		minPrice, maxPrice = clearingPrice, clearingPrice
+		// fees are charged once taker settlements have their final clearing price
+		for _, settlement := range settlements {
+			settlement.ChargeFee(pair.GetMakerFeeRateOrZero())
+		}
+		for _, settlement := range allTakerSettlements {
+			settlement.ChargeFee(pair.GetTakerFeeRateOrZero())
+		}
 		settlements = append(settlements, allTakerSettlements...)
 	}
 	return ExecutionOutcome{
@@ -47,6 +56,7 @@ func MatchMarketOrders(
 		Settlements:   settlements,
 		MinPrice:      minPrice,
 		MaxPrice:      maxPrice,
+		Cancellations: cancellations,
 	}
 }
 
@@ -55,12 +65,14 @@ func MatchMarketOrder(
 	marketOrder *types.Order,
 	orderBookEntries *types.CachedSortedOrderBookEntries,
 	direction types.PositionDirection,
+	pair types.Pair,
 	totalExecuted *sdk.Dec,
 	totalPrice *sdk.Dec,
 	minPrice *sdk.Dec,
 	maxPrice *sdk.Dec,
 	settlements []*types.SettlementEntry,
 	allTakerSettlements []*types.SettlementEntry,
+	cancellations *[]*types.Cancellation,
 	blockOrders *cache.BlockOrders,
 ) ([]*types.SettlementEntry, []*types.SettlementEntry) {
 	remainingQuantity := marketOrder.Quantity
@@ -75,11 +87,26 @@ func MatchMarketOrder(
 				break
 			}
 		}
-		var executed sdk.Dec
-		if remainingQuantity.LTE(entry.GetOrderEntry().Quantity) {
-			executed = remainingQuantity
-		} else {
-			executed = entry.GetOrderEntry().Quantity
+		taker := takerAllocation(marketOrder, remainingQuantity)
+		executed, selfTradeMaker := GetSelfTradeFreeQuantityForTaker(pair, taker, entry.GetOrderEntry(), remainingQuantity)
+		if executed.IsZero() && selfTradeMaker != nil {
+			takerReduction, makerReduction := getSelfTradeReductions(pair, taker, selfTradeMaker)
+			if makerReduction.IsPositive() {
+				if makerReduction.Equal(selfTradeMaker.Quantity) {
+					*cancellations = append(*cancellations, newSelfTradeCancellation(
+						types.ContractAddress(marketOrder.ContractAddr), pair, selfTradeMaker, types.OppositePositionDirection[direction], entry.GetPrice(),
+					))
+				}
+				orderBookEntries.ReduceAllocation(ctx, selfTradeMaker.OrderId, makerReduction)
+			}
+			remainingQuantity = remainingQuantity.Sub(takerReduction)
+			if remainingQuantity.IsZero() {
+				*cancellations = append(*cancellations, newSelfTradeCancellation(
+					types.ContractAddress(marketOrder.ContractAddr), pair, taker, direction, marketOrder.Price,
+				))
+				break
+			}
+			continue
 		}
 		remainingQuantity = remainingQuantity.Sub(executed)
 		*totalExecuted = totalExecuted.Add(executed)
@@ -96,6 +123,7 @@ func MatchMarketOrder(
 			orderBookEntries,
 			marketOrder.Price,
 			entry.GetPrice(),
+			pair,
 		)
 		// update the status of order in the memState
 		UpdateOrderData(marketOrder, executed, blockOrders)
@@ -117,6 +145,7 @@ func MatchFOKMarketOrder(
 	marketOrder *types.Order,
 	orderBookEntries *types.CachedSortedOrderBookEntries,
 	direction types.PositionDirection,
+	pair types.Pair,
 	totalExecuted *sdk.Dec,
 	totalPrice *sdk.Dec,
 	minPrice *sdk.Dec,
@@ -143,6 +172,10 @@ func MatchFOKMarketOrder(
 		} else {
 			executed = entry.GetOrderEntry().Quantity
 		}
+		// fill-or-kill orders that would trade against the same account are killed
+		if _, selfTradeMaker := GetSelfTradeFreeQuantityForTaker(pair, takerAllocation(marketOrder, remainingQuantity), entry.GetOrderEntry(), executed); selfTradeMaker != nil {
+			break
+		}
 		remainingQuantity = remainingQuantity.Sub(executed)
 
 		takerSettlements, makerSettlements := Settle(
@@ -152,6 +185,7 @@ func MatchFOKMarketOrder(
 			orderBookEntries,
 			marketOrder.Price,
 			entry.GetPrice(),
+			pair,
 		)
 		newSettlements = append(newSettlements, makerSettlements...)
 		newTakerSettlements = append(newTakerSettlements, takerSettlements...)
@@ -189,6 +223,7 @@ func MatchByValueFOKMarketOrder(
 	marketOrder *types.Order,
 	orderBookEntries *types.CachedSortedOrderBookEntries,
 	direction types.PositionDirection,
+	pair types.Pair,
 	totalExecuted *sdk.Dec,
 	totalPrice *sdk.Dec,
 	minPrice *sdk.Dec,
@@ -216,6 +251,10 @@ func MatchByValueFOKMarketOrder(
 			executed = entry.GetOrderEntry().Quantity
 			remainingFund = remainingFund.Sub(executed.Mul(entry.GetPrice()))
 		}
+		if _, selfTradeMaker := GetSelfTradeFreeQuantityForTaker(pair, takerAllocation(marketOrder, remainingQuantity), entry.GetOrderEntry(), executed); selfTradeMaker != nil {
+			remainingFund = remainingFund.Add(executed.Mul(entry.GetPrice()))
+			break
+		}
 		remainingQuantity = remainingQuantity.Sub(executed)
 
 		takerSettlements, makerSettlements := Settle(
@@ -225,6 +264,7 @@ func MatchByValueFOKMarketOrder(
 			orderBookEntries,
 			marketOrder.Price,
 			entry.GetPrice(),
+			pair,
 		)
 		newSettlements = append(newSettlements, makerSettlements...)
 		newTakerSettlements = MergeByNominalTakerSettlements(append(newTakerSettlements, takerSettlements...))
@@ -257,6 +297,15 @@ func MatchByValueFOKMarketOrder(
 	return settlements, allTakerSettlements
 }
 
+func takerAllocation(marketOrder *types.Order, quantity sdk.Dec) *types.Allocation {
+	return &types.Allocation{
+		OrderId:             marketOrder.Id,
+		Quantity:            quantity,
+		Account:             marketOrder.Account,
+		SelfTradePrevention: marketOrder.SelfTradePrevention,
+	}
+}
+
 func MergeByNominalTakerSettlements(settlements []*types.SettlementEntry) []*types.SettlementEntry {
 	aggregatedSettlement := types.SettlementEntry{Quantity: sdk.ZeroDec()}
 	for _, settlement := range settlements {
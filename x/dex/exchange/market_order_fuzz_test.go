@@ -87,6 +87,6 @@ func fuzzTargetMatchMarketOrders(
 		if takerLong {
 			book = orderbook.Shorts
 		}
-		exchange.MatchMarketOrders(TestFuzzMarketCtx, orders, book, direction, blockOrders)
+		exchange.MatchMarketOrders(TestFuzzMarketCtx, orders, book, direction, types.Pair{}, blockOrders)
 	})
 }
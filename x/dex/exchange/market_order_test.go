@@ -72,7 +72,7 @@ func TestMatchFoKMarketOrderFromShortBookNotEnoughLiquidity(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.Pair{}, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -140,7 +140,7 @@ func TestMatchFoKMarketOrderFromShortBookHappyPath(t *testing.T) {
 		Data:              "{\"position_effect\":\"Open\",\"leverage\":\"1\"}",
 	})
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.Pair{}, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -158,6 +158,7 @@ func TestMatchFoKMarketOrderFromShortBookHappyPath(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                5,
@@ -171,6 +172,7 @@ func TestMatchFoKMarketOrderFromShortBookHappyPath(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Fokmarket",
 		OrderId:                1,
@@ -236,7 +238,7 @@ func TestMatchByValueFOKMarketOrderFromShortBookNotEnoughLiquidity(t *testing.T)
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, &dex.BlockOrders{},
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.Pair{}, &dex.BlockOrders{},
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -308,7 +310,7 @@ func TestMatchByValueFOKMarketOrderFromShortBookHappyPath(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.Pair{}, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -325,6 +327,7 @@ func TestMatchByValueFOKMarketOrderFromShortBookHappyPath(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(90),
 		ExpectedCostOrProceed:  sdk.NewDec(90),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                4,
@@ -338,6 +341,7 @@ func TestMatchByValueFOKMarketOrderFromShortBookHappyPath(t *testing.T) {
 		Quantity:               sdk.MustNewDecFromStr("0.5"),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                5,
@@ -388,7 +392,7 @@ func TestMatchSingleMarketOrderFromShortBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.Pair{}, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -405,6 +409,7 @@ func TestMatchSingleMarketOrderFromShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                5,
@@ -418,6 +423,7 @@ func TestMatchSingleMarketOrderFromShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Market",
 		OrderId:                1,
@@ -468,7 +474,7 @@ func TestMatchSingleMarketOrderFromLongBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Longs
 	outcome := exchange.MatchMarketOrders(
-		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders,
+		ctx, shortOrders, entries, types.PositionDirection_SHORT, types.Pair{}, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -485,6 +491,7 @@ func TestMatchSingleMarketOrderFromLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                5,
@@ -498,6 +505,7 @@ func TestMatchSingleMarketOrderFromLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(5),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Market",
 		OrderId:                1,
@@ -566,7 +574,7 @@ func TestMatchSingleMarketOrderFromMultipleShortBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.Pair{}, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -599,6 +607,7 @@ func TestMatchSingleMarketOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(2),
 		ExecutionCostOrProceed: sdk.NewDec(90),
 		ExpectedCostOrProceed:  sdk.NewDec(90),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                5,
@@ -612,6 +621,7 @@ func TestMatchSingleMarketOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(3),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                6,
@@ -625,6 +635,7 @@ func TestMatchSingleMarketOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(2),
 		ExecutionCostOrProceed: sdk.NewDec(96),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Market",
 		OrderId:                1,
@@ -638,6 +649,7 @@ func TestMatchSingleMarketOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(3),
 		ExecutionCostOrProceed: sdk.NewDec(96),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Market",
 		OrderId:                1,
@@ -706,7 +718,7 @@ func TestMatchSingleMarketOrderFromMultipleLongBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Longs
 	outcome := exchange.MatchMarketOrders(
-		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders,
+		ctx, shortOrders, entries, types.PositionDirection_SHORT, types.Pair{}, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -739,6 +751,7 @@ func TestMatchSingleMarketOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(2),
 		ExecutionCostOrProceed: sdk.NewDec(110),
 		ExpectedCostOrProceed:  sdk.NewDec(110),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                5,
@@ -752,6 +765,7 @@ func TestMatchSingleMarketOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(3),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                6,
@@ -765,6 +779,7 @@ func TestMatchSingleMarketOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(2),
 		ExecutionCostOrProceed: sdk.NewDec(104),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Market",
 		OrderId:                1,
@@ -778,6 +793,7 @@ func TestMatchSingleMarketOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(3),
 		ExecutionCostOrProceed: sdk.NewDec(104),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Market",
 		OrderId:                1,
@@ -870,7 +886,7 @@ func TestMatchMultipleMarketOrderFromMultipleShortBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Shorts
 	outcome := exchange.MatchMarketOrders(
-		ctx, longOrders, entries, types.PositionDirection_LONG, blockOrders,
+		ctx, longOrders, entries, types.PositionDirection_LONG, types.Pair{}, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -900,6 +916,7 @@ func TestMatchMultipleMarketOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(90),
 		ExpectedCostOrProceed:  sdk.NewDec(90),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                4,
@@ -913,6 +930,7 @@ func TestMatchMultipleMarketOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(90),
 		ExpectedCostOrProceed:  sdk.NewDec(90),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                4,
@@ -926,6 +944,7 @@ func TestMatchMultipleMarketOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(4),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "def",
 		OrderType:              "Limit",
 		OrderId:                5,
@@ -939,6 +958,7 @@ func TestMatchMultipleMarketOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.MustNewDecFromStr("96.666666666666666667"),
 		ExpectedCostOrProceed:  sdk.NewDec(104),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "jkl",
 		OrderType:              "Market",
 		OrderId:                1,
@@ -952,6 +972,7 @@ func TestMatchMultipleMarketOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.MustNewDecFromStr("96.666666666666666667"),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Market",
 		OrderId:                2,
@@ -965,6 +986,7 @@ func TestMatchMultipleMarketOrderFromMultipleShortBook(t *testing.T) {
 		Quantity:               sdk.NewDec(4),
 		ExecutionCostOrProceed: sdk.MustNewDecFromStr("96.666666666666666667"),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Market",
 		OrderId:                2,
@@ -1061,7 +1083,7 @@ func TestMatchMultipleMarketOrderFromMultipleLongBook(t *testing.T) {
 	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM"})
 	entries := orderbook.Longs
 	outcome := exchange.MatchMarketOrders(
-		ctx, shortOrders, entries, types.PositionDirection_SHORT, blockOrders,
+		ctx, shortOrders, entries, types.PositionDirection_SHORT, types.Pair{}, blockOrders,
 	)
 	totalPrice := outcome.TotalNotional
 	totalExecuted := outcome.TotalQuantity
@@ -1095,6 +1117,7 @@ func TestMatchMultipleMarketOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(110),
 		ExpectedCostOrProceed:  sdk.NewDec(110),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                4,
@@ -1108,6 +1131,7 @@ func TestMatchMultipleMarketOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.NewDec(110),
 		ExpectedCostOrProceed:  sdk.NewDec(110),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                4,
@@ -1121,6 +1145,7 @@ func TestMatchMultipleMarketOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(4),
 		ExecutionCostOrProceed: sdk.NewDec(100),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Limit",
 		OrderId:                5,
@@ -1134,6 +1159,7 @@ func TestMatchMultipleMarketOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.MustNewDecFromStr("103.333333333333333333"),
 		ExpectedCostOrProceed:  sdk.NewDec(96),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "jkl",
 		OrderType:              "Market",
 		OrderId:                1,
@@ -1147,6 +1173,7 @@ func TestMatchMultipleMarketOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(1),
 		ExecutionCostOrProceed: sdk.MustNewDecFromStr("103.333333333333333333"),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Market",
 		OrderId:                2,
@@ -1160,6 +1187,7 @@ func TestMatchMultipleMarketOrderFromMultipleLongBook(t *testing.T) {
 		Quantity:               sdk.NewDec(4),
 		ExecutionCostOrProceed: sdk.MustNewDecFromStr("103.333333333333333333"),
 		ExpectedCostOrProceed:  sdk.NewDec(100),
+		Fee:                    sdk.ZeroDec(),
 		Account:                "abc",
 		OrderType:              "Market",
 		OrderId:                2,
@@ -1173,3 +1201,45 @@ func TestMatchMultipleMarketOrderFromMultipleLongBook(t *testing.T) {
 	assert.Equal(t, blockOrders.Get()[2].Quantity, sdk.NewDec(2))
 	assert.Equal(t, blockOrders.Get()[2].Status, types.OrderStatus_PLACED)
 }
+
+func TestMatchMarketOrdersChargesFees(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+	makerFeeRate, takerFeeRate := sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002")
+	pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", MakerFeeRate: &makerFeeRate, TakerFeeRate: &takerFeeRate}
+	longOrder := &types.Order{
+		Id:                1,
+		Account:           "abc",
+		ContractAddr:      "test",
+		Price:             sdk.NewDec(100),
+		Quantity:          sdk.NewDec(5),
+		PriceDenom:        "USDC",
+		AssetDenom:        "ATOM",
+		OrderType:         types.OrderType_MARKET,
+		PositionDirection: types.PositionDirection_LONG,
+	}
+	dexkeeper.SetShortOrderBookEntry(ctx, "test", &types.ShortBook{
+		Price: sdk.NewDec(100),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(100),
+			Quantity:    sdk.NewDec(5),
+			Allocations: []*types.Allocation{{OrderId: 5, Account: "def", Quantity: sdk.NewDec(5)}},
+			PriceDenom:  "USDC",
+			AssetDenom:  "ATOM",
+		},
+	})
+	blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "testAccount", pair)
+	blockOrders.Add(longOrder)
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+	outcome := exchange.MatchMarketOrders(
+		ctx, []*types.Order{longOrder}, orderbook.Shorts, types.PositionDirection_LONG, pair, blockOrders,
+	)
+	settlements := outcome.Settlements
+	assert.Equal(t, 2, len(settlements))
+	// maker settlement
+	assert.Equal(t, uint64(5), settlements[0].OrderId)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.5"), settlements[0].Fee)
+	// taker settlement
+	assert.Equal(t, uint64(1), settlements[1].OrderId)
+	assert.Equal(t, sdk.MustNewDecFromStr("1"), settlements[1].Fee)
+}
@@ -0,0 +1,175 @@
+package exchange
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// GetSelfTradePrevention returns the mode that applies when two orders of the same account would
+// match. The newer order's mode wins, followed by the older order's and finally the pair's.
+func GetSelfTradePrevention(pair types.Pair, first *types.Allocation, second *types.Allocation) types.SelfTradePrevention {
+	newer, older := first, second
+	if first.OrderId < second.OrderId {
+		newer, older = second, first
+	}
+	if newer.SelfTradePrevention != types.SelfTradePrevention_NO_PREVENTION {
+		return newer.SelfTradePrevention
+	}
+	if older.SelfTradePrevention != types.SelfTradePrevention_NO_PREVENTION {
+		return older.SelfTradePrevention
+	}
+	return pair.SelfTradePrevention
+}
+
+func isSelfTrade(pair types.Pair, first *types.Allocation, second *types.Allocation) bool {
+	return first.Account == second.Account && GetSelfTradePrevention(pair, first, second) != types.SelfTradePrevention_NO_PREVENTION
+}
+
+// getSelfTradeReductions returns the quantities by which the two allocations need to be reduced
+// to prevent them from trading against each other.
+func getSelfTradeReductions(pair types.Pair, first *types.Allocation, second *types.Allocation) (sdk.Dec, sdk.Dec) {
+	firstIsNewer := first.OrderId > second.OrderId
+	switch GetSelfTradePrevention(pair, first, second) {
+	case types.SelfTradePrevention_CANCEL_NEWEST:
+		if firstIsNewer {
+			return first.Quantity, sdk.ZeroDec()
+		}
+		return sdk.ZeroDec(), second.Quantity
+	case types.SelfTradePrevention_CANCEL_OLDEST:
+		if firstIsNewer {
+			return sdk.ZeroDec(), second.Quantity
+		}
+		return first.Quantity, sdk.ZeroDec()
+	case types.SelfTradePrevention_DECREMENT_BOTH:
+		overlap := sdk.MinDec(first.Quantity, second.Quantity)
+		return overlap, overlap
+	default:
+		return sdk.ZeroDec(), sdk.ZeroDec()
+	}
+}
+
+// GetSelfTradeFreeQuantity returns how much of the two order book entries can be settled against
+// each other, following the FIFO allocation of SettleFromBook, before two allocations of the same
+// account would be matched. The conflicting allocations are returned if there are any.
+// Pro-rata allocation can match any two allocations of the entries, so nothing can be settled
+// until all conflicts between them have been resolved.
+func GetSelfTradeFreeQuantity(
+	pair types.Pair,
+	longEntry *types.OrderEntry,
+	shortEntry *types.OrderEntry,
+) (sdk.Dec, *types.Allocation, *types.Allocation) {
+	if pair.MatchingAlgorithm == types.MatchingAlgorithm_PRO_RATA {
+		for _, long := range longEntry.Allocations {
+			for _, short := range shortEntry.Allocations {
+				if long.Quantity.IsPositive() && short.Quantity.IsPositive() && isSelfTrade(pair, long, short) {
+					return sdk.ZeroDec(), long, short
+				}
+			}
+		}
+		return sdk.MinDec(longEntry.Quantity, shortEntry.Quantity), nil, nil
+	}
+	free := sdk.ZeroDec()
+	longPtr, shortPtr := 0, 0
+	longRemaining, shortRemaining := sdk.ZeroDec(), sdk.ZeroDec()
+	for {
+		for longRemaining.IsZero() && longPtr < len(longEntry.Allocations) {
+			longRemaining = longEntry.Allocations[longPtr].Quantity
+			longPtr++
+		}
+		for shortRemaining.IsZero() && shortPtr < len(shortEntry.Allocations) {
+			shortRemaining = shortEntry.Allocations[shortPtr].Quantity
+			shortPtr++
+		}
+		if longRemaining.IsZero() || shortRemaining.IsZero() {
+			break
+		}
+		long, short := longEntry.Allocations[longPtr-1], shortEntry.Allocations[shortPtr-1]
+		if isSelfTrade(pair, long, short) {
+			return free, long, short
+		}
+		quantity := sdk.MinDec(longRemaining, shortRemaining)
+		free = free.Add(quantity)
+		longRemaining = longRemaining.Sub(quantity)
+		shortRemaining = shortRemaining.Sub(quantity)
+	}
+	return sdk.MinDec(longEntry.Quantity, shortEntry.Quantity), nil, nil
+}
+
+// GetSelfTradeFreeQuantityForTaker is the counterpart of GetSelfTradeFreeQuantity for a taker order
+// consuming up to `quantity` from a single order book entry.
+func GetSelfTradeFreeQuantityForTaker(
+	pair types.Pair,
+	taker *types.Allocation,
+	entry *types.OrderEntry,
+	quantity sdk.Dec,
+) (sdk.Dec, *types.Allocation) {
+	if pair.MatchingAlgorithm == types.MatchingAlgorithm_PRO_RATA {
+		for _, allocation := range entry.Allocations {
+			if allocation.Quantity.IsPositive() && isSelfTrade(pair, taker, allocation) {
+				return sdk.ZeroDec(), allocation
+			}
+		}
+		return sdk.MinDec(quantity, entry.Quantity), nil
+	}
+	free := sdk.ZeroDec()
+	for _, allocation := range entry.Allocations {
+		if free.GTE(quantity) {
+			break
+		}
+		if allocation.Quantity.IsZero() {
+			continue
+		}
+		if isSelfTrade(pair, taker, allocation) {
+			return free, allocation
+		}
+		free = free.Add(sdk.MinDec(allocation.Quantity, quantity.Sub(free)))
+	}
+	return sdk.MinDec(quantity, entry.Quantity), nil
+}
+
+// PreventSelfTradeFromBook reduces or removes the two conflicting allocations on the current long
+// and short entries of the order book according to the applicable self-trade prevention mode, and
+// returns cancellations for the orders that have nothing left.
+func PreventSelfTradeFromBook(
+	ctx sdk.Context,
+	orderbook *types.OrderBook,
+	long *types.Allocation,
+	short *types.Allocation,
+	longPrice sdk.Dec,
+	shortPrice sdk.Dec,
+) []*types.Cancellation {
+	cancellations := []*types.Cancellation{}
+	longReduction, shortReduction := getSelfTradeReductions(orderbook.Pair, long, short)
+	if longReduction.IsPositive() {
+		if longReduction.Equal(long.Quantity) {
+			cancellations = append(cancellations, newSelfTradeCancellation(orderbook.Contract, orderbook.Pair, long, types.PositionDirection_LONG, longPrice))
+		}
+		orderbook.Longs.ReduceAllocation(ctx, long.OrderId, longReduction)
+	}
+	if shortReduction.IsPositive() {
+		if shortReduction.Equal(short.Quantity) {
+			cancellations = append(cancellations, newSelfTradeCancellation(orderbook.Contract, orderbook.Pair, short, types.PositionDirection_SHORT, shortPrice))
+		}
+		orderbook.Shorts.ReduceAllocation(ctx, short.OrderId, shortReduction)
+	}
+	return cancellations
+}
+
+func newSelfTradeCancellation(
+	contract types.ContractAddress,
+	pair types.Pair,
+	allocation *types.Allocation,
+	direction types.PositionDirection,
+	price sdk.Dec,
+) *types.Cancellation {
+	return &types.Cancellation{
+		Id:                allocation.OrderId,
+		Initiator:         types.CancellationInitiator_SELF_TRADE,
+		Creator:           allocation.Account,
+		ContractAddr:      string(contract),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		PositionDirection: direction,
+		Price:             price,
+	}
+}
@@ -0,0 +1,128 @@
+package exchange_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	keeperutil "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSelfTradeOrder(id uint64, account string, quantity int64, direction types.PositionDirection, orderType types.OrderType) *types.Order {
+	return &types.Order{
+		Id:                id,
+		Price:             sdk.NewDec(100),
+		Quantity:          sdk.NewDec(quantity),
+		Account:           account,
+		PositionDirection: direction,
+		ContractAddr:      "test",
+		PriceDenom:        "USDC",
+		AssetDenom:        "ATOM",
+		OrderType:         orderType,
+	}
+}
+
+func getCancelledIDs(cancellations []*types.Cancellation) []uint64 {
+	res := []uint64{}
+	for _, cancellation := range cancellations {
+		res = append(res, cancellation.Id)
+	}
+	return res
+}
+
+func TestGetSelfTradePrevention(t *testing.T) {
+	pair := types.Pair{SelfTradePrevention: types.SelfTradePrevention_CANCEL_NEWEST}
+	older := &types.Allocation{OrderId: 1, SelfTradePrevention: types.SelfTradePrevention_DECREMENT_BOTH}
+	newer := &types.Allocation{OrderId: 2}
+	assert.Equal(t, types.SelfTradePrevention_DECREMENT_BOTH, exchange.GetSelfTradePrevention(pair, newer, older))
+	newer.SelfTradePrevention = types.SelfTradePrevention_CANCEL_OLDEST
+	assert.Equal(t, types.SelfTradePrevention_CANCEL_OLDEST, exchange.GetSelfTradePrevention(pair, older, newer))
+	older.SelfTradePrevention, newer.SelfTradePrevention = types.SelfTradePrevention_NO_PREVENTION, types.SelfTradePrevention_NO_PREVENTION
+	assert.Equal(t, types.SelfTradePrevention_CANCEL_NEWEST, exchange.GetSelfTradePrevention(pair, older, newer))
+}
+
+func TestMatchLimitOrdersSelfTradePrevention(t *testing.T) {
+	for _, tc := range []struct {
+		mode                types.SelfTradePrevention
+		expectedCancelled   []uint64
+		expectedSettlements int
+		expectedExecuted    sdk.Dec
+		expectedShortLeft   sdk.Dec
+	}{
+		{types.SelfTradePrevention_NO_PREVENTION, []uint64{}, 4, sdk.NewDec(10), sdk.NewDec(2)},
+		{types.SelfTradePrevention_CANCEL_NEWEST, []uint64{2}, 2, sdk.NewDec(8), sdk.ZeroDec()},
+		{types.SelfTradePrevention_CANCEL_OLDEST, []uint64{1}, 0, sdk.ZeroDec(), sdk.NewDec(7)},
+		{types.SelfTradePrevention_DECREMENT_BOTH, []uint64{2}, 2, sdk.NewDec(4), sdk.NewDec(2)},
+	} {
+		dexkeeper, ctx := keepertest.DexKeeper(t)
+		ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+		pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", SelfTradePrevention: tc.mode}
+		longOrders := []*types.Order{newSelfTradeOrder(1, "abc", 5, types.PositionDirection_LONG, types.OrderType_LIMIT)}
+		shortOrders := []*types.Order{
+			newSelfTradeOrder(2, "abc", 3, types.PositionDirection_SHORT, types.OrderType_LIMIT),
+			newSelfTradeOrder(3, "def", 4, types.PositionDirection_SHORT, types.OrderType_LIMIT),
+		}
+		exchange.AddOutstandingLimitOrdersToOrderbook(ctx, dexkeeper, longOrders, shortOrders)
+		orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+		outcome := exchange.MatchLimitOrders(ctx, orderbook)
+		assert.Equal(t, tc.expectedCancelled, getCancelledIDs(outcome.Cancellations), tc.mode.String())
+		for _, cancellation := range outcome.Cancellations {
+			assert.Equal(t, types.CancellationInitiator_SELF_TRADE, cancellation.Initiator)
+		}
+		assert.Equal(t, tc.expectedSettlements, len(outcome.Settlements), tc.mode.String())
+		assert.Equal(t, tc.expectedExecuted, outcome.TotalQuantity, tc.mode.String())
+		shortBook, found := dexkeeper.GetShortBookByPrice(ctx, "test", sdk.NewDec(100), "USDC", "ATOM")
+		if tc.expectedShortLeft.IsZero() {
+			assert.False(t, found, tc.mode.String())
+		} else {
+			assert.Equal(t, tc.expectedShortLeft, shortBook.Entry.Quantity, tc.mode.String())
+		}
+	}
+}
+
+func TestMatchMarketOrderSelfTradePrevention(t *testing.T) {
+	for _, tc := range []struct {
+		mode              types.SelfTradePrevention
+		orderType         types.OrderType
+		expectedCancelled []uint64
+		expectedExecuted  sdk.Dec
+	}{
+		{types.SelfTradePrevention_NO_PREVENTION, types.OrderType_MARKET, []uint64{}, sdk.NewDec(6)},
+		{types.SelfTradePrevention_CANCEL_NEWEST, types.OrderType_MARKET, []uint64{3}, sdk.ZeroDec()},
+		{types.SelfTradePrevention_CANCEL_OLDEST, types.OrderType_MARKET, []uint64{1}, sdk.NewDec(5)},
+		{types.SelfTradePrevention_DECREMENT_BOTH, types.OrderType_MARKET, []uint64{1}, sdk.NewDec(1)},
+		// fill-or-kill orders are killed rather than partially filled
+		{types.SelfTradePrevention_CANCEL_OLDEST, types.OrderType_FOKMARKET, []uint64{}, sdk.ZeroDec()},
+	} {
+		dexkeeper, ctx := keepertest.DexKeeper(t)
+		ctx = ctx.WithBlockHeight(int64(TestHeight)).WithBlockTime(time.Unix(int64(TestTimestamp), 0))
+		pair := types.Pair{PriceDenom: "USDC", AssetDenom: "ATOM", SelfTradePrevention: tc.mode}
+		dexkeeper.SetShortOrderBookEntry(ctx, "test", &types.ShortBook{
+			Price: sdk.NewDec(100),
+			Entry: &types.OrderEntry{
+				Price:    sdk.NewDec(100),
+				Quantity: sdk.NewDec(10),
+				Allocations: []*types.Allocation{
+					{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(5)},
+					{OrderId: 2, Account: "def", Quantity: sdk.NewDec(5)},
+				},
+				PriceDenom: "USDC",
+				AssetDenom: "ATOM",
+			},
+		})
+		marketOrder := newSelfTradeOrder(3, "abc", 6, types.PositionDirection_LONG, tc.orderType)
+		blockOrders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, "test", pair)
+		blockOrders.Add(marketOrder)
+		orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress("test"), pair)
+		outcome := exchange.MatchMarketOrders(
+			ctx, []*types.Order{marketOrder}, orderbook.Shorts, types.PositionDirection_LONG, pair, blockOrders,
+		)
+		assert.Equal(t, tc.expectedCancelled, getCancelledIDs(outcome.Cancellations), tc.mode.String())
+		assert.Equal(t, tc.expectedExecuted, outcome.TotalQuantity, tc.mode.String())
+	}
+}
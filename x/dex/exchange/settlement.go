@@ -14,14 +14,15 @@ func Settle(
 	orderbook *types.CachedSortedOrderBookEntries,
 	worstPrice sdk.Dec,
 	makerPrice sdk.Dec,
+	pair types.Pair,
 ) ([]*types.SettlementEntry, []*types.SettlementEntry) {
-	// settlement of one liquidity taker's order is allocated on a FIFO basis
+	// settlement of one liquidity taker's order is allocated by the pair's matching algorithm
 	takerSettlements := []*types.SettlementEntry{}
 	makerSettlements := []*types.SettlementEntry{}
 	if quantityTaken.IsZero() {
 		return takerSettlements, makerSettlements
 	}
-	newToSettle, _ := orderbook.SettleQuantity(ctx, quantityTaken)
+	newToSettle := settleQuantity(ctx, orderbook, pair, quantityTaken)
 	for _, toSettle := range newToSettle {
 		takerSettlements = append(takerSettlements, types.NewSettlementEntry(
 			ctx,
@@ -52,6 +53,22 @@ func Settle(
 	return takerSettlements, makerSettlements
 }
 
+// settleQuantity settles the quantity from the current entry of the book, allocated among the
+// entry's orders by the pair's matching algorithm
+func settleQuantity(
+	ctx sdk.Context,
+	orderbook *types.CachedSortedOrderBookEntries,
+	pair types.Pair,
+	quantity sdk.Dec,
+) []types.ToSettle {
+	if pair.MatchingAlgorithm == types.MatchingAlgorithm_PRO_RATA {
+		res, _ := orderbook.SettleQuantityProRata(ctx, quantity, pair.GetProRataMinAllocationOrZero())
+		return res
+	}
+	res, _ := orderbook.SettleQuantity(ctx, quantity)
+	return res
+}
+
 // this function update the order data in the memState
 // to be noted that the order status will only reflect for market orders that are settled
 func UpdateOrderData(
@@ -68,21 +85,28 @@ func UpdateOrderData(
 	blockOrders.Add(orderStored)
 }
 
+// SettleFromBook settles the executed quantity between the current long and short entries of the
+// book. They're executed at the midpoint of their prices unless an execution price is given, e.g.
+// a uniform clearing price.
 func SettleFromBook(
 	ctx sdk.Context,
 	orderbook *types.OrderBook,
 	executedQuantity sdk.Dec,
 	longPrice sdk.Dec,
 	shortPrice sdk.Dec,
+	executionPrice *sdk.Dec,
 ) []*types.SettlementEntry {
-	// settlement from within the order book is also allocated on a FIFO basis
+	avgPrice := longPrice.Add(shortPrice).Quo(sdk.NewDec(2))
+	if executionPrice != nil {
+		avgPrice = *executionPrice
+	}
+	// settlement from within the order book is also allocated by the pair's matching algorithm
 	settlements := []*types.SettlementEntry{}
 	if executedQuantity.IsZero() {
 		return settlements
 	}
-	newLongToSettle, _ := orderbook.Longs.SettleQuantity(ctx, executedQuantity)
-	newShortToSettle, _ := orderbook.Shorts.SettleQuantity(ctx, executedQuantity)
-	avgPrice := longPrice.Add(shortPrice).Quo(sdk.NewDec(2))
+	newLongToSettle := settleQuantity(ctx, orderbook.Longs, orderbook.Pair, executedQuantity)
+	newShortToSettle := settleQuantity(ctx, orderbook.Shorts, orderbook.Pair, executedQuantity)
 	longPtr, shortPtr := 0, 0
 	for longPtr < len(newLongToSettle) && shortPtr < len(newShortToSettle) {
 		longToSettle := newLongToSettle[longPtr]
@@ -93,6 +117,11 @@ func SettleFromBook(
 		} else {
 			quantity = shortToSettle.Amount
 		}
+		// the order placed earlier is the maker
+		longFeeRate, shortFeeRate := orderbook.Pair.GetTakerFeeRateOrZero(), orderbook.Pair.GetMakerFeeRateOrZero()
+		if longToSettle.OrderID < shortToSettle.OrderID {
+			longFeeRate, shortFeeRate = shortFeeRate, longFeeRate
+		}
 		settlements = append(settlements, types.NewSettlementEntry(
 			ctx,
 			longToSettle.OrderID,
@@ -104,7 +133,7 @@ func SettleFromBook(
 			avgPrice,
 			longPrice,
 			types.OrderType_LIMIT,
-		), types.NewSettlementEntry(
+		).ChargeFee(longFeeRate), types.NewSettlementEntry(
 			ctx,
 			shortToSettle.OrderID,
 			shortToSettle.Account,
@@ -115,7 +144,7 @@ func SettleFromBook(
 			avgPrice,
 			shortPrice,
 			types.OrderType_LIMIT,
-		))
+		).ChargeFee(shortFeeRate))
 		newLongToSettle[longPtr] = types.ToSettle{Account: longToSettle.Account, Amount: longToSettle.Amount.Sub(quantity), OrderID: longToSettle.OrderID}
 		newShortToSettle[shortPtr] = types.ToSettle{Account: shortToSettle.Account, Amount: shortToSettle.Amount.Sub(quantity), OrderID: shortToSettle.OrderID}
 		if newLongToSettle[longPtr].Amount.IsZero() {
@@ -22,6 +22,10 @@ func FuzzSettleMarketOrder(f *testing.F) {
 	f.Fuzz(fuzzTargetMatchMarketOrders)
 }
 
+func FuzzSettleProRata(f *testing.F) {
+	f.Fuzz(fuzzTargetSettleProRata)
+}
+
 func fuzzTargetSettle(
 	t *testing.T,
 	long bool,
@@ -34,6 +38,51 @@ func fuzzTargetSettle(
 	priceIsNil bool,
 	quantityI int64,
 	quantityIsNil bool,
+) {
+	fuzzSettle(t, keepertest.TestPair, long, prices, quantities, entryWeights, accountIndices, allocationWeights, priceI, priceIsNil, quantityI, quantityIsNil)
+}
+
+func fuzzTargetSettleProRata(
+	t *testing.T,
+	long bool,
+	prices []byte,
+	quantities []byte,
+	entryWeights []byte,
+	accountIndices []byte,
+	allocationWeights []byte,
+	priceI int64,
+	priceIsNil bool,
+	quantityI int64,
+	quantityIsNil bool,
+	minAllocationI int64,
+	minAllocationIsNil bool,
+) {
+	// matching never settles a nil quantity
+	if quantityIsNil {
+		return
+	}
+	pair := keepertest.TestPair
+	pair.MatchingAlgorithm = types.MatchingAlgorithm_PRO_RATA
+	if !minAllocationIsNil {
+		minAllocation := fuzzing.FuzzDec(minAllocationI, false)
+		pair.ProRataMinAllocation = &minAllocation
+	}
+	fuzzSettle(t, pair, long, prices, quantities, entryWeights, accountIndices, allocationWeights, priceI, priceIsNil, quantityI, quantityIsNil)
+}
+
+func fuzzSettle(
+	t *testing.T,
+	pair types.Pair,
+	long bool,
+	prices []byte,
+	quantities []byte,
+	entryWeights []byte,
+	accountIndices []byte,
+	allocationWeights []byte,
+	priceI int64,
+	priceIsNil bool,
+	quantityI int64,
+	quantityIsNil bool,
 ) {
 	dexkeeper, ctx := keepertest.DexKeeper(t)
 	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
@@ -62,14 +111,18 @@ func fuzzTargetSettle(
 		orders = orders[:len(entries)]
 	}
 
-	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
 	book := orderbook.Longs
 	if long {
 		book = orderbook.Shorts
 	}
 	for i, entry := range entries {
+		// pro-rata settlement always happens against the entry that the book is pointing at
+		if pair.MatchingAlgorithm == types.MatchingAlgorithm_PRO_RATA && book.Next(ctx) == nil {
+			break
+		}
 		require.NotPanics(t, func() {
-			exchange.Settle(ctx, orders[i], quantity, book, price, entry.GetPrice())
+			exchange.Settle(ctx, orders[i], quantity, book, price, entry.GetPrice(), pair)
 		})
 	}
 }
@@ -79,6 +132,10 @@ func FuzzSettleLimitOrder(f *testing.F) {
 	f.Fuzz(fuzzTargetMatchMarketOrders)
 }
 
+func FuzzSettleFromBookProRata(f *testing.F) {
+	f.Fuzz(fuzzTargetSettleFromBookProRata)
+}
+
 func fuzzTargetSettleFromBook(
 	t *testing.T,
 	buyEntryWeights []byte,
@@ -89,6 +146,47 @@ func fuzzTargetSettleFromBook(
 	sellAllocationWeights []byte,
 	quantityI int64,
 	quantityIsNil bool,
+) {
+	fuzzSettleFromBook(t, keepertest.TestPair, buyEntryWeights, sellEntryWeights, buyAccountIndices, sellAccountIndices, buyAllocationWeights, sellAllocationWeights, quantityI, quantityIsNil)
+}
+
+func fuzzTargetSettleFromBookProRata(
+	t *testing.T,
+	buyEntryWeights []byte,
+	sellEntryWeights []byte,
+	buyAccountIndices []byte,
+	sellAccountIndices []byte,
+	buyAllocationWeights []byte,
+	sellAllocationWeights []byte,
+	quantityI int64,
+	quantityIsNil bool,
+	minAllocationI int64,
+	minAllocationIsNil bool,
+) {
+	// matching never settles a nil quantity
+	if quantityIsNil {
+		return
+	}
+	pair := keepertest.TestPair
+	pair.MatchingAlgorithm = types.MatchingAlgorithm_PRO_RATA
+	if !minAllocationIsNil {
+		minAllocation := fuzzing.FuzzDec(minAllocationI, false)
+		pair.ProRataMinAllocation = &minAllocation
+	}
+	fuzzSettleFromBook(t, pair, buyEntryWeights, sellEntryWeights, buyAccountIndices, sellAccountIndices, buyAllocationWeights, sellAllocationWeights, quantityI, quantityIsNil)
+}
+
+func fuzzSettleFromBook(
+	t *testing.T,
+	pair types.Pair,
+	buyEntryWeights []byte,
+	sellEntryWeights []byte,
+	buyAccountIndices []byte,
+	sellAccountIndices []byte,
+	buyAllocationWeights []byte,
+	sellAllocationWeights []byte,
+	quantityI int64,
+	quantityIsNil bool,
 ) {
 	dexkeeper, ctx := keepertest.DexKeeper(t)
 	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
@@ -109,10 +207,14 @@ func fuzzTargetSettleFromBook(
 		sellEntries = sellEntries[:len(buyEntries)]
 	}
 
-	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), types.Pair{PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom})
+	orderbook := keeperutil.PopulateOrderbook(ctx, dexkeeper, types.ContractAddress(keepertest.TestContract), pair)
 	for i, longEntry := range buyEntries {
+		// pro-rata settlement always happens against the entries that the book is pointing at
+		if pair.MatchingAlgorithm == types.MatchingAlgorithm_PRO_RATA && (orderbook.Longs.Next(ctx) == nil || orderbook.Shorts.Next(ctx) == nil) {
+			break
+		}
 		require.NotPanics(t, func() {
-			exchange.SettleFromBook(ctx, orderbook, quantity, longEntry.GetPrice(), sellEntries[i].GetPrice())
+			exchange.SettleFromBook(ctx, orderbook, quantity, longEntry.GetPrice(), sellEntries[i].GetPrice(), nil)
 		})
 	}
 }
@@ -32,6 +32,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			k.SetShortBook(ctx, contractState.ContractInfo.ContractAddr, elem)
 		}
 
+		for _, elem := range contractState.TriggeredOrdersList {
+			k.SetTriggeredOrder(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+
 		for _, elem := range contractState.PriceList {
 			for _, priceElem := range elem.Prices {
 				k.SetPriceState(ctx, *priceElem, contractState.ContractInfo.ContractAddr)
@@ -40,6 +44,58 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 		k.SetNextOrderID(ctx, contractState.ContractInfo.ContractAddr, contractState.NextOrderId)
 
+		for _, elem := range contractState.GoodTilTimeOrdersList {
+			k.SetGoodTilTimeOrder(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+		for _, elem := range contractState.PendingBracketOrdersList {
+			k.SetBracketOrder(ctx, false, contractState.ContractInfo.ContractAddr, elem)
+		}
+		for _, elem := range contractState.ActiveBracketOrdersList {
+			k.SetBracketOrder(ctx, true, contractState.ContractInfo.ContractAddr, elem)
+		}
+
+		for _, elem := range contractState.CandleList {
+			for _, candle := range elem.Candles {
+				k.SetCandle(ctx, contractState.ContractInfo.ContractAddr, elem.Pair, elem.IntervalInSeconds, candle)
+			}
+		}
+
+		for _, elem := range contractState.OrderCountList {
+			if err := k.SetOrderCount(ctx, contractState.ContractInfo.ContractAddr, elem.PriceDenom, elem.AssetDenom, elem.PositionDirection, elem.Price, elem.Count); err != nil {
+				panic(err)
+			}
+		}
+
+		if contractState.MatchResult != nil {
+			// the match result is stamped with the height of the block it was produced in
+			k.SetMatchResult(ctx.WithBlockHeight(contractState.MatchResult.Height), contractState.ContractInfo.ContractAddr, contractState.MatchResult)
+		}
+
+		for _, elem := range contractState.AccountFillList {
+			k.SetAccountFill(ctx, contractState.ContractInfo.ContractAddr, elem.Index, elem.Fill)
+		}
+
+		if contractState.RentTopUp != nil {
+			k.SetRentTopUp(ctx, *contractState.RentTopUp)
+		}
+
+		for _, elem := range contractState.RentChargeList {
+			k.SetRentCharge(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+
+		for _, elem := range contractState.PairHaltList {
+			k.SetPairHalt(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+
+		for _, elem := range contractState.ExecutionStatsList {
+			k.SetContractExecutionStats(ctx, contractState.ContractInfo.ContractAddr, elem)
+		}
+
+		k.SetUncollectedFees(ctx, contractState.ContractInfo.ContractAddr, contractState.UncollectedFeeList)
+	}
+
+	for _, elem := range genState.AssetList {
+		k.SetAssetMetadata(ctx, elem)
 	}
 
 	// this line is used by starport scaffolding # genesis/module/init
@@ -58,28 +114,50 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	for i, contractInfo := range allContractInfo {
 		contractAddr := contractInfo.ContractAddr
 		registeredPairs := k.GetAllRegisteredPairs(ctx, contractAddr)
-		// Save all price info for contract, for all its pairs
+		// Save all price, candle and order count info for contract, for all its pairs
 		contractPrices := []types.ContractPairPrices{}
+		contractCandles := []types.ContractPairCandles{}
+		orderCounts := []types.OrderCount{}
 		for _, elem := range registeredPairs {
 			pairPrices := k.GetAllPrices(ctx, contractAddr, elem)
 			contractPrices = append(contractPrices, types.ContractPairPrices{
 				PricePair: elem,
 				Prices:    pairPrices,
 			})
+			contractCandles = append(contractCandles, k.GetAllCandlesForPair(ctx, contractAddr, elem)...)
+			orderCounts = append(orderCounts, k.GetAllOrderCountsForPair(ctx, contractAddr, elem.PriceDenom, elem.AssetDenom)...)
 		}
 		contractStates[i] = types.ContractState{
-			ContractInfo:  contractInfo,
-			LongBookList:  k.GetAllLongBook(ctx, contractAddr),
-			ShortBookList: k.GetAllShortBook(ctx, contractAddr),
-			PairList:      registeredPairs,
-			PriceList:     contractPrices,
-			NextOrderId:   k.GetNextOrderID(ctx, contractAddr),
+			ContractInfo:             contractInfo,
+			LongBookList:             k.GetAllLongBook(ctx, contractAddr),
+			ShortBookList:            k.GetAllShortBook(ctx, contractAddr),
+			TriggeredOrdersList:      k.GetAllTriggeredOrders(ctx, contractAddr),
+			PairList:                 registeredPairs,
+			PriceList:                contractPrices,
+			NextOrderId:              k.GetNextOrderID(ctx, contractAddr),
+			GoodTilTimeOrdersList:    k.GetAllGoodTilTimeOrders(ctx, contractAddr),
+			CandleList:               contractCandles,
+			OrderCountList:           orderCounts,
+			AccountFillList:          k.GetAllAccountFills(ctx, contractAddr),
+			RentChargeList:           k.GetAllRentCharges(ctx, contractAddr),
+			PairHaltList:             k.GetAllPairHalts(ctx, contractAddr),
+			ExecutionStatsList:       k.GetAllContractExecutionStats(ctx, contractAddr),
+			PendingBracketOrdersList: k.GetAllBracketOrders(ctx, false, contractAddr),
+			ActiveBracketOrdersList:  k.GetAllBracketOrders(ctx, true, contractAddr),
+			UncollectedFeeList:       k.GetUncollectedFees(ctx, contractAddr),
+		}
+		if matchResult, found := k.GetMatchResultState(ctx, contractAddr); found {
+			contractStates[i].MatchResult = matchResult
+		}
+		if topUp, found := k.GetRentTopUp(ctx, contractAddr); found {
+			contractStates[i].RentTopUp = &topUp
 		}
 	}
 	genesis.ContractState = contractStates
+	genesis.AssetList = k.GetAllAssetMetadata(ctx)
 
-	_, currentEpoch := k.IsNewEpoch(ctx)
-	genesis.LastEpoch = currentEpoch
+	// the last epoch the module has processed, which may be behind the epoch module's
+	genesis.LastEpoch = k.GetEpoch(ctx)
 
 	return genesis
 }
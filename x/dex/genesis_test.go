@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TEST_PAIR() types.Pair {
@@ -74,6 +75,21 @@ func TestGenesis(t *testing.T) {
 				},
 			},
 		},
+		TriggeredOrdersList: []types.Order{
+			{
+				Id:                1,
+				Account:           "test",
+				ContractAddr:      contractInfo.ContractAddr,
+				Price:             sdk.ZeroDec(),
+				Quantity:          sdk.NewDec(1),
+				PriceDenom:        "USDC",
+				AssetDenom:        "SEI",
+				OrderType:         types.OrderType_STOPLOSS,
+				PositionDirection: types.PositionDirection_LONG,
+				Nominal:           sdk.ZeroDec(),
+				TriggerPrice:      sdk.NewDec(100),
+			},
+		},
 		ContractInfo: contractInfo,
 		PairList:     pairList,
 		PriceList:    priceList,
@@ -95,6 +111,7 @@ func TestGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, genesisState.ContractState[0].LongBookList, got.ContractState[0].LongBookList)
 	require.ElementsMatch(t, genesisState.ContractState[0].ShortBookList, got.ContractState[0].ShortBookList)
+	require.ElementsMatch(t, genesisState.ContractState[0].TriggeredOrdersList, got.ContractState[0].TriggeredOrdersList)
 	require.ElementsMatch(t, genesisState.ContractState[0].PairList, got.ContractState[0].PairList)
 	require.Equal(t, genesisState.ContractState[0].ContractInfo.CodeId, got.ContractState[0].ContractInfo.CodeId)
 	require.Equal(t, genesisState.ContractState[0].ContractInfo.ContractAddr, got.ContractState[0].ContractInfo.ContractAddr)
@@ -103,3 +120,144 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.ContractState[0].NextOrderId, got.ContractState[0].NextOrderId)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	k, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(20)
+	k.SetParams(ctx, types.DefaultParams())
+	k.SetEpoch(ctx, 3)
+	pair := keepertest.TestPair
+	makerFeeRate := sdk.MustNewDecFromStr("0.001")
+	pair.MakerFeeRate = &makerFeeRate
+
+	require.NoError(t, k.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr: keepertest.TestContract,
+		Creator:      keepertest.TestAccount,
+		RentBalance:  100,
+		Dependencies: []*types.ContractDependencyInfo{{Dependency: keepertest.TestContract2}},
+	}))
+	require.NoError(t, k.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr:            keepertest.TestContract2,
+		Creator:                 keepertest.TestAccount,
+		NumIncomingDependencies: 1,
+	}))
+	k.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+	k.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(10),
+			Quantity:    sdk.NewDec(3),
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+			Allocations: []*types.Allocation{{OrderId: 1, Account: keepertest.TestAccount, Quantity: sdk.NewDec(3)}},
+		},
+	})
+	k.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.NewDec(12),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(12),
+			Quantity:    sdk.NewDec(2),
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+			Allocations: []*types.Allocation{{OrderId: 2, Account: keepertest.TestAccount2, Quantity: sdk.NewDec(2)}},
+		},
+	})
+	require.NoError(t, k.SetOrderCount(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(10), 1))
+	require.NoError(t, k.SetOrderCount(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, types.PositionDirection_SHORT, sdk.NewDec(12), 1))
+	k.SetNextOrderID(ctx, keepertest.TestContract, 5)
+	k.SetTriggeredOrder(ctx, keepertest.TestContract, types.Order{
+		Id:           3,
+		Account:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		Price:        sdk.NewDec(9),
+		Quantity:     sdk.NewDec(1),
+		PriceDenom:   pair.PriceDenom,
+		AssetDenom:   pair.AssetDenom,
+		OrderType:    types.OrderType_STOPLOSS,
+		TriggerPrice: sdk.NewDec(9),
+	})
+	k.SetGoodTilTimeOrder(ctx, keepertest.TestContract, types.Order{
+		Id:                2,
+		Account:           keepertest.TestAccount2,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(12),
+		Quantity:          sdk.NewDec(2),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_SHORT,
+		TimeInForce:       types.TimeInForce_GTT,
+		ExpiryHeight:      500,
+	})
+	k.SetBracketOrder(ctx, false, keepertest.TestContract, types.Order{
+		Id:                4,
+		Account:           keepertest.TestAccount,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.NewDec(15),
+		Quantity:          sdk.NewDec(3),
+		PriceDenom:        pair.PriceDenom,
+		AssetDenom:        pair.AssetDenom,
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_SHORT,
+		ParentOrderId:     1,
+		BracketRole:       types.BracketRole_TAKE_PROFIT,
+	})
+	keepertest.SeedPriceSnapshot(ctx, k, "11", 5)
+	k.SetCandle(ctx, keepertest.TestContract, pair, 60, types.Candle{
+		BeginTimestamp: 0, EndTimestamp: 60, Open: sdk.NewDec(11), High: sdk.NewDec(11), Low: sdk.NewDec(11), Close: sdk.NewDec(11), Volume: sdk.OneDec(), Notional: sdk.NewDec(11),
+	})
+	k.SetCandle(ctx, keepertest.TestContract, pair, 3600, types.Candle{
+		BeginTimestamp: 0, EndTimestamp: 3600, Open: sdk.NewDec(11), High: sdk.NewDec(11), Low: sdk.NewDec(11), Close: sdk.NewDec(11), Volume: sdk.OneDec(), Notional: sdk.NewDec(11),
+	})
+	fills := []*types.SettlementEntry{
+		{Account: keepertest.TestAccount, PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, Quantity: sdk.OneDec(), ExecutionCostOrProceed: sdk.NewDec(11), ExpectedCostOrProceed: sdk.NewDec(11), Fee: sdk.ZeroDec(), Height: 19},
+		{Account: keepertest.TestAccount2, PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom, Quantity: sdk.OneDec(), ExecutionCostOrProceed: sdk.NewDec(11), ExpectedCostOrProceed: sdk.NewDec(11), Fee: sdk.ZeroDec(), Height: 19},
+	}
+	k.SetAccountFills(ctx, keepertest.TestContract, fills)
+	k.SetMatchResult(ctx.WithBlockHeight(19), keepertest.TestContract, types.NewMatchResult(nil, nil, fills))
+	k.SetRentTopUp(ctx, types.RentTopUp{ContractAddr: keepertest.TestContract, Funder: keepertest.TestAccount, Threshold: 10, Amount: 5, SpendLimit: 50})
+	k.SetRentCharge(ctx, keepertest.TestContract, types.RentCharge{Height: 19, Amount: 2, RentBalance: 100})
+	k.SetPairHalt(ctx, keepertest.TestContract, types.PairHalt{Pair: &types.Pair{PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom}, HaltedByCreator: true})
+	k.SetContractExecutionStats(ctx, keepertest.TestContract, types.ContractExecutionStats{Height: 19, PlacementGas: 100, SettlementGas: 50})
+	k.SetUncollectedFees(ctx, keepertest.TestContract, sdk.NewDecCoins(sdk.NewDecCoinFromDec(pair.PriceDenom, sdk.MustNewDecFromStr("0.5"))))
+	k.SetAssetMetadata(ctx, types.AssetMetadata{
+		TypeAsset: "native",
+		Metadata: banktypes.Metadata{
+			Base:       "uatom",
+			Display:    "atom",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: "atom", Exponent: 6}},
+		},
+	})
+
+	exported := dex.ExportGenesis(ctx, *k)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, 2, len(exported.ContractState))
+	contractState := exported.ContractState[0]
+	if contractState.ContractInfo.ContractAddr != keepertest.TestContract {
+		contractState = exported.ContractState[1]
+	}
+	require.Equal(t, 1, len(contractState.GoodTilTimeOrdersList))
+	require.Equal(t, 1, len(contractState.PendingBracketOrdersList))
+	require.Equal(t, 2, len(contractState.CandleList))
+	require.Equal(t, 2, len(contractState.OrderCountList))
+	require.Equal(t, 2, len(contractState.AccountFillList))
+	require.Equal(t, uint64(1), contractState.AccountFillList[1].Index)
+	require.Equal(t, int64(19), contractState.MatchResult.Height)
+	require.Equal(t, uint64(50), contractState.RentTopUp.SpendLimit)
+	require.Equal(t, 1, len(contractState.RentChargeList))
+	require.Equal(t, 1, len(contractState.PairHaltList))
+	require.Equal(t, 1, len(contractState.ExecutionStatsList))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), contractState.UncollectedFeeList.AmountOf(pair.PriceDenom))
+	require.Equal(t, 1, len(exported.AssetList))
+	require.Equal(t, uint64(3), exported.LastEpoch)
+
+	imported, importedCtx := keepertest.DexKeeper(t)
+	dex.InitGenesis(importedCtx.WithBlockHeight(20), *imported, *exported)
+	reexported := dex.ExportGenesis(importedCtx.WithBlockHeight(20), *imported)
+
+	expected, err := exported.Marshal()
+	require.NoError(t, err)
+	actual, err := reexported.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
@@ -1,6 +1,8 @@
 package dex
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
@@ -12,3 +14,34 @@ func HandleAddAssetMetadataProposal(ctx sdk.Context, k *keeper.Keeper, p *types.
 	}
 	return nil
 }
+
+func HandleUpdateFeeRatesProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateFeeRatesProposal) error {
+	for _, feeRate := range p.FeeRateList {
+		if err := k.SetFeeRatesForPair(ctx, feeRate.ContractAddr, *feeRate.Pair, feeRate.MakerFeeRate, feeRate.TakerFeeRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HandleUpdatePairHaltsProposal halts or resumes trading of pairs. Halts made by the contracts'
+// creators or by the circuit breaker are left as is.
+func HandleUpdatePairHaltsProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdatePairHaltsProposal) error {
+	for _, update := range p.PairHaltList {
+		if _, found := k.GetRegisteredPair(ctx, update.ContractAddr, update.Pair.PriceDenom, update.Pair.AssetDenom); !found {
+			return types.ErrPairNotRegistered
+		}
+		halt, _ := k.GetPairHalt(ctx, update.ContractAddr, *update.Pair)
+		halt.HaltedByGovernance = update.Halted
+		k.SetPairHalt(ctx, update.ContractAddr, halt)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSetPairHalt,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, update.ContractAddr),
+			sdk.NewAttribute(types.AttributeKeyPriceDenom, update.Pair.PriceDenom),
+			sdk.NewAttribute(types.AttributeKeyAssetDenom, update.Pair.AssetDenom),
+			sdk.NewAttribute(types.AttributeKeyHalted, fmt.Sprint(update.Halted)),
+			sdk.NewAttribute(types.AttributeKeyHaltedBy, types.AttributeValueGovernance),
+		))
+	}
+	return nil
+}
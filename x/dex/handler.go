@@ -26,6 +26,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgCancelOrders:
 			res, err := msgServer.CancelOrders(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelAll:
+			res, err := msgServer.CancelAll(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReplaceOrders:
+			res, err := msgServer.ReplaceOrders(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgRegisterContract:
 			res, err := msgServer.RegisterContract(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -47,6 +53,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUnsuspendContract:
 			res, err := msgServer.UnsuspendContract(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetRentTopUp:
+			res, err := msgServer.SetRentTopUp(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetPairHalt:
+			res, err := msgServer.SetPairHalt(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -60,6 +72,10 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case *types.AddAssetMetadataProposal:
 			return HandleAddAssetMetadataProposal(ctx, &k, c)
+		case *types.UpdateFeeRatesProposal:
+			return HandleUpdateFeeRatesProposal(ctx, &k, c)
+		case *types.UpdatePairHaltsProposal:
+			return HandleUpdatePairHaltsProposal(ctx, &k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized dex proposal content type: %T", c)
 		}
@@ -61,6 +61,10 @@ func (w KeeperWrapper) GetPlaceSudoMsg(ctx sdk.Context, typedContractAddr types.
 	contractOrderPlacements := []types.Order{}
 	for _, pair := range registeredPairs {
 		for _, order := range dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, typedContractAddr, pair).Get() {
+			// orders rejected before placement (e.g. crossing post-only orders) are not sent to the contract
+			if order.Status == types.OrderStatus_FAILED_TO_PLACE {
+				continue
+			}
 			contractOrderPlacements = append(contractOrderPlacements, *order)
 			if len(contractOrderPlacements) == MaxOrdersPerSudoCall {
 				msgs = append(msgs, types.SudoOrderPlacementMsg{
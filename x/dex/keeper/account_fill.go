@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// fills of an account are keyed by pair and then by height and their index among the contract's
+// settlements of that block, so that they can be paginated in chronological order. Every fill is
+// also indexed by height alone, pointing back to its key, so that it can be pruned without
+// knowing the account.
+func accountFillKey(height uint64, index uint64) []byte {
+	return append(GetKeyForTs(height), GetKeyForTs(index)...)
+}
+
+func (k Keeper) SetAccountFills(ctx sdk.Context, contractAddr string, settlements []*types.SettlementEntry) {
+	for i, settlement := range settlements {
+		k.SetAccountFill(ctx, contractAddr, uint64(i), *settlement)
+	}
+}
+
+// SetAccountFill stores a fill given its index among the contract's settlements of its block
+func (k Keeper) SetAccountFill(ctx sdk.Context, contractAddr string, index uint64, settlement types.SettlementEntry) {
+	store := ctx.KVStore(k.storeKey)
+	heightStore := prefix.NewStore(store, types.AccountFillHeightPrefix(contractAddr))
+	suffix := accountFillKey(settlement.Height, index)
+	key := append(types.AccountFillPrefix(contractAddr, settlement.Account, settlement.PriceDenom, settlement.AssetDenom), suffix...)
+	store.Set(key, k.Cdc.MustMarshal(&settlement))
+	heightStore.Set(suffix, key)
+}
+
+// GetAllAccountFills returns all fills of a contract in the order they were settled
+func (k Keeper) GetAllAccountFills(ctx sdk.Context, contractAddr string) []types.AccountFill {
+	store := ctx.KVStore(k.storeKey)
+	heightStore := prefix.NewStore(store, types.AccountFillHeightPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(heightStore, []byte{})
+
+	defer iterator.Close()
+
+	list := []types.AccountFill{}
+	for ; iterator.Valid(); iterator.Next() {
+		fill := types.AccountFill{Index: binary.BigEndian.Uint64(iterator.Key()[8:16])}
+		k.Cdc.MustUnmarshal(store.Get(iterator.Value()), &fill.Fill)
+		list = append(list, fill)
+	}
+
+	return list
+}
+
+func (k Keeper) GetAccountFillsPaginated(ctx sdk.Context, contractAddr string, account string, pair types.Pair, fromHeight uint64, page *query.PageRequest) (list []types.SettlementEntry, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountFillPrefix(contractAddr, account, pair.PriceDenom, pair.AssetDenom))
+
+	pageRes, err = query.FilteredPaginate(store, page, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if binary.BigEndian.Uint64(key[:8]) < fromHeight {
+			return false, nil
+		}
+		if accumulate {
+			var fill types.SettlementEntry
+			if err := k.Cdc.Unmarshal(value, &fill); err != nil {
+				return false, err
+			}
+			list = append(list, fill)
+		}
+		return true, nil
+	})
+
+	return
+}
+
+// DeleteAccountFillsBefore removes the fills of a contract settled before the given height
+func (k Keeper) DeleteAccountFillsBefore(ctx sdk.Context, contractAddr string, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	heightStore := prefix.NewStore(store, types.AccountFillHeightPrefix(contractAddr))
+	// the iterator can't be open while deleting
+	keys, fillKeys := [][]byte{}, [][]byte{}
+	iterator := heightStore.Iterator(nil, GetKeyForTs(height))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		fillKeys = append(fillKeys, iterator.Value())
+	}
+	iterator.Close()
+	for i, key := range keys {
+		store.Delete(fillKeys[i])
+		heightStore.Delete(key)
+	}
+}
+
+func (k Keeper) RemoveAllAccountFillsForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.AccountFillContractPrefix(contractAddr))
+	k.removeAllForPrefix(ctx, types.AccountFillHeightPrefix(contractAddr))
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func fill(account string, height uint64, orderID uint64) *types.SettlementEntry {
+	return &types.SettlementEntry{
+		Account:                account,
+		PriceDenom:             keepertest.TestPriceDenom,
+		AssetDenom:             keepertest.TestAssetDenom,
+		Quantity:               sdk.OneDec(),
+		ExecutionCostOrProceed: sdk.OneDec(),
+		ExpectedCostOrProceed:  sdk.OneDec(),
+		Fee:                    sdk.ZeroDec(),
+		OrderId:                orderID,
+		Height:                 height,
+	}
+}
+
+func TestGetAccountFillsPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	other := sdk.AccAddress([]byte("other_account_______")).String()
+	keeper.SetAccountFills(ctx, keepertest.TestContract, []*types.SettlementEntry{
+		fill(keepertest.TestAccount, 2, 1), fill(other, 2, 2), fill(keepertest.TestAccount, 2, 3),
+	})
+	keeper.SetAccountFills(ctx, keepertest.TestContract, []*types.SettlementEntry{
+		fill(keepertest.TestAccount, 1, 4),
+	})
+
+	fills, pageRes, err := keeper.GetAccountFillsPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPair, 0, &query.PageRequest{Limit: 2, CountTotal: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), pageRes.Total)
+	require.Equal(t, 2, len(fills))
+	require.Equal(t, uint64(4), fills[0].OrderId)
+	require.Equal(t, uint64(1), fills[1].OrderId)
+
+	fills, _, err = keeper.GetAccountFillsPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPair, 0, &query.PageRequest{Key: pageRes.NextKey})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fills))
+	require.Equal(t, uint64(3), fills[0].OrderId)
+
+	fills, _, err = keeper.GetAccountFillsPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPair, 2, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fills))
+	require.Equal(t, uint64(1), fills[0].OrderId)
+	require.Equal(t, uint64(3), fills[1].OrderId)
+
+	fills, _, err = keeper.GetAccountFillsPaginated(ctx, keepertest.TestContract, other, keepertest.TestPair, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fills))
+	require.Equal(t, uint64(2), fills[0].OrderId)
+}
+
+func TestDeleteAccountFillsBefore(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	other := sdk.AccAddress([]byte("other_account_______")).String()
+	keeper.SetAccountFills(ctx, keepertest.TestContract, []*types.SettlementEntry{
+		fill(keepertest.TestAccount, 1, 1), fill(other, 1, 2),
+	})
+	keeper.SetAccountFills(ctx, keepertest.TestContract, []*types.SettlementEntry{
+		fill(keepertest.TestAccount, 2, 3),
+	})
+
+	keeper.DeleteAccountFillsBefore(ctx, keepertest.TestContract, 2)
+	fills, _, err := keeper.GetAccountFillsPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPair, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fills))
+	require.Equal(t, uint64(3), fills[0].OrderId)
+	fills, _, err = keeper.GetAccountFillsPaginated(ctx, keepertest.TestContract, other, keepertest.TestPair, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, fills)
+
+	keeper.RemoveAllAccountFillsForContract(ctx, keepertest.TestContract)
+	fills, _, err = keeper.GetAccountFillsPaginated(ctx, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPair, 0, nil)
+	require.NoError(t, err)
+	require.Empty(t, fills)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// The account order index records the price levels at which each account has orders resting on a
+// side of a pair's book, so that an account's orders can be found without loading the whole book.
+// It is kept in sync by the order book setters.
+
+func (k Keeper) updateAccountOrderLevels(
+	ctx sdk.Context,
+	long bool,
+	contractAddr string,
+	priceDenom string,
+	assetDenom string,
+	price sdk.Dec,
+	oldAllocations []*types.Allocation,
+	newAllocations []*types.Allocation,
+) {
+	key := GetKeyForPrice(price)
+	oldAccounts := map[string]struct{}{}
+	for _, allocation := range oldAllocations {
+		oldAccounts[allocation.Account] = struct{}{}
+	}
+	newAccounts := map[string]struct{}{}
+	for _, allocation := range newAllocations {
+		if _, ok := newAccounts[allocation.Account]; ok {
+			continue
+		}
+		newAccounts[allocation.Account] = struct{}{}
+		if _, ok := oldAccounts[allocation.Account]; !ok {
+			prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountOrderPrefix(long, contractAddr, priceDenom, assetDenom, allocation.Account)).Set(key, []byte{1})
+		}
+	}
+	for _, allocation := range oldAllocations {
+		if _, ok := newAccounts[allocation.Account]; !ok {
+			prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountOrderPrefix(long, contractAddr, priceDenom, assetDenom, allocation.Account)).Delete(key)
+		}
+	}
+}
+
+// GetAccountOrderPricesForPair returns the prices of the levels on a side of the book at which the
+// account has resting orders, in ascending order
+func (k Keeper) GetAccountOrderPricesForPair(ctx sdk.Context, long bool, contractAddr string, account string, priceDenom string, assetDenom string) []sdk.Dec {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountOrderPrefix(long, contractAddr, priceDenom, assetDenom, account))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	prices := []sdk.Dec{}
+	for ; iterator.Valid(); iterator.Next() {
+		prices = append(prices, dexutils.BytesToDec(iterator.Key()))
+	}
+	return prices
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountOrderLevels(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	entry := func(price int64, accounts ...string) *types.OrderEntry {
+		allocations := []*types.Allocation{}
+		for i, account := range accounts {
+			allocations = append(allocations, &types.Allocation{OrderId: uint64(i), Account: account, Quantity: sdk.OneDec()})
+		}
+		return &types.OrderEntry{
+			Price:       sdk.NewDec(price),
+			Quantity:    sdk.NewDec(int64(len(accounts))),
+			PriceDenom:  keepertest.TestPriceDenom,
+			AssetDenom:  keepertest.TestAssetDenom,
+			Allocations: allocations,
+		}
+	}
+	getPrices := func(long bool, account string) []sdk.Dec {
+		return keeper.GetAccountOrderPricesForPair(ctx, long, keepertest.TestContract, account, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	}
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{Price: sdk.NewDec(10), Entry: entry(10, "a", "b", "a")})
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{Price: sdk.NewDec(8), Entry: entry(8, "a")})
+	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{Price: sdk.NewDec(12), Entry: entry(12, "b")})
+	require.Equal(t, []sdk.Dec{sdk.NewDec(8), sdk.NewDec(10)}, getPrices(true, "a"))
+	require.Equal(t, []sdk.Dec{sdk.NewDec(10)}, getPrices(true, "b"))
+	require.Equal(t, []sdk.Dec{sdk.NewDec(12)}, getPrices(false, "b"))
+	require.Empty(t, getPrices(false, "a"))
+
+	// accounts whose orders leave a level are dropped from it
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{Price: sdk.NewDec(10), Entry: entry(10, "b")})
+	require.Equal(t, []sdk.Dec{sdk.NewDec(8)}, getPrices(true, "a"))
+	keeper.RemoveLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(10), keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.Empty(t, getPrices(true, "b"))
+
+	keeper.RemoveAllShortBooksForContract(ctx, keepertest.TestContract)
+	require.Empty(t, getPrices(false, "b"))
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetBracketOrder stores a take-profit/stop-loss order under its parent. Pending bracket orders
+// wait for their parent to fill, and active ones wait for their sibling to be triggered or filled.
+func (k Keeper) SetBracketOrder(ctx sdk.Context, active bool, contractAddr string, order types.Order) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.BracketOrderPrefix(active, contractAddr, order.PriceDenom, order.AssetDenom),
+	)
+	b := k.Cdc.MustMarshal(&order)
+	store.Set(getKeyForBracketOrder(order.ParentOrderId, order.Id), b)
+}
+
+func (k Keeper) RemoveBracketOrder(ctx sdk.Context, active bool, contractAddr string, order types.Order) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.BracketOrderPrefix(active, contractAddr, order.PriceDenom, order.AssetDenom),
+	)
+	store.Delete(getKeyForBracketOrder(order.ParentOrderId, order.Id))
+}
+
+// GetAllBracketOrdersForPair returns bracket orders of a pair ordered by parent
+func (k Keeper) GetAllBracketOrdersForPair(ctx sdk.Context, active bool, contractAddr string, priceDenom string, assetDenom string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BracketOrderPrefix(active, contractAddr, priceDenom, assetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) GetAllBracketOrders(ctx sdk.Context, active bool, contractAddr string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BracketOrderContractPrefix(active, contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllBracketOrdersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.BracketOrderContractPrefix(false, contractAddr))
+	k.removeAllForPrefix(ctx, types.BracketOrderContractPrefix(true, contractAddr))
+}
+
+func getKeyForBracketOrder(parentOrderID uint64, orderID uint64) []byte {
+	return append(GetKeyForOrderID(parentOrderID), GetKeyForOrderID(orderID)...)
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBracketOrder(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	order := types.Order{
+		Id:            3,
+		Account:       keepertest.TestAccount,
+		ContractAddr:  keepertest.TestContract,
+		PriceDenom:    keepertest.TestPriceDenom,
+		AssetDenom:    keepertest.TestAssetDenom,
+		OrderType:     types.OrderType_LIMIT,
+		ParentOrderId: 2,
+		BracketRole:   types.BracketRole_TAKE_PROFIT,
+	}
+	keeper.SetBracketOrder(ctx, false, keepertest.TestContract, order)
+	// ordered by parent before id
+	order.Id = 4
+	order.ParentOrderId = 1
+	keeper.SetBracketOrder(ctx, false, keepertest.TestContract, order)
+	keeper.SetBracketOrder(ctx, true, keepertest.TestContract, order)
+	orders := keeper.GetAllBracketOrdersForPair(ctx, false, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.Equal(t, 2, len(orders))
+	require.Equal(t, uint64(4), orders[0].Id)
+	require.Equal(t, uint64(3), orders[1].Id)
+	require.Equal(t, 1, len(keeper.GetAllBracketOrders(ctx, true, keepertest.TestContract)))
+
+	keeper.RemoveBracketOrder(ctx, false, keepertest.TestContract, order)
+	orders = keeper.GetAllBracketOrdersForPair(ctx, false, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.Equal(t, 1, len(orders))
+	require.Equal(t, uint64(3), orders[0].Id)
+	require.Equal(t, 1, len(keeper.GetAllBracketOrders(ctx, true, keepertest.TestContract)))
+
+	keeper.RemoveAllBracketOrdersForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllBracketOrders(ctx, false, keepertest.TestContract))
+	require.Empty(t, keeper.GetAllBracketOrders(ctx, true, keepertest.TestContract))
+}
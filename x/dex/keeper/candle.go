@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// candles of a pair are keyed by interval and then by begin timestamp, so that the candles of an
+// interval can be paginated and pruned in chronological order
+func (k Keeper) getCandleStore(ctx sdk.Context, contractAddr string, pair types.Pair, intervalInSeconds uint64) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom), GetKeyForTs(intervalInSeconds)...),
+	)
+}
+
+func (k Keeper) SetCandle(ctx sdk.Context, contractAddr string, pair types.Pair, intervalInSeconds uint64, candle types.Candle) {
+	store := k.getCandleStore(ctx, contractAddr, pair, intervalInSeconds)
+	b := k.Cdc.MustMarshal(&candle)
+	store.Set(GetKeyForTs(candle.BeginTimestamp), b)
+}
+
+func (k Keeper) GetCandle(ctx sdk.Context, contractAddr string, pair types.Pair, intervalInSeconds uint64, beginTimestamp uint64) (types.Candle, bool) {
+	store := k.getCandleStore(ctx, contractAddr, pair, intervalInSeconds)
+	res := types.Candle{}
+	b := store.Get(GetKeyForTs(beginTimestamp))
+	if b == nil {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+func (k Keeper) GetCandlesPaginated(ctx sdk.Context, contractAddr string, pair types.Pair, intervalInSeconds uint64, page *query.PageRequest) (list []types.Candle, pageRes *query.PageResponse, err error) {
+	store := k.getCandleStore(ctx, contractAddr, pair, intervalInSeconds)
+
+	pageRes, err = query.Paginate(store, page, func(key []byte, value []byte) error {
+		var candle types.Candle
+		if err := k.Cdc.Unmarshal(value, &candle); err != nil {
+			return err
+		}
+
+		list = append(list, candle)
+		return nil
+	})
+
+	return
+}
+
+// DeleteCandlesBefore removes the candles of an interval that begin before the given timestamp
+func (k Keeper) DeleteCandlesBefore(ctx sdk.Context, contractAddr string, pair types.Pair, intervalInSeconds uint64, timestamp uint64) {
+	store := k.getCandleStore(ctx, contractAddr, pair, intervalInSeconds)
+	// the iterator can't be open while deleting
+	keys := [][]byte{}
+	iterator := store.Iterator(nil, GetKeyForTs(timestamp))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllCandlesForPair returns the candles of a pair grouped by interval
+func (k Keeper) GetAllCandlesForPair(ctx sdk.Context, contractAddr string, pair types.Pair) []types.ContractPairCandles {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	list := []types.ContractPairCandles{}
+	for ; iterator.Valid(); iterator.Next() {
+		interval := binary.BigEndian.Uint64(iterator.Key()[:8])
+		if len(list) == 0 || list[len(list)-1].IntervalInSeconds != interval {
+			list = append(list, types.ContractPairCandles{
+				Pair:              types.Pair{PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom},
+				IntervalInSeconds: interval,
+			})
+		}
+		var candle types.Candle
+		k.Cdc.MustUnmarshal(iterator.Value(), &candle)
+		list[len(list)-1].Candles = append(list[len(list)-1].Candles, candle)
+	}
+
+	return list
+}
+
+func (k Keeper) RemoveAllCandlesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.CandleContractPrefix(contractAddr))
+}
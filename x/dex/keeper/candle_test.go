@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	dexkeeper "github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func seedCandle(ctx sdk.Context, k *dexkeeper.Keeper, interval uint64, begin uint64) {
+	k.SetCandle(ctx, keepertest.TestContract, keepertest.TestPair, interval, types.Candle{
+		BeginTimestamp: begin,
+		EndTimestamp:   begin + interval,
+		Open:           sdk.OneDec(),
+		High:           sdk.OneDec(),
+		Low:            sdk.OneDec(),
+		Close:          sdk.OneDec(),
+		Volume:         sdk.OneDec(),
+		Notional:       sdk.OneDec(),
+	})
+}
+
+func TestGetCandlesPaginated(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	seedCandle(ctx, keeper, types.CandleIntervalMinute, 120)
+	seedCandle(ctx, keeper, types.CandleIntervalMinute, 60)
+	seedCandle(ctx, keeper, types.CandleIntervalMinute, 180)
+	seedCandle(ctx, keeper, types.CandleIntervalHour, 3600)
+
+	candles, pageRes, err := keeper.GetCandlesPaginated(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalMinute, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(candles))
+	require.Equal(t, uint64(60), candles[0].BeginTimestamp)
+	require.Equal(t, uint64(120), candles[1].BeginTimestamp)
+	require.NotNil(t, pageRes.NextKey)
+
+	candles, _, err = keeper.GetCandlesPaginated(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalMinute, &query.PageRequest{Key: pageRes.NextKey})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(candles))
+	require.Equal(t, uint64(180), candles[0].BeginTimestamp)
+
+	candles, _, err = keeper.GetCandlesPaginated(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalMinute, &query.PageRequest{Limit: 1, Reverse: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(candles))
+	require.Equal(t, uint64(180), candles[0].BeginTimestamp)
+}
+
+func TestDeleteCandlesBefore(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	seedCandle(ctx, keeper, types.CandleIntervalMinute, 60)
+	seedCandle(ctx, keeper, types.CandleIntervalMinute, 120)
+	seedCandle(ctx, keeper, types.CandleIntervalMinute, 180)
+	seedCandle(ctx, keeper, types.CandleIntervalHour, 0)
+
+	keeper.DeleteCandlesBefore(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalMinute, 120)
+	_, found := keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalMinute, 60)
+	require.False(t, found)
+	_, found = keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalMinute, 120)
+	require.True(t, found)
+	_, found = keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalMinute, 180)
+	require.True(t, found)
+	// other intervals are left as is
+	_, found = keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalHour, 0)
+	require.True(t, found)
+
+	keeper.RemoveAllCandlesForContract(ctx, keepertest.TestContract)
+	_, found = keeper.GetCandle(ctx, keepertest.TestContract, keepertest.TestPair, types.CandleIntervalHour, 0)
+	require.False(t, found)
+}
@@ -136,6 +136,15 @@ func (k Keeper) DoUnregisterContract(ctx sdk.Context, contract types.ContractInf
 	k.RemoveAllLongBooksForContract(ctx, contract.ContractAddr)
 	k.RemoveAllShortBooksForContract(ctx, contract.ContractAddr)
 	k.RemoveAllPricesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllCandlesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllAccountFillsForContract(ctx, contract.ContractAddr)
+	k.RemoveRentDataForContract(ctx, contract.ContractAddr)
+	k.RemoveAllUncollectedFeesForContract(ctx, contract.ContractAddr)
+	k.RemoveAllContractExecutionStatsForContract(ctx, contract.ContractAddr)
+	k.RemoveAllPairHaltsForContract(ctx, contract.ContractAddr)
+	k.RemoveAllTriggeredOrdersForContract(ctx, contract.ContractAddr)
+	k.RemoveAllGoodTilTimeOrdersForContract(ctx, contract.ContractAddr)
+	k.RemoveAllBracketOrdersForContract(ctx, contract.ContractAddr)
 	k.DeleteMatchResultState(ctx, contract.ContractAddr)
 	k.DeleteNextOrderID(ctx, contract.ContractAddr)
 	k.DeleteAllRegisteredPairsForContract(ctx, contract.ContractAddr)
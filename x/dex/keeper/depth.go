@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// The depth index mirrors the order book with one aggregate per price level, so that depth can be
+// served without loading every order of the book. It is kept in sync by the order book setters.
+
+func (k Keeper) setDepthLevel(ctx sdk.Context, long bool, contractAddr string, entry *types.OrderEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DepthPrefix(long, contractAddr, entry.PriceDenom, entry.AssetDenom))
+	if entry.Quantity.IsNil() || !entry.Quantity.IsPositive() {
+		store.Delete(GetKeyForPrice(entry.Price))
+		return
+	}
+	level := types.DepthLevel{
+		Price:    entry.Price,
+		Quantity: entry.Quantity,
+	}
+	store.Set(GetKeyForPrice(entry.Price), k.Cdc.MustMarshal(&level))
+}
+
+func (k Keeper) removeDepthLevel(ctx sdk.Context, long bool, contractAddr string, price sdk.Dec, priceDenom string, assetDenom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DepthPrefix(long, contractAddr, priceDenom, assetDenom))
+	store.Delete(GetKeyForPrice(price))
+}
+
+// GetTopNDepthLevelsForPair returns up to n levels of a side of the book, best first. If bucket is
+// positive, levels are merged into multiples of it, rounding bids down and asks up.
+func (k Keeper) GetTopNDepthLevelsForPair(ctx sdk.Context, long bool, contractAddr string, priceDenom string, assetDenom string, n int, bucket sdk.Dec) []types.DepthLevel {
+	list := []types.DepthLevel{}
+	if n == 0 {
+		return list
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DepthPrefix(long, contractAddr, priceDenom, assetDenom))
+	var iterator sdk.Iterator
+	if long {
+		iterator = sdk.KVStoreReversePrefixIterator(store, []byte{})
+	} else {
+		iterator = sdk.KVStorePrefixIterator(store, []byte{})
+	}
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var level types.DepthLevel
+		k.Cdc.MustUnmarshal(iterator.Value(), &level)
+		if bucket.IsPositive() {
+			if long {
+				level.Price = level.Price.Quo(bucket).TruncateDec().Mul(bucket)
+			} else {
+				level.Price = level.Price.Quo(bucket).Ceil().Mul(bucket)
+			}
+		}
+		if len(list) > 0 && list[len(list)-1].Price.Equal(level.Price) {
+			list[len(list)-1].Quantity = list[len(list)-1].Quantity.Add(level.Quantity)
+			continue
+		}
+		if len(list) == n {
+			break
+		}
+		list = append(list, level)
+	}
+
+	return list
+}
+
+// GetAllDepthLevelsForPair returns every level of a side of the book, by ascending price
+func (k Keeper) GetAllDepthLevelsForPair(ctx sdk.Context, long bool, contractAddr string, priceDenom string, assetDenom string) []types.DepthLevel {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DepthPrefix(long, contractAddr, priceDenom, assetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	list := []types.DepthLevel{}
+	for ; iterator.Valid(); iterator.Next() {
+		var level types.DepthLevel
+		k.Cdc.MustUnmarshal(iterator.Value(), &level)
+		list = append(list, level)
+	}
+
+	return list
+}
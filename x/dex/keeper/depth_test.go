@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func depthTestEntry(price int64, quantity int64) *types.OrderEntry {
+	return &types.OrderEntry{
+		Price:       sdk.NewDec(price),
+		Quantity:    sdk.NewDec(quantity),
+		PriceDenom:  keepertest.TestPriceDenom,
+		AssetDenom:  keepertest.TestAssetDenom,
+		Allocations: []*types.Allocation{{OrderId: 1, Account: keepertest.TestAccount, Quantity: sdk.NewDec(quantity)}},
+	}
+}
+
+func TestDepthLevels(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for _, price := range []int64{7, 8, 10, 11} {
+		keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{Price: sdk.NewDec(price), Entry: depthTestEntry(price, price)})
+	}
+	for _, price := range []int64{12, 13, 15} {
+		keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{Price: sdk.NewDec(price), Entry: depthTestEntry(price, 1)})
+	}
+
+	// best first
+	require.Equal(t, []types.DepthLevel{
+		{Price: sdk.NewDec(11), Quantity: sdk.NewDec(11)},
+		{Price: sdk.NewDec(10), Quantity: sdk.NewDec(10)},
+	}, keeper.GetTopNDepthLevelsForPair(ctx, true, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 2, sdk.ZeroDec()))
+	require.Equal(t, []types.DepthLevel{
+		{Price: sdk.NewDec(12), Quantity: sdk.NewDec(1)},
+	}, keeper.GetTopNDepthLevelsForPair(ctx, false, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 1, sdk.ZeroDec()))
+
+	// bids are rounded down and asks up to the bucket
+	require.Equal(t, []types.DepthLevel{
+		{Price: sdk.NewDec(10), Quantity: sdk.NewDec(21)},
+		{Price: sdk.NewDec(5), Quantity: sdk.NewDec(15)},
+	}, keeper.GetTopNDepthLevelsForPair(ctx, true, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 5, sdk.NewDec(5)))
+	require.Equal(t, []types.DepthLevel{
+		{Price: sdk.NewDec(15), Quantity: sdk.NewDec(3)},
+	}, keeper.GetTopNDepthLevelsForPair(ctx, false, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 5, sdk.NewDec(5)))
+
+	// the index follows updates and removals of the book
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{Price: sdk.NewDec(11), Entry: depthTestEntry(11, 4)})
+	keeper.RemoveLongBookByPrice(ctx, keepertest.TestContract, sdk.NewDec(10), keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.Equal(t, []types.DepthLevel{
+		{Price: sdk.NewDec(11), Quantity: sdk.NewDec(4)},
+		{Price: sdk.NewDec(8), Quantity: sdk.NewDec(8)},
+	}, keeper.GetTopNDepthLevelsForPair(ctx, true, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 2, sdk.ZeroDec()))
+
+	keeper.RemoveAllLongBooksForContract(ctx, keepertest.TestContract)
+	keeper.RemoveAllShortBooksForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetTopNDepthLevelsForPair(ctx, true, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 5, sdk.ZeroDec()))
+	require.Empty(t, keeper.GetTopNDepthLevelsForPair(ctx, false, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 5, sdk.ZeroDec()))
+}
@@ -17,6 +17,15 @@ func (k Keeper) SetEpoch(ctx sdk.Context, epoch uint64) {
 	ctx.Logger().Info(fmt.Sprintf("Current epoch %d", epoch))
 }
 
+func (k Keeper) GetEpoch(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get([]byte(EpochKey))
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
 func (k Keeper) IsNewEpoch(ctx sdk.Context) (bool, uint64) {
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get([]byte(EpochKey))
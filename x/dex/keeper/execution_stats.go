@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// execution stats of a contract are keyed by height, so that they can be paginated and pruned in
+// chronological order
+func (k Keeper) SetContractExecutionStats(ctx sdk.Context, contractAddr string, stats types.ContractExecutionStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExecutionStatsPrefix(contractAddr))
+	store.Set(GetKeyForTs(stats.Height), k.Cdc.MustMarshal(&stats))
+}
+
+func (k Keeper) GetContractExecutionStatsPaginated(ctx sdk.Context, contractAddr string, page *query.PageRequest) (list []types.ContractExecutionStats, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExecutionStatsPrefix(contractAddr))
+
+	pageRes, err = query.Paginate(store, page, func(key []byte, value []byte) error {
+		var stats types.ContractExecutionStats
+		if err := k.Cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+
+		list = append(list, stats)
+		return nil
+	})
+
+	return
+}
+
+func (k Keeper) GetAllContractExecutionStats(ctx sdk.Context, contractAddr string) []types.ContractExecutionStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExecutionStatsPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	list := []types.ContractExecutionStats{}
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ContractExecutionStats
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// DeleteContractExecutionStatsBefore removes the execution stats of a contract recorded before the given height
+func (k Keeper) DeleteContractExecutionStatsBefore(ctx sdk.Context, contractAddr string, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExecutionStatsPrefix(contractAddr))
+	// the iterator can't be open while deleting
+	keys := [][]byte{}
+	iterator := store.Iterator(nil, GetKeyForTs(height))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	k.ExecutionWallTimes.DeleteBefore(contractAddr, height)
+}
+
+func (k Keeper) RemoveAllContractExecutionStatsForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.ExecutionStatsPrefix(contractAddr))
+	k.ExecutionWallTimes.DeleteBefore(contractAddr, math.MaxUint64)
+}
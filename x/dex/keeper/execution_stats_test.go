@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractExecutionStats(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for _, height := range []uint64{3, 1, 2} {
+		keeper.SetContractExecutionStats(ctx, keepertest.TestContract, types.ContractExecutionStats{Height: height, PlacementGas: height})
+		keeper.ExecutionWallTimes.Set(keepertest.TestContract, height, time.Millisecond)
+	}
+	keeper.SetContractExecutionStats(ctx, keepertest.TestContract2, types.ContractExecutionStats{Height: 1})
+
+	stats, pageRes, err := keeper.GetContractExecutionStatsPaginated(ctx, keepertest.TestContract, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stats))
+	require.Equal(t, uint64(1), stats[0].Height)
+	require.Equal(t, uint64(2), stats[1].Height)
+	require.NotNil(t, pageRes.NextKey)
+
+	keeper.DeleteContractExecutionStatsBefore(ctx, keepertest.TestContract, 3)
+	require.Equal(t, []types.ContractExecutionStats{{Height: 3, PlacementGas: 3}}, keeper.GetAllContractExecutionStats(ctx, keepertest.TestContract))
+	_, found := keeper.ExecutionWallTimes.Get(keepertest.TestContract, 2)
+	require.False(t, found)
+	_, found = keeper.ExecutionWallTimes.Get(keepertest.TestContract, 3)
+	require.True(t, found)
+
+	keeper.RemoveAllContractExecutionStatsForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllContractExecutionStats(ctx, keepertest.TestContract))
+	_, found = keeper.ExecutionWallTimes.Get(keepertest.TestContract, 3)
+	require.False(t, found)
+	// other contracts are left as is
+	require.Equal(t, 1, len(keeper.GetAllContractExecutionStats(ctx, keepertest.TestContract2)))
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// contract_addr, pair -> maker/taker fee rates
+func (k Keeper) SetFeeRatesForPair(ctx sdk.Context, contractAddr string, pair types.Pair, makerFeeRate sdk.Dec, takerFeeRate sdk.Dec) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RegisteredPairPrefix(contractAddr))
+
+	pair, found := k.GetRegisteredPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)
+	if !found {
+		return types.ErrPairNotRegistered
+	}
+	pair.MakerFeeRate = &makerFeeRate
+	pair.TakerFeeRate = &takerFeeRate
+	store.Set(types.PairPrefix(pair.PriceDenom, pair.AssetDenom), k.Cdc.MustMarshal(&pair))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetFeeRates,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+		sdk.NewAttribute(types.AttributeKeyPriceDenom, pair.PriceDenom),
+		sdk.NewAttribute(types.AttributeKeyAssetDenom, pair.AssetDenom),
+		sdk.NewAttribute(types.AttributeKeyMakerFeeRate, makerFeeRate.String()),
+		sdk.NewAttribute(types.AttributeKeyTakerFeeRate, takerFeeRate.String()),
+	))
+	return nil
+}
+
+// CollectFees transfers the fees charged on a contract's settlements, along with the fees it still
+// owes from earlier blocks, from the contract to the dex fee collector module account. Whatever
+// can't be collected, i.e. the fractional amounts or everything if the transfer fails, is carried
+// over to the contract's next collection, so that all fees reported in settlements are eventually
+// collected.
+func (k Keeper) CollectFees(ctx sdk.Context, contractAddr string, settlements []*types.SettlementEntry) error {
+	fees := k.GetUncollectedFees(ctx, contractAddr)
+	for _, settlement := range settlements {
+		if settlement.Fee.IsNil() || !settlement.Fee.IsPositive() {
+			continue
+		}
+		fees = fees.Add(sdk.NewDecCoinFromDec(settlement.PriceDenom, settlement.Fee))
+	}
+	collected, remainder := fees.TruncateDecimal()
+	if !collected.IsZero() {
+		if err := k.sendFees(ctx, contractAddr, collected); err != nil {
+			k.SetUncollectedFees(ctx, contractAddr, fees)
+			return err
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCollectFees,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+			sdk.NewAttribute(types.AttributeKeyAmount, collected.String()),
+		))
+	}
+	k.SetUncollectedFees(ctx, contractAddr, remainder)
+	return nil
+}
+
+// sendFees transfers the fees from the contract, without side effects if the transfer fails
+func (k Keeper) sendFees(ctx sdk.Context, contractAddr string, fees sdk.Coins) error {
+	contract, err := sdk.AccAddressFromBech32(contractAddr)
+	if err != nil {
+		return err
+	}
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(cacheCtx, contract, types.FeeCollectorName, fees); err != nil {
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// GetUncollectedFees returns the fees a contract has been charged but that haven't been collected yet
+func (k Keeper) GetUncollectedFees(ctx sdk.Context, contractAddr string) sdk.DecCoins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UncollectedFeePrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	fees := sdk.NewDecCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee sdk.DecCoin
+		k.Cdc.MustUnmarshal(iterator.Value(), &fee)
+		fees = fees.Add(fee)
+	}
+	return fees
+}
+
+// SetUncollectedFees replaces the uncollected fees of a contract
+func (k Keeper) SetUncollectedFees(ctx sdk.Context, contractAddr string, fees sdk.DecCoins) {
+	k.RemoveAllUncollectedFeesForContract(ctx, contractAddr)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UncollectedFeePrefix(contractAddr))
+	for _, fee := range fees {
+		fee := fee
+		if !fee.IsPositive() {
+			continue
+		}
+		store.Set(types.DenomPrefix(fee.Denom), k.Cdc.MustMarshal(&fee))
+	}
+}
+
+func (k Keeper) RemoveAllUncollectedFeesForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.UncollectedFeePrefix(contractAddr))
+}
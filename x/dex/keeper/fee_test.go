@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetFeeRatesForPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	err := keeper.SetFeeRatesForPair(ctx, keepertest.TestContract, keepertest.TestPair, sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002"))
+	require.Equal(t, types.ErrPairNotRegistered, err)
+
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	err = keeper.SetFeeRatesForPair(ctx, keepertest.TestContract, keepertest.TestPair, sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002"))
+	require.NoError(t, err)
+	pair, found := keeper.GetRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("0.001"), pair.GetMakerFeeRateOrZero())
+	require.Equal(t, sdk.MustNewDecFromStr("0.002"), pair.GetTakerFeeRateOrZero())
+	require.Equal(t, *keepertest.TestPair.PriceTicksize, *pair.PriceTicksize)
+}
+
+func TestCollectFees(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	contractAddr := sdk.MustAccAddressFromBech32(keepertest.TestContract)
+	funds := sdk.NewCoins(sdk.NewCoin(keepertest.TestPriceDenom, sdk.NewInt(100)))
+	require.NoError(t, keeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, keeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, contractAddr, funds))
+
+	settlements := []*types.SettlementEntry{
+		{PriceDenom: keepertest.TestPriceDenom, Fee: sdk.MustNewDecFromStr("1.5")},
+		{PriceDenom: keepertest.TestPriceDenom, Fee: sdk.MustNewDecFromStr("2.7")},
+		{PriceDenom: keepertest.TestPriceDenom, Fee: sdk.ZeroDec()},
+	}
+	require.NoError(t, keeper.CollectFees(ctx, keepertest.TestContract, settlements))
+	require.Equal(t, sdk.NewInt(96), keeper.BankKeeper.GetBalance(ctx, contractAddr, keepertest.TestPriceDenom).Amount)
+	feeCollector := authtypes.NewModuleAddress(types.FeeCollectorName)
+	require.Equal(t, sdk.NewInt(4), keeper.BankKeeper.GetBalance(ctx, feeCollector, keepertest.TestPriceDenom).Amount)
+	// the fractional amount is carried over to the next collection
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), keeper.GetUncollectedFees(ctx, keepertest.TestContract).AmountOf(keepertest.TestPriceDenom))
+
+	settlements = []*types.SettlementEntry{{PriceDenom: keepertest.TestPriceDenom, Fee: sdk.MustNewDecFromStr("0.9")}}
+	require.NoError(t, keeper.CollectFees(ctx, keepertest.TestContract, settlements))
+	require.Equal(t, sdk.NewInt(95), keeper.BankKeeper.GetBalance(ctx, contractAddr, keepertest.TestPriceDenom).Amount)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), keeper.GetUncollectedFees(ctx, keepertest.TestContract).AmountOf(keepertest.TestPriceDenom))
+}
+
+func TestCollectFeesInsufficientFunds(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	contractAddr := sdk.MustAccAddressFromBech32(keepertest.TestContract)
+	funds := sdk.NewCoins(sdk.NewCoin(keepertest.TestPriceDenom, sdk.NewInt(100)))
+	require.NoError(t, keeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, keeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, contractAddr, funds))
+
+	// contracts must hold enough funds to pay for the fees, which are otherwise carried over in full
+	settlements := []*types.SettlementEntry{{PriceDenom: keepertest.TestPriceDenom, Fee: sdk.MustNewDecFromStr("150.5")}}
+	require.Error(t, keeper.CollectFees(ctx, keepertest.TestContract, settlements))
+	require.Equal(t, sdk.NewInt(100), keeper.BankKeeper.GetBalance(ctx, contractAddr, keepertest.TestPriceDenom).Amount)
+	require.Equal(t, sdk.MustNewDecFromStr("150.5"), keeper.GetUncollectedFees(ctx, keepertest.TestContract).AmountOf(keepertest.TestPriceDenom))
+
+	// and collected along with the fees of a later settlement once the contract can pay
+	require.NoError(t, keeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, keeper.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, contractAddr, funds))
+	settlements = []*types.SettlementEntry{{PriceDenom: keepertest.TestPriceDenom, Fee: sdk.MustNewDecFromStr("1.5")}}
+	require.NoError(t, keeper.CollectFees(ctx, keepertest.TestContract, settlements))
+	require.Equal(t, sdk.NewInt(48), keeper.BankKeeper.GetBalance(ctx, contractAddr, keepertest.TestPriceDenom).Amount)
+	feeCollector := authtypes.NewModuleAddress(types.FeeCollectorName)
+	require.Equal(t, sdk.NewInt(152), keeper.BankKeeper.GetBalance(ctx, feeCollector, keepertest.TestPriceDenom).Amount)
+	require.True(t, keeper.GetUncollectedFees(ctx, keepertest.TestContract).IsZero())
+
+	keeper.SetUncollectedFees(ctx, keepertest.TestContract, sdk.NewDecCoins(sdk.NewDecCoinFromDec(keepertest.TestPriceDenom, sdk.OneDec())))
+	keeper.RemoveAllUncollectedFeesForContract(ctx, keepertest.TestContract)
+	require.True(t, keeper.GetUncollectedFees(ctx, keepertest.TestContract).IsZero())
+}
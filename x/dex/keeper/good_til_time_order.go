@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// Good-til-time orders are stored by id, and indexed by expiry height and/or timestamp followed
+// by id, so that each block only reads the orders that have expired as of it.
+
+// SetGoodTilTimeOrder indexes a good-til-time order resting on the book so that it can be
+// cancelled once it expires
+func (k Keeper) SetGoodTilTimeOrder(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GoodTilTimeOrderPrefix(contractAddr, order.PriceDenom, order.AssetDenom),
+	)
+	b := k.Cdc.MustMarshal(&order)
+	store.Set(GetKeyForOrderID(order.Id), b)
+	k.forEachGoodTilTimeExpiry(ctx, contractAddr, order, func(store prefix.Store, key []byte) {
+		store.Set(key, GetKeyForOrderID(order.Id))
+	})
+}
+
+func (k Keeper) RemoveGoodTilTimeOrder(ctx sdk.Context, contractAddr string, orderID uint64, priceDenom string, assetDenom string) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GoodTilTimeOrderPrefix(contractAddr, priceDenom, assetDenom),
+	)
+	b := store.Get(GetKeyForOrderID(orderID))
+	if b == nil {
+		return
+	}
+	var order types.Order
+	k.Cdc.MustUnmarshal(b, &order)
+	k.forEachGoodTilTimeExpiry(ctx, contractAddr, order, func(store prefix.Store, key []byte) {
+		store.Delete(key)
+	})
+	store.Delete(GetKeyForOrderID(orderID))
+}
+
+// GetExpiredGoodTilTimeOrdersForPair returns the good-til-time orders that have reached their
+// expiry height or timestamp as of the current block, by expiry
+func (k Keeper) GetExpiredGoodTilTimeOrdersForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GoodTilTimeOrderPrefix(contractAddr, priceDenom, assetDenom))
+	seen := map[uint64]struct{}{}
+	for _, byTimestamp := range []bool{false, true} {
+		current := uint64(ctx.BlockHeight())
+		if byTimestamp {
+			current = uint64(ctx.BlockTime().Unix())
+		}
+		expiryStore := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.GoodTilTimeExpiryPrefix(contractAddr, priceDenom, assetDenom, byTimestamp),
+		)
+		iterator := expiryStore.Iterator(nil, GetKeyForTs(current+1))
+		for ; iterator.Valid(); iterator.Next() {
+			orderID := binary.BigEndian.Uint64(iterator.Value())
+			if _, ok := seen[orderID]; ok {
+				continue
+			}
+			seen[orderID] = struct{}{}
+			var val types.Order
+			k.Cdc.MustUnmarshal(store.Get(iterator.Value()), &val)
+			list = append(list, val)
+		}
+		iterator.Close()
+	}
+
+	return
+}
+
+func (k Keeper) GetAllGoodTilTimeOrdersForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GoodTilTimeOrderPrefix(contractAddr, priceDenom, assetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) GetAllGoodTilTimeOrders(ctx sdk.Context, contractAddr string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GoodTilTimeOrderContractPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllGoodTilTimeOrdersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.GoodTilTimeOrderContractPrefix(contractAddr))
+	k.removeAllForPrefix(ctx, types.GoodTilTimeExpiryContractPrefix(contractAddr))
+}
+
+// forEachGoodTilTimeExpiry calls f with the expiry index key of each expiry the order has
+func (k Keeper) forEachGoodTilTimeExpiry(ctx sdk.Context, contractAddr string, order types.Order, f func(prefix.Store, []byte)) {
+	for _, byTimestamp := range []bool{false, true} {
+		expiry := order.ExpiryHeight
+		if byTimestamp {
+			expiry = order.ExpiryTimestamp
+		}
+		if expiry == 0 {
+			continue
+		}
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.GoodTilTimeExpiryPrefix(contractAddr, order.PriceDenom, order.AssetDenom, byTimestamp),
+		)
+		f(store, goodTilTimeExpiryKey(expiry, order.Id))
+	}
+}
+
+func goodTilTimeExpiryKey(expiry uint64, orderID uint64) []byte {
+	return append(GetKeyForTs(expiry), GetKeyForOrderID(orderID)...)
+}
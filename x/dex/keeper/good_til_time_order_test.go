@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoodTilTimeOrder(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	order := types.Order{
+		Id:           1,
+		Account:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		OrderType:    types.OrderType_LIMIT,
+		TimeInForce:  types.TimeInForce_GTT,
+		ExpiryHeight: 10,
+	}
+	keeper.SetGoodTilTimeOrder(ctx, keepertest.TestContract, order)
+	order.Id = 2
+	keeper.SetGoodTilTimeOrder(ctx, keepertest.TestContract, order)
+	require.Equal(t, 2, len(keeper.GetAllGoodTilTimeOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)))
+
+	keeper.RemoveGoodTilTimeOrder(ctx, keepertest.TestContract, 1, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	orders := keeper.GetAllGoodTilTimeOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.Equal(t, 1, len(orders))
+	require.Equal(t, uint64(2), orders[0].Id)
+
+	keeper.RemoveAllGoodTilTimeOrdersForContract(ctx, keepertest.TestContract)
+	require.Equal(t, 0, len(keeper.GetAllGoodTilTimeOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)))
+}
+
+func TestGetExpiredGoodTilTimeOrdersForPair(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	newOrder := func(id uint64, expiryHeight uint64, expiryTimestamp uint64) types.Order {
+		return types.Order{
+			Id:              id,
+			Account:         keepertest.TestAccount,
+			ContractAddr:    keepertest.TestContract,
+			PriceDenom:      keepertest.TestPriceDenom,
+			AssetDenom:      keepertest.TestAssetDenom,
+			OrderType:       types.OrderType_LIMIT,
+			TimeInForce:     types.TimeInForce_GTT,
+			ExpiryHeight:    expiryHeight,
+			ExpiryTimestamp: expiryTimestamp,
+		}
+	}
+	keeper.SetGoodTilTimeOrder(ctx, keepertest.TestContract, newOrder(1, 11, 0))
+	keeper.SetGoodTilTimeOrder(ctx, keepertest.TestContract, newOrder(2, 10, 0))
+	keeper.SetGoodTilTimeOrder(ctx, keepertest.TestContract, newOrder(3, 0, 1000))
+	keeper.SetGoodTilTimeOrder(ctx, keepertest.TestContract, newOrder(4, 0, 1001))
+	// expired by both height and timestamp
+	keeper.SetGoodTilTimeOrder(ctx, keepertest.TestContract, newOrder(5, 9, 999))
+
+	expired := keeper.GetExpiredGoodTilTimeOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	ids := []uint64{}
+	for _, order := range expired {
+		ids = append(ids, order.Id)
+	}
+	require.Equal(t, []uint64{5, 2, 3}, ids)
+
+	// removing an order also removes it from the expiry index
+	keeper.RemoveGoodTilTimeOrder(ctx, keepertest.TestContract, 5, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	keeper.RemoveGoodTilTimeOrder(ctx, keepertest.TestContract, 2, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	expired = keeper.GetExpiredGoodTilTimeOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.Equal(t, 1, len(expired))
+	require.Equal(t, uint64(3), expired[0].Id)
+
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1001, 0))
+	require.Equal(t, 3, len(keeper.GetExpiredGoodTilTimeOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)))
+
+	keeper.RemoveAllGoodTilTimeOrdersForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetExpiredGoodTilTimeOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// RegisterInvariants registers all dex invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, types.OrderEntryQuantityInvariantName, OrderEntryQuantityInvariant(k))
+	ir.RegisterRoute(types.ModuleName, types.OrderCountInvariantName, OrderCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, types.RentBalanceInvariantName, RentBalanceInvariant(k))
+}
+
+// AllInvariants runs all dex invariants
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			OrderEntryQuantityInvariant(k),
+			OrderCountInvariant(k),
+			RentBalanceInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// OrderEntryQuantityInvariant checks that the quantity of every order book entry is the sum of
+// its allocations
+func OrderEntryQuantityInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		broken := []string{}
+		for _, contractInfo := range k.GetAllContractInfo(ctx) {
+			contractState := types.ContractState{
+				ContractInfo:  contractInfo,
+				LongBookList:  k.GetAllLongBook(ctx, contractInfo.ContractAddr),
+				ShortBookList: k.GetAllShortBook(ctx, contractInfo.ContractAddr),
+			}
+			broken = append(broken, contractState.BrokenOrderEntryQuantities()...)
+		}
+		return formatInvariant(types.OrderEntryQuantityInvariantName, broken)
+	}
+}
+
+// OrderCountInvariant checks that the order count of every price level is the number of orders
+// resting at it
+func OrderCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		broken := []string{}
+		for _, contractInfo := range k.GetAllContractInfo(ctx) {
+			contractAddr := contractInfo.ContractAddr
+			orderCounts := []types.OrderCount{}
+			for _, pair := range k.GetAllRegisteredPairs(ctx, contractAddr) {
+				orderCounts = append(orderCounts, k.GetAllOrderCountsForPair(ctx, contractAddr, pair.PriceDenom, pair.AssetDenom)...)
+			}
+			contractState := types.ContractState{
+				ContractInfo:   contractInfo,
+				LongBookList:   k.GetAllLongBook(ctx, contractAddr),
+				ShortBookList:  k.GetAllShortBook(ctx, contractAddr),
+				OrderCountList: orderCounts,
+			}
+			broken = append(broken, contractState.BrokenOrderCounts()...)
+		}
+		return formatInvariant(types.OrderCountInvariantName, broken)
+	}
+}
+
+// RentBalanceInvariant checks that the module balance covers the rent balances of all contracts
+func RentBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleBalance := k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(types.ModuleName), appparams.BaseCoinUnit)
+		broken := types.BrokenRentBalances(k.GetAllContractInfo(ctx), moduleBalance.Amount)
+		return formatInvariant(types.RentBalanceInvariantName, broken)
+	}
+}
+
+func formatInvariant(name string, broken []string) (string, bool) {
+	msg := fmt.Sprintf("%d broken:\n%s", len(broken), strings.Join(broken, "\n"))
+	return sdk.FormatInvariant(types.ModuleName, name, msg), len(broken) > 0
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvariants(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	pair := keepertest.TestPair
+	require.NoError(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract, RentBalance: 100}))
+	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
+	dexkeeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(10),
+			Quantity:    sdk.NewDec(3),
+			Allocations: []*types.Allocation{{OrderId: 1, Account: "abc", Quantity: sdk.NewDec(1)}, {OrderId: 2, Account: "def", Quantity: sdk.NewDec(2)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	require.NoError(t, dexkeeper.SetOrderCount(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(10), 2))
+	rent := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100)))
+	require.NoError(t, dexkeeper.BankKeeper.MintCoins(ctx, minttypes.ModuleName, rent))
+	require.NoError(t, dexkeeper.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, rent))
+	_, broken := keeper.AllInvariants(*dexkeeper)(ctx)
+	require.False(t, broken)
+
+	// an entry quantity that doesn't match its allocations
+	dexkeeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(11),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(11),
+			Quantity:    sdk.NewDec(5),
+			Allocations: []*types.Allocation{{OrderId: 3, Account: "abc", Quantity: sdk.NewDec(1)}},
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+		},
+	})
+	_, broken = keeper.OrderEntryQuantityInvariant(*dexkeeper)(ctx)
+	require.True(t, broken)
+	// the order count of the new entry is missing too
+	msg, broken := keeper.OrderCountInvariant(*dexkeeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "1 broken")
+
+	// a count left behind at a price level without orders
+	require.NoError(t, dexkeeper.SetOrderCount(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, types.PositionDirection_LONG, sdk.NewDec(11), 1))
+	_, broken = keeper.OrderCountInvariant(*dexkeeper)(ctx)
+	require.False(t, broken)
+	require.NoError(t, dexkeeper.SetOrderCount(ctx, keepertest.TestContract, pair.PriceDenom, pair.AssetDenom, types.PositionDirection_SHORT, sdk.NewDec(12), 1))
+	_, broken = keeper.OrderCountInvariant(*dexkeeper)(ctx)
+	require.True(t, broken)
+
+	// rent balances that the module balance doesn't cover
+	_, broken = keeper.RentBalanceInvariant(*dexkeeper)(ctx)
+	require.False(t, broken)
+	require.NoError(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract, RentBalance: 101}))
+	_, broken = keeper.RentBalanceInvariant(*dexkeeper)(ctx)
+	require.True(t, broken)
+}
@@ -27,7 +27,10 @@ type (
 		EpochKeeper   epochkeeper.Keeper
 		BankKeeper    bankkeeper.Keeper
 		WasmKeeper    wasm.Keeper
+		OracleKeeper  types.OracleKeeper
 		MemState      *dexcache.MemState
+		// node-local, since wall times differ between nodes
+		ExecutionWallTimes *dexcache.ExecutionWallTimes
 	}
 )
 
@@ -53,6 +56,8 @@ func NewKeeper(
 		BankKeeper:    bankKeeper,
 		AccountKeeper: accountKeeper,
 		MemState:      dexcache.NewMemState(memKey),
+
+		ExecutionWallTimes: dexcache.NewExecutionWallTimes(),
 	}
 }
 
@@ -72,6 +77,10 @@ func (k *Keeper) SetWasmKeeper(wasmKeeper *wasm.Keeper) {
 	k.WasmKeeper = *wasmKeeper
 }
 
+func (k *Keeper) SetOracleKeeper(oracleKeeper types.OracleKeeper) {
+	k.OracleKeeper = oracleKeeper
+}
+
 func (k Keeper) CreateModuleAccount(ctx sdk.Context) {
 	moduleAcc := authtypes.NewEmptyModuleAccount(types.ModuleName)
 	k.AccountKeeper.SetModuleAccount(ctx, moduleAcc)
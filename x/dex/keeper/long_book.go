@@ -16,8 +16,14 @@ func (k Keeper) SetLongBook(ctx sdk.Context, contractAddr string, longBook types
 			true, contractAddr, longBook.Entry.PriceDenom, longBook.Entry.AssetDenom,
 		),
 	)
+	oldAllocations := []*types.Allocation{}
+	if old, found := k.GetLongBookByPrice(ctx, contractAddr, longBook.Entry.Price, longBook.Entry.PriceDenom, longBook.Entry.AssetDenom); found {
+		oldAllocations = old.Entry.Allocations
+	}
 	b := k.Cdc.MustMarshal(&longBook)
 	store.Set(GetKeyForLongBook(longBook), b)
+	k.setDepthLevel(ctx, true, contractAddr, longBook.Entry)
+	k.updateAccountOrderLevels(ctx, true, contractAddr, longBook.Entry.PriceDenom, longBook.Entry.AssetDenom, longBook.Entry.Price, oldAllocations, longBook.Entry.Allocations)
 }
 
 func (k Keeper) SetLongOrderBookEntry(ctx sdk.Context, contractAddr string, longBook types.OrderBookEntry) {
@@ -51,7 +57,11 @@ func (k Keeper) RemoveLongBookByPrice(ctx sdk.Context, contractAddr string, pric
 			true, contractAddr, priceDenom, assetDenom,
 		),
 	)
+	if old, found := k.GetLongBookByPrice(ctx, contractAddr, price, priceDenom, assetDenom); found {
+		k.updateAccountOrderLevels(ctx, true, contractAddr, priceDenom, assetDenom, price, old.Entry.Allocations, nil)
+	}
 	store.Delete(GetKeyForPrice(price))
+	k.removeDepthLevel(ctx, true, contractAddr, price, priceDenom, assetDenom)
 }
 
 // GetAllLongBook returns all longBook
@@ -173,6 +183,8 @@ func (k Keeper) GetLongAllocationForOrderID(ctx sdk.Context, contractAddr string
 
 func (k Keeper) RemoveAllLongBooksForContract(ctx sdk.Context, contractAddr string) {
 	k.removeAllForPrefix(ctx, types.OrderBookContractPrefix(true, contractAddr))
+	k.removeAllForPrefix(ctx, types.DepthContractPrefix(true, contractAddr))
+	k.removeAllForPrefix(ctx, types.AccountOrderContractPrefix(true, contractAddr))
 }
 
 func GetKeyForLongBook(longBook types.LongBook) []byte {
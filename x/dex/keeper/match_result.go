@@ -30,6 +30,9 @@ func (k Keeper) GetMatchResultState(ctx sdk.Context, contractAddr string) (*type
 	)
 	bz := store.Get([]byte(MatchResultKey))
 	result := types.MatchResult{}
+	if bz == nil {
+		return &result, false
+	}
 	if err := result.Unmarshal(bz); err != nil {
 		panic(err)
 	}
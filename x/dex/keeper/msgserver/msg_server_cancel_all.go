@@ -0,0 +1,35 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// CancelAll records the request in memory. The matching resting orders of the creator are only
+// resolved at EndBlock, so that the cost of the tx doesn't grow with the number of orders.
+func (k msgServer) CancelAll(goCtx context.Context, msg *types.MsgCancelAll) (*types.MsgCancelAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	if filter := msg.Filter; filter != nil && len(filter.PriceDenom) > 0 && !k.HasRegisteredPair(ctx, msg.ContractAddr, filter.PriceDenom, filter.AssetDenom) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pair %s/%s is not registered", filter.PriceDenom, filter.AssetDenom)
+	}
+
+	utils.GetMemState(ctx.Context()).GetBlockCancelAlls(ctx, types.ContractAddress(msg.ContractAddr)).Add(msg)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelAll,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, msg.ContractAddr),
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+	))
+	utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.ContractAddr, k.GetContractWithoutGasCharge)
+	return &types.MsgCancelAllResponse{}, nil
+}
@@ -0,0 +1,43 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelAll(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	msg := types.NewMsgCancelAll(keepertest.TestAccount, TestContract, &types.CancelAllFilter{
+		PriceDenom: keepertest.TestPriceDenom,
+		AssetDenom: keepertest.TestAssetDenom,
+	})
+	_, err := server.CancelAll(wctx, msg)
+	require.Nil(t, err)
+	// orders are only resolved at EndBlock
+	requests := dexutils.GetMemState(ctx.Context()).GetBlockCancelAlls(ctx, TestContract).Get()
+	require.Equal(t, 1, len(requests))
+	require.Equal(t, keepertest.TestAccount, requests[0].Creator)
+	require.Equal(t, 0, len(dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, TestContract, keepertest.TestPair).Get()))
+
+	// the same request is only recorded once
+	_, err = server.CancelAll(wctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(dexutils.GetMemState(ctx.Context()).GetBlockCancelAlls(ctx, TestContract).Get()))
+
+	// unregistered pair
+	_, err = server.CancelAll(wctx, types.NewMsgCancelAll(keepertest.TestAccount, TestContract, &types.CancelAllFilter{
+		PriceDenom: "unknown",
+		AssetDenom: keepertest.TestAssetDenom,
+	}))
+	require.NotNil(t, err)
+}
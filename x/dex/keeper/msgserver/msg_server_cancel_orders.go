@@ -20,17 +20,11 @@ func (k msgServer) CancelOrders(goCtx context.Context, msg *types.MsgCancelOrder
 
 	events := []sdk.Event{}
 	for _, cancellation := range msg.GetCancellations() {
-		var allocation *types.Allocation
-		var found bool
-		if cancellation.PositionDirection == types.PositionDirection_LONG {
-			allocation, found = k.GetLongAllocationForOrderID(ctx, msg.ContractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Price, cancellation.Id)
-		} else {
-			allocation, found = k.GetShortAllocationForOrderID(ctx, msg.ContractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Price, cancellation.Id)
-		}
+		account, found := k.getOrderAccount(ctx, msg.ContractAddr, cancellation)
 		if !found {
 			continue
 		}
-		if allocation.Account != msg.Creator {
+		if account != msg.Creator {
 			return nil, errors.New("cannot cancel orders created by others")
 		}
 		pair := types.Pair{PriceDenom: cancellation.PriceDenom, AssetDenom: cancellation.AssetDenom}
@@ -58,3 +52,22 @@ func (k msgServer) CancelOrders(goCtx context.Context, msg *types.MsgCancelOrder
 	utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.ContractAddr, k.GetContractWithoutGasCharge)
 	return &types.MsgCancelOrdersResponse{}, nil
 }
+
+// getOrderAccount returns the owner of a resting order, which is either on the order book or, for
+// stop loss/limit orders that haven't been triggered yet, in the trigger book.
+func (k msgServer) getOrderAccount(ctx sdk.Context, contractAddr string, cancellation *types.Cancellation) (string, bool) {
+	var allocation *types.Allocation
+	var found bool
+	if cancellation.PositionDirection == types.PositionDirection_LONG {
+		allocation, found = k.GetLongAllocationForOrderID(ctx, contractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Price, cancellation.Id)
+	} else {
+		allocation, found = k.GetShortAllocationForOrderID(ctx, contractAddr, cancellation.PriceDenom, cancellation.AssetDenom, cancellation.Price, cancellation.Id)
+	}
+	if found {
+		return allocation.Account, true
+	}
+	if triggeredOrder, triggeredFound := k.GetTriggeredOrderByID(ctx, contractAddr, cancellation.Id, cancellation.PriceDenom, cancellation.AssetDenom); triggeredFound {
+		return triggeredOrder.Account, true
+	}
+	return "", false
+}
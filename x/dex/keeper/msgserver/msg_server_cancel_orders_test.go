@@ -63,6 +63,50 @@ func TestCancelOrder(t *testing.T) {
 	require.Equal(t, keepertest.TestContract, pairBlockCancellations.Get()[0].ContractAddr)
 }
 
+func TestCancelTriggeredOrder(t *testing.T) {
+	// store an untriggered stop loss order
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, types.Order{
+		Id:                1,
+		Account:           keepertest.TestAccount,
+		ContractAddr:      keepertest.TestContract,
+		Price:             sdk.ZeroDec(),
+		Quantity:          sdk.MustNewDecFromStr("2"),
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_SHORT,
+		TriggerPrice:      sdk.OneDec(),
+	})
+
+	msg := &types.MsgCancelOrders{
+		Creator:      keepertest.TestAccount,
+		ContractAddr: keepertest.TestContract,
+		Cancellations: []*types.Cancellation{
+			{
+				Price:             sdk.ZeroDec(),
+				PositionDirection: types.PositionDirection_SHORT,
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+				Id:                1,
+			},
+		},
+	}
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	_, err := server.CancelOrders(wctx, msg)
+	require.Nil(t, err)
+	pairBlockCancellations := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.Equal(t, 1, len(pairBlockCancellations.Get()))
+	require.Equal(t, uint64(1), pairBlockCancellations.Get()[0].Id)
+
+	// cannot cancel stop orders created by others
+	msg.Creator = "sei1ewxvf5a9wq9zk5nurtl6m9yfxpnhyp7s7uk5sl"
+	_, err = server.CancelOrders(wctx, msg)
+	require.NotNil(t, err)
+}
+
 func TestInvalidCancels(t *testing.T) {
 	// nil cancel price
 	keeper, ctx := keepertest.DexKeeper(t)
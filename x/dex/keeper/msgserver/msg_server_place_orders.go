@@ -11,32 +11,32 @@ import (
 	"github.com/sei-protocol/sei-chain/x/dex/utils"
 )
 
-func (k msgServer) transferFunds(goCtx context.Context, msg *types.MsgPlaceOrders) error {
-	if len(msg.Funds) == 0 {
+func (k msgServer) transferFunds(goCtx context.Context, creator string, contract string, funds sdk.Coins) error {
+	if len(funds) == 0 {
 		return nil
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	contractAddr := sdk.MustAccAddressFromBech32(msg.ContractAddr)
-	if err := k.BankKeeper.IsSendEnabledCoins(ctx, msg.Funds...); err != nil {
+	contractAddr := sdk.MustAccAddressFromBech32(contract)
+	if err := k.BankKeeper.IsSendEnabledCoins(ctx, funds...); err != nil {
 		return err
 	}
 	if k.BankKeeper.BlockedAddr(contractAddr) {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", contractAddr.String())
 	}
 
-	sender := sdk.MustAccAddressFromBech32(msg.Creator)
-	for _, fund := range msg.Funds {
+	sender := sdk.MustAccAddressFromBech32(creator)
+	for _, fund := range funds {
 		if fund.Amount.IsNil() || fund.IsNegative() {
 			return errors.New("fund deposits cannot be nil or negative")
 		}
-		utils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(msg.GetContractAddr())).Add(&types.DepositInfoEntry{
-			Creator: msg.Creator,
+		utils.GetMemState(ctx.Context()).GetDepositInfo(ctx, types.ContractAddress(contract)).Add(&types.DepositInfoEntry{
+			Creator: creator,
 			Denom:   fund.Denom,
 			Amount:  sdk.NewDec(fund.Amount.Int64()),
 		})
 	}
-	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, msg.Funds); err != nil {
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, funds); err != nil {
 		return fmt.Errorf("error sending coins to contract: %s", err)
 	}
 	return nil
@@ -50,30 +50,58 @@ func (k msgServer) PlaceOrders(goCtx context.Context, msg *types.MsgPlaceOrders)
 		return nil, err
 	}
 
-	if err := k.transferFunds(goCtx, msg); err != nil {
+	if err := k.transferFunds(goCtx, msg.Creator, msg.ContractAddr, msg.Funds); err != nil {
 		return nil, err
 	}
+	idsInResp, err := k.placeOrders(ctx, msg.Creator, msg.ContractAddr, msg.GetOrders())
+	if err != nil {
+		return nil, err
+	}
+
+	utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.ContractAddr, k.GetContractWithoutGasCharge)
+	return &types.MsgPlaceOrdersResponse{
+		OrderIds: idsInResp,
+	}, nil
+}
+
+// placeOrders assigns ids to the orders and adds them to the block's orders
+func (k msgServer) placeOrders(ctx sdk.Context, creator string, contractAddr string, orders []*types.Order) ([]uint64, error) {
 	events := []sdk.Event{}
-	nextID := k.GetNextOrderID(ctx, msg.ContractAddr)
+	nextID := k.GetNextOrderID(ctx, contractAddr)
 	idsInResp := []uint64{}
 	maxOrderPerPrice := k.GetMaxOrderPerPrice(ctx)
-	for _, order := range msg.GetOrders() {
-		if k.GetOrderCountState(ctx, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price) >= maxOrderPerPrice {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order book already has more than %d orders for %s-%s-%s %s at %s", maxOrderPerPrice, msg.GetContractAddr(), order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price)
+	if err := k.CheckOrdersPriceBand(ctx, contractAddr, orders); err != nil {
+		return nil, err
+	}
+	var parentID uint64
+	for _, order := range orders {
+		if k.GetOrderCountState(ctx, contractAddr, order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price) >= maxOrderPerPrice {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order book already has more than %d orders for %s-%s-%s %s at %s", maxOrderPerPrice, contractAddr, order.PriceDenom, order.AssetDenom, order.PositionDirection, order.Price)
+		}
+		if order.IsExpired(ctx) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "good-til-time order has already expired")
 		}
-		priceTicksize, found := k.Keeper.GetPriceTickSizeForPair(ctx, msg.GetContractAddr(), types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom})
+		priceTicksize, found := k.Keeper.GetPriceTickSizeForPair(ctx, contractAddr, types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom})
 		if !found {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no price ticksize configured", order.PriceDenom, order.AssetDenom)
 		}
-		quantityTicksize, found := k.Keeper.GetQuantityTickSizeForPair(ctx, msg.GetContractAddr(), types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom})
+		quantityTicksize, found := k.Keeper.GetQuantityTickSizeForPair(ctx, contractAddr, types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom})
 		if !found {
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "the pair {price:%s,asset:%s} has no quantity ticksize configured", order.PriceDenom, order.AssetDenom)
 		}
 		pair := types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom, PriceTicksize: &priceTicksize, QuantityTicksize: &quantityTicksize}
+		if k.IsPairHalted(ctx, contractAddr, pair) {
+			return nil, sdkerrors.Wrapf(types.ErrPairHalted, "trading of {price:%s,asset:%s} is halted", order.PriceDenom, order.AssetDenom)
+		}
 		order.Id = nextID
-		order.Account = msg.Creator
-		order.ContractAddr = msg.GetContractAddr()
-		utils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(msg.GetContractAddr()), pair).Add(order)
+		if order.BracketRole == types.BracketRole_NO_BRACKET {
+			parentID = nextID
+		} else {
+			order.ParentOrderId = parentID
+		}
+		order.Account = creator
+		order.ContractAddr = contractAddr
+		utils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr), pair).Add(order)
 		idsInResp = append(idsInResp, nextID)
 		events = append(events, sdk.NewEvent(
 			types.EventTypePlaceOrder,
@@ -81,11 +109,7 @@ func (k msgServer) PlaceOrders(goCtx context.Context, msg *types.MsgPlaceOrders)
 		))
 		nextID++
 	}
-	k.SetNextOrderID(ctx, msg.ContractAddr, nextID)
+	k.SetNextOrderID(ctx, contractAddr, nextID)
 	ctx.EventManager().EmitEvents(events)
-
-	utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.ContractAddr, k.GetContractWithoutGasCharge)
-	return &types.MsgPlaceOrdersResponse{
-		OrderIds: idsInResp,
-	}, nil
+	return idsInResp, nil
 }
@@ -61,6 +61,104 @@ func TestPlaceOrder(t *testing.T) {
 	require.Equal(t, msg.Orders[0].Account, TestCreator)
 }
 
+func TestPlaceOrderWithBrackets(t *testing.T) {
+	newOrder := func(direction types.PositionDirection, role types.BracketRole) *types.Order {
+		return &types.Order{
+			Price:             sdk.MustNewDecFromStr("10"),
+			Quantity:          sdk.MustNewDecFromStr("10"),
+			PositionDirection: direction,
+			OrderType:         types.OrderType_LIMIT,
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+			BracketRole:       role,
+		}
+	}
+	msg := &types.MsgPlaceOrders{
+		Creator:      TestCreator,
+		ContractAddr: TestContract,
+		Orders: []*types.Order{
+			newOrder(types.PositionDirection_LONG, types.BracketRole_NO_BRACKET),
+			newOrder(types.PositionDirection_SHORT, types.BracketRole_TAKE_PROFIT),
+			newOrder(types.PositionDirection_SHORT, types.BracketRole_NO_BRACKET),
+			newOrder(types.PositionDirection_LONG, types.BracketRole_TAKE_PROFIT),
+		},
+	}
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	res, err := server.PlaceOrders(wctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{0, 1, 2, 3}, res.OrderIds)
+	orders := dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(TestContract), keepertest.TestPair)
+	require.Equal(t, uint64(0), orders.GetByID(0).ParentOrderId)
+	require.Equal(t, uint64(0), orders.GetByID(1).ParentOrderId)
+	require.Equal(t, uint64(0), orders.GetByID(2).ParentOrderId)
+	require.Equal(t, uint64(2), orders.GetByID(3).ParentOrderId)
+}
+
+func TestPlaceOrderHaltedPair(t *testing.T) {
+	msg := &types.MsgPlaceOrders{
+		Creator:      TestCreator,
+		ContractAddr: TestContract,
+		Orders: []*types.Order{
+			{
+				Price:             sdk.MustNewDecFromStr("10"),
+				Quantity:          sdk.MustNewDecFromStr("10"),
+				Data:              "",
+				PositionDirection: types.PositionDirection_LONG,
+				OrderType:         types.OrderType_LIMIT,
+				PriceDenom:        keepertest.TestPriceDenom,
+				AssetDenom:        keepertest.TestAssetDenom,
+			},
+		},
+	}
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	keeper.SetPairHalt(ctx, TestContract, types.PairHalt{Pair: &keepertest.TestPair, HaltedByCreator: true})
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	_, err := server.PlaceOrders(wctx, msg)
+	require.ErrorIs(t, err, types.ErrPairHalted)
+}
+
+func TestPlaceOrderOutsidePriceBand(t *testing.T) {
+	newMsg := func(price string) *types.MsgPlaceOrders {
+		return &types.MsgPlaceOrders{
+			Creator:      TestCreator,
+			ContractAddr: keepertest.TestContract,
+			Orders: []*types.Order{
+				{
+					Price:             sdk.MustNewDecFromStr(price),
+					Quantity:          sdk.MustNewDecFromStr("10"),
+					PositionDirection: types.PositionDirection_LONG,
+					OrderType:         types.OrderType_LIMIT,
+					PriceDenom:        keepertest.TestPriceDenom,
+					AssetDenom:        keepertest.TestAssetDenom,
+				},
+			},
+		}
+	}
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, keepertest.TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, keepertest.TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	params := types.DefaultParams()
+	params.PriceBand = sdk.MustNewDecFromStr("0.1")
+	keeper.SetParams(ctx, params)
+	keepertest.SeedPriceSnapshot(ctx, keeper, "100", 1)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+	_, err := server.PlaceOrders(wctx, newMsg("111"))
+	require.ErrorIs(t, err, types.ErrPriceOutsideBand)
+	_, err = server.PlaceOrders(wctx, newMsg("110"))
+	require.Nil(t, err)
+}
+
 func TestPlaceOrderWithDeposit(t *testing.T) {
 	msg := &types.MsgPlaceOrders{
 		Creator:      TestCreator,
@@ -0,0 +1,93 @@
+package msgserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/sei-protocol/sei-chain/x/dex/utils"
+)
+
+// ReplaceOrders adds both the cancellations and the new orders to the block's caches so that they
+// are applied together at EndBlock. A cancelled order is linked to the first new limit order of
+// the same pair, direction and price, which takes over its queue position if it only decreases the
+// quantity.
+func (k msgServer) ReplaceOrders(goCtx context.Context, msg *types.MsgReplaceOrders) (*types.MsgReplaceOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	for _, cancellation := range msg.GetCancellations() {
+		account, found := k.getOrderAccount(ctx, msg.ContractAddr, cancellation)
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "order %d to replace is not found", cancellation.Id)
+		}
+		if account != msg.Creator {
+			return nil, errors.New("cannot replace orders created by others")
+		}
+	}
+
+	if err := k.transferFunds(goCtx, msg.Creator, msg.ContractAddr, msg.Funds); err != nil {
+		return nil, err
+	}
+	idsInResp, err := k.placeOrders(ctx, msg.Creator, msg.ContractAddr, msg.GetOrders())
+	if err != nil {
+		return nil, err
+	}
+
+	events := []sdk.Event{}
+	replacements := map[uint64]struct{}{}
+	for _, cancellation := range msg.GetCancellations() {
+		pair := types.Pair{PriceDenom: cancellation.PriceDenom, AssetDenom: cancellation.AssetDenom}
+		pairBlockCancellations := utils.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(msg.GetContractAddr()), pair)
+		if pairBlockCancellations.Has(cancellation) {
+			continue
+		}
+		cancel := types.Cancellation{
+			Id:                cancellation.Id,
+			Initiator:         types.CancellationInitiator_USER,
+			Creator:           msg.Creator,
+			ContractAddr:      msg.ContractAddr,
+			Price:             cancellation.Price,
+			AssetDenom:        cancellation.AssetDenom,
+			PriceDenom:        cancellation.PriceDenom,
+			PositionDirection: cancellation.PositionDirection,
+		}
+		for _, order := range msg.GetOrders() {
+			if _, ok := replacements[order.Id]; ok || !canReplaceInPlace(&cancel, order) {
+				continue
+			}
+			replacements[order.Id] = struct{}{}
+			cancel.ReplacementOrderId = order.Id
+			break
+		}
+		pairBlockCancellations.Add(&cancel)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeCancelOrder,
+			sdk.NewAttribute(types.AttributeKeyCancellationID, fmt.Sprint(cancellation.Id)),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	utils.GetMemState(ctx.Context()).SetDownstreamsToProcess(ctx, msg.ContractAddr, k.GetContractWithoutGasCharge)
+	return &types.MsgReplaceOrdersResponse{
+		OrderIds: idsInResp,
+	}, nil
+}
+
+// only limit orders that would rest at the same level as the cancelled order can take over its position
+func canReplaceInPlace(cancellation *types.Cancellation, order *types.Order) bool {
+	return order.OrderType == types.OrderType_LIMIT &&
+		order.TimeInForce != types.TimeInForce_IOC &&
+		order.BracketRole == types.BracketRole_NO_BRACKET &&
+		order.PositionDirection == cancellation.PositionDirection &&
+		order.PriceDenom == cancellation.PriceDenom &&
+		order.AssetDenom == cancellation.AssetDenom &&
+		order.Price.Equal(cancellation.Price)
+}
@@ -0,0 +1,96 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetLongBook(ctx, TestContract, types.LongBook{
+		Price: sdk.OneDec(),
+		Entry: &types.OrderEntry{
+			Price:      sdk.OneDec(),
+			Quantity:   sdk.MustNewDecFromStr("2"),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{
+					Account:  keepertest.TestAccount,
+					OrderId:  1,
+					Quantity: sdk.MustNewDecFromStr("2"),
+				},
+			},
+		},
+	})
+	keeper.SetNextOrderID(ctx, TestContract, 2)
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	keeper.SetPriceTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.PriceTicksize)
+	keeper.SetQuantityTickSizeForPair(ctx, TestContract, keepertest.TestPair, *keepertest.TestPair.QuantityTicksize)
+	wctx := sdk.WrapSDKContext(ctx)
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	newOrder := func(price sdk.Dec) *types.Order {
+		return &types.Order{
+			Price:             price,
+			Quantity:          sdk.OneDec(),
+			Data:              "{}",
+			PositionDirection: types.PositionDirection_LONG,
+			OrderType:         types.OrderType_LIMIT,
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+		}
+	}
+	cancellation := &types.Cancellation{
+		Id:                1,
+		Price:             sdk.OneDec(),
+		PositionDirection: types.PositionDirection_LONG,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+	}
+	msg := types.NewMsgReplaceOrders(
+		keepertest.TestAccount,
+		TestContract,
+		[]*types.Cancellation{cancellation},
+		[]*types.Order{newOrder(sdk.NewDec(2)), newOrder(sdk.OneDec())},
+		nil,
+	)
+	res, err := server.ReplaceOrders(wctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{2, 3}, res.OrderIds)
+	require.Equal(t, 2, len(dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, TestContract, keepertest.TestPair).Get()))
+	cancels := dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, TestContract, keepertest.TestPair).Get()
+	require.Equal(t, 1, len(cancels))
+	require.Equal(t, uint64(1), cancels[0].Id)
+	// only the order at the cancelled order's price can take over its position
+	require.Equal(t, uint64(3), cancels[0].ReplacementOrderId)
+
+	// nothing is placed if any order to replace isn't found
+	cancellation.Id = 5
+	_, err = server.ReplaceOrders(wctx, types.NewMsgReplaceOrders(
+		keepertest.TestAccount,
+		TestContract,
+		[]*types.Cancellation{cancellation},
+		[]*types.Order{newOrder(sdk.OneDec())},
+		nil,
+	))
+	require.NotNil(t, err)
+	require.Equal(t, uint64(4), keeper.GetNextOrderID(ctx, TestContract))
+
+	// orders of others cannot be replaced
+	cancellation.Id = 1
+	_, err = server.ReplaceOrders(wctx, types.NewMsgReplaceOrders(
+		"sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc",
+		TestContract,
+		[]*types.Cancellation{cancellation},
+		[]*types.Order{newOrder(sdk.OneDec())},
+		nil,
+	))
+	require.NotNil(t, err)
+}
@@ -0,0 +1,46 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetPairHalt lets the contract's creator halt or resume trading of a pair. It doesn't lift halts
+// made through governance or by the circuit breaker.
+func (k msgServer) SetPairHalt(goCtx context.Context, msg *types.MsgSetPairHalt) (*types.MsgSetPairHaltResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractAddr)
+	if err != nil {
+		return nil, err
+	}
+	if contract.Creator != msg.Creator {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+	if _, found := k.GetRegisteredPair(ctx, msg.ContractAddr, msg.Pair.PriceDenom, msg.Pair.AssetDenom); !found {
+		return nil, types.ErrPairNotRegistered
+	}
+
+	halt, _ := k.GetPairHalt(ctx, msg.ContractAddr, *msg.Pair)
+	halt.HaltedByCreator = msg.Halted
+	k.Keeper.SetPairHalt(ctx, msg.ContractAddr, halt)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetPairHalt,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, msg.ContractAddr),
+		sdk.NewAttribute(types.AttributeKeyPriceDenom, msg.Pair.PriceDenom),
+		sdk.NewAttribute(types.AttributeKeyAssetDenom, msg.Pair.AssetDenom),
+		sdk.NewAttribute(types.AttributeKeyHalted, fmt.Sprint(msg.Halted)),
+		sdk.NewAttribute(types.AttributeKeyHaltedBy, types.AttributeValueCreator),
+	))
+	return &types.MsgSetPairHaltResponse{}, nil
+}
@@ -0,0 +1,50 @@
+package msgserver_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetPairHalt(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: TestContract, Creator: TestCreator})
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	_, err := server.SetPairHalt(wctx, types.NewMsgSetPairHalt(TestCreator, TestContract, &keepertest.TestPair, true))
+	require.Nil(t, err)
+	require.True(t, keeper.IsPairHalted(ctx, TestContract, keepertest.TestPair))
+
+	// a governance halt isn't lifted by the creator
+	halt, _ := keeper.GetPairHalt(ctx, TestContract, keepertest.TestPair)
+	halt.HaltedByGovernance = true
+	keeper.SetPairHalt(ctx, TestContract, halt)
+	_, err = server.SetPairHalt(wctx, types.NewMsgSetPairHalt(TestCreator, TestContract, &keepertest.TestPair, false))
+	require.Nil(t, err)
+	require.True(t, keeper.IsPairHalted(ctx, TestContract, keepertest.TestPair))
+	halt, _ = keeper.GetPairHalt(ctx, TestContract, keepertest.TestPair)
+	require.False(t, halt.HaltedByCreator)
+}
+
+func TestSetPairHaltInvalid(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: TestContract, Creator: TestCreator})
+	server := msgserver.NewMsgServerImpl(*keeper)
+
+	// pair not registered
+	_, err := server.SetPairHalt(wctx, types.NewMsgSetPairHalt(TestCreator, TestContract, &keepertest.TestPair, true))
+	require.ErrorIs(t, err, types.ErrPairNotRegistered)
+
+	// not the creator
+	keeper.AddRegisteredPair(ctx, TestContract, keepertest.TestPair)
+	_, err = server.SetPairHalt(wctx, types.NewMsgSetPairHalt(keepertest.TestAccount, TestContract, &keepertest.TestPair, true))
+	require.NotNil(t, err)
+	require.False(t, keeper.IsPairHalted(ctx, TestContract, keepertest.TestPair))
+}
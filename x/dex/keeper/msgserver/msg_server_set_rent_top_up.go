@@ -0,0 +1,49 @@
+package msgserver
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetRentTopUp lets the sender fund a contract's rent automatically. A contract has at most one
+// funding account, which can only be replaced or removed by itself or the contract's creator.
+func (k msgServer) SetRentTopUp(goCtx context.Context, msg *types.MsgSetRentTopUp) (*types.MsgSetRentTopUpResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("request invalid: %s", err))
+		return nil, err
+	}
+
+	contract, err := k.GetContract(ctx, msg.ContractAddr)
+	if err != nil {
+		return nil, err
+	}
+	if existing, found := k.GetRentTopUp(ctx, msg.ContractAddr); found && existing.Funder != msg.Sender && contract.Creator != msg.Sender {
+		return nil, sdkerrors.ErrUnauthorized
+	}
+
+	if msg.SpendLimit == 0 {
+		k.DeleteRentTopUp(ctx, msg.ContractAddr)
+	} else {
+		k.Keeper.SetRentTopUp(ctx, types.RentTopUp{
+			ContractAddr: msg.ContractAddr,
+			Funder:       msg.Sender,
+			Threshold:    msg.Threshold,
+			Amount:       msg.Amount,
+			SpendLimit:   msg.SpendLimit,
+		})
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetRentTopUp,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, msg.ContractAddr),
+		sdk.NewAttribute(types.AttributeKeyFunder, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeySpendLimit, fmt.Sprint(msg.SpendLimit)),
+	))
+	return &types.MsgSetRentTopUpResponse{}, nil
+}
@@ -0,0 +1,117 @@
+package msgserver_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestSetRentTopUp(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	wctx := sdk.WrapSDKContext(ctx)
+	dexkeeper := testApp.DexKeeper
+	server := msgserver.NewMsgServerImpl(dexkeeper)
+
+	creator := "sei1h9yjz89tl0dl6zu65dpxcqnxfhq60wxx8s5kag"
+	funder, _ := sdk.AccAddressFromBech32("sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx")
+	other := "sei1ewxvf5a9wq9zk5nurtl6m9yfxpnhyp7s7uk5sl"
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(25)))
+	testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, funder, amounts)
+	require.NoError(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr: TestContractA,
+		Creator:      creator,
+		RentBalance:  100,
+	}))
+
+	// unregistered contract
+	_, err := server.SetRentTopUp(wctx, types.NewMsgSetRentTopUp(funder.String(), TestContractB, 100, 10, 50))
+	require.Error(t, err)
+
+	_, err = server.SetRentTopUp(wctx, types.NewMsgSetRentTopUp(funder.String(), TestContractA, 100, 10, 30))
+	require.NoError(t, err)
+	topUp, found := dexkeeper.GetRentTopUp(ctx, TestContractA)
+	require.True(t, found)
+	require.Equal(t, funder.String(), topUp.Funder)
+	// only the funder or the creator can replace an existing top-up
+	_, err = server.SetRentTopUp(wctx, types.NewMsgSetRentTopUp(other, TestContractA, 100, 10, 50))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the balance isn't below the threshold yet
+	dexkeeperutils.TopUpRents(ctx, &dexkeeper)
+	contract, err := dexkeeper.GetContract(ctx, TestContractA)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), contract.RentBalance)
+
+	contract.RentBalance = 99
+	require.NoError(t, dexkeeper.SetContract(ctx, &contract))
+	dexkeeperutils.TopUpRents(ctx, &dexkeeper)
+	contract, err = dexkeeper.GetContract(ctx, TestContractA)
+	require.NoError(t, err)
+	require.Equal(t, uint64(109), contract.RentBalance)
+	require.Equal(t, int64(15), testApp.BankKeeper.GetBalance(ctx, funder, "usei").Amount.Int64())
+	topUp, _ = dexkeeper.GetRentTopUp(ctx, TestContractA)
+	require.Equal(t, uint64(20), topUp.SpendLimit)
+
+	// a top-up the funder can't afford is skipped
+	contract.RentBalance = 0
+	require.NoError(t, dexkeeper.SetContract(ctx, &contract))
+	testApp.BankKeeper.SendCoins(ctx, funder, sdk.MustAccAddressFromBech32(other), sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(10))))
+	dexkeeperutils.TopUpRents(ctx, &dexkeeper)
+	contract, _ = dexkeeper.GetContract(ctx, TestContractA)
+	require.Equal(t, uint64(0), contract.RentBalance)
+	topUp, _ = dexkeeper.GetRentTopUp(ctx, TestContractA)
+	require.Equal(t, uint64(20), topUp.SpendLimit)
+
+	// the creator can replace the top-up, whose last transfer is capped by the remaining spend limit
+	_, err = server.SetRentTopUp(wctx, types.NewMsgSetRentTopUp(creator, TestContractA, 100, 10, 15))
+	require.NoError(t, err)
+	topUp, _ = dexkeeper.GetRentTopUp(ctx, TestContractA)
+	require.Equal(t, creator, topUp.Funder)
+	testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sdk.MustAccAddressFromBech32(creator), amounts)
+	dexkeeperutils.TopUpRents(ctx, &dexkeeper)
+	contract, _ = dexkeeper.GetContract(ctx, TestContractA)
+	require.Equal(t, uint64(10), contract.RentBalance)
+	contract.RentBalance = 0
+	require.NoError(t, dexkeeper.SetContract(ctx, &contract))
+	dexkeeperutils.TopUpRents(ctx, &dexkeeper)
+	contract, _ = dexkeeper.GetContract(ctx, TestContractA)
+	require.Equal(t, uint64(5), contract.RentBalance)
+	_, found = dexkeeper.GetRentTopUp(ctx, TestContractA)
+	require.False(t, found)
+
+	// a top-up larger than the max rent balance is skipped instead of wrapping around
+	contract.RentBalance = 0
+	require.NoError(t, dexkeeper.SetContract(ctx, &contract))
+	dexkeeper.SetRentTopUp(ctx, types.RentTopUp{
+		ContractAddr: TestContractA,
+		Funder:       funder.String(),
+		Threshold:    10,
+		Amount:       types.MaxAllowedRentBalance + 1,
+		SpendLimit:   types.MaxAllowedRentBalance + 1,
+	})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	dexkeeperutils.TopUpRents(ctx, &dexkeeper)
+	contract, _ = dexkeeper.GetContract(ctx, TestContractA)
+	require.Equal(t, uint64(0), contract.RentBalance)
+	require.Equal(t, types.EventTypeRentTopUpFailed, ctx.EventManager().Events()[0].Type)
+
+	// removal
+	_, err = server.SetRentTopUp(wctx, types.NewMsgSetRentTopUp(funder.String(), TestContractA, 100, 10, 50))
+	require.NoError(t, err)
+	_, err = server.SetRentTopUp(wctx, types.NewMsgSetRentTopUp(funder.String(), TestContractA, 0, 0, 0))
+	require.NoError(t, err)
+	_, found = dexkeeper.GetRentTopUp(ctx, TestContractA)
+	require.False(t, found)
+}
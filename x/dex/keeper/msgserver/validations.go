@@ -2,9 +2,7 @@ package msgserver
 
 import (
 	"fmt"
-	"math"
 
-	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
@@ -30,8 +28,7 @@ func (k msgServer) ValidateSuspension(ctx sdk.Context, contractAddress string) e
 }
 
 func (k msgServer) maxAllowedRentBalance() uint64 {
-	// TODO: replace with a wasm keeper query once its gas registry is made public
-	return uint64(math.MaxUint64) / wasmkeeper.DefaultGasMultiplier
+	return k.GetMaxAllowedRentBalance()
 }
 
 func (k msgServer) minAllowedRentBalance(ctx sdk.Context) uint64 {
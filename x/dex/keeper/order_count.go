@@ -54,3 +54,30 @@ func (k Keeper) IncreaseOrderCount(ctx sdk.Context, contractAddr string, priceDe
 	oldCount := k.GetOrderCountState(ctx, contractAddr, priceDenom, assetDenom, direction, price)
 	return k.SetOrderCount(ctx, contractAddr, priceDenom, assetDenom, direction, price, oldCount+count)
 }
+
+// GetAllOrderCountsForPair returns the order counts of all price levels of a pair on both sides
+func (k Keeper) GetAllOrderCountsForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) []types.OrderCount {
+	list := []types.OrderCount{}
+	for _, direction := range []types.PositionDirection{types.PositionDirection_LONG, types.PositionDirection_SHORT} {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.OrderCountPrefix(contractAddr, priceDenom, assetDenom, direction == types.PositionDirection_LONG),
+		)
+		iterator := sdk.KVStorePrefixIterator(store, []byte{})
+		for ; iterator.Valid(); iterator.Next() {
+			price := sdk.Dec{}
+			if err := price.Unmarshal(iterator.Key()); err != nil {
+				panic(err)
+			}
+			list = append(list, types.OrderCount{
+				PriceDenom:        priceDenom,
+				AssetDenom:        assetDenom,
+				PositionDirection: direction,
+				Price:             price,
+				Count:             binary.BigEndian.Uint64(iterator.Value()),
+			})
+		}
+		iterator.Close()
+	}
+	return list
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// SetPairHalt stores the halt of a pair, or removes it if none of its reasons applies anymore
+func (k Keeper) SetPairHalt(ctx sdk.Context, contractAddr string, halt types.PairHalt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PairHaltContractPrefix(contractAddr))
+	key := types.PairPrefix(halt.Pair.PriceDenom, halt.Pair.AssetDenom)
+	if !halt.HaltedByGovernance && !halt.HaltedByCreator && halt.CircuitBreakerUntilHeight <= uint64(ctx.BlockHeight()) {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.Cdc.MustMarshal(&halt))
+}
+
+func (k Keeper) GetPairHalt(ctx sdk.Context, contractAddr string, pair types.Pair) (types.PairHalt, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PairHaltContractPrefix(contractAddr))
+	res := types.PairHalt{Pair: &types.Pair{PriceDenom: pair.PriceDenom, AssetDenom: pair.AssetDenom}}
+	b := store.Get(types.PairPrefix(pair.PriceDenom, pair.AssetDenom))
+	if b == nil {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+func (k Keeper) GetAllPairHalts(ctx sdk.Context, contractAddr string) []types.PairHalt {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PairHaltContractPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	list := []types.PairHalt{}
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PairHalt
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// IsPairHalted returns whether new orders for the pair are rejected and its matching is paused
+func (k Keeper) IsPairHalted(ctx sdk.Context, contractAddr string, pair types.Pair) bool {
+	halt, found := k.GetPairHalt(ctx, contractAddr, pair)
+	if !found {
+		return false
+	}
+	return halt.HaltedByGovernance || halt.HaltedByCreator || halt.CircuitBreakerUntilHeight > uint64(ctx.BlockHeight())
+}
+
+func (k Keeper) RemoveAllPairHaltsForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.PairHaltContractPrefix(contractAddr))
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairHalt(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(5)
+	require.False(t, keeper.IsPairHalted(ctx, keepertest.TestContract, keepertest.TestPair))
+	halt, found := keeper.GetPairHalt(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.False(t, found)
+	require.Equal(t, keepertest.TestPriceDenom, halt.Pair.PriceDenom)
+
+	halt.HaltedByCreator = true
+	keeper.SetPairHalt(ctx, keepertest.TestContract, halt)
+	require.True(t, keeper.IsPairHalted(ctx, keepertest.TestContract, keepertest.TestPair))
+	require.Equal(t, 1, len(keeper.GetAllPairHalts(ctx, keepertest.TestContract)))
+
+	// lifting the creator halt while the circuit breaker is still active keeps the pair halted
+	halt.HaltedByCreator = false
+	halt.CircuitBreakerUntilHeight = 7
+	keeper.SetPairHalt(ctx, keepertest.TestContract, halt)
+	require.True(t, keeper.IsPairHalted(ctx, keepertest.TestContract, keepertest.TestPair))
+	require.False(t, keeper.IsPairHalted(ctx.WithBlockHeight(7), keepertest.TestContract, keepertest.TestPair))
+
+	// a halt without any reason left is removed
+	keeper.SetPairHalt(ctx.WithBlockHeight(7), keepertest.TestContract, halt)
+	_, found = keeper.GetPairHalt(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.False(t, found)
+}
+
+func TestRemoveAllPairHaltsForContract(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	keeper.SetPairHalt(ctx, keepertest.TestContract, types.PairHalt{Pair: &keepertest.TestPair, HaltedByGovernance: true})
+	keeper.SetPairHalt(ctx, "other", types.PairHalt{Pair: &keepertest.TestPair, HaltedByGovernance: true})
+	keeper.RemoveAllPairHaltsForContract(ctx, keepertest.TestContract)
+	require.Empty(t, keeper.GetAllPairHalts(ctx, keepertest.TestContract))
+	require.Equal(t, 1, len(keeper.GetAllPairHalts(ctx, "other")))
+}
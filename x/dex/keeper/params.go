@@ -36,6 +36,38 @@ func (k Keeper) GetMaxPairsPerContract(ctx sdk.Context) uint64 {
 	return k.GetParams(ctx).MaxPairsPerContract
 }
 
+func (k Keeper) GetCandleRetention(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).CandleRetention
+}
+
+func (k Keeper) GetAccountFillRetention(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).AccountFillRetention
+}
+
+func (k Keeper) GetRentLowWaterMark(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).RentLowWaterMark
+}
+
+func (k Keeper) GetRentHistoryRetention(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).RentHistoryRetention
+}
+
+func (k Keeper) GetCircuitBreakerThreshold(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).CircuitBreakerThreshold
+}
+
+func (k Keeper) GetCircuitBreakerPauseBlocks(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).CircuitBreakerPauseBlocks
+}
+
+func (k Keeper) GetPriceBand(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).PriceBand
+}
+
+func (k Keeper) GetExecutionStatsRetention(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).ExecutionStatsRetention
+}
+
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.Paramstore.SetParamSet(ctx, &params)
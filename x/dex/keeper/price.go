@@ -5,9 +5,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
 
+// lookback of the oracle TWAPs that serve as reference price for pairs without a price snapshot
+const ReferenceOracleTwapLookbackSeconds = 600
+
 func (k Keeper) SetPriceState(ctx sdk.Context, price types.Price, contractAddr string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PricePrefix(contractAddr, price.Pair.PriceDenom, price.Pair.AssetDenom))
 	b := k.Cdc.MustMarshal(&price)
@@ -87,6 +91,85 @@ func (k Keeper) GetPricesForTwap(ctx sdk.Context, contractAddr string, pair type
 	return
 }
 
+func (k Keeper) GetLatestPrice(ctx sdk.Context, contractAddr string, pair types.Pair) (types.Price, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PricePrefix(contractAddr, pair.PriceDenom, pair.AssetDenom))
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	res := types.Price{}
+	if !iterator.Valid() {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(iterator.Value(), &res)
+	return res, true
+}
+
+// GetReferencePrice returns the price that the circuit breaker and the price band of a pair are
+// relative to, which is the pair's latest price snapshot, or the ratio of the oracle TWAPs of its
+// asset and price denoms if the pair has no snapshot.
+func (k Keeper) GetReferencePrice(ctx sdk.Context, contractAddr string, pair types.Pair) (sdk.Dec, bool) {
+	if price, found := k.GetLatestPrice(ctx, contractAddr, pair); found {
+		return price.Price, true
+	}
+	if k.OracleKeeper == nil {
+		return sdk.ZeroDec(), false
+	}
+	twaps, err := k.OracleKeeper.CalculateTwaps(ctx, ReferenceOracleTwapLookbackSeconds)
+	if err != nil {
+		return sdk.ZeroDec(), false
+	}
+	var assetTwap, priceTwap *sdk.Dec
+	for _, twap := range twaps {
+		twap := twap
+		switch twap.Denom {
+		case pair.AssetDenom:
+			assetTwap = &twap.Twap
+		case pair.PriceDenom:
+			priceTwap = &twap.Twap
+		}
+	}
+	if assetTwap == nil || priceTwap == nil || !priceTwap.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+	return assetTwap.Quo(*priceTwap), true
+}
+
+// CheckOrdersPriceBand returns an error if any of the limit orders is priced further away from its
+// pair's reference price than the price band allows. The reference price of each pair is only
+// looked up once.
+func (k Keeper) CheckOrdersPriceBand(ctx sdk.Context, contractAddr string, orders []*types.Order) error {
+	band := k.GetPriceBand(ctx)
+	if band.IsNil() || !band.IsPositive() {
+		return nil
+	}
+	type referencePrice struct {
+		price sdk.Dec
+		found bool
+	}
+	referencePrices := map[types.PairString]referencePrice{}
+	for _, order := range orders {
+		// the price of market orders is only the worst price they're willing to be filled at
+		if order.OrderType != types.OrderType_LIMIT && order.OrderType != types.OrderType_STOPLIMIT {
+			continue
+		}
+		pair := types.Pair{PriceDenom: order.PriceDenom, AssetDenom: order.AssetDenom}
+		reference, ok := referencePrices[types.GetPairString(&pair)]
+		if !ok {
+			reference.price, reference.found = k.GetReferencePrice(ctx, contractAddr, pair)
+			referencePrices[types.GetPairString(&pair)] = reference
+		}
+		if !reference.found {
+			continue
+		}
+		lower, upper := reference.price.Mul(sdk.OneDec().Sub(band)), reference.price.Mul(sdk.OneDec().Add(band))
+		if order.Price.LT(lower) || order.Price.GT(upper) {
+			return sdkerrors.Wrapf(types.ErrPriceOutsideBand, "price %s of {price:%s,asset:%s} is outside of [%s, %s]", order.Price, order.PriceDenom, order.AssetDenom, lower, upper)
+		}
+	}
+	return nil
+}
+
 func (k Keeper) RemoveAllPricesForContract(ctx sdk.Context, contractAddr string) {
 	k.removeAllForPrefix(ctx, types.PriceContractPrefix(contractAddr))
 }
@@ -3,7 +3,9 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	oracletypes "github.com/sei-protocol/sei-chain/x/oracle/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,3 +20,42 @@ func TestDeletePriceStateBefore(t *testing.T) {
 	require.Equal(t, uint64(2), prices[0].SnapshotTimestampInSeconds)
 	require.Equal(t, uint64(3), prices[1].SnapshotTimestampInSeconds)
 }
+
+type mockOracleKeeper struct {
+	twaps oracletypes.OracleTwaps
+}
+
+func (m mockOracleKeeper) CalculateTwaps(sdk.Context, uint64) (oracletypes.OracleTwaps, error) {
+	return m.twaps, nil
+}
+
+func TestGetLatestPrice(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	_, found := keeper.GetLatestPrice(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.False(t, found)
+	keepertest.SeedPriceSnapshot(ctx, keeper, "100", 1)
+	keepertest.SeedPriceSnapshot(ctx, keeper, "101", 2)
+	price, found := keeper.GetLatestPrice(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("101"), price.Price)
+}
+
+func TestGetReferencePrice(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	_, found := keeper.GetReferencePrice(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.False(t, found)
+
+	keeper.SetOracleKeeper(mockOracleKeeper{twaps: oracletypes.OracleTwaps{
+		{Denom: keepertest.TestAssetDenom, Twap: sdk.MustNewDecFromStr("50")},
+		{Denom: keepertest.TestPriceDenom, Twap: sdk.MustNewDecFromStr("2")},
+	}})
+	price, found := keeper.GetReferencePrice(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("25"), price)
+
+	// the latest snapshot takes precedence over oracle prices
+	keepertest.SeedPriceSnapshot(ctx, keeper, "30", 1)
+	price, found = keeper.GetReferencePrice(ctx, keepertest.TestContract, keepertest.TestPair)
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("30"), price)
+}
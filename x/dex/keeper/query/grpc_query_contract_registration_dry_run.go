@@ -0,0 +1,65 @@
+package query
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetContractRegistrationDryRun runs the given contract registration on a cache-wrapped copy of
+// the state and shows how it would change the dependency graph, without registering it.
+func (k KeeperWrapper) GetContractRegistrationDryRun(c context.Context, req *types.QueryContractRegistrationDryRunRequest) (*types.QueryContractRegistrationDryRunResponse, error) {
+	if req == nil || req.Msg == nil || req.Msg.Contract == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractAddr := req.Msg.Contract.ContractAddr
+	oldDependencies := map[string]struct{}{}
+	if existing, err := k.GetContract(ctx, contractAddr); err == nil {
+		for _, dependency := range existing.Dependencies {
+			oldDependencies[dependency.Dependency] = struct{}{}
+		}
+	}
+	newDependencies := map[string]struct{}{}
+	for _, dependency := range req.Msg.Contract.Dependencies {
+		newDependencies[dependency.Dependency] = struct{}{}
+	}
+
+	// nothing done by the registration is written back
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := msgserver.NewMsgServerImpl(*k.Keeper).RegisterContract(sdk.WrapSDKContext(cacheCtx), req.Msg)
+	circularDependency := err == types.ErrCircularContractDependency
+	if err != nil && !circularDependency {
+		return nil, err
+	}
+
+	graph, _ := buildContractDependencyGraph(k.GetAllContractInfo(cacheCtx))
+	return &types.QueryContractRegistrationDryRunResponse{
+		Graph:               graph,
+		AddedDependencies:   dependencyEdgesDiff(contractAddr, newDependencies, oldDependencies),
+		RemovedDependencies: dependencyEdgesDiff(contractAddr, oldDependencies, newDependencies),
+		CircularDependency:  circularDependency,
+	}, nil
+}
+
+// dependencyEdgesDiff returns the edges from contractAddr to dependencies in `from` but not in `to`.
+func dependencyEdgesDiff(contractAddr string, from map[string]struct{}, to map[string]struct{}) []*types.ContractDependencyEdge {
+	dependencies := []string{}
+	for dependency := range from {
+		if _, ok := to[dependency]; !ok {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+	sort.Strings(dependencies)
+	edges := []*types.ContractDependencyEdge{}
+	for _, dependency := range dependencies {
+		edges = append(edges, &types.ContractDependencyEdge{ContractAddr: contractAddr, Dependency: dependency})
+	}
+	return edges
+}
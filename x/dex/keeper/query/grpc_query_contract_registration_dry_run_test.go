@@ -0,0 +1,153 @@
+package query_test
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
+	minttypes "github.com/sei-protocol/sei-chain/x/mint/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	GOOD_CONTRACT_INSTANTIATE = `{"whitelist": ["sei1h9yjz89tl0dl6zu65dpxcqnxfhq60wxx8s5kag"],
+    "use_whitelist":false,"admin":"sei1h9yjz89tl0dl6zu65dpxcqnxfhq60wxx8s5kag",
+	"limit_order_fee":{"decimal":"0.0001","negative":false},
+	"market_order_fee":{"decimal":"0.0001","negative":false},
+	"liquidation_order_fee":{"decimal":"0.0001","negative":false},
+	"margin_ratio":{"decimal":"0.0625","negative":false},
+	"max_leverage":{"decimal":"4","negative":false},
+	"default_base":"USDC",
+	"native_token":"USDC","denoms": ["SEI","ATOM","USDC","SOL","ETH","OSMO","AVAX","BTC"],
+	"full_denom_mapping": [["usei","SEI","0.000001"],["uatom","ATOM","0.000001"],["uusdc","USDC","0.000001"]],
+	"funding_payment_lookback":3600,"spot_market_contract":"sei1h9yjz89tl0dl6zu65dpxcqnxfhq60wxx8s5kag",
+	"supported_collateral_denoms": ["USDC"],
+	"supported_multicollateral_denoms": ["ATOM"],
+	"oracle_denom_mapping": [["usei","SEI","1"],["uatom","ATOM","1"],["uusdc","USDC","1"],["ueth","ETH","1"]],
+	"multicollateral_whitelist": ["sei1h9yjz89tl0dl6zu65dpxcqnxfhq60wxx8s5kag"],
+	"multicollateral_whitelist_enable": true,
+	"funding_payment_pairs": [["USDC","ETH"]],
+	"default_margin_ratios":{
+		"initial":"0.3",
+		"partial":"0.25",
+		"maintenance":"0.06"
+	}}`
+)
+
+func TestContractRegistrationDryRun(t *testing.T) {
+	testApp := keepertest.TestApp()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	ctx = ctx.WithContext(context.WithValue(ctx.Context(), dexutils.DexMemStateContextKey, dexcache.NewMemState(testApp.GetMemKey(types.MemStoreKey))))
+	wctx := sdk.WrapSDKContext(ctx)
+	dexkeeper := testApp.DexKeeper
+	wrapper := query.KeeperWrapper{Keeper: &dexkeeper}
+
+	testAccount, _ := sdk.AccAddressFromBech32(keepertest.TestAccount)
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100000000)), sdk.NewCoin("uusdc", sdk.NewInt(100000000)))
+	testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts)
+	testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, testAccount, amounts)
+	wasm, err := ioutil.ReadFile("../../testdata/mars.wasm")
+	require.NoError(t, err)
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&testApp.WasmKeeper)
+	var perm *wasmtypes.AccessConfig
+	codeID, err := contractKeeper.Create(ctx, testAccount, wasm, perm)
+	require.NoError(t, err)
+	contractAddrs := []string{}
+	for i := 0; i < 4; i++ {
+		contractAddr, _, err := contractKeeper.Instantiate(ctx, codeID, testAccount, testAccount, []byte(GOOD_CONTRACT_INSTANTIATE), "test",
+			sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(100000))))
+		require.NoError(t, err)
+		contractAddrs = append(contractAddrs, contractAddr.String())
+	}
+	contractA, contractB, contractC, unregistered := contractAddrs[0], contractAddrs[1], contractAddrs[2], contractAddrs[3]
+	newMsg := func(creator string, contractAddr string, dependencies ...string) *types.MsgRegisterContract {
+		contract := &types.ContractInfoV2{CodeId: codeID, ContractAddr: contractAddr, RentBalance: types.DefaultMinRentDeposit}
+		for _, dependency := range dependencies {
+			contract.Dependencies = append(contract.Dependencies, &types.ContractDependencyInfo{Dependency: dependency})
+		}
+		return &types.MsgRegisterContract{Creator: creator, Contract: contract}
+	}
+
+	// A -> B, C
+	server := msgserver.NewMsgServerImpl(dexkeeper)
+	_, err = server.RegisterContract(wctx, newMsg(keepertest.TestAccount, contractB))
+	require.NoError(t, err)
+	_, err = server.RegisterContract(wctx, newMsg(keepertest.TestAccount, contractA, contractB))
+	require.NoError(t, err)
+	_, err = server.RegisterContract(wctx, newMsg(keepertest.TestAccount, contractC))
+	require.NoError(t, err)
+	balance := testApp.BankKeeper.GetBalance(ctx, testAccount, "usei")
+
+	// C -> B adds an edge and moves C ahead of B
+	res, err := wrapper.GetContractRegistrationDryRun(wctx, &types.QueryContractRegistrationDryRunRequest{
+		Msg: newMsg(keepertest.TestAccount, contractC, contractB),
+	})
+	require.NoError(t, err)
+	require.False(t, res.CircularDependency)
+	require.Equal(t, []*types.ContractDependencyEdge{
+		{ContractAddr: contractC, Dependency: contractB},
+	}, res.AddedDependencies)
+	require.Empty(t, res.RemovedDependencies)
+	for _, node := range res.Graph.Nodes {
+		if node.ContractAddr == contractB {
+			require.Equal(t, int64(2), node.NumIncomingDependencies)
+			require.Equal(t, sortedAddrs(contractA, contractC), node.Dependents)
+		}
+	}
+	require.Equal(t, 2, len(res.Graph.FrontierLevels))
+
+	// A without dependencies removes its edge and leaves a single level
+	res, err = wrapper.GetContractRegistrationDryRun(wctx, &types.QueryContractRegistrationDryRunRequest{
+		Msg: newMsg(keepertest.TestAccount, contractA),
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.AddedDependencies)
+	require.Equal(t, []*types.ContractDependencyEdge{
+		{ContractAddr: contractA, Dependency: contractB},
+	}, res.RemovedDependencies)
+	require.Equal(t, 1, len(res.Graph.FrontierLevels))
+
+	// B -> A would close a cycle
+	res, err = wrapper.GetContractRegistrationDryRun(wctx, &types.QueryContractRegistrationDryRunRequest{
+		Msg: newMsg(keepertest.TestAccount, contractB, contractA),
+	})
+	require.NoError(t, err)
+	require.True(t, res.CircularDependency)
+	require.Empty(t, res.Graph.ExecutionOrder)
+	require.Equal(t, 3, len(res.Graph.Nodes))
+
+	// neither the graph nor the rent deposits are changed
+	graph, err := wrapper.GetContractDependencyGraph(wctx, &types.QueryGetContractDependencyGraphRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(graph.Graph.FrontierLevels))
+	contractInfo, err := dexkeeper.GetContract(ctx, contractC)
+	require.NoError(t, err)
+	require.Empty(t, contractInfo.Dependencies)
+	require.Equal(t, balance, testApp.BankKeeper.GetBalance(ctx, testAccount, "usei"))
+
+	// registration errors are returned as is
+	_, err = wrapper.GetContractRegistrationDryRun(wctx, &types.QueryContractRegistrationDryRunRequest{
+		Msg: newMsg(keepertest.TestAccount, contractC, unregistered),
+	})
+	require.ErrorIs(t, err, types.ErrContractNotExists)
+	_, err = wrapper.GetContractRegistrationDryRun(wctx, &types.QueryContractRegistrationDryRunRequest{
+		Msg: newMsg(keepertest.TestAccount2, contractC),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = wrapper.GetContractRegistrationDryRun(wctx, &types.QueryContractRegistrationDryRunRequest{
+		Msg: newMsg(keepertest.TestAccount, contractC, contractB, contractB),
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetAccountFills(c context.Context, req *types.QueryGetAccountFillsRequest) (*types.QueryGetAccountFillsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Account); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account %s: %s", req.Account, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fills, pageRes, err := k.GetAccountFillsPaginated(
+		ctx,
+		req.ContractAddr,
+		req.Account,
+		types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom},
+		req.FromHeight,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*types.SettlementEntry, len(fills))
+	for i := range fills {
+		res[i] = &fills[i]
+	}
+	return &types.QueryGetAccountFillsResponse{Fills: res, Pagination: pageRes}, nil
+}
@@ -0,0 +1,71 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountFills(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.AccountFillRetention = 2
+	keeper.SetParams(ctx, params)
+
+	for height := int64(1); height <= 3; height++ {
+		blockCtx := ctx.WithBlockHeight(height)
+		settlement := types.NewSettlementEntry(blockCtx, uint64(height), keepertest.TestAccount, types.PositionDirection_LONG,
+			keepertest.TestPriceDenom, keepertest.TestAssetDenom, sdk.OneDec(), sdk.NewDec(100), sdk.NewDec(100), types.OrderType_LIMIT)
+		dexkeeperutils.UpdateAccountFillsFromSettlements(blockCtx, keeper, keepertest.TestContract, []*types.SettlementEntry{settlement})
+	}
+
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	resp, err := wrapper.GetAccountFills(sdk.WrapSDKContext(ctx), &types.QueryGetAccountFillsRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	})
+	require.NoError(t, err)
+	// only the fills of the latest two blocks are retained
+	require.Equal(t, 2, len(resp.Fills))
+	require.Equal(t, uint64(2), resp.Fills[0].Height)
+	require.Equal(t, uint64(3), resp.Fills[1].Height)
+
+	resp, err = wrapper.GetAccountFills(sdk.WrapSDKContext(ctx), &types.QueryGetAccountFillsRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+		FromHeight:   3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.Fills))
+	require.Equal(t, uint64(3), resp.Fills[0].OrderId)
+
+	// disabling the retention prunes all fills
+	params.AccountFillRetention = 0
+	keeper.SetParams(ctx, params)
+	dexkeeperutils.UpdateAccountFillsFromSettlements(ctx.WithBlockHeight(4), keeper, keepertest.TestContract, []*types.SettlementEntry{})
+	resp, err = wrapper.GetAccountFills(sdk.WrapSDKContext(ctx), &types.QueryGetAccountFillsRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Fills)
+
+	_, err = wrapper.GetAccountFills(sdk.WrapSDKContext(ctx), &types.QueryGetAccountFillsRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      "invalid",
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	})
+	require.Error(t, err)
+}
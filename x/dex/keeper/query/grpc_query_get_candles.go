@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetCandles(c context.Context, req *types.QueryGetCandlesRequest) (*types.QueryGetCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if !types.IsSupportedCandleInterval(req.IntervalInSeconds) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported candle interval %d, must be one of %v", req.IntervalInSeconds, types.SupportedCandleIntervals)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	candles, pageRes, err := k.GetCandlesPaginated(
+		ctx,
+		req.ContractAddr,
+		types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom},
+		req.IntervalInSeconds,
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*types.Candle, len(candles))
+	for i := range candles {
+		res[i] = &candles[i]
+	}
+	return &types.QueryGetCandlesResponse{Candles: res, Pagination: pageRes}, nil
+}
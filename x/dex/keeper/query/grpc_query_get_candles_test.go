@@ -0,0 +1,126 @@
+package query_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+// an outcome of a single fill, which is settled for both sides
+func fillOutcome(price int64, quantity int64) exchange.ExecutionOutcome {
+	settlement := func() *types.SettlementEntry {
+		return &types.SettlementEntry{
+			PriceDenom:             keepertest.TestPriceDenom,
+			AssetDenom:             keepertest.TestAssetDenom,
+			Quantity:               sdk.NewDec(quantity),
+			ExecutionCostOrProceed: sdk.NewDec(price),
+		}
+	}
+	return exchange.ExecutionOutcome{
+		TotalNotional: sdk.NewDec(2 * price * quantity),
+		TotalQuantity: sdk.NewDec(2 * quantity),
+		Settlements:   []*types.SettlementEntry{settlement(), settlement()},
+		MinPrice:      sdk.NewDec(price),
+		MaxPrice:      sdk.NewDec(price),
+	}
+}
+
+func TestGetCandles(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	params := keeper.GetParams(ctx)
+	params.CandleRetention = 2
+	keeper.SetParams(ctx, params)
+
+	for _, fill := range []struct {
+		timestamp int64
+		price     int64
+		quantity  int64
+	}{
+		{0, 100, 1},
+		{10, 110, 2},
+		{59, 90, 1},
+		{60, 95, 3},
+		{120, 105, 1},
+	} {
+		blockCtx := ctx.WithBlockTime(time.Unix(fill.timestamp, 0))
+		dexkeeperutils.UpdateCandlesFromExecutionOutcome(blockCtx, keeper, keepertest.TestContract, keepertest.TestPair, fillOutcome(fill.price, fill.quantity))
+	}
+	// the outcome of a block without any execution doesn't open a candle
+	blockCtx := ctx.WithBlockTime(time.Unix(180, 0))
+	dexkeeperutils.UpdateCandlesFromExecutionOutcome(blockCtx, keeper, keepertest.TestContract, keepertest.TestPair, exchange.ExecutionOutcome{
+		TotalNotional: sdk.ZeroDec(),
+		TotalQuantity: sdk.ZeroDec(),
+		MinPrice:      sdk.NewDec(math.MaxInt64),
+		MaxPrice:      sdk.OneDec().Neg(),
+	})
+
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	resp, err := wrapper.GetCandles(sdk.WrapSDKContext(ctx), &types.QueryGetCandlesRequest{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		IntervalInSeconds: types.CandleIntervalMinute,
+	})
+	require.NoError(t, err)
+	// only the latest two 1m candles are retained
+	require.Equal(t, []*types.Candle{
+		{
+			BeginTimestamp: 60,
+			EndTimestamp:   120,
+			Open:           sdk.NewDec(95),
+			High:           sdk.NewDec(95),
+			Low:            sdk.NewDec(95),
+			Close:          sdk.NewDec(95),
+			Volume:         sdk.NewDec(3),
+			Notional:       sdk.NewDec(285),
+		},
+		{
+			BeginTimestamp: 120,
+			EndTimestamp:   180,
+			Open:           sdk.NewDec(105),
+			High:           sdk.NewDec(105),
+			Low:            sdk.NewDec(105),
+			Close:          sdk.NewDec(105),
+			Volume:         sdk.NewDec(1),
+			Notional:       sdk.NewDec(105),
+		},
+	}, resp.Candles)
+
+	resp, err = wrapper.GetCandles(sdk.WrapSDKContext(ctx), &types.QueryGetCandlesRequest{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		IntervalInSeconds: types.CandleIntervalHour,
+		Pagination:        &sdkquery.PageRequest{Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.Candle{
+		{
+			BeginTimestamp: 0,
+			EndTimestamp:   3600,
+			Open:           sdk.NewDec(100),
+			High:           sdk.NewDec(110),
+			Low:            sdk.NewDec(90),
+			Close:          sdk.NewDec(105),
+			Volume:         sdk.NewDec(8),
+			Notional:       sdk.NewDec(100 + 220 + 90 + 285 + 105),
+		},
+	}, resp.Candles)
+
+	_, err = wrapper.GetCandles(sdk.WrapSDKContext(ctx), &types.QueryGetCandlesRequest{
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		IntervalInSeconds: 30,
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/contract"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetContractDependencyGraph(c context.Context, req *types.QueryGetContractDependencyGraphRequest) (*types.QueryGetContractDependencyGraphResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	graph, _ := buildContractDependencyGraph(k.GetAllContractInfo(ctx))
+	return &types.QueryGetContractDependencyGraphResponse{Graph: graph}, nil
+}
+
+// buildContractDependencyGraph returns the graph of the given contracts along with the order
+// and frontier levels they would be executed in. If the contracts have a circular dependency,
+// only the nodes are filled in and ErrCircularContractDependency is returned.
+func buildContractDependencyGraph(contracts []types.ContractInfoV2) (*types.ContractDependencyGraph, error) {
+	sorted := make([]types.ContractInfoV2, len(contracts))
+	copy(sorted, contracts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ContractAddr < sorted[j].ContractAddr })
+
+	dependents := map[string][]string{}
+	for _, contractInfo := range sorted {
+		for _, dependency := range contractInfo.Dependencies {
+			dependents[dependency.Dependency] = append(dependents[dependency.Dependency], contractInfo.ContractAddr)
+		}
+	}
+	graph := &types.ContractDependencyGraph{
+		Nodes:          []*types.ContractDependencyNode{},
+		ExecutionOrder: []string{},
+		FrontierLevels: []*types.ContractFrontierLevel{},
+	}
+	for _, contractInfo := range sorted {
+		dependencies := []string{}
+		for _, dependency := range contractInfo.Dependencies {
+			dependencies = append(dependencies, dependency.Dependency)
+		}
+		contractDependents := dependents[contractInfo.ContractAddr]
+		if contractDependents == nil {
+			contractDependents = []string{}
+		}
+		graph.Nodes = append(graph.Nodes, &types.ContractDependencyNode{
+			ContractAddr:            contractInfo.ContractAddr,
+			Dependencies:            dependencies,
+			Dependents:              contractDependents,
+			NumIncomingDependencies: contractInfo.NumIncomingDependencies,
+		})
+	}
+
+	levels, err := contract.FrontierLevelsContractInfo(sorted)
+	if err != nil {
+		return graph, err
+	}
+	for _, level := range levels {
+		contractAddrs := []string{}
+		for _, contractInfo := range level {
+			contractAddrs = append(contractAddrs, contractInfo.ContractAddr)
+		}
+		graph.ExecutionOrder = append(graph.ExecutionOrder, contractAddrs...)
+		graph.FrontierLevels = append(graph.FrontierLevels, &types.ContractFrontierLevel{ContractAddrs: contractAddrs})
+	}
+	return graph, nil
+}
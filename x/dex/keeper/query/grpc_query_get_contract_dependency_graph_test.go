@@ -0,0 +1,70 @@
+package query_test
+
+import (
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestContract -> TestContract2, with TestAccount as a third contract that has no dependency
+func setDependencyGraphContracts(t *testing.T, dexkeeper *keeper.Keeper, ctx sdk.Context) {
+	require.NoError(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr: keepertest.TestContract,
+		Dependencies: []*types.ContractDependencyInfo{{Dependency: keepertest.TestContract2}},
+	}))
+	require.NoError(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr:            keepertest.TestContract2,
+		NumIncomingDependencies: 1,
+	}))
+	require.NoError(t, dexkeeper.SetContract(ctx, &types.ContractInfoV2{
+		ContractAddr: keepertest.TestAccount,
+	}))
+}
+
+func sortedAddrs(addrs ...string) []string {
+	sort.Strings(addrs)
+	return addrs
+}
+
+func TestGetContractDependencyGraph(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: dexkeeper}
+	wctx := sdk.WrapSDKContext(ctx)
+
+	res, err := wrapper.GetContractDependencyGraph(wctx, &types.QueryGetContractDependencyGraphRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Graph.Nodes)
+	require.Empty(t, res.Graph.ExecutionOrder)
+
+	setDependencyGraphContracts(t, dexkeeper, ctx)
+	res, err = wrapper.GetContractDependencyGraph(wctx, &types.QueryGetContractDependencyGraphRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Graph.Nodes))
+	for _, node := range res.Graph.Nodes {
+		switch node.ContractAddr {
+		case keepertest.TestContract:
+			require.Equal(t, []string{keepertest.TestContract2}, node.Dependencies)
+			require.Empty(t, node.Dependents)
+		case keepertest.TestContract2:
+			require.Empty(t, node.Dependencies)
+			require.Equal(t, []string{keepertest.TestContract}, node.Dependents)
+			require.Equal(t, int64(1), node.NumIncomingDependencies)
+		default:
+			require.Equal(t, keepertest.TestAccount, node.ContractAddr)
+			require.Empty(t, node.Dependencies)
+			require.Empty(t, node.Dependents)
+		}
+	}
+	firstLevel := sortedAddrs(keepertest.TestContract, keepertest.TestAccount)
+	require.Equal(t, []*types.ContractFrontierLevel{
+		{ContractAddrs: firstLevel},
+		{ContractAddrs: []string{keepertest.TestContract2}},
+	}, res.Graph.FrontierLevels)
+	require.Equal(t, append(firstLevel, keepertest.TestContract2), res.Graph.ExecutionOrder)
+}
@@ -0,0 +1,32 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetContractExecutionStats(c context.Context, req *types.QueryGetContractExecutionStatsRequest) (*types.QueryGetContractExecutionStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stats, pageRes, err := k.GetContractExecutionStatsPaginated(ctx, req.ContractAddr, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*types.ContractExecutionStats, len(stats))
+	for i := range stats {
+		// wall times are only known to this node
+		if wallTime, ok := k.ExecutionWallTimes.Get(req.ContractAddr, stats[i].Height); ok {
+			stats[i].WallTimeMicroseconds = uint64(wallTime.Microseconds())
+		}
+		res[i] = &stats[i]
+	}
+	return &types.QueryGetContractExecutionStatsResponse{Stats: res, Pagination: pageRes}, nil
+}
@@ -0,0 +1,59 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetContractExecutionStats(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	params := keeper.GetParams(ctx)
+	params.ExecutionStatsRetention = 2
+	keeper.SetParams(ctx, params)
+
+	for height := int64(1); height <= 3; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		stats := dexcache.NewExecutionStats()
+		stats.AddSudo(keepertest.TestContract, dexcache.PlacementPhase, 100, 3*time.Millisecond)
+		stats.AddSudo(keepertest.TestContract, dexcache.PlacementPhase, 50, 2*time.Millisecond)
+		stats.AddSudo(keepertest.TestContract, dexcache.SettlementPhase, 20, time.Millisecond)
+		errors := map[string]string{}
+		if height == 3 {
+			errors[keepertest.TestContract] = "insufficient rent"
+		}
+		dexkeeperutils.RecordExecutionStats(ctx, keeper, stats, []string{keepertest.TestContract}, errors)
+	}
+
+	res, err := wrapper.GetContractExecutionStats(sdk.WrapSDKContext(ctx), &types.QueryGetContractExecutionStatsRequest{
+		ContractAddr: keepertest.TestContract,
+		Pagination:   &sdkquery.PageRequest{},
+	})
+	require.NoError(t, err)
+	// height 1 has fallen out of retention by height 3
+	require.Equal(t, []*types.ContractExecutionStats{
+		{Height: 2, PlacementGas: 150, SettlementGas: 20, WallTimeMicroseconds: 6000},
+		{Height: 3, PlacementGas: 150, SettlementGas: 20, Error: "insufficient rent", WallTimeMicroseconds: 6000},
+	}, res.Stats)
+
+	// nothing is recorded once the retention is set to 0
+	params.ExecutionStatsRetention = 0
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(4)
+	dexkeeperutils.RecordExecutionStats(ctx, keeper, dexcache.NewExecutionStats(), []string{keepertest.TestContract}, map[string]string{})
+	res, err = wrapper.GetContractExecutionStats(sdk.WrapSDKContext(ctx), &types.QueryGetContractExecutionStatsRequest{ContractAddr: keepertest.TestContract})
+	require.NoError(t, err)
+	require.Empty(t, res.Stats)
+
+	_, err = wrapper.GetContractExecutionStats(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultDepthLevels = 20
+	MaxDepthLevels     = 500
+)
+
+func (k KeeperWrapper) GetDepth(c context.Context, req *types.QueryGetDepthRequest) (*types.QueryGetDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	levels := req.Levels
+	if levels == 0 {
+		levels = DefaultDepthLevels
+	}
+	if levels > MaxDepthLevels {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d levels can be queried", MaxDepthLevels)
+	}
+	pair, found := k.GetRegisteredPair(ctx, req.ContractAddr, req.PriceDenom, req.AssetDenom)
+	if !found {
+		return nil, types.ErrPairNotRegistered
+	}
+	bucket := sdk.ZeroDec()
+	if req.Aggregation > 0 {
+		if pair.PriceTicksize == nil || !pair.PriceTicksize.IsPositive() {
+			return nil, status.Error(codes.FailedPrecondition, "pair has no price tick size to aggregate by")
+		}
+		bucket = pair.PriceTicksize.Mul(sdk.NewDecFromInt(sdk.NewIntFromUint64(req.Aggregation)))
+	}
+
+	return &types.QueryGetDepthResponse{
+		Bids: k.GetTopNDepthLevelsForPair(ctx, true, req.ContractAddr, req.PriceDenom, req.AssetDenom, int(levels), bucket),
+		Asks: k.GetTopNDepthLevelsForPair(ctx, false, req.ContractAddr, req.PriceDenom, req.AssetDenom, int(levels), bucket),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDepth(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	request := &types.QueryGetDepthRequest{
+		ContractAddr: keepertest.TestContract,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	}
+	_, err := wrapper.GetDepth(wctx, request)
+	require.ErrorIs(t, err, types.ErrPairNotRegistered)
+
+	keeper.AddRegisteredPair(ctx, keepertest.TestContract, keepertest.TestPair)
+	// levels are priced 0 to n-1 with a quantity equal to their price, so the empty level at 0 isn't indexed
+	keepertest.CreateNLongBook(keeper, ctx, 5)
+	keepertest.CreateNShortBook(keeper, ctx, 10)
+	res, err := wrapper.GetDepth(wctx, request)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(res.Bids))
+	require.Equal(t, sdk.NewDec(4), res.Bids[0].Price)
+	require.Equal(t, 9, len(res.Asks))
+	require.Equal(t, sdk.NewDec(1), res.Asks[0].Price)
+
+	request.Levels = 2
+	request.Aggregation = 4
+	res, err = wrapper.GetDepth(wctx, request)
+	require.NoError(t, err)
+	require.Equal(t, []types.DepthLevel{
+		{Price: sdk.NewDec(4), Quantity: sdk.NewDec(4)},
+		{Price: sdk.NewDec(0), Quantity: sdk.NewDec(6)},
+	}, res.Bids)
+	require.Equal(t, []types.DepthLevel{
+		{Price: sdk.NewDec(4), Quantity: sdk.NewDec(10)},
+		{Price: sdk.NewDec(8), Quantity: sdk.NewDec(26)},
+	}, res.Asks)
+
+	request.Levels = query.MaxDepthLevels + 1
+	_, err = wrapper.GetDepth(wctx, request)
+	require.Error(t, err)
+
+	_, err = wrapper.GetDepth(wctx, nil)
+	require.Error(t, err)
+}
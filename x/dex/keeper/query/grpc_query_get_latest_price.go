@@ -15,23 +15,14 @@ func (k KeeperWrapper) GetLatestPrice(goCtx context.Context, req *types.QueryGet
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	prices := k.GetAllPrices(ctx, req.ContractAddr, types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom})
-
-	if len(prices) == 0 {
+	latestPrice, found := k.Keeper.GetLatestPrice(ctx, req.ContractAddr, types.Pair{PriceDenom: req.PriceDenom, AssetDenom: req.AssetDenom})
+	if !found {
 		return &types.QueryGetLatestPriceResponse{
 			Price: &types.Price{},
 		}, nil
 	}
 
-	latestPrice := prices[0]
-
-	for _, price := range prices {
-		if price.SnapshotTimestampInSeconds > latestPrice.SnapshotTimestampInSeconds {
-			latestPrice = price
-		}
-	}
-
 	return &types.QueryGetLatestPriceResponse{
-		Price: latestPrice,
+		Price: &latestPrice,
 	}, nil
 }
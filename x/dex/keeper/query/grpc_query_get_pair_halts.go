@@ -0,0 +1,24 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetPairHalts(c context.Context, req *types.QueryGetPairHaltsRequest) (*types.QueryGetPairHaltsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	halts := []*types.PairHalt{}
+	for _, halt := range k.GetAllPairHalts(ctx, req.ContractAddr) {
+		halt := halt
+		halts = append(halts, &halt)
+	}
+	return &types.QueryGetPairHaltsResponse{Halts: halts}, nil
+}
@@ -0,0 +1,28 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetRentHistory(c context.Context, req *types.QueryGetRentHistoryRequest) (*types.QueryGetRentHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	charges, pageRes, err := k.GetRentChargesPaginated(ctx, req.ContractAddr, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*types.RentCharge, len(charges))
+	for i := range charges {
+		res[i] = &charges[i]
+	}
+	return &types.QueryGetRentHistoryResponse{Charges: res, Pagination: pageRes}, nil
+}
@@ -0,0 +1,69 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func countLowRentEvents(ctx sdk.Context) int {
+	cnt := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeLowRent {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+func TestGetRentHistory(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	params := keeper.GetParams(ctx)
+	params.RentHistoryRetention = 2
+	params.RentLowWaterMark = 50
+	keeper.SetParams(ctx, params)
+
+	preRent := uint64(100)
+	for height, rent := range []uint64{90, 60, 40, 40, 10} {
+		ctx = ctx.WithBlockHeight(int64(height + 1)).WithEventManager(sdk.NewEventManager())
+		dexkeeperutils.RecordRentCharges(ctx, keeper, map[string]uint64{keepertest.TestContract: preRent}, map[string]uint64{keepertest.TestContract: rent})
+		preRent = rent
+		// the event is only emitted when the balance drops below the low-water mark
+		if height == 2 {
+			require.Equal(t, 1, countLowRentEvents(ctx))
+		} else {
+			require.Equal(t, 0, countLowRentEvents(ctx))
+		}
+	}
+
+	res, err := wrapper.GetRentHistory(sdk.WrapSDKContext(ctx), &types.QueryGetRentHistoryRequest{
+		ContractAddr: keepertest.TestContract,
+		Pagination:   &sdkquery.PageRequest{},
+	})
+	require.NoError(t, err)
+	// no charge at height 4, and height 3 has fallen out of retention by height 5
+	require.Equal(t, []*types.RentCharge{{Height: 5, Amount: 30, RentBalance: 10}}, res.Charges)
+
+	_, err = wrapper.GetRentHistory(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
+
+func TestGetRentTopUp(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	_, err := wrapper.GetRentTopUp(sdk.WrapSDKContext(ctx), &types.QueryGetRentTopUpRequest{ContractAddr: keepertest.TestContract})
+	require.Error(t, err)
+
+	topUp := types.RentTopUp{ContractAddr: keepertest.TestContract, Funder: keepertest.TestAccount, Threshold: 10, Amount: 1, SpendLimit: 5}
+	keeper.SetRentTopUp(ctx, topUp)
+	res, err := wrapper.GetRentTopUp(sdk.WrapSDKContext(ctx), &types.QueryGetRentTopUpRequest{ContractAddr: keepertest.TestContract})
+	require.NoError(t, err)
+	require.Equal(t, topUp, *res.TopUp)
+}
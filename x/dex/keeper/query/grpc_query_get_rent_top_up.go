@@ -0,0 +1,23 @@
+package query
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k KeeperWrapper) GetRentTopUp(c context.Context, req *types.QueryGetRentTopUpRequest) (*types.QueryGetRentTopUpResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	topUp, found := k.Keeper.GetRentTopUp(ctx, req.ContractAddr)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no rent top-up registered for contract")
+	}
+	return &types.QueryGetRentTopUpResponse{TopUp: &topUp}, nil
+}
@@ -0,0 +1,28 @@
+package query
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// GetTriggeredOrders returns all stop loss/limit orders of an account that are still pending in the trigger book
+func (k KeeperWrapper) GetTriggeredOrders(c context.Context, req *types.QueryGetTriggeredOrdersRequest) (*types.QueryGetTriggeredOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	orders := []*types.Order{}
+	for _, order := range k.GetAllTriggeredOrders(ctx, req.ContractAddr) {
+		if order.Account == req.Account {
+			order := order
+			orders = append(orders, &order)
+		}
+	}
+
+	return &types.QueryGetTriggeredOrdersResponse{Orders: orders}, nil
+}
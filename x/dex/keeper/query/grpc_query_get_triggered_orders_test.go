@@ -0,0 +1,47 @@
+package query_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTriggeredOrders(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	wrapper := query.KeeperWrapper{Keeper: keeper}
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, types.Order{
+		Id:                1,
+		Account:           keepertest.TestAccount,
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.NewDec(10),
+	})
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, types.Order{
+		Id:                2,
+		Account:           "other",
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_STOPLIMIT,
+		PositionDirection: types.PositionDirection_SHORT,
+		TriggerPrice:      sdk.NewDec(10),
+	})
+	resp, err := wrapper.GetTriggeredOrders(wctx, &types.QueryGetTriggeredOrdersRequest{
+		ContractAddr: keepertest.TestContract,
+		Account:      keepertest.TestAccount,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(resp.Orders))
+	require.Equal(t, uint64(1), resp.Orders[0].Id)
+
+	_, err = wrapper.GetTriggeredOrders(wctx, nil)
+	require.NotNil(t, err)
+}
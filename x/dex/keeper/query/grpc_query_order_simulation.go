@@ -2,119 +2,211 @@ package query
 
 import (
 	"context"
-	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	dexcontract "github.com/sei-protocol/sei-chain/x/dex/contract"
+	keeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 )
 
-type priceQuantity struct {
-	price    sdk.Dec
-	quantity sdk.Dec
-}
-
+// GetOrderSimulation runs the orders through the same matching as the end of the block would, together
+// with the orders and cancellations already placed in this block, on a cache-wrapped copy of the state.
 // Note that this simulation is only accurate if it's called as part of the main Sei process (e.g. in Begin/EndBlock, transaction handler
 // or contract querier), because it needs to access dex's in-memory state.
 func (k KeeperWrapper) GetOrderSimulation(c context.Context, req *types.QueryOrderSimulationRequest) (*types.QueryOrderSimulationResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	orders := req.Orders
+	if req.Order != nil {
+		orders = append([]*types.Order{req.Order}, orders...)
+	}
+	if len(orders) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no order to simulate")
+	}
+	for _, order := range orders[1:] {
+		if order.PriceDenom != orders[0].PriceDenom || order.AssetDenom != orders[0].AssetDenom {
+			return nil, status.Error(codes.InvalidArgument, "all simulated orders must be for the same pair")
+		}
+	}
 	ctx := sdk.UnwrapSDKContext(c)
-	matchedPriceQuantities := k.getMatchedPriceQuantities(ctx, req)
-	executedQuantity := sdk.ZeroDec()
-	for _, pq := range matchedPriceQuantities {
-		if executedQuantity.Add(pq.quantity).GTE(req.Order.Quantity) {
-			executedQuantity = req.Order.Quantity
-			break
+	contract := types.ContractAddress(req.ContractAddr)
+	pair, found := k.GetRegisteredPair(ctx, req.ContractAddr, orders[0].PriceDenom, orders[0].AssetDenom)
+	if !found {
+		pair = types.Pair{PriceDenom: orders[0].PriceDenom, AssetDenom: orders[0].AssetDenom}
+	}
+	mid := k.getMidPrice(ctx, contract, pair)
+
+	// nothing done by the simulation is written back
+	cacheCtx, _ := ctx.CacheContext()
+	blockOrders := dexutils.GetMemState(cacheCtx.Context()).GetBlockOrders(cacheCtx, contract, pair)
+	simulatedIDs := map[uint64]int{}
+	nextID := k.GetNextOrderID(ctx, req.ContractAddr)
+	for i, order := range orders {
+		simulated := *order
+		simulated.Id = nextID + uint64(i)
+		simulated.ContractAddr = req.ContractAddr
+		simulated.Status = types.OrderStatus_PLACED
+		blockOrders.Add(&simulated)
+		simulatedIDs[simulated.Id] = i
+	}
+	dexcontract.RejectCrossingPostOnlyOrders(cacheCtx, contract, []types.Pair{pair}, k.Keeper)
+	orderbook := keeperutils.PopulateOrderbook(cacheCtx, k.Keeper, contract, pair)
+	settlements := dexcontract.ExecutePair(cacheCtx, req.ContractAddr, pair, k.Keeper, orderbook)
+
+	results := make([]*types.OrderSimulationResult, len(orders))
+	for i := range results {
+		results[i] = &types.OrderSimulationResult{ExecutedQuantity: sdk.ZeroDec(), Fills: []*types.OrderSimulationFill{}}
+	}
+	addFill := func(orderID uint64, price sdk.Dec, quantity sdk.Dec) {
+		if i, ok := simulatedIDs[orderID]; ok {
+			results[i].Fills = addSimulationFill(results[i].Fills, price, quantity)
 		}
-		executedQuantity = executedQuantity.Add(pq.quantity)
 	}
-	return &types.QueryOrderSimulationResponse{
-		ExecutedQuantity: &executedQuantity,
-	}, nil
-}
+	addSimulationFills(settlements, blockOrders, addFill)
 
-func (k KeeperWrapper) getMatchedPriceQuantities(ctx sdk.Context, req *types.QueryOrderSimulationRequest) []priceQuantity {
-	orderDirection := req.Order.PositionDirection
-	// get existing liquidity
-	eligibleOrderBookPriceToQuantity := map[string]sdk.Dec{}
-	if orderDirection == types.PositionDirection_SHORT {
-		for _, lb := range k.GetAllLongBookForPair(ctx, req.ContractAddr, req.Order.PriceDenom, req.Order.AssetDenom) {
-			if req.Order.Price.IsZero() || req.Order.Price.LTE(lb.GetPrice()) {
-				eligibleOrderBookPriceToQuantity[lb.GetPrice().String()] = lb.GetOrderEntry().Quantity
-			}
+	simulatedSettlements := []*types.SettlementEntry{}
+	notionals := make([]sdk.Dec, len(orders))
+	for i := range notionals {
+		notionals[i] = sdk.ZeroDec()
+	}
+	for _, settlement := range settlements {
+		i, ok := simulatedIDs[settlement.OrderId]
+		if !ok {
+			continue
 		}
-	} else {
-		for _, sb := range k.GetAllShortBookForPair(ctx, req.ContractAddr, req.Order.PriceDenom, req.Order.AssetDenom) {
-			if req.Order.Price.IsZero() || req.Order.Price.GTE(sb.GetPrice()) {
-				eligibleOrderBookPriceToQuantity[sb.GetPrice().String()] = sb.GetOrderEntry().Quantity
+		simulatedSettlements = append(simulatedSettlements, settlement)
+		results[i].ExecutedQuantity = results[i].ExecutedQuantity.Add(settlement.Quantity)
+		notionals[i] = notionals[i].Add(settlement.Quantity.Mul(settlement.ExecutionCostOrProceed))
+	}
+	totalExecuted := sdk.ZeroDec()
+	for i, result := range results {
+		totalExecuted = totalExecuted.Add(result.ExecutedQuantity)
+		if result.ExecutedQuantity.IsZero() {
+			continue
+		}
+		averagePrice := notionals[i].Quo(result.ExecutedQuantity)
+		worstPrice := getWorstFillPrice(result.Fills, orders[i].PositionDirection)
+		result.AveragePrice = &averagePrice
+		result.WorstPrice = &worstPrice
+		if mid != nil {
+			slippage := averagePrice.Sub(*mid).Quo(*mid)
+			if orders[i].PositionDirection == types.PositionDirection_SHORT {
+				slippage = slippage.Neg()
 			}
+			result.Slippage = &slippage
 		}
 	}
+	return &types.QueryOrderSimulationResponse{
+		ExecutedQuantity: &totalExecuted,
+		Results:          results,
+		Settlements:      simulatedSettlements,
+	}, nil
+}
+
+func (k KeeperWrapper) getMidPrice(ctx sdk.Context, contract types.ContractAddress, pair types.Pair) *sdk.Dec {
+	bestBids := k.GetTopNLongBooksForPair(ctx, string(contract), pair.PriceDenom, pair.AssetDenom, 1)
+	bestAsks := k.GetTopNShortBooksForPair(ctx, string(contract), pair.PriceDenom, pair.AssetDenom, 1)
+	if len(bestBids) == 0 || len(bestAsks) == 0 {
+		return nil
+	}
+	mid := bestBids[0].GetPrice().Add(bestAsks[0].GetPrice()).Quo(sdk.NewDec(2))
+	return &mid
+}
 
-	// exclude liquidity to be cancelled
-	pair := types.Pair{PriceDenom: req.Order.PriceDenom, AssetDenom: req.Order.AssetDenom}
-	for _, cancel := range dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(req.ContractAddr), pair).Get() {
-		var cancelledAllocation *types.Allocation
-		var found bool
-		if cancel.PositionDirection == types.PositionDirection_LONG {
-			cancelledAllocation, found = k.GetLongAllocationForOrderID(ctx, req.ContractAddr, cancel.PriceDenom, cancel.AssetDenom, cancel.Price, cancel.Id)
-		} else {
-			cancelledAllocation, found = k.GetShortAllocationForOrderID(ctx, req.ContractAddr, cancel.PriceDenom, cancel.AssetDenom, cancel.Price, cancel.Id)
+// addSimulationFills adds the fills of the settlements by the price level they were matched at.
+// Market orders of each side are settled as their makers followed by their takers, both in the
+// order they were matched, so walking the two by quantity gives the levels that each taker was
+// filled at. Orders crossing within the book are filled at their execution price.
+func addSimulationFills(
+	settlements []*types.SettlementEntry,
+	blockOrders *cache.BlockOrders,
+	addFill func(orderID uint64, price sdk.Dec, quantity sdk.Dec),
+) {
+	isMarketTaker := func(settlement *types.SettlementEntry) bool {
+		if !blockOrders.Has(settlement.OrderId) {
+			return false
+		}
+		switch blockOrders.GetByID(settlement.OrderId).OrderType {
+		case types.OrderType_MARKET, types.OrderType_FOKMARKET, types.OrderType_FOKMARKETBYVALUE:
+			return true
+		default:
+			return false
 		}
-		if !found {
+	}
+	makers, takers := []*types.SettlementEntry{}, []*types.SettlementEntry{}
+	for _, settlement := range settlements {
+		if isMarketTaker(settlement) {
+			takers = append(takers, settlement)
 			continue
 		}
-		if q, ok := eligibleOrderBookPriceToQuantity[cancel.Price.String()]; ok {
-			eligibleOrderBookPriceToQuantity[cancel.Price.String()] = q.Sub(cancelledAllocation.Quantity)
+		if len(takers) > 0 {
+			addMarketOrderFills(makers, takers, addFill)
+			makers, takers = []*types.SettlementEntry{}, []*types.SettlementEntry{}
 		}
+		makers = append(makers, settlement)
+	}
+	if len(takers) > 0 {
+		addMarketOrderFills(makers, takers, addFill)
+		return
+	}
+	for _, settlement := range makers {
+		addFill(settlement.OrderId, settlement.ExecutionCostOrProceed, settlement.Quantity)
 	}
+}
 
-	priceQuantities := []priceQuantity{}
-	for price, quantity := range eligibleOrderBookPriceToQuantity {
-		if quantity.IsPositive() {
-			priceQuantities = append(priceQuantities, priceQuantity{price: sdk.MustNewDecFromStr(price), quantity: quantity})
-		}
+func addMarketOrderFills(
+	makers []*types.SettlementEntry,
+	takers []*types.SettlementEntry,
+	addFill func(orderID uint64, price sdk.Dec, quantity sdk.Dec),
+) {
+	takerIdx, takerRemaining := 0, sdk.ZeroDec()
+	if len(takers) > 0 {
+		takerRemaining = takers[0].Quantity
 	}
-	sort.Slice(priceQuantities, func(i int, j int) bool {
-		if orderDirection == types.PositionDirection_SHORT {
-			// short order corresponds to long book which needs to be in descending order
-			return priceQuantities[i].price.GT(priceQuantities[j].price)
+	for _, maker := range makers {
+		// makers are settled at their own price level, which is also the level the taker is filled at
+		addFill(maker.OrderId, maker.ExecutionCostOrProceed, maker.Quantity)
+		makerRemaining := maker.Quantity
+		for makerRemaining.IsPositive() && takerIdx < len(takers) {
+			quantity := sdk.MinDec(makerRemaining, takerRemaining)
+			addFill(takers[takerIdx].OrderId, maker.ExecutionCostOrProceed, quantity)
+			makerRemaining = makerRemaining.Sub(quantity)
+			takerRemaining = takerRemaining.Sub(quantity)
+			if !takerRemaining.IsPositive() {
+				takerIdx++
+				if takerIdx < len(takers) {
+					takerRemaining = takers[takerIdx].Quantity
+				}
+			}
 		}
-		// long order corresponds to long book which needs to be in ascending order
-		return priceQuantities[i].price.LT(priceQuantities[j].price)
-	})
+	}
+}
 
-	// exclude liquidity to be taken
-	ptr := 0
-	for _, order := range dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(req.ContractAddr), pair).GetSortedMarketOrders(orderDirection) {
-		// If existing market order has price zero, it means it doesn't specify a worst price and will always have precedence over the simulated
-		// order
-		if !order.Price.IsZero() {
-			// If the simulated order doesn't specify a worst price, no existing order with a worst price will take liquidity from it
-			if req.Order.Price.IsZero() {
-				break
-			}
-			if orderDirection == types.PositionDirection_LONG && order.Price.LT(req.Order.Price) {
-				break
-			}
-			if orderDirection == types.PositionDirection_SHORT && order.Price.GT(req.Order.Price) {
-				break
-			}
+// addSimulationFill adds the quantity to the fill at the given price, keeping fills in the order their
+// price levels were first matched
+func addSimulationFill(fills []*types.OrderSimulationFill, price sdk.Dec, quantity sdk.Dec) []*types.OrderSimulationFill {
+	for _, fill := range fills {
+		if fill.Price.Equal(price) {
+			fill.Quantity = fill.Quantity.Add(quantity)
+			return fills
 		}
-		remainingQuantity := order.Quantity
-		for ptr < len(priceQuantities) {
-			if remainingQuantity.LTE(priceQuantities[ptr].quantity) {
-				priceQuantities[ptr].quantity = priceQuantities[ptr].quantity.Sub(remainingQuantity)
-				break
-			}
-			remainingQuantity = remainingQuantity.Sub(priceQuantities[ptr].quantity)
-			ptr++
+	}
+	return append(fills, &types.OrderSimulationFill{Price: price, Quantity: quantity})
+}
+
+func getWorstFillPrice(fills []*types.OrderSimulationFill, direction types.PositionDirection) sdk.Dec {
+	worst := fills[0].Price
+	for _, fill := range fills[1:] {
+		if (direction == types.PositionDirection_LONG && fill.Price.GT(worst)) ||
+			(direction == types.PositionDirection_SHORT && fill.Price.LT(worst)) {
+			worst = fill.Price
 		}
 	}
-	return priceQuantities[ptr:]
+	return worst
 }
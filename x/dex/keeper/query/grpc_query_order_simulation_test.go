@@ -5,16 +5,39 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	dexkeeper "github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper/query"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
 	"github.com/stretchr/testify/require"
 )
 
+const TestMaker = "sei1ewxvf5a9wq9zk5nurtl6m9yfxpnhyp7s7uk5sl"
+
+func setSimulationShortBook(keeper *dexkeeper.Keeper, ctx sdk.Context, price string, quantity string, orderID uint64) {
+	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
+		Price: sdk.MustNewDecFromStr(price),
+		Entry: &types.OrderEntry{
+			Price:      sdk.MustNewDecFromStr(price),
+			Quantity:   sdk.MustNewDecFromStr(quantity),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{
+					Account:  TestMaker,
+					Quantity: sdk.MustNewDecFromStr(quantity),
+					OrderId:  orderID,
+				},
+			},
+		},
+	})
+}
+
 func TestGetOrderSimulation(t *testing.T) {
 	keeper, ctx := keepertest.DexKeeper(t)
 	wrapper := query.KeeperWrapper{Keeper: keeper}
 	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetNextOrderID(ctx, keepertest.TestContract, 10)
 
 	testOrder := types.Order{
 		Account:           keepertest.TestAccount,
@@ -24,60 +47,99 @@ func TestGetOrderSimulation(t *testing.T) {
 		Price:             sdk.MustNewDecFromStr("10"),
 		Quantity:          sdk.MustNewDecFromStr("5"),
 		PositionDirection: types.PositionDirection_LONG,
+		OrderType:         types.OrderType_MARKET,
 	}
 
 	// no liquidity
 	res, err := wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{Order: &testOrder, ContractAddr: keepertest.TestContract})
 	require.Nil(t, err)
 	require.Equal(t, sdk.ZeroDec(), *res.ExecutedQuantity)
+	require.Equal(t, 1, len(res.Results))
+	require.Nil(t, res.Results[0].AveragePrice)
+	require.Empty(t, res.Settlements)
 
 	// partial liquidity on orderbook
-	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
-		Price: sdk.MustNewDecFromStr("9"),
+	setSimulationShortBook(keeper, ctx, "9", "2", 1)
+	setSimulationShortBook(keeper, ctx, "8", "1", 2)
+	keeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.MustNewDecFromStr("7"),
 		Entry: &types.OrderEntry{
-			Price:      sdk.MustNewDecFromStr("9"),
-			Quantity:   sdk.MustNewDecFromStr("2"),
+			Price:      sdk.MustNewDecFromStr("7"),
+			Quantity:   sdk.OneDec(),
 			PriceDenom: keepertest.TestPriceDenom,
 			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{Account: TestMaker, Quantity: sdk.OneDec(), OrderId: 3},
+			},
 		},
 	})
 	res, err = wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{Order: &testOrder, ContractAddr: keepertest.TestContract})
 	require.Nil(t, err)
-	require.Equal(t, sdk.MustNewDecFromStr("2"), *res.ExecutedQuantity)
+	require.Equal(t, sdk.MustNewDecFromStr("3"), *res.ExecutedQuantity)
+	result := res.Results[0]
+	require.Equal(t, sdk.MustNewDecFromStr("3"), result.ExecutedQuantity)
+	require.Equal(t, []*types.OrderSimulationFill{
+		{Price: sdk.MustNewDecFromStr("8"), Quantity: sdk.OneDec()},
+		{Price: sdk.MustNewDecFromStr("9"), Quantity: sdk.MustNewDecFromStr("2")},
+	}, result.Fills)
+	averagePrice := sdk.MustNewDecFromStr("26").Quo(sdk.MustNewDecFromStr("3"))
+	require.Equal(t, averagePrice, *result.AveragePrice)
+	require.Equal(t, sdk.MustNewDecFromStr("9"), *result.WorstPrice)
+	// mid price is 7.5
+	mid := sdk.MustNewDecFromStr("7.5")
+	require.Equal(t, averagePrice.Sub(mid).Quo(mid), *result.Slippage)
+	require.Equal(t, 2, len(res.Settlements))
+	for _, settlement := range res.Settlements {
+		require.Equal(t, uint64(10), settlement.OrderId)
+		require.Equal(t, averagePrice, settlement.ExecutionCostOrProceed)
+	}
+	// the order book is left as is
+	entry, found := keeper.GetShortBookByPrice(ctx, keepertest.TestContract, sdk.MustNewDecFromStr("8"), keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.True(t, found)
+	require.Equal(t, sdk.OneDec(), entry.Entry.Quantity)
+	require.Equal(t, uint64(10), keeper.GetNextOrderID(ctx, keepertest.TestContract))
 
-	// full liquidity on orderbook
-	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
-		Price: sdk.MustNewDecFromStr("8"),
-		Entry: &types.OrderEntry{
-			Price:      sdk.MustNewDecFromStr("8"),
-			Quantity:   sdk.MustNewDecFromStr("1"),
-			PriceDenom: keepertest.TestPriceDenom,
-			AssetDenom: keepertest.TestAssetDenom,
-		},
-	})
-	res, err = wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{Order: &testOrder, ContractAddr: keepertest.TestContract})
+	// limit orders cross the book half-way between the price levels
+	limitOrder := testOrder
+	limitOrder.OrderType = types.OrderType_LIMIT
+	res, err = wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{Order: &limitOrder, ContractAddr: keepertest.TestContract})
+	require.Nil(t, err)
+	result = res.Results[0]
+	require.Equal(t, sdk.MustNewDecFromStr("3"), result.ExecutedQuantity)
+	require.Equal(t, []*types.OrderSimulationFill{
+		{Price: sdk.MustNewDecFromStr("9"), Quantity: sdk.OneDec()},
+		{Price: sdk.MustNewDecFromStr("9.5"), Quantity: sdk.MustNewDecFromStr("2")},
+	}, result.Fills)
+	require.Equal(t, sdk.MustNewDecFromStr("28").Quo(sdk.MustNewDecFromStr("3")), *result.AveragePrice)
+	require.Equal(t, sdk.MustNewDecFromStr("9.5"), *result.WorstPrice)
+
+	// batches are matched in the same block and share the clearing price of market orders
+	first, second := testOrder, testOrder
+	first.Quantity, second.Quantity = sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("2")
+	res, err = wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{Orders: []*types.Order{&first, &second}, ContractAddr: keepertest.TestContract})
 	require.Nil(t, err)
 	require.Equal(t, sdk.MustNewDecFromStr("3"), *res.ExecutedQuantity)
+	require.Equal(t, 2, len(res.Results))
+	require.Equal(t, sdk.MustNewDecFromStr("2"), res.Results[0].ExecutedQuantity)
+	require.Equal(t, sdk.MustNewDecFromStr("9"), *res.Results[0].WorstPrice)
+	require.Equal(t, sdk.OneDec(), res.Results[1].ExecutedQuantity)
+	require.Equal(t, []*types.OrderSimulationFill{
+		{Price: sdk.MustNewDecFromStr("9"), Quantity: sdk.OneDec()},
+	}, res.Results[1].Fills)
+	require.Equal(t, averagePrice, *res.Results[0].AveragePrice)
+	require.Equal(t, averagePrice, *res.Results[1].AveragePrice)
+
+	// batches can't span pairs
+	otherPair := testOrder
+	otherPair.AssetDenom = "other"
+	_, err = wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{Orders: []*types.Order{&testOrder, &otherPair}, ContractAddr: keepertest.TestContract})
+	require.NotNil(t, err)
+	_, err = wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{ContractAddr: keepertest.TestContract})
+	require.NotNil(t, err)
 
 	// liquidity taken by cancel
-	keeper.SetShortBook(ctx, keepertest.TestContract, types.ShortBook{
-		Price: sdk.MustNewDecFromStr("9"),
-		Entry: &types.OrderEntry{
-			Price:      sdk.MustNewDecFromStr("9"),
-			Quantity:   sdk.MustNewDecFromStr("2"),
-			PriceDenom: keepertest.TestPriceDenom,
-			AssetDenom: keepertest.TestAssetDenom,
-			Allocations: []*types.Allocation{
-				{
-					Account:  keepertest.TestAccount,
-					Quantity: sdk.MustNewDecFromStr("2"),
-					OrderId:  1,
-				},
-			},
-		},
-	})
 	dexutils.GetMemState(ctx.Context()).GetBlockCancels(ctx, types.ContractAddress(keepertest.TestContract), keepertest.TestPair).Add(
-		&types.Cancellation{Id: 1, Price: sdk.MustNewDecFromStr("9"), PositionDirection: types.PositionDirection_SHORT, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom},
+		&types.Cancellation{Id: 1, Creator: TestMaker, Price: sdk.MustNewDecFromStr("9"), PositionDirection: types.PositionDirection_SHORT, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom},
 	)
 	res, err = wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{Order: &testOrder, ContractAddr: keepertest.TestContract})
 	require.Nil(t, err)
@@ -86,7 +148,9 @@ func TestGetOrderSimulation(t *testing.T) {
 	// liquidity taken by earlier market orders
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(keepertest.TestContract), keepertest.TestPair).Add(
 		&types.Order{
-			Account:           keepertest.TestAccount,
+			Id:                5,
+			Account:           TestMaker,
+			ContractAddr:      keepertest.TestContract,
 			PriceDenom:        keepertest.TestPriceDenom,
 			AssetDenom:        keepertest.TestAssetDenom,
 			Price:             sdk.MustNewDecFromStr("11"),
@@ -98,4 +162,5 @@ func TestGetOrderSimulation(t *testing.T) {
 	res, err = wrapper.GetOrderSimulation(wctx, &types.QueryOrderSimulationRequest{Order: &testOrder, ContractAddr: keepertest.TestContract})
 	require.Nil(t, err)
 	require.Equal(t, sdk.ZeroDec(), *res.ExecutedQuantity)
+	require.Empty(t, res.Settlements)
 }
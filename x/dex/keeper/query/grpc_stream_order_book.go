@@ -0,0 +1,263 @@
+package query
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultStreamPollInterval = 100 * time.Millisecond
+	// number of contract blocks whose updates are kept for subscribers that are behind
+	streamUpdateCacheSize = 256
+)
+
+// StreamKeeperWrapper serves the dex Stream service. Unlike queries, streams outlive a single
+// context, so every block is read from a context created for the committed state at its height.
+type StreamKeeperWrapper struct {
+	*keeper.Keeper
+
+	queryContext func(height int64) (sdk.Context, error)
+	latestHeight func() int64
+	pollInterval time.Duration
+	updates      *blockUpdateCache
+}
+
+func NewStreamKeeperWrapper(
+	k *keeper.Keeper,
+	queryContext func(height int64) (sdk.Context, error),
+	latestHeight func() int64,
+	pollInterval time.Duration,
+) StreamKeeperWrapper {
+	return StreamKeeperWrapper{
+		Keeper:       k,
+		queryContext: queryContext,
+		latestHeight: latestHeight,
+		pollInterval: pollInterval,
+		updates:      newBlockUpdateCache(streamUpdateCacheSize),
+	}
+}
+
+func (k StreamKeeperWrapper) SubscribeOrderBook(req *types.SubscribeOrderBookRequest, stream types.Stream_SubscribeOrderBookServer) error {
+	if req == nil || req.ContractAddr == "" {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StartHeight < 0 {
+		return status.Error(codes.InvalidArgument, "start height cannot be negative")
+	}
+	height := req.StartHeight
+	if height == 0 {
+		height = k.latestHeight() + 1
+	}
+
+	ticker := time.NewTicker(k.pollInterval)
+	defer ticker.Stop()
+	for {
+		for ; height <= k.latestHeight(); height++ {
+			resp, err := k.getOrderBookUpdate(req, height)
+			if err != nil {
+				return err
+			}
+			if len(resp.Pairs) == 0 {
+				continue
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// getOrderBookUpdate returns the updates of the block at the given height for the pairs that the
+// request subscribes to. The updates of a block are computed once and shared by all subscribers.
+func (k StreamKeeperWrapper) getOrderBookUpdate(req *types.SubscribeOrderBookRequest, height int64) (*types.SubscribeOrderBookResponse, error) {
+	pairs, err := k.updates.get(req.ContractAddr, height, func() ([]*types.PairOrderBookUpdate, error) {
+		return k.computeBlockUpdate(req.ContractAddr, height)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.SubscribeOrderBookResponse{Height: height, ContractAddr: req.ContractAddr}
+	for _, update := range pairs {
+		if req.PriceDenom != "" && req.AssetDenom != "" && (update.PriceDenom != req.PriceDenom || update.AssetDenom != req.AssetDenom) {
+			continue
+		}
+		resp.Pairs = append(resp.Pairs, update)
+	}
+	return resp, nil
+}
+
+// computeBlockUpdate diffs the depth of the pairs that the match result at the given height has
+// touched against their depth at the previous height
+func (k StreamKeeperWrapper) computeBlockUpdate(contractAddr string, height int64) ([]*types.PairOrderBookUpdate, error) {
+	updates := []*types.PairOrderBookUpdate{}
+	ctx, err := k.queryContext(height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "state at height %d is not available: %s", height, err)
+	}
+	result, _ := k.GetMatchResultState(ctx, contractAddr)
+	if result.Height != height {
+		// no match result has been written for the contract in this block
+		return updates, nil
+	}
+	pairs := getTouchedPairs(result)
+	if len(pairs) == 0 {
+		return updates, nil
+	}
+	var prevCtx *sdk.Context
+	if height > 1 {
+		c, err := k.queryContext(height - 1)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "state at height %d is not available: %s", height-1, err)
+		}
+		prevCtx = &c
+	}
+
+	for _, pair := range pairs {
+		update := &types.PairOrderBookUpdate{
+			PriceDenom:  pair.PriceDenom,
+			AssetDenom:  pair.AssetDenom,
+			Settlements: []*types.SettlementEntry{},
+		}
+		var prevLongs, prevShorts []types.DepthLevel
+		if prevCtx != nil {
+			prevLongs = k.GetAllDepthLevelsForPair(*prevCtx, true, contractAddr, pair.PriceDenom, pair.AssetDenom)
+			prevShorts = k.GetAllDepthLevelsForPair(*prevCtx, false, contractAddr, pair.PriceDenom, pair.AssetDenom)
+		}
+		update.LongLevels = getDepthDiff(prevLongs, k.GetAllDepthLevelsForPair(ctx, true, contractAddr, pair.PriceDenom, pair.AssetDenom))
+		update.ShortLevels = getDepthDiff(prevShorts, k.GetAllDepthLevelsForPair(ctx, false, contractAddr, pair.PriceDenom, pair.AssetDenom))
+		for _, settlement := range result.Settlements {
+			if settlement.PriceDenom == pair.PriceDenom && settlement.AssetDenom == pair.AssetDenom {
+				update.Settlements = append(update.Settlements, settlement)
+			}
+		}
+		if len(update.LongLevels) == 0 && len(update.ShortLevels) == 0 && len(update.Settlements) == 0 {
+			continue
+		}
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+// getTouchedPairs returns the pairs with orders, cancellations or settlements in the match result,
+// sorted by their string representation
+func getTouchedPairs(result *types.MatchResult) []types.Pair {
+	touched := map[types.PairString]types.Pair{}
+	add := func(priceDenom string, assetDenom string) {
+		pair := types.Pair{PriceDenom: priceDenom, AssetDenom: assetDenom}
+		touched[types.GetPairString(&pair)] = pair
+	}
+	for _, order := range result.Orders {
+		add(order.PriceDenom, order.AssetDenom)
+	}
+	for _, cancellation := range result.Cancellations {
+		add(cancellation.PriceDenom, cancellation.AssetDenom)
+	}
+	for _, settlement := range result.Settlements {
+		add(settlement.PriceDenom, settlement.AssetDenom)
+	}
+	pairStrs := make([]types.PairString, 0, len(touched))
+	for pairStr := range touched {
+		pairStrs = append(pairStrs, pairStr)
+	}
+	sort.Slice(pairStrs, func(i, j int) bool { return pairStrs[i] < pairStrs[j] })
+	pairs := make([]types.Pair, 0, len(pairStrs))
+	for _, pairStr := range pairStrs {
+		pairs = append(pairs, touched[pairStr])
+	}
+	return pairs
+}
+
+// getDepthDiff returns the levels whose quantity differs between the two sides of a book, sorted
+// by ascending price. Levels that no longer exist are returned with a zero quantity.
+func getDepthDiff(prev []types.DepthLevel, cur []types.DepthLevel) []*types.DepthLevel {
+	prevQuantities := map[string]sdk.Dec{}
+	for _, level := range prev {
+		prevQuantities[level.Price.String()] = level.Quantity
+	}
+	diff := []*types.DepthLevel{}
+	for _, level := range cur {
+		price := level.Price.String()
+		if prevQuantity, ok := prevQuantities[price]; !ok || !prevQuantity.Equal(level.Quantity) {
+			diff = append(diff, &types.DepthLevel{Price: level.Price, Quantity: level.Quantity})
+		}
+		delete(prevQuantities, price)
+	}
+	for _, level := range prev {
+		if _, ok := prevQuantities[level.Price.String()]; ok {
+			diff = append(diff, &types.DepthLevel{Price: level.Price, Quantity: sdk.ZeroDec()})
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool { return diff[i].Price.LT(diff[j].Price) })
+	return diff
+}
+
+type blockUpdateKey struct {
+	contractAddr string
+	height       int64
+}
+
+type blockUpdate struct {
+	once  sync.Once
+	pairs []*types.PairOrderBookUpdate
+	err   error
+}
+
+// blockUpdateCache keeps the updates of the most recent contract blocks. Subscribers that ask for
+// the same block while it's being computed wait for the first computation instead of repeating it.
+type blockUpdateCache struct {
+	mu      sync.Mutex
+	size    int
+	updates map[blockUpdateKey]*blockUpdate
+	order   []blockUpdateKey
+}
+
+func newBlockUpdateCache(size int) *blockUpdateCache {
+	return &blockUpdateCache{size: size, updates: map[blockUpdateKey]*blockUpdate{}}
+}
+
+func (c *blockUpdateCache) get(contractAddr string, height int64, compute func() ([]*types.PairOrderBookUpdate, error)) ([]*types.PairOrderBookUpdate, error) {
+	key := blockUpdateKey{contractAddr: contractAddr, height: height}
+	c.mu.Lock()
+	update, ok := c.updates[key]
+	if !ok {
+		update = &blockUpdate{}
+		c.updates[key] = update
+		c.order = append(c.order, key)
+		if len(c.order) > c.size {
+			delete(c.updates, c.order[0])
+			c.order = c.order[1:]
+		}
+	}
+	c.mu.Unlock()
+
+	update.once.Do(func() {
+		update.pairs, update.err = compute()
+	})
+	if update.err != nil {
+		// errors such as a height that isn't available yet aren't cached
+		c.mu.Lock()
+		if c.updates[key] == update {
+			delete(c.updates, key)
+			for i, k := range c.order {
+				if k == key {
+					c.order = append(c.order[:i], c.order[i+1:]...)
+					break
+				}
+			}
+		}
+		c.mu.Unlock()
+	}
+	return update.pairs, update.err
+}
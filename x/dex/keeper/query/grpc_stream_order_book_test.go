@@ -0,0 +1,154 @@
+package query_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	keeperquery "github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type mockOrderBookStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	cancel   context.CancelFunc
+	expected int
+	received []*types.SubscribeOrderBookResponse
+}
+
+func (s *mockOrderBookStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockOrderBookStream) Send(resp *types.SubscribeOrderBookResponse) error {
+	s.received = append(s.received, resp)
+	if len(s.received) == s.expected {
+		s.cancel()
+	}
+	return nil
+}
+
+func longBook(price int64, quantity int64) types.LongBook {
+	return types.LongBook{
+		Price: sdk.NewDec(price),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(price),
+			Quantity:   sdk.NewDec(quantity),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+		},
+	}
+}
+
+func TestSubscribeOrderBook(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	order := &types.Order{Id: 1, PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom}
+
+	ctx1 := ctx.WithBlockHeight(1)
+	keeper.SetLongBook(ctx1, keepertest.TestContract, longBook(10, 5))
+	keeper.SetLongBook(ctx1, keepertest.TestContract, longBook(9, 1))
+	keeper.SetMatchResult(ctx1, keepertest.TestContract, types.NewMatchResult([]*types.Order{order}, []*types.Cancellation{}, []*types.SettlementEntry{}))
+
+	ctx2, _ := ctx1.CacheContext()
+	ctx2 = ctx2.WithBlockHeight(2)
+	keeper.SetLongBook(ctx2, keepertest.TestContract, longBook(10, 3))
+	keeper.RemoveLongBookByPrice(ctx2, keepertest.TestContract, sdk.NewDec(9), keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	settlement := &types.SettlementEntry{
+		Account:    keepertest.TestAccount,
+		PriceDenom: keepertest.TestPriceDenom,
+		AssetDenom: keepertest.TestAssetDenom,
+		Quantity:   sdk.NewDec(2),
+		OrderId:    1,
+	}
+	keeper.SetMatchResult(ctx2, keepertest.TestContract, types.NewMatchResult([]*types.Order{}, []*types.Cancellation{}, []*types.SettlementEntry{settlement}))
+
+	// no match result is written at height 3
+	ctx3, _ := ctx2.CacheContext()
+	ctx3 = ctx3.WithBlockHeight(3)
+	keeper.SetLongBook(ctx3, keepertest.TestContract, longBook(8, 1))
+
+	contexts := map[int64]sdk.Context{1: ctx1, 2: ctx2, 3: ctx3}
+	loads := 0
+	wrapper := keeperquery.NewStreamKeeperWrapper(keeper, func(height int64) (sdk.Context, error) {
+		loads++
+		c, ok := contexts[height]
+		if !ok {
+			return sdk.Context{}, fmt.Errorf("height %d is pruned", height)
+		}
+		return c, nil
+	}, func() int64 { return 3 }, time.Millisecond)
+
+	streamCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream := &mockOrderBookStream{ctx: streamCtx, cancel: cancel, expected: 2}
+	err := wrapper.SubscribeOrderBook(&types.SubscribeOrderBookRequest{ContractAddr: keepertest.TestContract, StartHeight: 1}, stream)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 2, len(stream.received))
+
+	first := stream.received[0]
+	require.Equal(t, int64(1), first.Height)
+	require.Equal(t, 1, len(first.Pairs))
+	require.Equal(t, []*types.DepthLevel{
+		{Price: sdk.NewDec(9), Quantity: sdk.NewDec(1)},
+		{Price: sdk.NewDec(10), Quantity: sdk.NewDec(5)},
+	}, first.Pairs[0].LongLevels)
+	require.Empty(t, first.Pairs[0].ShortLevels)
+	require.Empty(t, first.Pairs[0].Settlements)
+
+	second := stream.received[1]
+	require.Equal(t, int64(2), second.Height)
+	require.Equal(t, 1, len(second.Pairs))
+	require.Equal(t, []*types.DepthLevel{
+		{Price: sdk.NewDec(9), Quantity: sdk.ZeroDec()},
+		{Price: sdk.NewDec(10), Quantity: sdk.NewDec(3)},
+	}, second.Pairs[0].LongLevels)
+	require.Equal(t, 1, len(second.Pairs[0].Settlements))
+	require.Equal(t, uint64(1), second.Pairs[0].Settlements[0].OrderId)
+
+	// other subscribers are served the updates that have already been computed
+	loadsForFirstSubscriber := loads
+	streamCtx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream = &mockOrderBookStream{ctx: streamCtx, cancel: cancel, expected: 2}
+	err = wrapper.SubscribeOrderBook(&types.SubscribeOrderBookRequest{
+		ContractAddr: keepertest.TestContract,
+		StartHeight:  1,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   keepertest.TestAssetDenom,
+	}, stream)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, first, stream.received[0])
+	require.Equal(t, second, stream.received[1])
+	require.Equal(t, loadsForFirstSubscriber, loads)
+
+	// subscribers to other pairs get no updates
+	streamCtx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	stream = &mockOrderBookStream{ctx: streamCtx, cancel: cancel}
+	err = wrapper.SubscribeOrderBook(&types.SubscribeOrderBookRequest{
+		ContractAddr: keepertest.TestContract,
+		StartHeight:  1,
+		PriceDenom:   keepertest.TestPriceDenom,
+		AssetDenom:   "other",
+	}, stream)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Empty(t, stream.received)
+}
+
+func TestSubscribeOrderBookPrunedHeight(t *testing.T) {
+	keeper, _ := keepertest.DexKeeper(t)
+	wrapper := keeperquery.NewStreamKeeperWrapper(keeper, func(height int64) (sdk.Context, error) {
+		return sdk.Context{}, fmt.Errorf("height %d is pruned", height)
+	}, func() int64 { return 3 }, time.Millisecond)
+
+	stream := &mockOrderBookStream{ctx: context.Background()}
+	err := wrapper.SubscribeOrderBook(&types.SubscribeOrderBookRequest{ContractAddr: keepertest.TestContract, StartHeight: 1}, stream)
+	require.Error(t, err)
+	require.Empty(t, stream.received)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// Since cosmwasm would amplify gas limit by a multiplier for its internal gas metering,
+// the rent balance needs to be capped so that the amplified result doesn't exceed uint64 limit.
+func (k Keeper) GetMaxAllowedRentBalance() uint64 {
+	return types.MaxAllowedRentBalance
+}
+
+func (k Keeper) SetRentTopUp(ctx sdk.Context, topUp types.RentTopUp) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RentTopUpPrefix(topUp.ContractAddr), k.Cdc.MustMarshal(&topUp))
+}
+
+func (k Keeper) GetRentTopUp(ctx sdk.Context, contractAddr string) (types.RentTopUp, bool) {
+	store := ctx.KVStore(k.storeKey)
+	res := types.RentTopUp{}
+	b := store.Get(types.RentTopUpPrefix(contractAddr))
+	if b == nil {
+		return res, false
+	}
+	k.Cdc.MustUnmarshal(b, &res)
+	return res, true
+}
+
+func (k Keeper) GetAllRentTopUps(ctx sdk.Context) []types.RentTopUp {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RentTopUpKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	list := []types.RentTopUp{}
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RentTopUp
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+func (k Keeper) DeleteRentTopUp(ctx sdk.Context, contractAddr string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RentTopUpPrefix(contractAddr))
+}
+
+// rent charges of a contract are keyed by height, so that they can be paginated and pruned in
+// chronological order
+func (k Keeper) SetRentCharge(ctx sdk.Context, contractAddr string, charge types.RentCharge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RentChargePrefix(contractAddr))
+	store.Set(GetKeyForTs(charge.Height), k.Cdc.MustMarshal(&charge))
+}
+
+func (k Keeper) GetRentChargesPaginated(ctx sdk.Context, contractAddr string, page *query.PageRequest) (list []types.RentCharge, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RentChargePrefix(contractAddr))
+
+	pageRes, err = query.Paginate(store, page, func(key []byte, value []byte) error {
+		var charge types.RentCharge
+		if err := k.Cdc.Unmarshal(value, &charge); err != nil {
+			return err
+		}
+
+		list = append(list, charge)
+		return nil
+	})
+
+	return
+}
+
+func (k Keeper) GetAllRentCharges(ctx sdk.Context, contractAddr string) []types.RentCharge {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RentChargePrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	list := []types.RentCharge{}
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RentCharge
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// DeleteRentChargesBefore removes the rent charges of a contract made before the given height
+func (k Keeper) DeleteRentChargesBefore(ctx sdk.Context, contractAddr string, height uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RentChargePrefix(contractAddr))
+	// the iterator can't be open while deleting
+	keys := [][]byte{}
+	iterator := store.Iterator(nil, GetKeyForTs(height))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) RemoveRentDataForContract(ctx sdk.Context, contractAddr string) {
+	k.DeleteRentTopUp(ctx, contractAddr)
+	k.removeAllForPrefix(ctx, types.RentChargePrefix(contractAddr))
+}
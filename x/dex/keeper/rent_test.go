@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRentTopUp(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	_, found := keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.False(t, found)
+
+	topUp := types.RentTopUp{
+		ContractAddr: keepertest.TestContract,
+		Funder:       keepertest.TestAccount,
+		Threshold:    100,
+		Amount:       10,
+		SpendLimit:   50,
+	}
+	keeper.SetRentTopUp(ctx, topUp)
+	res, found := keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.True(t, found)
+	require.Equal(t, topUp, res)
+	require.Equal(t, []types.RentTopUp{topUp}, keeper.GetAllRentTopUps(ctx))
+
+	keeper.DeleteRentTopUp(ctx, keepertest.TestContract)
+	_, found = keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.False(t, found)
+	require.Empty(t, keeper.GetAllRentTopUps(ctx))
+}
+
+func TestRentCharges(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	for _, height := range []uint64{3, 1, 2} {
+		keeper.SetRentCharge(ctx, keepertest.TestContract, types.RentCharge{Height: height, Amount: height, RentBalance: 100 - height})
+	}
+	keeper.SetRentCharge(ctx, "other", types.RentCharge{Height: 1, Amount: 1, RentBalance: 1})
+
+	charges, pageRes, err := keeper.GetRentChargesPaginated(ctx, keepertest.TestContract, &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(charges))
+	require.Equal(t, uint64(1), charges[0].Height)
+	require.Equal(t, uint64(2), charges[1].Height)
+	require.NotNil(t, pageRes.NextKey)
+
+	keeper.DeleteRentChargesBefore(ctx, keepertest.TestContract, 3)
+	charges, _, err = keeper.GetRentChargesPaginated(ctx, keepertest.TestContract, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.RentCharge{{Height: 3, Amount: 3, RentBalance: 97}}, charges)
+
+	keeper.SetRentTopUp(ctx, types.RentTopUp{ContractAddr: keepertest.TestContract})
+	keeper.RemoveRentDataForContract(ctx, keepertest.TestContract)
+	charges, _, err = keeper.GetRentChargesPaginated(ctx, keepertest.TestContract, &query.PageRequest{})
+	require.NoError(t, err)
+	require.Empty(t, charges)
+	_, found := keeper.GetRentTopUp(ctx, keepertest.TestContract)
+	require.False(t, found)
+	// other contracts are left as is
+	charges, _, err = keeper.GetRentChargesPaginated(ctx, "other", &query.PageRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(charges))
+}
@@ -11,8 +11,14 @@ import (
 // SetShortBook set a specific shortBook in the store
 func (k Keeper) SetShortBook(ctx sdk.Context, contractAddr string, shortBook types.ShortBook) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderBookPrefix(false, contractAddr, shortBook.Entry.PriceDenom, shortBook.Entry.AssetDenom))
+	oldAllocations := []*types.Allocation{}
+	if old, found := k.GetShortBookByPrice(ctx, contractAddr, shortBook.Entry.Price, shortBook.Entry.PriceDenom, shortBook.Entry.AssetDenom); found {
+		oldAllocations = old.Entry.Allocations
+	}
 	b := k.Cdc.MustMarshal(&shortBook)
 	store.Set(GetKeyForShortBook(shortBook), b)
+	k.setDepthLevel(ctx, false, contractAddr, shortBook.Entry)
+	k.updateAccountOrderLevels(ctx, false, contractAddr, shortBook.Entry.PriceDenom, shortBook.Entry.AssetDenom, shortBook.Entry.Price, oldAllocations, shortBook.Entry.Allocations)
 }
 
 func (k Keeper) SetShortOrderBookEntry(ctx sdk.Context, contractAddr string, shortBook types.OrderBookEntry) {
@@ -36,7 +42,11 @@ func (k Keeper) GetShortOrderBookEntryByPrice(ctx sdk.Context, contractAddr stri
 
 func (k Keeper) RemoveShortBookByPrice(ctx sdk.Context, contractAddr string, price sdk.Dec, priceDenom string, assetDenom string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderBookPrefix(false, contractAddr, priceDenom, assetDenom))
+	if old, found := k.GetShortBookByPrice(ctx, contractAddr, price, priceDenom, assetDenom); found {
+		k.updateAccountOrderLevels(ctx, false, contractAddr, priceDenom, assetDenom, price, old.Entry.Allocations, nil)
+	}
 	store.Delete(GetKeyForPrice(price))
+	k.removeDepthLevel(ctx, false, contractAddr, price, priceDenom, assetDenom)
 }
 
 // GetAllShortBook returns all shortBook
@@ -158,6 +168,8 @@ func (k Keeper) GetShortAllocationForOrderID(ctx sdk.Context, contractAddr strin
 
 func (k Keeper) RemoveAllShortBooksForContract(ctx sdk.Context, contractAddr string) {
 	k.removeAllForPrefix(ctx, types.OrderBookContractPrefix(false, contractAddr))
+	k.removeAllForPrefix(ctx, types.DepthContractPrefix(false, contractAddr))
+	k.removeAllForPrefix(ctx, types.AccountOrderContractPrefix(false, contractAddr))
 }
 
 func GetKeyForShortBook(shortBook types.ShortBook) []byte {
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// The trigger book stores orders by id. Orders waiting for their trigger price are also indexed by
+// direction and trigger price followed by id, orders that have reached it are indexed in a separate
+// set, and all orders are indexed by account, so that each block only reads the orders it acts on.
+
+// SetTriggeredOrder stores a stop loss/limit order that is waiting for (or has reached) its trigger price
+func (k Keeper) SetTriggeredOrder(ctx sdk.Context, contractAddr string, order types.Order) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggerOrderBookPrefix(contractAddr, order.PriceDenom, order.AssetDenom),
+	)
+	if old, found := k.GetTriggeredOrderByID(ctx, contractAddr, order.Id, order.PriceDenom, order.AssetDenom); found {
+		k.forEachTriggeredOrderIndex(ctx, contractAddr, old, func(store prefix.Store, key []byte) {
+			store.Delete(key)
+		})
+	}
+	b := k.Cdc.MustMarshal(&order)
+	store.Set(GetKeyForOrderID(order.Id), b)
+	k.forEachTriggeredOrderIndex(ctx, contractAddr, order, func(store prefix.Store, key []byte) {
+		store.Set(key, GetKeyForOrderID(order.Id))
+	})
+}
+
+func (k Keeper) GetTriggeredOrderByID(ctx sdk.Context, contractAddr string, orderID uint64, priceDenom string, assetDenom string) (val types.Order, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom),
+	)
+	b := store.Get(GetKeyForOrderID(orderID))
+	if b == nil {
+		return val, false
+	}
+	k.Cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) RemoveTriggeredOrder(ctx sdk.Context, contractAddr string, orderID uint64, priceDenom string, assetDenom string) {
+	order, found := k.GetTriggeredOrderByID(ctx, contractAddr, orderID, priceDenom, assetDenom)
+	if !found {
+		return
+	}
+	k.forEachTriggeredOrderIndex(ctx, contractAddr, order, func(store prefix.Store, key []byte) {
+		store.Delete(key)
+	})
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom),
+	)
+	store.Delete(GetKeyForOrderID(orderID))
+}
+
+// GetTriggerReachedOrdersForPair returns the orders of the pair's trigger book that have reached
+// their trigger price, by id
+func (k Keeper) GetTriggerReachedOrdersForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) []types.Order {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggeredOrderPrefix(contractAddr, priceDenom, assetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	return k.getTriggeredOrdersFromIndex(ctx, contractAddr, priceDenom, assetDenom, iterator)
+}
+
+// GetTriggerCrossedOrdersForPair returns the orders of the pair's trigger book that are still
+// waiting for their trigger price and that would be triggered by the given price, i.e. long orders
+// with a trigger price at or below it and short orders with a trigger price at or above it
+func (k Keeper) GetTriggerCrossedOrdersForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, price sdk.Dec) (list []types.Order) {
+	priceKey := GetKeyForPrice(price)
+	longStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerPricePrefix(true, contractAddr, priceDenom, assetDenom))
+	longIterator := longStore.Iterator(nil, sdk.PrefixEndBytes(priceKey))
+	list = append(list, k.getTriggeredOrdersFromIndex(ctx, contractAddr, priceDenom, assetDenom, longIterator)...)
+	longIterator.Close()
+
+	shortStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerPricePrefix(false, contractAddr, priceDenom, assetDenom))
+	shortIterator := shortStore.Iterator(priceKey, nil)
+	list = append(list, k.getTriggeredOrdersFromIndex(ctx, contractAddr, priceDenom, assetDenom, shortIterator)...)
+	shortIterator.Close()
+
+	return
+}
+
+// GetAccountTriggeredOrdersForPair returns the account's orders in the pair's trigger book, by id
+func (k Keeper) GetAccountTriggeredOrdersForPair(ctx sdk.Context, contractAddr string, account string, priceDenom string, assetDenom string) []types.Order {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerAccountOrderPrefix(contractAddr, priceDenom, assetDenom, account))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	return k.getTriggeredOrdersFromIndex(ctx, contractAddr, priceDenom, assetDenom, iterator)
+}
+
+func (k Keeper) GetAllTriggeredOrdersForPair(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllTriggeredOrders returns triggered orders across all pairs of a contract
+func (k Keeper) GetAllTriggeredOrders(ctx sdk.Context, contractAddr string) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerOrderBookContractPrefix(contractAddr))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) RemoveAllTriggeredOrdersForContract(ctx sdk.Context, contractAddr string) {
+	k.removeAllForPrefix(ctx, types.TriggerOrderBookContractPrefix(contractAddr))
+	k.removeAllForPrefix(ctx, types.TriggerPriceContractPrefix(true, contractAddr))
+	k.removeAllForPrefix(ctx, types.TriggerPriceContractPrefix(false, contractAddr))
+	k.removeAllForPrefix(ctx, types.TriggeredOrderContractPrefix(contractAddr))
+	k.removeAllForPrefix(ctx, types.TriggerAccountOrderContractPrefix(contractAddr))
+}
+
+// forEachTriggeredOrderIndex calls f with the store and key of each index entry of the order
+func (k Keeper) forEachTriggeredOrderIndex(ctx sdk.Context, contractAddr string, order types.Order, f func(prefix.Store, []byte)) {
+	f(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerAccountOrderPrefix(contractAddr, order.PriceDenom, order.AssetDenom, order.Account)),
+		GetKeyForOrderID(order.Id),
+	)
+	if order.TriggerStatus {
+		f(
+			prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggeredOrderPrefix(contractAddr, order.PriceDenom, order.AssetDenom)),
+			GetKeyForOrderID(order.Id),
+		)
+		return
+	}
+	// an order without a trigger price can't be triggered
+	if order.TriggerPrice.IsNil() {
+		return
+	}
+	long := order.PositionDirection == types.PositionDirection_LONG
+	f(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerPricePrefix(long, contractAddr, order.PriceDenom, order.AssetDenom)),
+		append(GetKeyForPrice(order.TriggerPrice), GetKeyForOrderID(order.Id)...),
+	)
+}
+
+// getTriggeredOrdersFromIndex loads the orders whose ids are the values of an index iterator
+func (k Keeper) getTriggeredOrdersFromIndex(ctx sdk.Context, contractAddr string, priceDenom string, assetDenom string, iterator sdk.Iterator) (list []types.Order) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TriggerOrderBookPrefix(contractAddr, priceDenom, assetDenom))
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Order
+		k.Cdc.MustUnmarshal(store.Get(iterator.Value()), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+func GetKeyForOrderID(orderID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, orderID)
+	return key
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggeredOrder(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	order := types.Order{
+		Id:                1,
+		Account:           keepertest.TestAccount,
+		ContractAddr:      keepertest.TestContract,
+		PriceDenom:        keepertest.TestPriceDenom,
+		AssetDenom:        keepertest.TestAssetDenom,
+		OrderType:         types.OrderType_STOPLOSS,
+		PositionDirection: types.PositionDirection_LONG,
+		TriggerPrice:      sdk.NewDec(10),
+	}
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, order)
+	order.Id = 2
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, order)
+	order.Id = 3
+	order.PriceDenom = "uusdc"
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, order)
+
+	got, found := keeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 1, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.True(t, found)
+	require.Equal(t, uint64(1), got.Id)
+	require.Equal(t, sdk.NewDec(10), got.TriggerPrice)
+	_, found = keeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 3, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.False(t, found)
+
+	require.Equal(t, 2, len(keeper.GetAllTriggeredOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)))
+	require.Equal(t, 3, len(keeper.GetAllTriggeredOrders(ctx, keepertest.TestContract)))
+
+	keeper.RemoveTriggeredOrder(ctx, keepertest.TestContract, 1, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	_, found = keeper.GetTriggeredOrderByID(ctx, keepertest.TestContract, 1, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.False(t, found)
+	require.Equal(t, 2, len(keeper.GetAllTriggeredOrders(ctx, keepertest.TestContract)))
+
+	keeper.RemoveAllTriggeredOrdersForContract(ctx, keepertest.TestContract)
+	require.Equal(t, 0, len(keeper.GetAllTriggeredOrders(ctx, keepertest.TestContract)))
+}
+
+func TestTriggeredOrderIndexes(t *testing.T) {
+	keeper, ctx := keepertest.DexKeeper(t)
+	newOrder := func(id uint64, direction types.PositionDirection, triggerPrice int64, account string) types.Order {
+		return types.Order{
+			Id:                id,
+			Account:           account,
+			ContractAddr:      keepertest.TestContract,
+			PriceDenom:        keepertest.TestPriceDenom,
+			AssetDenom:        keepertest.TestAssetDenom,
+			OrderType:         types.OrderType_STOPLOSS,
+			PositionDirection: direction,
+			TriggerPrice:      sdk.NewDec(triggerPrice),
+		}
+	}
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, newOrder(1, types.PositionDirection_LONG, 100, keepertest.TestAccount))
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, newOrder(2, types.PositionDirection_LONG, 101, keepertest.TestAccount))
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, newOrder(3, types.PositionDirection_SHORT, 100, keepertest.TestAccount))
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, newOrder(4, types.PositionDirection_SHORT, 99, "other"))
+
+	ids := func(orders []types.Order) []uint64 {
+		res := []uint64{}
+		for _, order := range orders {
+			res = append(res, order.Id)
+		}
+		return res
+	}
+	crossed := func(price sdk.Dec) []uint64 {
+		return ids(keeper.GetTriggerCrossedOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, price))
+	}
+	require.Equal(t, []uint64{1, 3}, crossed(sdk.NewDec(100)))
+	require.Equal(t, []uint64{1, 2}, crossed(sdk.NewDec(101)))
+	require.Equal(t, []uint64{4, 3}, crossed(sdk.NewDec(99)))
+	require.Equal(t, []uint64{1}, crossed(sdk.MustNewDecFromStr("100.5")))
+	require.Equal(t, []uint64{1, 2, 3}, ids(keeper.GetAccountTriggeredOrdersForPair(ctx, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPriceDenom, keepertest.TestAssetDenom)))
+	require.Empty(t, keeper.GetTriggerReachedOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+
+	// a triggered order moves from the trigger price index to the triggered set
+	order := newOrder(1, types.PositionDirection_LONG, 100, keepertest.TestAccount)
+	order.TriggerStatus = true
+	keeper.SetTriggeredOrder(ctx, keepertest.TestContract, order)
+	require.Equal(t, []uint64{3}, crossed(sdk.NewDec(100)))
+	require.Equal(t, []uint64{1}, ids(keeper.GetTriggerReachedOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)))
+
+	keeper.RemoveTriggeredOrder(ctx, keepertest.TestContract, 1, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	keeper.RemoveTriggeredOrder(ctx, keepertest.TestContract, 3, keepertest.TestPriceDenom, keepertest.TestAssetDenom)
+	require.Empty(t, keeper.GetTriggerReachedOrdersForPair(ctx, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+	require.Equal(t, []uint64{2}, ids(keeper.GetAccountTriggeredOrdersForPair(ctx, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPriceDenom, keepertest.TestAssetDenom)))
+	require.Equal(t, []uint64{4}, crossed(sdk.NewDec(99)))
+
+	keeper.RemoveAllTriggeredOrdersForContract(ctx, keepertest.TestContract)
+	require.Empty(t, crossed(sdk.NewDec(101)))
+	require.Empty(t, keeper.GetAccountTriggeredOrdersForPair(ctx, keepertest.TestContract, "other", keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+}
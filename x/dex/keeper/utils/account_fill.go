@@ -0,0 +1,26 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// UpdateAccountFillsFromSettlements indexes a block's settlements of a contract by account, and
+// prunes the fills that have fallen out of the retention window. Disabling the retention prunes
+// all previously indexed fills.
+func UpdateAccountFillsFromSettlements(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr string,
+	settlements []*types.SettlementEntry,
+) {
+	retention := keeper.GetAccountFillRetention(ctx)
+	height := uint64(ctx.BlockHeight())
+	if retention > 0 {
+		keeper.SetAccountFills(ctx, contractAddr, settlements)
+	}
+	if retention <= height {
+		keeper.DeleteAccountFillsBefore(ctx, contractAddr, height+1-retention)
+	}
+}
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// UpdateCandlesFromExecutionOutcome folds a block's execution into the current candle of every
+// supported interval, and prunes candles beyond the retention once a new candle is opened.
+func UpdateCandlesFromExecutionOutcome(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	outcome exchange.ExecutionOutcome,
+) {
+	retention := keeper.GetCandleRetention(ctx)
+	if retention == 0 || outcome.TotalQuantity.IsZero() {
+		return
+	}
+
+	avgPrice := outcome.TotalNotional.Quo(outcome.TotalQuantity)
+	// every fill is settled for both the long and the short side
+	volume, notional := sdk.ZeroDec(), sdk.ZeroDec()
+	for _, settlement := range outcome.Settlements {
+		volume = volume.Add(settlement.Quantity)
+		notional = notional.Add(settlement.Quantity.Mul(settlement.ExecutionCostOrProceed))
+	}
+	volume, notional = volume.QuoInt64(2), notional.QuoInt64(2)
+
+	now := uint64(ctx.BlockTime().Unix())
+	for _, interval := range types.SupportedCandleIntervals {
+		begin := now - now%interval
+		candle, found := keeper.GetCandle(ctx, string(contractAddr), pair, interval, begin)
+		if !found {
+			candle = types.Candle{
+				BeginTimestamp: begin,
+				EndTimestamp:   begin + interval,
+				Open:           avgPrice,
+				High:           outcome.MaxPrice,
+				Low:            outcome.MinPrice,
+				Volume:         sdk.ZeroDec(),
+				Notional:       sdk.ZeroDec(),
+			}
+			// the new candle counts towards the retention
+			if lookback := (retention - 1) * interval; lookback < begin {
+				keeper.DeleteCandlesBefore(ctx, string(contractAddr), pair, interval, begin-lookback)
+			}
+		}
+		candle.High = sdk.MaxDec(candle.High, outcome.MaxPrice)
+		candle.Low = sdk.MinDec(candle.Low, outcome.MinPrice)
+		candle.Close = avgPrice
+		candle.Volume = candle.Volume.Add(volume)
+		candle.Notional = candle.Notional.Add(notional)
+		keeper.SetCandle(ctx, string(contractAddr), pair, interval, candle)
+	}
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/exchange"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// TripCircuitBreakerIfNeeded pauses matching of the pair for the configured number of blocks if the
+// block's clearing price has moved further than the threshold away from the reference price. It needs
+// to run before the block's price snapshot is stored, since that becomes the reference price. The
+// fills of the block that trips the circuit breaker stand.
+func TripCircuitBreakerIfNeeded(
+	ctx sdk.Context,
+	keeper *keeper.Keeper,
+	contractAddr types.ContractAddress,
+	pair types.Pair,
+	outcome exchange.ExecutionOutcome,
+) {
+	threshold := keeper.GetCircuitBreakerThreshold(ctx)
+	pauseBlocks := keeper.GetCircuitBreakerPauseBlocks(ctx)
+	if threshold.IsNil() || !threshold.IsPositive() || pauseBlocks == 0 || outcome.TotalQuantity.IsZero() {
+		return
+	}
+	referencePrice, found := keeper.GetReferencePrice(ctx, string(contractAddr), pair)
+	if !found || !referencePrice.IsPositive() {
+		return
+	}
+	clearingPrice := outcome.TotalNotional.Quo(outcome.TotalQuantity)
+	if clearingPrice.Sub(referencePrice).Abs().Quo(referencePrice).LTE(threshold) {
+		return
+	}
+
+	halt, _ := keeper.GetPairHalt(ctx, string(contractAddr), pair)
+	halt.CircuitBreakerUntilHeight = uint64(ctx.BlockHeight()) + 1 + pauseBlocks
+	keeper.SetPairHalt(ctx, string(contractAddr), halt)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCircuitBreaker,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, string(contractAddr)),
+		sdk.NewAttribute(types.AttributeKeyPriceDenom, pair.PriceDenom),
+		sdk.NewAttribute(types.AttributeKeyAssetDenom, pair.AssetDenom),
+		sdk.NewAttribute(types.AttributeKeyPrice, clearingPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyReferencePrice, referencePrice.String()),
+		sdk.NewAttribute(types.AttributeKeyUntilHeight, fmt.Sprint(halt.CircuitBreakerUntilHeight)),
+	))
+}
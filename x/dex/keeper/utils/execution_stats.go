@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+)
+
+// RecordExecutionStats records the execution stats of the given contracts in this block, along with
+// the truncated error that dropped a contract from the block if any, and prunes the stats that have
+// fallen out of the retention window.
+func RecordExecutionStats(ctx sdk.Context, dexkeeper *keeper.Keeper, stats *dexcache.ExecutionStats, contractAddrs []string, errors map[string]string) {
+	retention := dexkeeper.GetExecutionStatsRetention(ctx)
+	height := uint64(ctx.BlockHeight())
+	sorted := make([]string, len(contractAddrs))
+	copy(sorted, contractAddrs)
+	sort.Strings(sorted)
+	for _, contractAddr := range sorted {
+		if retention > 0 {
+			contractStats, wallTime := stats.Get(contractAddr)
+			contractStats.Height = height
+			contractStats.Error = errors[contractAddr]
+			dexkeeper.SetContractExecutionStats(ctx, contractAddr, contractStats)
+			dexkeeper.ExecutionWallTimes.Set(contractAddr, height, wallTime)
+		}
+		if retention <= height {
+			dexkeeper.DeleteContractExecutionStatsBefore(ctx, contractAddr, height+1-retention)
+		}
+	}
+}
@@ -19,6 +19,10 @@ func SetPriceStateFromExecutionOutcome(
 	}
 
 	avgPrice := outcome.TotalNotional.Quo(outcome.TotalQuantity)
+	if outcome.ClearingPrice != nil {
+		// limit orders of pairs in the UNIFORM_PRICE clearing mode all trade at the clearing price
+		avgPrice = *outcome.ClearingPrice
+	}
 	priceState := types.Price{
 		Pair:                       &pair,
 		Price:                      avgPrice,
@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	appparams "github.com/sei-protocol/sei-chain/app/params"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// TopUpRents pulls rent from the funding accounts of contracts whose rent balance has fallen below
+// their top-up threshold. Suspended contracts aren't topped up since rent doesn't unsuspend them.
+// A top-up that can't be made is skipped for the block and reported with an event.
+func TopUpRents(ctx sdk.Context, dexkeeper *keeper.Keeper) {
+	for _, topUp := range dexkeeper.GetAllRentTopUps(ctx) {
+		contract, err := dexkeeper.GetContract(ctx, topUp.ContractAddr)
+		if err != nil || contract.Suspended || contract.RentBalance >= topUp.Threshold {
+			continue
+		}
+		if err := topUpRent(ctx, dexkeeper, contract, topUp); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to top up rent of %s: %s", topUp.ContractAddr, err))
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeRentTopUpFailed,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, topUp.ContractAddr),
+				sdk.NewAttribute(types.AttributeKeyFunder, topUp.Funder),
+				sdk.NewAttribute(types.AttributeKeyStatusDescription, err.Error()),
+			))
+		}
+	}
+}
+
+func topUpRent(ctx sdk.Context, dexkeeper *keeper.Keeper, contract types.ContractInfoV2, topUp types.RentTopUp) error {
+	amount := topUp.Amount
+	if topUp.SpendLimit < amount {
+		amount = topUp.SpendLimit
+	}
+	if maxRent := dexkeeper.GetMaxAllowedRentBalance(); amount > maxRent || contract.RentBalance > maxRent-amount {
+		return fmt.Errorf("rent balance %d would exceed the limit of %d", contract.RentBalance, dexkeeper.GetMaxAllowedRentBalance())
+	}
+	funder, err := sdk.AccAddressFromBech32(topUp.Funder)
+	if err != nil {
+		return err
+	}
+	// the transfer and the bookkeeping either both happen or neither does
+	cacheCtx, write := ctx.CacheContext()
+	if err := dexkeeper.BankKeeper.SendCoins(
+		cacheCtx, funder, dexkeeper.AccountKeeper.GetModuleAddress(types.ModuleName),
+		sdk.NewCoins(sdk.NewCoin(appparams.BaseCoinUnit, sdk.NewIntFromUint64(amount))),
+	); err != nil {
+		return err
+	}
+	contract.RentBalance += amount
+	if err := dexkeeper.SetContract(cacheCtx, &contract); err != nil {
+		return err
+	}
+	topUp.SpendLimit -= amount
+	if topUp.SpendLimit == 0 {
+		dexkeeper.DeleteRentTopUp(cacheCtx, topUp.ContractAddr)
+	} else {
+		dexkeeper.SetRentTopUp(cacheCtx, topUp)
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRentTopUp,
+		sdk.NewAttribute(types.AttributeKeyContractAddress, topUp.ContractAddr),
+		sdk.NewAttribute(types.AttributeKeyFunder, topUp.Funder),
+		sdk.NewAttribute(types.AttributeKeyAmount, fmt.Sprint(amount)),
+		sdk.NewAttribute(types.AttributeKeyRentBalance, fmt.Sprint(contract.RentBalance)),
+		sdk.NewAttribute(types.AttributeKeySpendLimit, fmt.Sprint(topUp.SpendLimit)),
+	))
+	return nil
+}
+
+// RecordRentCharges records the rent charged to contracts in this block, pruning the charges that
+// have fallen out of the retention window, and emits a low_rent event for contracts whose balance
+// has just dropped below the low-water mark.
+func RecordRentCharges(ctx sdk.Context, dexkeeper *keeper.Keeper, preRents map[string]uint64, postRents map[string]uint64) {
+	retention := dexkeeper.GetRentHistoryRetention(ctx)
+	lowWaterMark := dexkeeper.GetRentLowWaterMark(ctx)
+	height := uint64(ctx.BlockHeight())
+	contractAddrs := []string{}
+	for contractAddr := range preRents {
+		contractAddrs = append(contractAddrs, contractAddr)
+	}
+	sort.Strings(contractAddrs)
+	for _, contractAddr := range contractAddrs {
+		preRent := preRents[contractAddr]
+		postRent, ok := postRents[contractAddr]
+		if !ok || postRent >= preRent {
+			continue
+		}
+		if retention > 0 {
+			dexkeeper.SetRentCharge(ctx, contractAddr, types.RentCharge{
+				Height:      height,
+				Amount:      preRent - postRent,
+				RentBalance: postRent,
+			})
+		}
+		if retention <= height {
+			dexkeeper.DeleteRentChargesBefore(ctx, contractAddr, height+1-retention)
+		}
+		if lowWaterMark > 0 && preRent >= lowWaterMark && postRent < lowWaterMark {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeLowRent,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr),
+				sdk.NewAttribute(types.AttributeKeyRentBalance, fmt.Sprint(postRent)),
+			))
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/sei-protocol/sei-chain/utils"
 	"github.com/sei-protocol/sei-chain/utils/logging"
 	"github.com/sei-protocol/sei-chain/utils/metrics"
+	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 )
@@ -31,6 +32,23 @@ func getMsgType(msg interface{}) string {
 	}
 }
 
+func getExecutionPhase(msg interface{}) (dexcache.ExecutionPhase, bool) {
+	switch msg := msg.(type) {
+	case types.SudoSettlementMsg:
+		return dexcache.SettlementPhase, true
+	case types.SudoOrderPlacementMsg:
+		// deposits are sent in a placement message without orders
+		if len(msg.OrderPlacements.Orders) == 0 && len(msg.OrderPlacements.Deposits) > 0 {
+			return dexcache.DepositPhase, true
+		}
+		return dexcache.PlacementPhase, true
+	case types.SudoOrderCancellationMsg:
+		return dexcache.CancellationPhase, true
+	default:
+		return 0, false
+	}
+}
+
 func sudo(sdkCtx sdk.Context, k *keeper.Keeper, contractAddress sdk.AccAddress, wasmMsg []byte, msgType string) ([]byte, uint64, error) {
 	defer utils.PanicHandler(func(err any) {
 		utils.MetricsPanicCallback(err, sdkCtx, fmt.Sprintf("%s|%s", contractAddress, msgType))
@@ -102,7 +120,13 @@ func CallContractSudo(sdkCtx sdk.Context, k *keeper.Keeper, contractAddr string,
 		return []byte{}, err
 	}
 	msgType := getMsgType(msg)
+	sudoStart := time.Now()
 	data, gasUsed, suderr := sudo(sdkCtx, k, contractAddress, wasmMsg, msgType)
+	if stats := dexcache.GetExecutionStats(sdkCtx); stats != nil {
+		if phase, ok := getExecutionPhase(msg); ok {
+			stats.AddSudo(contractAddr, phase, gasUsed, time.Since(sudoStart))
+		}
+	}
 	if err := k.ChargeRentForGas(sdkCtx, contractAddr, gasUsed, gasAllowance); err != nil {
 		metrics.IncrementSudoFailCount(msgType)
 		sdkCtx.Logger().Error(err.Error())
@@ -0,0 +1,13 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// V17ToV18 only sets the newly added candle retention so that the other params are kept as is
+func V17ToV18(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	dexkeeper.Paramstore.Set(ctx, types.KeyCandleRetention, uint64(types.DefaultCandleRetention))
+	return nil
+}
@@ -0,0 +1,30 @@
+package migrations_test
+
+import (
+	"reflect"
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate17to18(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	// write old params
+	prevParams := types.DefaultParams()
+	prevParams.MaxOrderPerPrice = 5
+	prevParams.CandleRetention = 0
+	for _, pair := range prevParams.ParamSetPairs() {
+		if string(pair.Key) != string(types.KeyCandleRetention) {
+			dexkeeper.Paramstore.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+		}
+	}
+
+	err := migrations.V17ToV18(ctx, *dexkeeper)
+	require.NoError(t, err)
+	params := dexkeeper.GetParams(ctx)
+	require.Equal(t, uint64(types.DefaultCandleRetention), params.CandleRetention)
+	require.Equal(t, uint64(5), params.MaxOrderPerPrice)
+}
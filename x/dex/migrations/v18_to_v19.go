@@ -0,0 +1,13 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// V18ToV19 only sets the newly added account fill retention so that the other params are kept as is
+func V18ToV19(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	dexkeeper.Paramstore.Set(ctx, types.KeyAccountFillRetention, uint64(types.DefaultAccountFillRetention))
+	return nil
+}
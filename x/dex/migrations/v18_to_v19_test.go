@@ -0,0 +1,29 @@
+package migrations_test
+
+import (
+	"reflect"
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate18to19(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	// write old params
+	prevParams := types.DefaultParams()
+	prevParams.CandleRetention = 60
+	for _, pair := range prevParams.ParamSetPairs() {
+		if string(pair.Key) != string(types.KeyAccountFillRetention) {
+			dexkeeper.Paramstore.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+		}
+	}
+
+	err := migrations.V18ToV19(ctx, *dexkeeper)
+	require.NoError(t, err)
+	params := dexkeeper.GetParams(ctx)
+	require.Equal(t, uint64(types.DefaultAccountFillRetention), params.AccountFillRetention)
+	require.Equal(t, uint64(60), params.CandleRetention)
+}
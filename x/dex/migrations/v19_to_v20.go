@@ -0,0 +1,14 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// V19ToV20 only sets the newly added rent params so that the other params are kept as is
+func V19ToV20(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	dexkeeper.Paramstore.Set(ctx, types.KeyRentLowWaterMark, uint64(types.DefaultRentLowWaterMark))
+	dexkeeper.Paramstore.Set(ctx, types.KeyRentHistoryRetention, uint64(types.DefaultRentHistoryRetention))
+	return nil
+}
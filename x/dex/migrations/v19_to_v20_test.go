@@ -0,0 +1,30 @@
+package migrations_test
+
+import (
+	"reflect"
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate19to20(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	// write old params
+	prevParams := types.DefaultParams()
+	prevParams.AccountFillRetention = 100
+	for _, pair := range prevParams.ParamSetPairs() {
+		if string(pair.Key) != string(types.KeyRentLowWaterMark) && string(pair.Key) != string(types.KeyRentHistoryRetention) {
+			dexkeeper.Paramstore.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+		}
+	}
+
+	err := migrations.V19ToV20(ctx, *dexkeeper)
+	require.NoError(t, err)
+	params := dexkeeper.GetParams(ctx)
+	require.Equal(t, uint64(types.DefaultRentLowWaterMark), params.RentLowWaterMark)
+	require.Equal(t, uint64(types.DefaultRentHistoryRetention), params.RentHistoryRetention)
+	require.Equal(t, uint64(100), params.AccountFillRetention)
+}
@@ -0,0 +1,15 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// V20ToV21 only sets the newly added circuit breaker and price band params so that the other params are kept as is
+func V20ToV21(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	dexkeeper.Paramstore.Set(ctx, types.KeyCircuitBreakerThreshold, types.DefaultCircuitBreakerThreshold)
+	dexkeeper.Paramstore.Set(ctx, types.KeyCircuitBreakerPauseBlocks, uint64(types.DefaultCircuitBreakerPauseBlocks))
+	dexkeeper.Paramstore.Set(ctx, types.KeyPriceBand, types.DefaultPriceBand)
+	return nil
+}
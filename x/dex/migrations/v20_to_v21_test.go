@@ -0,0 +1,33 @@
+package migrations_test
+
+import (
+	"reflect"
+	"testing"
+
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate20to21(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	// write old params
+	prevParams := types.DefaultParams()
+	prevParams.RentHistoryRetention = 7
+	for _, pair := range prevParams.ParamSetPairs() {
+		if string(pair.Key) != string(types.KeyCircuitBreakerThreshold) &&
+			string(pair.Key) != string(types.KeyCircuitBreakerPauseBlocks) &&
+			string(pair.Key) != string(types.KeyPriceBand) {
+			dexkeeper.Paramstore.Set(ctx, pair.Key, reflect.Indirect(reflect.ValueOf(pair.Value)).Interface())
+		}
+	}
+
+	err := migrations.V20ToV21(ctx, *dexkeeper)
+	require.NoError(t, err)
+	params := dexkeeper.GetParams(ctx)
+	require.Equal(t, types.DefaultCircuitBreakerThreshold, params.CircuitBreakerThreshold)
+	require.Equal(t, uint64(types.DefaultCircuitBreakerPauseBlocks), params.CircuitBreakerPauseBlocks)
+	require.Equal(t, types.DefaultPriceBand, params.PriceBand)
+	require.Equal(t, uint64(7), params.RentHistoryRetention)
+}
@@ -0,0 +1,13 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+)
+
+// V21ToV22 only sets the newly added execution stats retention param so that the other params are kept as is
+func V21ToV22(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	dexkeeper.Paramstore.Set(ctx, types.KeyExecutionStatsRetention, uint64(types.DefaultExecutionStatsRetention))
+	return nil
+}
@@ -0,0 +1,20 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+)
+
+// V22ToV23 builds the depth index of existing order books, which is maintained whenever a book is
+// set from now on
+func V22ToV23(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	for _, c := range dexkeeper.GetAllContractInfo(ctx) {
+		for _, longBook := range dexkeeper.GetAllLongBook(ctx, c.ContractAddr) {
+			dexkeeper.SetLongBook(ctx, c.ContractAddr, longBook)
+		}
+		for _, shortBook := range dexkeeper.GetAllShortBook(ctx, c.ContractAddr) {
+			dexkeeper.SetShortBook(ctx, c.ContractAddr, shortBook)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate22to23(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract})
+	// write books without the depth index, as they were before the migration
+	store := ctx.KVStore(dexkeeper.GetStoreKey())
+	longBook := types.LongBook{
+		Price: sdk.NewDec(9),
+		Entry: &types.OrderEntry{Price: sdk.NewDec(9), Quantity: sdk.NewDec(2), PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom},
+	}
+	shortBook := types.ShortBook{
+		Price: sdk.NewDec(11),
+		Entry: &types.OrderEntry{Price: sdk.NewDec(11), Quantity: sdk.NewDec(3), PriceDenom: keepertest.TestPriceDenom, AssetDenom: keepertest.TestAssetDenom},
+	}
+	prefix.NewStore(store, types.OrderBookPrefix(true, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)).Set(
+		keeper.GetKeyForLongBook(longBook), dexkeeper.Cdc.MustMarshal(&longBook),
+	)
+	prefix.NewStore(store, types.OrderBookPrefix(false, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)).Set(
+		keeper.GetKeyForShortBook(shortBook), dexkeeper.Cdc.MustMarshal(&shortBook),
+	)
+	require.Empty(t, dexkeeper.GetTopNDepthLevelsForPair(ctx, true, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 10, sdk.ZeroDec()))
+
+	require.NoError(t, migrations.V22ToV23(ctx, *dexkeeper))
+	require.Equal(t,
+		[]types.DepthLevel{{Price: sdk.NewDec(9), Quantity: sdk.NewDec(2)}},
+		dexkeeper.GetTopNDepthLevelsForPair(ctx, true, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 10, sdk.ZeroDec()),
+	)
+	require.Equal(t,
+		[]types.DepthLevel{{Price: sdk.NewDec(11), Quantity: sdk.NewDec(3)}},
+		dexkeeper.GetTopNDepthLevelsForPair(ctx, false, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom, 10, sdk.ZeroDec()),
+	)
+}
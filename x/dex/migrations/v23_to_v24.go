@@ -0,0 +1,23 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+)
+
+// V23ToV24 builds the account order index of existing order books, which is maintained whenever a
+// book is set from now on. Levels are removed before being set again since only changes to the
+// accounts of a level are written to the index.
+func V23ToV24(ctx sdk.Context, dexkeeper keeper.Keeper) error {
+	for _, c := range dexkeeper.GetAllContractInfo(ctx) {
+		for _, longBook := range dexkeeper.GetAllLongBook(ctx, c.ContractAddr) {
+			dexkeeper.RemoveLongBookByPrice(ctx, c.ContractAddr, longBook.Entry.Price, longBook.Entry.PriceDenom, longBook.Entry.AssetDenom)
+			dexkeeper.SetLongBook(ctx, c.ContractAddr, longBook)
+		}
+		for _, shortBook := range dexkeeper.GetAllShortBook(ctx, c.ContractAddr) {
+			dexkeeper.RemoveShortBookByPrice(ctx, c.ContractAddr, shortBook.Entry.Price, shortBook.Entry.PriceDenom, shortBook.Entry.AssetDenom)
+			dexkeeper.SetShortBook(ctx, c.ContractAddr, shortBook)
+		}
+	}
+	return nil
+}
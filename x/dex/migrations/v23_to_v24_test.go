@@ -0,0 +1,40 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/migrations"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate23to24(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	dexkeeper.SetContract(ctx, &types.ContractInfoV2{ContractAddr: keepertest.TestContract})
+	// write a book without the account order index, as it was before the migration
+	store := ctx.KVStore(dexkeeper.GetStoreKey())
+	longBook := types.LongBook{
+		Price: sdk.NewDec(9),
+		Entry: &types.OrderEntry{
+			Price:       sdk.NewDec(9),
+			Quantity:    sdk.NewDec(2),
+			PriceDenom:  keepertest.TestPriceDenom,
+			AssetDenom:  keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{{OrderId: 1, Account: keepertest.TestAccount, Quantity: sdk.NewDec(2)}},
+		},
+	}
+	prefix.NewStore(store, types.OrderBookPrefix(true, keepertest.TestContract, keepertest.TestPriceDenom, keepertest.TestAssetDenom)).Set(
+		keeper.GetKeyForLongBook(longBook), dexkeeper.Cdc.MustMarshal(&longBook),
+	)
+	require.Empty(t, dexkeeper.GetAccountOrderPricesForPair(ctx, true, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPriceDenom, keepertest.TestAssetDenom))
+
+	require.NoError(t, migrations.V23ToV24(ctx, *dexkeeper))
+	require.Equal(t,
+		[]sdk.Dec{sdk.NewDec(9)},
+		dexkeeper.GetAccountOrderPricesForPair(ctx, true, keepertest.TestContract, keepertest.TestAccount, keepertest.TestPriceDenom, keepertest.TestAssetDenom),
+	)
+}
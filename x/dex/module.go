@@ -30,6 +30,7 @@ import (
 	dexkeeperabci "github.com/sei-protocol/sei-chain/x/dex/keeper/abci"
 	"github.com/sei-protocol/sei-chain/x/dex/keeper/msgserver"
 	dexkeeperquery "github.com/sei-protocol/sei-chain/x/dex/keeper/query"
+	dexkeeperutils "github.com/sei-protocol/sei-chain/x/dex/keeper/utils"
 	"github.com/sei-protocol/sei-chain/x/dex/migrations"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	dexutils "github.com/sei-protocol/sei-chain/x/dex/utils"
@@ -209,10 +210,36 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	_ = cfg.RegisterMigration(types.ModuleName, 15, func(ctx sdk.Context) error {
 		return migrations.V15ToV16(ctx, am.keeper)
 	})
+	_ = cfg.RegisterMigration(types.ModuleName, 16, func(ctx sdk.Context) error {
+		return migrations.V16ToV17(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 17, func(ctx sdk.Context) error {
+		return migrations.V17ToV18(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 18, func(ctx sdk.Context) error {
+		return migrations.V18ToV19(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 19, func(ctx sdk.Context) error {
+		return migrations.V19ToV20(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 20, func(ctx sdk.Context) error {
+		return migrations.V20ToV21(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 21, func(ctx sdk.Context) error {
+		return migrations.V21ToV22(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 22, func(ctx sdk.Context) error {
+		return migrations.V22ToV23(ctx, am.keeper)
+	})
+	_ = cfg.RegisterMigration(types.ModuleName, 23, func(ctx sdk.Context) error {
+		return migrations.V23ToV24(ctx, am.keeper)
+	})
 }
 
 // RegisterInvariants registers the capability module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
@@ -233,7 +260,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 16 }
+func (AppModule) ConsensusVersion() uint64 { return 24 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
@@ -280,6 +307,8 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	}
 	// only write if all contracts have been processed
 	cachedStore.Write()
+
+	contract.MoveTriggeredOrders(ctx, &am.keeper, allContracts)
 }
 
 func (am AppModule) getPriceToDelete(
@@ -308,7 +337,11 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) (ret []abc
 	defer span.End()
 	defer dexutils.GetMemState(ctx.Context()).Clear(ctx)
 
+	// top up rents first so that contracts that would otherwise fall below the processable rent get processed
+	dexkeeperutils.TopUpRents(ctx, &am.keeper)
 	validContractsInfo := am.keeper.GetAllProcessableContractInfo(ctx)
+	contract.ResolveCancelAlls(ctx, &am.keeper, validContractsInfo)
+	contract.CancelExpiredOrders(ctx, &am.keeper, validContractsInfo)
 	// Each iteration is atomic. If an iteration finishes without any error, it will return,
 	// otherwise it will rollback any state change, filter out contracts that cause the error,
 	// and proceed to the next iteration. The loop is guaranteed to finish since
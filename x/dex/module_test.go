@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/utils/tracing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/sei-protocol/sei-chain/app"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
 	dexcache "github.com/sei-protocol/sei-chain/x/dex/cache"
 	"github.com/sei-protocol/sei-chain/x/dex/contract"
@@ -49,6 +50,17 @@ const (
 	}}`
 )
 
+// depositRent funds the dex module with rent that a test assigns to a contract directly
+func depositRent(ctx sdk.Context, testApp *app.App, rent int64) {
+	amounts := sdk.NewCoins(sdk.NewCoin("usei", sdk.NewInt(rent)))
+	if err := testApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amounts); err != nil {
+		panic(err)
+	}
+	if err := testApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, amounts); err != nil {
+		panic(err)
+	}
+}
+
 func TestEndBlockMarketOrder(t *testing.T) {
 	testApp := keepertest.TestApp()
 	dexkeeper := testApp.DexKeeper
@@ -79,6 +91,7 @@ func TestEndBlockMarketOrder(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
+	depositRent(ctx, testApp, 100000000)
 	err = dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	if err != nil {
 		panic(err)
@@ -215,6 +228,7 @@ func TestEndBlockLimitOrder(t *testing.T) {
 		panic(err)
 	}
 
+	depositRent(ctx, testApp, 100000000)
 	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
@@ -363,6 +377,7 @@ func TestEndBlockRollback(t *testing.T) {
 	dexkeeper := testApp.DexKeeper
 	pair := TEST_PAIR()
 	// register contract and pair
+	depositRent(ctx, testApp, 100000000)
 	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: keepertest.TestContract, NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
 	// place one order to a nonexistent contract
@@ -399,6 +414,7 @@ func TestEndBlockPartialRollback(t *testing.T) {
 	dexkeeper := testApp.DexKeeper
 	pair := TEST_PAIR()
 	// register contract and pair
+	depositRent(ctx, testApp, 100000000)
 	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: keepertest.TestContract, NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, keepertest.TestContract, pair)
 	// place one order to a nonexistent contract
@@ -440,6 +456,7 @@ func TestEndBlockPartialRollback(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
+	depositRent(ctx, testApp, 100000000)
 	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
 	// place one order to the good contract
@@ -480,6 +497,20 @@ func TestEndBlockPartialRollback(t *testing.T) {
 	require.Equal(t, 1, len(matchResult.Orders))
 	_, found := dexkeeper.GetLongBookByPrice(ctx, contractAddr.String(), sdk.MustNewDecFromStr("0.0001"), pair.PriceDenom, pair.AssetDenom)
 	require.True(t, found)
+	// execution stats are recorded for both contracts, along with why the bad contract was dropped
+	badStats := dexkeeper.GetAllContractExecutionStats(ctx, keepertest.TestContract)
+	require.Equal(t, 1, len(badStats))
+	require.Equal(t, uint64(1), badStats[0].Height)
+	require.NotEmpty(t, badStats[0].Error)
+	goodStats := dexkeeper.GetAllContractExecutionStats(ctx, contractAddr.String())
+	require.Equal(t, 1, len(goodStats))
+	require.Empty(t, goodStats[0].Error)
+	require.Greater(t, goodStats[0].PlacementGas, uint64(0))
+	require.Greater(t, goodStats[0].DepositGas, uint64(0))
+	// wall times are kept in memory only
+	require.Zero(t, goodStats[0].WallTimeMicroseconds)
+	_, found = dexkeeper.ExecutionWallTimes.Get(contractAddr.String(), 1)
+	require.True(t, found)
 }
 
 func TestBeginBlock(t *testing.T) {
@@ -509,6 +540,7 @@ func TestBeginBlock(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
+	depositRent(ctx, testApp, 100000000)
 	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 
 	// right now just make sure it doesn't crash since it doesn't register any state to be checked against
@@ -547,6 +579,7 @@ func TestEndBlockPanicHandling(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
+	depositRent(ctx, testApp, 100000000)
 	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
@@ -687,6 +720,7 @@ func TestEndBlockContractWithoutPair(t *testing.T) {
 	}
 
 	// no pair registered
+	depositRent(ctx, testApp, 100000000)
 	contractInfo := types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000}
 	dexkeeper.SetContract(ctx, &contractInfo)
 
@@ -735,6 +769,7 @@ func TestOrderCountUpdate(t *testing.T) {
 		panic(err)
 	}
 
+	depositRent(ctx, testApp, 100000000)
 	dexkeeper.SetContract(ctx, &types.ContractInfoV2{CodeId: 123, ContractAddr: contractAddr.String(), NeedHook: false, NeedOrderMatching: true, RentBalance: 100000000})
 	dexkeeper.AddRegisteredPair(ctx, contractAddr.String(), pair)
 	dexutils.GetMemState(ctx.Context()).GetBlockOrders(ctx, types.ContractAddress(contractAddr.String()), pair).Add(
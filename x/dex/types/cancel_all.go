@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MatchesPair returns true if the filter applies to the given pair. A nil filter matches everything.
+func (f *CancelAllFilter) MatchesPair(pair Pair) bool {
+	if f == nil || len(f.PriceDenom) == 0 {
+		return true
+	}
+	return f.PriceDenom == pair.PriceDenom && f.AssetDenom == pair.AssetDenom
+}
+
+// Matches returns true if an order with the given direction and price is selected by the filter.
+func (f *CancelAllFilter) Matches(direction PositionDirection, price sdk.Dec) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.PositionDirections) > 0 {
+		found := false
+		for _, d := range f.PositionDirections {
+			if d == direction {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.MinPrice != nil && price.LT(*f.MinPrice) {
+		return false
+	}
+	return f.MaxPrice == nil || price.LTE(*f.MaxPrice)
+}
@@ -0,0 +1,24 @@
+package types
+
+const (
+	CandleIntervalMinute     uint64 = 60
+	CandleIntervalFiveMinute uint64 = 5 * 60
+	CandleIntervalHour       uint64 = 3600
+	CandleIntervalDay        uint64 = 24 * 3600
+)
+
+var SupportedCandleIntervals = []uint64{
+	CandleIntervalMinute,
+	CandleIntervalFiveMinute,
+	CandleIntervalHour,
+	CandleIntervalDay,
+}
+
+func IsSupportedCandleInterval(intervalInSeconds uint64) bool {
+	for _, interval := range SupportedCandleIntervals {
+		if interval == intervalInSeconds {
+			return true
+		}
+	}
+	return false
+}
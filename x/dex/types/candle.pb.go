@@ -0,0 +1,633 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/candle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OHLCV bucket of a pair's executions over [beginTimestamp, endTimestamp)
+type Candle struct {
+	BeginTimestamp uint64                                 `protobuf:"varint,1,opt,name=beginTimestamp,proto3" json:"begin_timestamp"`
+	EndTimestamp   uint64                                 `protobuf:"varint,2,opt,name=endTimestamp,proto3" json:"end_timestamp"`
+	Open           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=open,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open"`
+	High           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high"`
+	Low            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low"`
+	Close          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=close,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close"`
+	// executed quantity in the asset denom
+	Volume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume"`
+	// executed value in the price denom
+	Notional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=notional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"notional"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf37d12114793e49, []int{0}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetBeginTimestamp() uint64 {
+	if m != nil {
+		return m.BeginTimestamp
+	}
+	return 0
+}
+
+func (m *Candle) GetEndTimestamp() uint64 {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Candle)(nil), "seiprotocol.seichain.dex.Candle")
+}
+
+func init() { proto.RegisterFile("dex/candle.proto", fileDescriptor_bf37d12114793e49) }
+
+var fileDescriptor_bf37d12114793e49 = []byte{
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4d, 0x4b, 0xf3, 0x40,
+	0x10, 0xc7, 0x93, 0xa7, 0x69, 0x9e, 0x3e, 0xcb, 0xf3, 0xe6, 0xea, 0x61, 0xf1, 0x90, 0x14, 0x0f,
+	0xd2, 0x4b, 0x93, 0x83, 0x54, 0x45, 0x6f, 0x55, 0x28, 0x08, 0x82, 0x04, 0xf1, 0xe0, 0x45, 0xd2,
+	0x64, 0x48, 0x16, 0x93, 0xdd, 0xe0, 0xa6, 0x5a, 0xbf, 0x85, 0x1f, 0xab, 0xc7, 0x9e, 0x44, 0x3c,
+	0x04, 0x69, 0x6f, 0xfd, 0x14, 0x92, 0xe9, 0x8b, 0xd5, 0x5b, 0xbc, 0xcc, 0xcc, 0xf2, 0x9f, 0xdf,
+	0x6f, 0x2f, 0x43, 0xfe, 0x87, 0x30, 0x74, 0x03, 0x5f, 0x84, 0x09, 0x38, 0xd9, 0x9d, 0xcc, 0x25,
+	0x65, 0x0a, 0x38, 0x4e, 0x81, 0x4c, 0x1c, 0x05, 0x3c, 0x88, 0x7d, 0x2e, 0x9c, 0x10, 0x86, 0xdb,
+	0x5b, 0x91, 0x8c, 0x24, 0x46, 0x6e, 0x39, 0xcd, 0xf7, 0x77, 0x9e, 0x0d, 0x62, 0x9e, 0xa0, 0x80,
+	0x1e, 0x93, 0xbf, 0x7d, 0x88, 0xb8, 0xb8, 0xe4, 0x29, 0xa8, 0xdc, 0x4f, 0x33, 0xa6, 0x37, 0xf5,
+	0x96, 0xd1, 0xdd, 0x9c, 0x15, 0xf6, 0x3f, 0x4c, 0x6e, 0xf2, 0x65, 0xe4, 0x7d, 0x59, 0xa5, 0x1d,
+	0xf2, 0x1b, 0x44, 0xf8, 0x81, 0xfe, 0x40, 0x74, 0x63, 0x56, 0xd8, 0x7f, 0x40, 0x84, 0x6b, 0xe0,
+	0xa7, 0x35, 0x7a, 0x46, 0x0c, 0x99, 0x81, 0x60, 0xb5, 0xa6, 0xde, 0xfa, 0xd5, 0xdd, 0x1f, 0x15,
+	0xb6, 0xf6, 0x5a, 0xd8, 0xbb, 0x11, 0xcf, 0xe3, 0x41, 0xdf, 0x09, 0x64, 0xea, 0x06, 0x52, 0xa5,
+	0x52, 0x2d, 0x5a, 0x5b, 0x85, 0xb7, 0x6e, 0xfe, 0x98, 0x81, 0x72, 0x4e, 0x21, 0x98, 0x15, 0x36,
+	0xd2, 0x1e, 0xd6, 0xd2, 0x15, 0xf3, 0x28, 0x66, 0xc6, 0x77, 0x5d, 0x25, 0xed, 0x61, 0xa5, 0x3d,
+	0x52, 0x4b, 0xe4, 0x03, 0xab, 0xa3, 0xaa, 0x53, 0x59, 0x55, 0xc2, 0x5e, 0x59, 0xe8, 0x39, 0xa9,
+	0x07, 0x89, 0x54, 0xc0, 0x4c, 0x54, 0x1d, 0x54, 0x56, 0xcd, 0x71, 0x6f, 0xde, 0xe8, 0x05, 0x31,
+	0xef, 0x65, 0x32, 0x48, 0x81, 0xfd, 0x44, 0xdf, 0x61, 0x65, 0xdf, 0x82, 0xf7, 0x16, 0x9d, 0x5e,
+	0x91, 0x86, 0x90, 0x39, 0x97, 0xc2, 0x4f, 0x58, 0x03, 0x9d, 0x47, 0x95, 0x9d, 0x2b, 0x83, 0xb7,
+	0x9a, 0xba, 0xbd, 0xd1, 0xc4, 0xd2, 0xc7, 0x13, 0x4b, 0x7f, 0x9b, 0x58, 0xfa, 0xd3, 0xd4, 0xd2,
+	0xc6, 0x53, 0x4b, 0x7b, 0x99, 0x5a, 0xda, 0x75, 0x7b, 0xcd, 0xab, 0x80, 0xb7, 0x97, 0xe7, 0x8a,
+	0x0f, 0xbc, 0x57, 0x77, 0xe8, 0x96, 0x87, 0x8d, 0x5f, 0xf4, 0x4d, 0xcc, 0xf7, 0xde, 0x07, 0x00,
+	0x33, 0xaa, 0x5d, 0x23, 0xec, 0x02, 0x00, 0x00,
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Notional.Size()
+		i -= size
+		if _, err := m.Notional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCandle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EndTimestamp != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.EndTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BeginTimestamp != 0 {
+		i = encodeVarintCandle(dAtA, i, uint64(m.BeginTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCandle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCandle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginTimestamp != 0 {
+		n += 1 + sovCandle(uint64(m.BeginTimestamp))
+	}
+	if m.EndTimestamp != 0 {
+		n += 1 + sovCandle(uint64(m.EndTimestamp))
+	}
+	l = m.Open.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	l = m.Notional.Size()
+	n += 1 + l + sovCandle(uint64(l))
+	return n
+}
+
+func sovCandle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCandle(x uint64) (n int) {
+	return sovCandle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginTimestamp", wireType)
+			}
+			m.BeginTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+			}
+			m.EndTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Notional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCandle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCandle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCandle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCandle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCandle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCandle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCandle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCandle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCandle = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,14 +12,20 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPlaceOrders{}, "dex/MsgPlaceOrders", nil)
 	cdc.RegisterConcrete(&MsgCancelOrders{}, "dex/MsgCancelOrders", nil)
+	cdc.RegisterConcrete(&MsgCancelAll{}, "dex/MsgCancelAll", nil)
+	cdc.RegisterConcrete(&MsgReplaceOrders{}, "dex/MsgReplaceOrders", nil)
 	cdc.RegisterConcrete(&MsgRegisterContract{}, "dex/MsgRegisterContract", nil)
 	cdc.RegisterConcrete(&MsgRegisterPairs{}, "dex/MsgRegisterPairs", nil)
 	cdc.RegisterConcrete(&MsgUpdatePriceTickSize{}, "dex/MsgUpdatePriceTickSize", nil)
 	cdc.RegisterConcrete(&MsgUpdateQuantityTickSize{}, "dex/MsgUpdateQuantityTickSize", nil)
 	cdc.RegisterConcrete(&AddAssetMetadataProposal{}, "dex/AddAssetMetadataProposal", nil)
+	cdc.RegisterConcrete(&UpdateFeeRatesProposal{}, "dex/UpdateFeeRatesProposal", nil)
+	cdc.RegisterConcrete(&UpdatePairHaltsProposal{}, "dex/UpdatePairHaltsProposal", nil)
 	cdc.RegisterConcrete(&MsgUnregisterContract{}, "dex/MsgUnregisterContract", nil)
 	cdc.RegisterConcrete(&MsgContractDepositRent{}, "dex/MsgContractDepositRent", nil)
 	cdc.RegisterConcrete(&MsgUnsuspendContract{}, "dex/MsgUnsuspendContract", nil)
+	cdc.RegisterConcrete(&MsgSetRentTopUp{}, "dex/MsgSetRentTopUp", nil)
+	cdc.RegisterConcrete(&MsgSetPairHalt{}, "dex/MsgSetPairHalt", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -30,6 +36,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCancelOrders{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelAll{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReplaceOrders{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRegisterContract{},
 	)
@@ -45,6 +57,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&AddAssetMetadataProposal{},
 	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdateFeeRatesProposal{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&UpdatePairHaltsProposal{},
+	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnregisterContract{},
 	)
@@ -54,6 +72,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnsuspendContract{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetRentTopUp{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPairHalt{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
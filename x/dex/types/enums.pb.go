@@ -172,18 +172,30 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 type CancellationInitiator int32
 
 const (
-	CancellationInitiator_USER       CancellationInitiator = 0
-	CancellationInitiator_LIQUIDATED CancellationInitiator = 1
+	CancellationInitiator_USER         CancellationInitiator = 0
+	CancellationInitiator_LIQUIDATED   CancellationInitiator = 1
+	CancellationInitiator_EXPIRED      CancellationInitiator = 2
+	CancellationInitiator_SELF_TRADE   CancellationInitiator = 3
+	CancellationInitiator_OCO          CancellationInitiator = 4
+	CancellationInitiator_UNFILLED_IOC CancellationInitiator = 5
 )
 
 var CancellationInitiator_name = map[int32]string{
 	0: "USER",
 	1: "LIQUIDATED",
+	2: "EXPIRED",
+	3: "SELF_TRADE",
+	4: "OCO",
+	5: "UNFILLED_IOC",
 }
 
 var CancellationInitiator_value = map[string]int32{
-	"USER":       0,
-	"LIQUIDATED": 1,
+	"USER":         0,
+	"LIQUIDATED":   1,
+	"EXPIRED":      2,
+	"SELF_TRADE":   3,
+	"OCO":          4,
+	"UNFILLED_IOC": 5,
 }
 
 func (x CancellationInitiator) String() string {
@@ -194,6 +206,150 @@ func (CancellationInitiator) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b8c5bb23c6eb0b88, []int{5}
 }
 
+type TimeInForce int32
+
+const (
+	TimeInForce_GTC TimeInForce = 0
+	TimeInForce_IOC TimeInForce = 1
+	TimeInForce_GTT TimeInForce = 2
+)
+
+var TimeInForce_name = map[int32]string{
+	0: "GTC",
+	1: "IOC",
+	2: "GTT",
+}
+
+var TimeInForce_value = map[string]int32{
+	"GTC": 0,
+	"IOC": 1,
+	"GTT": 2,
+}
+
+func (x TimeInForce) String() string {
+	return proto.EnumName(TimeInForce_name, int32(x))
+}
+
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{6}
+}
+
+// Applied when an account's orders would match against each other. The mode of the
+// newer order takes precedence over the mode of the pair.
+type SelfTradePrevention int32
+
+const (
+	SelfTradePrevention_NO_PREVENTION  SelfTradePrevention = 0
+	SelfTradePrevention_CANCEL_NEWEST  SelfTradePrevention = 1
+	SelfTradePrevention_CANCEL_OLDEST  SelfTradePrevention = 2
+	SelfTradePrevention_DECREMENT_BOTH SelfTradePrevention = 3
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "NO_PREVENTION",
+	1: "CANCEL_NEWEST",
+	2: "CANCEL_OLDEST",
+	3: "DECREMENT_BOTH",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"NO_PREVENTION":  0,
+	"CANCEL_NEWEST":  1,
+	"CANCEL_OLDEST":  2,
+	"DECREMENT_BOTH": 3,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{7}
+}
+
+// How the quantity settled at a price level is split among its resting orders
+type MatchingAlgorithm int32
+
+const (
+	MatchingAlgorithm_FIFO     MatchingAlgorithm = 0
+	MatchingAlgorithm_PRO_RATA MatchingAlgorithm = 1
+)
+
+var MatchingAlgorithm_name = map[int32]string{
+	0: "FIFO",
+	1: "PRO_RATA",
+}
+
+var MatchingAlgorithm_value = map[string]int32{
+	"FIFO":     0,
+	"PRO_RATA": 1,
+}
+
+func (x MatchingAlgorithm) String() string {
+	return proto.EnumName(MatchingAlgorithm_name, int32(x))
+}
+
+func (MatchingAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{8}
+}
+
+// How crossing limit orders are priced when they're matched at the end of a block
+type ClearingMode int32
+
+const (
+	ClearingMode_CONTINUOUS    ClearingMode = 0
+	ClearingMode_UNIFORM_PRICE ClearingMode = 1
+)
+
+var ClearingMode_name = map[int32]string{
+	0: "CONTINUOUS",
+	1: "UNIFORM_PRICE",
+}
+
+var ClearingMode_value = map[string]int32{
+	"CONTINUOUS":    0,
+	"UNIFORM_PRICE": 1,
+}
+
+func (x ClearingMode) String() string {
+	return proto.EnumName(ClearingMode_name, int32(x))
+}
+
+func (ClearingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{9}
+}
+
+// Marks an order placed as a child of the order preceding it in the same message. Children
+// only become active once their parent fills, and cancel each other once one of them is
+// triggered or filled.
+type BracketRole int32
+
+const (
+	BracketRole_NO_BRACKET  BracketRole = 0
+	BracketRole_TAKE_PROFIT BracketRole = 1
+	BracketRole_STOP_LOSS   BracketRole = 2
+)
+
+var BracketRole_name = map[int32]string{
+	0: "NO_BRACKET",
+	1: "TAKE_PROFIT",
+	2: "STOP_LOSS",
+}
+
+var BracketRole_value = map[string]int32{
+	"NO_BRACKET":  0,
+	"TAKE_PROFIT": 1,
+	"STOP_LOSS":   2,
+}
+
+func (x BracketRole) String() string {
+	return proto.EnumName(BracketRole_name, int32(x))
+}
+
+func (BracketRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b8c5bb23c6eb0b88, []int{10}
+}
+
 func init() {
 	proto.RegisterEnum("seiprotocol.seichain.dex.PositionDirection", PositionDirection_name, PositionDirection_value)
 	proto.RegisterEnum("seiprotocol.seichain.dex.PositionEffect", PositionEffect_name, PositionEffect_value)
@@ -201,35 +357,55 @@ func init() {
 	proto.RegisterEnum("seiprotocol.seichain.dex.Unit", Unit_name, Unit_value)
 	proto.RegisterEnum("seiprotocol.seichain.dex.OrderStatus", OrderStatus_name, OrderStatus_value)
 	proto.RegisterEnum("seiprotocol.seichain.dex.CancellationInitiator", CancellationInitiator_name, CancellationInitiator_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.MatchingAlgorithm", MatchingAlgorithm_name, MatchingAlgorithm_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.ClearingMode", ClearingMode_name, ClearingMode_value)
+	proto.RegisterEnum("seiprotocol.seichain.dex.BracketRole", BracketRole_name, BracketRole_value)
 }
 
 func init() { proto.RegisterFile("dex/enums.proto", fileDescriptor_b8c5bb23c6eb0b88) }
 
 var fileDescriptor_b8c5bb23c6eb0b88 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xdf, 0x6e, 0xd3, 0x30,
-	0x18, 0xc5, 0xe3, 0xb5, 0xab, 0xd6, 0x0f, 0xd8, 0x8c, 0x01, 0x89, 0xab, 0xdc, 0x21, 0xa1, 0x48,
-	0x6b, 0x84, 0xe0, 0x05, 0xbc, 0xc4, 0x1d, 0xd6, 0xdc, 0x38, 0xe4, 0x0f, 0x12, 0xdc, 0x4c, 0x59,
-	0xe2, 0x31, 0x4b, 0x5d, 0x52, 0x25, 0xae, 0xd4, 0xbd, 0x05, 0x8f, 0xc5, 0xe5, 0x2e, 0xb9, 0x44,
-	0xed, 0x8b, 0x20, 0x3b, 0x8c, 0xbb, 0x73, 0x3e, 0x9f, 0xcf, 0xfe, 0xc9, 0x07, 0xce, 0x1a, 0xb5,
-	0x0b, 0x55, 0xbb, 0xbd, 0x1f, 0x16, 0x9b, 0xbe, 0x33, 0x1d, 0x79, 0x3b, 0x28, 0xed, 0x54, 0xdd,
-	0xad, 0x17, 0x83, 0xd2, 0xf5, 0x5d, 0xa5, 0xdb, 0x45, 0xa3, 0x76, 0xc1, 0x7b, 0x78, 0x99, 0x76,
-	0x83, 0x36, 0xba, 0x6b, 0x63, 0xdd, 0xab, 0xda, 0x0a, 0x72, 0x02, 0x53, 0x21, 0x93, 0x4b, 0xec,
-	0x91, 0x39, 0x1c, 0xe7, 0x9f, 0x65, 0x56, 0x60, 0x14, 0xbc, 0x83, 0xd3, 0xa7, 0x24, 0xbb, 0xbd,
-	0x55, 0xb5, 0xb1, 0x31, 0x99, 0xb2, 0x64, 0x8c, 0x45, 0x42, 0xe6, 0x0c, 0xa3, 0xa0, 0x81, 0xb9,
-	0xec, 0x1b, 0xd5, 0x17, 0x0f, 0x1b, 0x65, 0xe7, 0x82, 0xaf, 0x78, 0x81, 0x3d, 0x02, 0x30, 0x5b,
-	0xd1, 0xec, 0x8a, 0x15, 0x18, 0x91, 0x17, 0x30, 0x5f, 0xca, 0xab, 0x7f, 0x76, 0x42, 0x5e, 0x03,
-	0xfe, 0x6f, 0x2f, 0xbe, 0x7d, 0xa5, 0xa2, 0x64, 0x78, 0x4a, 0x9e, 0xc3, 0x49, 0x5e, 0xc8, 0x54,
-	0xc8, 0x3c, 0xc7, 0xc7, 0x76, 0xc5, 0x39, 0x77, 0xdb, 0x2c, 0xf8, 0x04, 0xd3, 0xb2, 0xd5, 0x66,
-	0x0c, 0xd1, 0x24, 0xa6, 0x59, 0x3c, 0x62, 0xac, 0xb8, 0x10, 0x1c, 0xa3, 0x51, 0x46, 0x99, 0xc4,
-	0x47, 0x16, 0x33, 0xa1, 0x89, 0xc4, 0x93, 0x40, 0xc0, 0x33, 0xc7, 0x96, 0x9b, 0xca, 0x6c, 0x07,
-	0x8b, 0x94, 0x0a, 0x1a, 0x31, 0xbb, 0xfa, 0x0a, 0xce, 0x96, 0x94, 0x0b, 0x16, 0x5f, 0x17, 0xf2,
-	0xda, 0x4d, 0x47, 0xce, 0x88, 0x26, 0x11, 0x13, 0x82, 0xc5, 0xf8, 0xc8, 0x61, 0x97, 0x62, 0xc9,
-	0x9d, 0x9d, 0x04, 0x1f, 0xe0, 0x4d, 0x54, 0xb5, 0xb5, 0x5a, 0xaf, 0x2b, 0xfb, 0x29, 0xbc, 0xd5,
-	0x46, 0x57, 0xa6, 0xeb, 0xed, 0x83, 0x65, 0xce, 0x32, 0xec, 0x91, 0x53, 0x00, 0xc1, 0xbf, 0x94,
-	0x3c, 0xa6, 0x05, 0x8b, 0x31, 0xba, 0xb8, 0xfc, 0xb5, 0xf7, 0xd1, 0xe3, 0xde, 0x47, 0x7f, 0xf6,
-	0x3e, 0xfa, 0x79, 0xf0, 0xbd, 0xc7, 0x83, 0xef, 0xfd, 0x3e, 0xf8, 0xde, 0xf7, 0xf3, 0x1f, 0xda,
-	0xdc, 0x6d, 0x6f, 0x16, 0x75, 0x77, 0x1f, 0x0e, 0x4a, 0x9f, 0x3f, 0xb5, 0xe5, 0x8c, 0xab, 0x2b,
-	0xdc, 0x85, 0xb6, 0x56, 0xf3, 0xb0, 0x51, 0xc3, 0xcd, 0xcc, 0x9d, 0x7f, 0xfc, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x40, 0x7d, 0x48, 0x4c, 0xea, 0x01, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x53, 0x4d, 0x6f, 0xe3, 0x36,
+	0x10, 0x95, 0x6c, 0xe7, 0x6b, 0x9c, 0x38, 0xb4, 0xd2, 0x02, 0x3d, 0xf9, 0x56, 0x20, 0x50, 0x11,
+	0x1b, 0x45, 0x7b, 0xed, 0x81, 0x96, 0xa8, 0x84, 0x08, 0x4d, 0xaa, 0x14, 0x95, 0x7e, 0x5c, 0x04,
+	0x45, 0x66, 0x6c, 0x62, 0x6d, 0x29, 0x90, 0x95, 0x45, 0xf2, 0x2f, 0xf6, 0x67, 0xed, 0x31, 0xc7,
+	0x3d, 0x2e, 0x92, 0x3f, 0xb2, 0xa0, 0x94, 0xec, 0xde, 0xe6, 0x3d, 0xbe, 0x21, 0xdf, 0x70, 0x66,
+	0xe0, 0x74, 0xa9, 0x1f, 0x67, 0xba, 0x7c, 0xd8, 0xee, 0xa6, 0xf7, 0x75, 0xd5, 0x54, 0xde, 0x2f,
+	0x3b, 0x6d, 0xda, 0xa8, 0xa8, 0x36, 0xd3, 0x9d, 0x36, 0xc5, 0x3a, 0x37, 0xe5, 0x74, 0xa9, 0x1f,
+	0xfd, 0x73, 0x18, 0xc7, 0xd5, 0xce, 0x34, 0xa6, 0x2a, 0x43, 0x53, 0xeb, 0xc2, 0x06, 0xde, 0x21,
+	0x0c, 0x98, 0xe0, 0x97, 0xc8, 0xf1, 0x8e, 0x60, 0x2f, 0xb9, 0x12, 0x52, 0x21, 0xd7, 0xff, 0x15,
+	0x46, 0xef, 0x4a, 0x72, 0x77, 0xa7, 0x8b, 0xc6, 0xca, 0x44, 0x4c, 0x78, 0x27, 0x0b, 0x98, 0x48,
+	0x08, 0x72, 0xfd, 0x25, 0x1c, 0x89, 0x7a, 0xa9, 0x6b, 0xf5, 0x74, 0xaf, 0x2d, 0xcf, 0xe8, 0x82,
+	0x2a, 0xe4, 0x78, 0x00, 0xfb, 0x0b, 0x2c, 0xaf, 0x89, 0x42, 0xae, 0x77, 0x02, 0x47, 0x91, 0xb8,
+	0x7e, 0x83, 0x7d, 0xef, 0x27, 0x40, 0xdf, 0xe1, 0xfc, 0xbf, 0x1b, 0xcc, 0x52, 0x82, 0x06, 0xde,
+	0x31, 0x1c, 0x26, 0x4a, 0xc4, 0x4c, 0x24, 0x09, 0xda, 0xb3, 0x29, 0x2d, 0x6a, 0x6f, 0xdb, 0xf7,
+	0xff, 0x84, 0x41, 0x5a, 0x9a, 0xa6, 0x13, 0x61, 0x1e, 0x62, 0x19, 0x76, 0x36, 0x16, 0x94, 0x31,
+	0x8a, 0xdc, 0x2e, 0x0c, 0xa4, 0x40, 0x3d, 0x6b, 0x93, 0x63, 0x2e, 0x50, 0xdf, 0x67, 0x30, 0x6c,
+	0xbd, 0x25, 0x4d, 0xde, 0x3c, 0xec, 0xac, 0xa5, 0x98, 0xe1, 0x80, 0xd8, 0xd4, 0x33, 0x38, 0x8d,
+	0x30, 0x65, 0x24, 0xcc, 0x94, 0xc8, 0x5a, 0xb6, 0xf3, 0x19, 0x60, 0x1e, 0x10, 0xc6, 0x48, 0x88,
+	0x7a, 0xad, 0xed, 0x94, 0x45, 0xb4, 0x85, 0x7d, 0xdf, 0xc0, 0xcf, 0x41, 0x5e, 0x16, 0x7a, 0xb3,
+	0xc9, 0xed, 0xa7, 0xd0, 0xd2, 0x34, 0x26, 0x6f, 0xaa, 0xda, 0x3e, 0x98, 0x26, 0x44, 0x22, 0xc7,
+	0x1b, 0x01, 0x30, 0xfa, 0x77, 0x4a, 0x43, 0xac, 0x48, 0x88, 0x5c, 0x6f, 0x08, 0x07, 0xe4, 0xdf,
+	0x98, 0xca, 0xf6, 0xba, 0x11, 0x40, 0x42, 0x58, 0x94, 0x29, 0x89, 0x43, 0x82, 0xfa, 0xde, 0x01,
+	0xf4, 0x45, 0x20, 0xd0, 0xc0, 0x43, 0x70, 0x9c, 0xf2, 0xee, 0x99, 0x8c, 0x8a, 0x00, 0xed, 0xf9,
+	0xe7, 0x30, 0x54, 0x66, 0xab, 0x69, 0x19, 0x55, 0x75, 0xa1, 0xad, 0xf2, 0x52, 0x05, 0xc8, 0xb1,
+	0x81, 0x15, 0xb8, 0x1d, 0xa3, 0x50, 0xcf, 0xbf, 0x85, 0xb3, 0x44, 0x6f, 0xee, 0x54, 0x9d, 0x2f,
+	0x75, 0x5c, 0xeb, 0x8f, 0xba, 0x6c, 0x3b, 0x3a, 0x86, 0x13, 0x2e, 0xb2, 0x58, 0x92, 0x1b, 0xc2,
+	0x15, 0x15, 0xb6, 0x67, 0x63, 0x38, 0xe9, 0x8a, 0xcb, 0x38, 0xf9, 0x87, 0x24, 0xb6, 0x2f, 0x3f,
+	0x28, 0xc1, 0x42, 0x4b, 0xf5, 0x3c, 0x0f, 0x46, 0x21, 0x09, 0x24, 0x59, 0x10, 0xae, 0xb2, 0xb9,
+	0x50, 0x57, 0xa8, 0xef, 0xff, 0x06, 0xe3, 0x45, 0xde, 0x14, 0x6b, 0x53, 0xae, 0xf0, 0x66, 0x55,
+	0xd5, 0xa6, 0x59, 0x6f, 0x6d, 0xd1, 0x11, 0x8d, 0x04, 0x72, 0x6c, 0x4f, 0x62, 0x29, 0x32, 0x89,
+	0x15, 0x46, 0xae, 0xff, 0x3b, 0x1c, 0x07, 0x1b, 0x9d, 0xd7, 0xa6, 0x5c, 0x2d, 0xaa, 0xa5, 0xb6,
+	0x55, 0x07, 0x82, 0x2b, 0xca, 0x53, 0x91, 0x26, 0x9d, 0x8d, 0x94, 0xd3, 0x48, 0xc8, 0x45, 0x16,
+	0x4b, 0x6a, 0xbf, 0xdd, 0xff, 0x0b, 0x86, 0xf3, 0x3a, 0x2f, 0x3e, 0xe8, 0x46, 0x56, 0x9b, 0x36,
+	0x83, 0x8b, 0x6c, 0x2e, 0x71, 0x60, 0xc7, 0xc5, 0xf1, 0x4e, 0x61, 0xa8, 0xf0, 0x35, 0xc9, 0x62,
+	0x29, 0x22, 0xfa, 0x36, 0x4e, 0x76, 0x36, 0xb2, 0x76, 0x54, 0x7a, 0xf3, 0xcb, 0xcf, 0x2f, 0x13,
+	0xf7, 0xf9, 0x65, 0xe2, 0x7e, 0x7d, 0x99, 0xb8, 0x9f, 0x5e, 0x27, 0xce, 0xf3, 0xeb, 0xc4, 0xf9,
+	0xf2, 0x3a, 0x71, 0xfe, 0xbf, 0x58, 0x99, 0x66, 0xfd, 0x70, 0x3b, 0x2d, 0xaa, 0xed, 0x6c, 0xa7,
+	0xcd, 0xc5, 0xfb, 0x4a, 0xb4, 0xa0, 0xdd, 0x89, 0xd9, 0xe3, 0xcc, 0xee, 0x4e, 0xf3, 0x74, 0xaf,
+	0x77, 0xb7, 0xfb, 0xed, 0xf9, 0x1f, 0xdf, 0x06, 0x00, 0xda, 0xac, 0x16, 0xcb, 0x4f, 0x03, 0x00,
+	0x00,
 }
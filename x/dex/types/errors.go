@@ -25,4 +25,6 @@ var (
 	ErrCircularContractDependency = sdkerrors.Register(ModuleName, 1103, "circular contract dependency detected")
 	ErrContractSuspended          = sdkerrors.Register(ModuleName, 1104, "contract suspended")
 	ErrContractNotSuspended       = sdkerrors.Register(ModuleName, 1105, "contract not suspended")
+	ErrPairHalted                 = sdkerrors.Register(ModuleName, 1106, "pair halted")
+	ErrPriceOutsideBand           = sdkerrors.Register(ModuleName, 1107, "price outside of price band")
 )
@@ -3,19 +3,56 @@ package types
 const (
 	EventTypePlaceOrder          = "place_order"
 	EventTypeCancelOrder         = "cancel_order"
+	EventTypeCancelAll           = "cancel_all"
 	EventTypeDepositRent         = "deposit_rent"
 	EventTypeRegisterContract    = "register_contract"
 	EventTypeUnregisterContract  = "unregister_contract"
 	EventTypeRegisterPair        = "register_pair"
 	EventTypeSetQuantityTickSize = "set_quantity_tick_size"
 	EventTypeSetPriceTickSize    = "set_price_tick_size"
+	EventTypeSetFeeRates         = "set_fee_rates"
+	EventTypeCollectFees         = "collect_fees"
+	EventTypeCollectFeesFailed   = "collect_fees_failed"
+	EventTypeTriggerOrder        = "trigger_order"
+	EventTypeOrderStatus         = "order_status"
+	EventTypeSetRentTopUp        = "set_rent_top_up"
+	EventTypeRentTopUp           = "rent_top_up"
+	EventTypeRentTopUpFailed     = "rent_top_up_failed"
+	EventTypeLowRent             = "low_rent"
+	EventTypeSetPairHalt         = "set_pair_halt"
+	EventTypeCircuitBreaker      = "circuit_breaker"
+	EventTypeBracketOrder        = "bracket_order"
 
-	AttributeKeyOrderID         = "order_id"
-	AttributeKeyCancellationID  = "cancellation_id"
-	AttributeKeyContractAddress = "contract_address"
-	AttributeKeyRentBalance     = "rent_balance"
-	AttributeKeyPriceDenom      = "price_denom"
-	AttributeKeyAssetDenom      = "asset_denom"
+	AttributeKeyOrderID           = "order_id"
+	AttributeKeyCancellationID    = "cancellation_id"
+	AttributeKeyContractAddress   = "contract_address"
+	AttributeKeyRentBalance       = "rent_balance"
+	AttributeKeyPriceDenom        = "price_denom"
+	AttributeKeyAssetDenom        = "asset_denom"
+	AttributeKeyOrderStatus       = "order_status"
+	AttributeKeyStatusDescription = "status_description"
+	AttributeKeyMakerFeeRate      = "maker_fee_rate"
+	AttributeKeyTakerFeeRate      = "taker_fee_rate"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyCreator           = "creator"
+	AttributeKeyFunder            = "funder"
+	AttributeKeySpendLimit        = "spend_limit"
+	AttributeKeyHalted            = "halted"
+	AttributeKeyHaltedBy          = "halted_by"
+	AttributeKeyPrice             = "price"
+	AttributeKeyReferencePrice    = "reference_price"
+	AttributeKeyUntilHeight       = "until_height"
+	AttributeKeyParentOrderID     = "parent_order_id"
+	AttributeKeyBracketRole       = "bracket_role"
+	AttributeKeyBracketStatus     = "bracket_status"
 
-	AttributeValueCategory = ModuleName
+	AttributeValueCategory   = ModuleName
+	AttributeValueGovernance = "governance"
+	AttributeValueCreator    = "creator"
+
+	// lifecycle of bracket orders
+	AttributeValueBracketPending   = "pending"
+	AttributeValueBracketActivated = "activated"
+	AttributeValueBracketTriggered = "triggered"
+	AttributeValueBracketCancelled = "cancelled"
 )
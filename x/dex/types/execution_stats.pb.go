@@ -0,0 +1,542 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/execution_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// sudo gas used by a contract during a block's EndBlock, by phase
+type ContractExecutionStats struct {
+	Height          uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	PlacementGas    uint64 `protobuf:"varint,2,opt,name=placementGas,proto3" json:"placement_gas"`
+	CancellationGas uint64 `protobuf:"varint,3,opt,name=cancellationGas,proto3" json:"cancellation_gas"`
+	SettlementGas   uint64 `protobuf:"varint,4,opt,name=settlementGas,proto3" json:"settlement_gas"`
+	DepositGas      uint64 `protobuf:"varint,5,opt,name=depositGas,proto3" json:"deposit_gas"`
+	// truncated error that caused the contract to be dropped from the block, if any
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error"`
+	// time spent in the contract's sudo calls. It differs between nodes, so it is only kept in
+	// the memory of the node serving the query and is 0 in state or if the node doesn't have it
+	WallTimeMicroseconds uint64 `protobuf:"varint,7,opt,name=wallTimeMicroseconds,proto3" json:"wall_time_microseconds"`
+}
+
+func (m *ContractExecutionStats) Reset()         { *m = ContractExecutionStats{} }
+func (m *ContractExecutionStats) String() string { return proto.CompactTextString(m) }
+func (*ContractExecutionStats) ProtoMessage()    {}
+func (*ContractExecutionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f892fe5874eba7, []int{0}
+}
+func (m *ContractExecutionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractExecutionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractExecutionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractExecutionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractExecutionStats.Merge(m, src)
+}
+func (m *ContractExecutionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractExecutionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractExecutionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractExecutionStats proto.InternalMessageInfo
+
+func (m *ContractExecutionStats) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractExecutionStats) GetPlacementGas() uint64 {
+	if m != nil {
+		return m.PlacementGas
+	}
+	return 0
+}
+
+func (m *ContractExecutionStats) GetCancellationGas() uint64 {
+	if m != nil {
+		return m.CancellationGas
+	}
+	return 0
+}
+
+func (m *ContractExecutionStats) GetSettlementGas() uint64 {
+	if m != nil {
+		return m.SettlementGas
+	}
+	return 0
+}
+
+func (m *ContractExecutionStats) GetDepositGas() uint64 {
+	if m != nil {
+		return m.DepositGas
+	}
+	return 0
+}
+
+func (m *ContractExecutionStats) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ContractExecutionStats) GetWallTimeMicroseconds() uint64 {
+	if m != nil {
+		return m.WallTimeMicroseconds
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ContractExecutionStats)(nil), "seiprotocol.seichain.dex.ContractExecutionStats")
+}
+
+func init() { proto.RegisterFile("dex/execution_stats.proto", fileDescriptor_a4f892fe5874eba7) }
+
+var fileDescriptor_a4f892fe5874eba7 = []byte{
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0x4e, 0xeb, 0x30,
+	0x14, 0xc6, 0x9b, 0xdb, 0x3f, 0x57, 0xf5, 0xbd, 0xa5, 0x60, 0x55, 0x55, 0xe8, 0x10, 0x57, 0x9d,
+	0xba, 0x34, 0x19, 0x10, 0x12, 0x13, 0x43, 0x11, 0xea, 0x04, 0x43, 0x60, 0x62, 0x89, 0x5c, 0xe7,
+	0x28, 0xb1, 0x94, 0xc4, 0x51, 0xec, 0x8a, 0xf0, 0x14, 0xf0, 0x58, 0x8c, 0x1d, 0x99, 0x22, 0xd4,
+	0x6e, 0x79, 0x0a, 0x14, 0xf7, 0x0f, 0x2d, 0x62, 0x3a, 0xc7, 0xdf, 0xf7, 0xfd, 0xce, 0x19, 0x8e,
+	0xd1, 0xb9, 0x0f, 0xb9, 0x03, 0x39, 0xb0, 0x85, 0xe2, 0x22, 0xf1, 0xa4, 0xa2, 0x4a, 0xda, 0x69,
+	0x26, 0x94, 0xc0, 0xa6, 0x04, 0xae, 0x3b, 0x26, 0x22, 0x5b, 0x02, 0x67, 0x21, 0xe5, 0x89, 0xed,
+	0x43, 0x3e, 0xe8, 0x05, 0x22, 0x10, 0xda, 0x72, 0xaa, 0x6e, 0x93, 0x1f, 0xbd, 0xd6, 0x51, 0xff,
+	0x46, 0x24, 0x2a, 0xa3, 0x4c, 0xdd, 0xee, 0x26, 0x3e, 0x54, 0x03, 0xf1, 0x08, 0xb5, 0x42, 0xe0,
+	0x41, 0xa8, 0x4c, 0x63, 0x68, 0x8c, 0x1b, 0x53, 0x54, 0x16, 0x64, 0xab, 0xb8, 0xdb, 0x8a, 0x2f,
+	0xd1, 0xff, 0x34, 0xa2, 0x0c, 0x62, 0x48, 0xd4, 0x8c, 0x4a, 0xf3, 0x8f, 0x4e, 0x9e, 0x95, 0x05,
+	0xe9, 0xec, 0x75, 0x2f, 0xa0, 0xd2, 0x3d, 0x8a, 0xe1, 0x6b, 0xd4, 0x65, 0x34, 0x61, 0x10, 0x45,
+	0xb4, 0xda, 0x57, 0x91, 0x75, 0x4d, 0xf6, 0xca, 0x82, 0x9c, 0x1e, 0x5a, 0x1a, 0xfe, 0x19, 0xc6,
+	0x57, 0xa8, 0x23, 0x41, 0xa9, 0x68, 0xbf, 0xb7, 0xa1, 0x69, 0x5c, 0x16, 0xe4, 0xe4, 0xdb, 0xd0,
+	0xec, 0x71, 0x10, 0x3b, 0x08, 0xf9, 0x90, 0x0a, 0xc9, 0x35, 0xd6, 0xd4, 0x58, 0xb7, 0x2c, 0xc8,
+	0xbf, 0xad, 0xaa, 0x99, 0x83, 0x08, 0x26, 0xa8, 0x09, 0x59, 0x26, 0x32, 0xb3, 0x35, 0x34, 0xc6,
+	0xed, 0x69, 0xbb, 0x2c, 0xc8, 0x46, 0x70, 0x37, 0x05, 0xdf, 0xa3, 0xde, 0x33, 0x8d, 0xa2, 0x47,
+	0x1e, 0xc3, 0x1d, 0x67, 0x99, 0x90, 0xc0, 0x44, 0xe2, 0x4b, 0xf3, 0xaf, 0x9e, 0x3d, 0x28, 0x0b,
+	0xd2, 0xaf, 0x7c, 0x4f, 0xf1, 0x18, 0xbc, 0xf8, 0x20, 0xe1, 0xfe, 0xca, 0x4d, 0x67, 0xef, 0x2b,
+	0xcb, 0x58, 0xae, 0x2c, 0xe3, 0x73, 0x65, 0x19, 0x6f, 0x6b, 0xab, 0xb6, 0x5c, 0x5b, 0xb5, 0x8f,
+	0xb5, 0x55, 0x7b, 0x9a, 0x04, 0x5c, 0x85, 0x8b, 0xb9, 0xcd, 0x44, 0xec, 0x48, 0xe0, 0x93, 0xdd,
+	0x9d, 0xf5, 0x43, 0x1f, 0xda, 0xc9, 0x9d, 0xea, 0x6b, 0xa8, 0x97, 0x14, 0xe4, 0xbc, 0xa5, 0xfd,
+	0x8b, 0xaf, 0x01, 0x00, 0x3f, 0x7c, 0x8b, 0xa5, 0x2e, 0x02, 0x00, 0x00,
+}
+
+func (m *ContractExecutionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractExecutionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractExecutionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WallTimeMicroseconds != 0 {
+		i = encodeVarintExecutionStats(dAtA, i, uint64(m.WallTimeMicroseconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintExecutionStats(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DepositGas != 0 {
+		i = encodeVarintExecutionStats(dAtA, i, uint64(m.DepositGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SettlementGas != 0 {
+		i = encodeVarintExecutionStats(dAtA, i, uint64(m.SettlementGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CancellationGas != 0 {
+		i = encodeVarintExecutionStats(dAtA, i, uint64(m.CancellationGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PlacementGas != 0 {
+		i = encodeVarintExecutionStats(dAtA, i, uint64(m.PlacementGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintExecutionStats(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExecutionStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExecutionStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContractExecutionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovExecutionStats(uint64(m.Height))
+	}
+	if m.PlacementGas != 0 {
+		n += 1 + sovExecutionStats(uint64(m.PlacementGas))
+	}
+	if m.CancellationGas != 0 {
+		n += 1 + sovExecutionStats(uint64(m.CancellationGas))
+	}
+	if m.SettlementGas != 0 {
+		n += 1 + sovExecutionStats(uint64(m.SettlementGas))
+	}
+	if m.DepositGas != 0 {
+		n += 1 + sovExecutionStats(uint64(m.DepositGas))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovExecutionStats(uint64(l))
+	}
+	if m.WallTimeMicroseconds != 0 {
+		n += 1 + sovExecutionStats(uint64(m.WallTimeMicroseconds))
+	}
+	return n
+}
+
+func sovExecutionStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExecutionStats(x uint64) (n int) {
+	return sovExecutionStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContractExecutionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutionStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExecutionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExecutionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementGas", wireType)
+			}
+			m.PlacementGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlacementGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancellationGas", wireType)
+			}
+			m.CancellationGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancellationGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementGas", wireType)
+			}
+			m.SettlementGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositGas", wireType)
+			}
+			m.DepositGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutionStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutionStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WallTimeMicroseconds", wireType)
+			}
+			m.WallTimeMicroseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutionStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WallTimeMicroseconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutionStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutionStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExecutionStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExecutionStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutionStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutionStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExecutionStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExecutionStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExecutionStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExecutionStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExecutionStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExecutionStats = fmt.Errorf("proto: unexpected end of group")
+)
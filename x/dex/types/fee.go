@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func ValidateFeeRate(rate sdk.Dec) error {
+	if rate.IsNil() || rate.IsNegative() || rate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("fee rate must be in [0, 1), got %s", rate)
+	}
+	return nil
+}
+
+// GetMakerFeeRateOrZero returns the pair's maker fee rate, or zero if it has never been set
+func (m *Pair) GetMakerFeeRateOrZero() sdk.Dec {
+	if m.MakerFeeRate == nil {
+		return sdk.ZeroDec()
+	}
+	return *m.MakerFeeRate
+}
+
+// GetTakerFeeRateOrZero returns the pair's taker fee rate, or zero if it has never been set
+func (m *Pair) GetTakerFeeRateOrZero() sdk.Dec {
+	if m.TakerFeeRate == nil {
+		return sdk.ZeroDec()
+	}
+	return *m.TakerFeeRate
+}
+
+// ChargeFee sets the fee of the settlement to the given rate of its notional, denominated
+// in the price denom.
+func (m *SettlementEntry) ChargeFee(rate sdk.Dec) *SettlementEntry {
+	m.Fee = m.Quantity.Mul(m.ExecutionCostOrProceed).Mul(rate)
+	return m
+}
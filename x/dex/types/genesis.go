@@ -21,11 +21,64 @@ func (gs GenesisState) Validate() error {
 	if paramErr != nil {
 		return paramErr
 	}
+	contractInfos := map[string]ContractInfoV2{}
 	for _, cs := range gs.ContractState {
 		csErr := cs.Validate()
 		if csErr != nil {
 			return csErr
 		}
+		if _, ok := contractInfos[cs.ContractInfo.ContractAddr]; ok {
+			return fmt.Errorf("duplicated contract %s", cs.ContractInfo.ContractAddr)
+		}
+		contractInfos[cs.ContractInfo.ContractAddr] = cs.ContractInfo
+	}
+	if err := validateDependencySiblings(contractInfos); err != nil {
+		return err
+	}
+	displayDenoms := map[string]struct{}{}
+	for _, asset := range gs.AssetList {
+		if err := sdk.ValidateDenom(asset.Metadata.Base); err != nil {
+			return err
+		}
+		// asset metadata is keyed by display denom, so duplicates would overwrite each other
+		if _, ok := displayDenoms[asset.Metadata.Display]; ok {
+			return fmt.Errorf("duplicated asset metadata for display denom %s", asset.Metadata.Display)
+		}
+		displayDenoms[asset.Metadata.Display] = struct{}{}
+	}
+	return nil
+}
+
+// validateDependencySiblings checks that the sibling links among contracts depending on the same
+// contract point at each other
+func validateDependencySiblings(contractInfos map[string]ContractInfoV2) error {
+	hasSibling := func(siblingAddr string, dependency string, expectedElder string, expectedYounger string) bool {
+		sibling, ok := contractInfos[siblingAddr]
+		if !ok {
+			return false
+		}
+		for _, dep := range sibling.Dependencies {
+			if dep.Dependency != dependency {
+				continue
+			}
+			if expectedElder != "" && dep.ImmediateElderSibling == expectedElder {
+				return true
+			}
+			if expectedYounger != "" && dep.ImmediateYoungerSibling == expectedYounger {
+				return true
+			}
+		}
+		return false
+	}
+	for addr, contractInfo := range contractInfos {
+		for _, dep := range contractInfo.Dependencies {
+			if dep.ImmediateElderSibling != "" && !hasSibling(dep.ImmediateElderSibling, dep.Dependency, "", addr) {
+				return fmt.Errorf("contract %s's elder sibling %s for dependency %s doesn't point back to it", addr, dep.ImmediateElderSibling, dep.Dependency)
+			}
+			if dep.ImmediateYoungerSibling != "" && !hasSibling(dep.ImmediateYoungerSibling, dep.Dependency, addr, "") {
+				return fmt.Errorf("contract %s's younger sibling %s for dependency %s doesn't point back to it", addr, dep.ImmediateYoungerSibling, dep.Dependency)
+			}
+		}
 	}
 	return nil
 }
@@ -71,5 +124,59 @@ func (cs ContractState) Validate() error {
 		}
 		shortBookPriceMap[priceElem] = struct{}{}
 	}
+	// Check that allocations add up to their order entries and that every order rests only once
+	orderIDs := map[uint64]struct{}{}
+	for _, entry := range cs.orderEntries() {
+		if len(entry.Allocations) == 0 {
+			continue
+		}
+		total := sdk.ZeroDec()
+		for _, allocation := range entry.Allocations {
+			if allocation.Quantity.IsNil() || !allocation.Quantity.IsPositive() {
+				return fmt.Errorf("non-positive allocation for order %d", allocation.OrderId)
+			}
+			if allocation.OrderId >= cs.NextOrderId {
+				return fmt.Errorf("allocated order %d is not below next order id %d", allocation.OrderId, cs.NextOrderId)
+			}
+			if _, ok := orderIDs[allocation.OrderId]; ok {
+				return fmt.Errorf("order %d is allocated more than once", allocation.OrderId)
+			}
+			orderIDs[allocation.OrderId] = struct{}{}
+			total = total.Add(allocation.Quantity)
+		}
+		if entry.Quantity.IsNil() || !entry.Quantity.Equal(total) {
+			return fmt.Errorf("allocations at price %s of {price:%s,asset:%s} don't add up to the entry quantity", entry.Price, entry.PriceDenom, entry.AssetDenom)
+		}
+	}
+	if cs.RentTopUp != nil && cs.RentTopUp.ContractAddr != cs.ContractInfo.ContractAddr {
+		return fmt.Errorf("rent top-up of contract %s is stored under %s", cs.RentTopUp.ContractAddr, cs.ContractInfo.ContractAddr)
+	}
+	if cs.MatchResult != nil && cs.MatchResult.ContractAddr != cs.ContractInfo.ContractAddr {
+		return fmt.Errorf("match result of contract %s is stored under %s", cs.MatchResult.ContractAddr, cs.ContractInfo.ContractAddr)
+	}
+	for _, halt := range cs.PairHaltList {
+		if halt.Pair == nil {
+			return fmt.Errorf("pair halt without pair")
+		}
+	}
+	for _, order := range append(cs.PendingBracketOrdersList, cs.ActiveBracketOrdersList...) {
+		if order.BracketRole == BracketRole_NO_BRACKET {
+			return fmt.Errorf("bracket order %d has no bracket role", order.Id)
+		}
+		if order.ParentOrderId >= order.Id {
+			return fmt.Errorf("bracket order %d is not placed after its parent order %d", order.Id, order.ParentOrderId)
+		}
+	}
 	return nil
 }
+
+func (cs ContractState) orderEntries() []*OrderEntry {
+	entries := []*OrderEntry{}
+	for _, elem := range cs.LongBookList {
+		entries = append(entries, elem.Entry)
+	}
+	for _, elem := range cs.ShortBookList {
+		entries = append(entries, elem.Entry)
+	}
+	return entries
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -28,6 +30,7 @@ type GenesisState struct {
 	Params        Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	ContractState []ContractState `protobuf:"bytes,2,rep,name=contractState,proto3" json:"contractState"`
 	LastEpoch     uint64          `protobuf:"varint,3,opt,name=lastEpoch,proto3" json:"lastEpoch,omitempty"`
+	AssetList     []AssetMetadata `protobuf:"bytes,4,rep,name=assetList,proto3" json:"assetList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,14 +87,33 @@ func (m *GenesisState) GetLastEpoch() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetAssetList() []AssetMetadata {
+	if m != nil {
+		return m.AssetList
+	}
+	return nil
+}
+
 type ContractState struct {
-	ContractInfo        ContractInfoV2       `protobuf:"bytes,1,opt,name=contractInfo,proto3" json:"contractInfo"`
-	LongBookList        []LongBook           `protobuf:"bytes,2,rep,name=longBookList,proto3" json:"longBookList"`
-	ShortBookList       []ShortBook          `protobuf:"bytes,3,rep,name=shortBookList,proto3" json:"shortBookList"`
-	TriggeredOrdersList []Order              `protobuf:"bytes,4,rep,name=triggeredOrdersList,proto3" json:"triggeredOrdersList"`
-	PairList            []Pair               `protobuf:"bytes,5,rep,name=pairList,proto3" json:"pairList"`
-	PriceList           []ContractPairPrices `protobuf:"bytes,6,rep,name=priceList,proto3" json:"priceList"`
-	NextOrderId         uint64               `protobuf:"varint,7,opt,name=nextOrderId,proto3" json:"nextOrderId,omitempty"`
+	ContractInfo             ContractInfoV2                              `protobuf:"bytes,1,opt,name=contractInfo,proto3" json:"contractInfo"`
+	LongBookList             []LongBook                                  `protobuf:"bytes,2,rep,name=longBookList,proto3" json:"longBookList"`
+	ShortBookList            []ShortBook                                 `protobuf:"bytes,3,rep,name=shortBookList,proto3" json:"shortBookList"`
+	TriggeredOrdersList      []Order                                     `protobuf:"bytes,4,rep,name=triggeredOrdersList,proto3" json:"triggeredOrdersList"`
+	PairList                 []Pair                                      `protobuf:"bytes,5,rep,name=pairList,proto3" json:"pairList"`
+	PriceList                []ContractPairPrices                        `protobuf:"bytes,6,rep,name=priceList,proto3" json:"priceList"`
+	NextOrderId              uint64                                      `protobuf:"varint,7,opt,name=nextOrderId,proto3" json:"nextOrderId,omitempty"`
+	GoodTilTimeOrdersList    []Order                                     `protobuf:"bytes,8,rep,name=goodTilTimeOrdersList,proto3" json:"goodTilTimeOrdersList"`
+	CandleList               []ContractPairCandles                       `protobuf:"bytes,9,rep,name=candleList,proto3" json:"candleList"`
+	OrderCountList           []OrderCount                                `protobuf:"bytes,10,rep,name=orderCountList,proto3" json:"orderCountList"`
+	MatchResult              *MatchResult                                `protobuf:"bytes,11,opt,name=matchResult,proto3" json:"matchResult,omitempty"`
+	AccountFillList          []AccountFill                               `protobuf:"bytes,12,rep,name=accountFillList,proto3" json:"accountFillList"`
+	RentTopUp                *RentTopUp                                  `protobuf:"bytes,13,opt,name=rentTopUp,proto3" json:"rentTopUp,omitempty"`
+	RentChargeList           []RentCharge                                `protobuf:"bytes,14,rep,name=rentChargeList,proto3" json:"rentChargeList"`
+	PairHaltList             []PairHalt                                  `protobuf:"bytes,15,rep,name=pairHaltList,proto3" json:"pairHaltList"`
+	ExecutionStatsList       []ContractExecutionStats                    `protobuf:"bytes,16,rep,name=executionStatsList,proto3" json:"executionStatsList"`
+	PendingBracketOrdersList []Order                                     `protobuf:"bytes,17,rep,name=pendingBracketOrdersList,proto3" json:"pendingBracketOrdersList"`
+	ActiveBracketOrdersList  []Order                                     `protobuf:"bytes,18,rep,name=activeBracketOrdersList,proto3" json:"activeBracketOrdersList"`
+	UncollectedFeeList       github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,19,rep,name=uncollectedFeeList,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"uncollectedFeeList"`
 }
 
 func (m *ContractState) Reset()         { *m = ContractState{} }
@@ -176,6 +198,90 @@ func (m *ContractState) GetNextOrderId() uint64 {
 	return 0
 }
 
+func (m *ContractState) GetGoodTilTimeOrdersList() []Order {
+	if m != nil {
+		return m.GoodTilTimeOrdersList
+	}
+	return nil
+}
+
+func (m *ContractState) GetCandleList() []ContractPairCandles {
+	if m != nil {
+		return m.CandleList
+	}
+	return nil
+}
+
+func (m *ContractState) GetOrderCountList() []OrderCount {
+	if m != nil {
+		return m.OrderCountList
+	}
+	return nil
+}
+
+func (m *ContractState) GetMatchResult() *MatchResult {
+	if m != nil {
+		return m.MatchResult
+	}
+	return nil
+}
+
+func (m *ContractState) GetAccountFillList() []AccountFill {
+	if m != nil {
+		return m.AccountFillList
+	}
+	return nil
+}
+
+func (m *ContractState) GetRentTopUp() *RentTopUp {
+	if m != nil {
+		return m.RentTopUp
+	}
+	return nil
+}
+
+func (m *ContractState) GetRentChargeList() []RentCharge {
+	if m != nil {
+		return m.RentChargeList
+	}
+	return nil
+}
+
+func (m *ContractState) GetPairHaltList() []PairHalt {
+	if m != nil {
+		return m.PairHaltList
+	}
+	return nil
+}
+
+func (m *ContractState) GetExecutionStatsList() []ContractExecutionStats {
+	if m != nil {
+		return m.ExecutionStatsList
+	}
+	return nil
+}
+
+func (m *ContractState) GetPendingBracketOrdersList() []Order {
+	if m != nil {
+		return m.PendingBracketOrdersList
+	}
+	return nil
+}
+
+func (m *ContractState) GetActiveBracketOrdersList() []Order {
+	if m != nil {
+		return m.ActiveBracketOrdersList
+	}
+	return nil
+}
+
+func (m *ContractState) GetUncollectedFeeList() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.UncollectedFeeList
+	}
+	return nil
+}
+
 type ContractPairPrices struct {
 	PricePair Pair     `protobuf:"bytes,1,opt,name=pricePair,proto3" json:"pricePair"`
 	Prices    []*Price `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
@@ -228,48 +334,271 @@ func (m *ContractPairPrices) GetPrices() []*Price {
 	return nil
 }
 
+type ContractPairCandles struct {
+	Pair              Pair     `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	IntervalInSeconds uint64   `protobuf:"varint,2,opt,name=intervalInSeconds,proto3" json:"intervalInSeconds,omitempty"`
+	Candles           []Candle `protobuf:"bytes,3,rep,name=candles,proto3" json:"candles"`
+}
+
+func (m *ContractPairCandles) Reset()         { *m = ContractPairCandles{} }
+func (m *ContractPairCandles) String() string { return proto.CompactTextString(m) }
+func (*ContractPairCandles) ProtoMessage()    {}
+func (*ContractPairCandles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a803aaabd08db59d, []int{3}
+}
+func (m *ContractPairCandles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractPairCandles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractPairCandles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractPairCandles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractPairCandles.Merge(m, src)
+}
+func (m *ContractPairCandles) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractPairCandles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractPairCandles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractPairCandles proto.InternalMessageInfo
+
+func (m *ContractPairCandles) GetPair() Pair {
+	if m != nil {
+		return m.Pair
+	}
+	return Pair{}
+}
+
+func (m *ContractPairCandles) GetIntervalInSeconds() uint64 {
+	if m != nil {
+		return m.IntervalInSeconds
+	}
+	return 0
+}
+
+func (m *ContractPairCandles) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
+// number of orders resting at a price level
+type OrderCount struct {
+	PriceDenom        string                                 `protobuf:"bytes,1,opt,name=priceDenom,proto3" json:"priceDenom,omitempty"`
+	AssetDenom        string                                 `protobuf:"bytes,2,opt,name=assetDenom,proto3" json:"assetDenom,omitempty"`
+	PositionDirection PositionDirection                      `protobuf:"varint,3,opt,name=positionDirection,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"positionDirection,omitempty"`
+	Price             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	Count             uint64                                 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *OrderCount) Reset()         { *m = OrderCount{} }
+func (m *OrderCount) String() string { return proto.CompactTextString(m) }
+func (*OrderCount) ProtoMessage()    {}
+func (*OrderCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a803aaabd08db59d, []int{4}
+}
+func (m *OrderCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderCount.Merge(m, src)
+}
+func (m *OrderCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderCount proto.InternalMessageInfo
+
+func (m *OrderCount) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *OrderCount) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *OrderCount) GetPositionDirection() PositionDirection {
+	if m != nil {
+		return m.PositionDirection
+	}
+	return PositionDirection_LONG
+}
+
+func (m *OrderCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// settled fill of an account along with its index among the contract's settlements of its block
+type AccountFill struct {
+	Index uint64          `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Fill  SettlementEntry `protobuf:"bytes,2,opt,name=fill,proto3" json:"fill"`
+}
+
+func (m *AccountFill) Reset()         { *m = AccountFill{} }
+func (m *AccountFill) String() string { return proto.CompactTextString(m) }
+func (*AccountFill) ProtoMessage()    {}
+func (*AccountFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a803aaabd08db59d, []int{5}
+}
+func (m *AccountFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountFill.Merge(m, src)
+}
+func (m *AccountFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountFill proto.InternalMessageInfo
+
+func (m *AccountFill) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *AccountFill) GetFill() SettlementEntry {
+	if m != nil {
+		return m.Fill
+	}
+	return SettlementEntry{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "seiprotocol.seichain.dex.GenesisState")
 	proto.RegisterType((*ContractState)(nil), "seiprotocol.seichain.dex.ContractState")
 	proto.RegisterType((*ContractPairPrices)(nil), "seiprotocol.seichain.dex.ContractPairPrices")
+	proto.RegisterType((*ContractPairCandles)(nil), "seiprotocol.seichain.dex.ContractPairCandles")
+	proto.RegisterType((*OrderCount)(nil), "seiprotocol.seichain.dex.OrderCount")
+	proto.RegisterType((*AccountFill)(nil), "seiprotocol.seichain.dex.AccountFill")
 }
 
 func init() { proto.RegisterFile("dex/genesis.proto", fileDescriptor_a803aaabd08db59d) }
 
 var fileDescriptor_a803aaabd08db59d = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0x5a, 0x0a, 0x73, 0x5b, 0xfe, 0x78, 0x3b, 0x44, 0x15, 0xca, 0xa2, 0x72, 0xa0,
-	0x07, 0x96, 0x48, 0xe5, 0xc0, 0x0d, 0xa1, 0x22, 0x34, 0x4d, 0xaa, 0xb4, 0xaa, 0x95, 0x40, 0xe2,
-	0x82, 0xd2, 0xc4, 0xa4, 0xd6, 0xba, 0xbc, 0x91, 0x6d, 0xa4, 0xf2, 0x2d, 0xd8, 0x47, 0xe2, 0xb6,
-	0xe3, 0x8e, 0x9c, 0x10, 0x6a, 0xbf, 0x08, 0xf2, 0x1b, 0x7b, 0x4d, 0x04, 0x59, 0xb9, 0xc5, 0x8f,
-	0x9f, 0xf7, 0xe7, 0xf7, 0xb5, 0x9f, 0x90, 0xa7, 0x09, 0x5b, 0x87, 0x29, 0xcb, 0x98, 0xe4, 0x32,
-	0xc8, 0x05, 0x28, 0xa0, 0xae, 0x64, 0x1c, 0xbf, 0x62, 0x58, 0x05, 0x92, 0xf1, 0x78, 0x19, 0xf1,
-	0x2c, 0x48, 0xd8, 0xba, 0x7f, 0x94, 0x42, 0x0a, 0xb8, 0x15, 0xea, 0xaf, 0xc2, 0xdf, 0x7f, 0xa2,
-	0x11, 0x79, 0x24, 0xa2, 0x4b, 0x43, 0xe8, 0x1f, 0x6a, 0x65, 0x05, 0x59, 0xfa, 0x79, 0x01, 0x70,
-	0x61, 0xc4, 0x23, 0x2d, 0xca, 0x25, 0x08, 0x55, 0x56, 0x1f, 0x6b, 0x15, 0x44, 0xc2, 0x84, 0x11,
-	0xa8, 0x16, 0x62, 0xc8, 0x94, 0x88, 0x62, 0x65, 0xb4, 0x47, 0xc5, 0x09, 0x5c, 0x94, 0x8b, 0x72,
-	0xc1, 0x63, 0x56, 0x08, 0x83, 0x1f, 0x0e, 0xe9, 0x9e, 0x16, 0x43, 0xcc, 0x55, 0xa4, 0x18, 0x7d,
-	0x43, 0xda, 0x45, 0x47, 0xae, 0xe3, 0x3b, 0xc3, 0xce, 0xc8, 0x0f, 0xea, 0x86, 0x0a, 0xa6, 0xe8,
-	0x1b, 0xb7, 0xae, 0x7f, 0x1d, 0x37, 0x66, 0xa6, 0x8a, 0xce, 0x49, 0xcf, 0xf6, 0x80, 0x40, 0xf7,
-	0x9e, 0xdf, 0x1c, 0x76, 0x46, 0x2f, 0xea, 0x31, 0xef, 0xca, 0x76, 0x43, 0xab, 0x32, 0xe8, 0x33,
-	0x72, 0xb0, 0x8a, 0xa4, 0x7a, 0x9f, 0x43, 0xbc, 0x74, 0x9b, 0xbe, 0x33, 0x6c, 0xcd, 0x76, 0xc2,
-	0xe0, 0xaa, 0x45, 0x7a, 0x15, 0x08, 0x9d, 0x91, 0xae, 0x05, 0x9c, 0x65, 0x5f, 0xc0, 0x8c, 0x32,
-	0xdc, 0xdf, 0x83, 0x76, 0x7f, 0x18, 0x99, 0x26, 0x2a, 0x0c, 0x3a, 0x21, 0x5d, 0xfd, 0x30, 0x63,
-	0x80, 0x8b, 0x09, 0x97, 0xca, 0xcc, 0x35, 0xa8, 0x67, 0x4e, 0x8c, 0xdb, 0xd2, 0xca, 0xd5, 0xf4,
-	0x9c, 0xf4, 0xf0, 0x45, 0x6f, 0x71, 0x4d, 0xc4, 0x3d, 0xaf, 0xc7, 0xcd, 0xad, 0xdd, 0x5e, 0x51,
-	0xa5, 0x9e, 0x7e, 0x24, 0x87, 0x4a, 0xf0, 0x34, 0x65, 0x82, 0x25, 0xe7, 0x3a, 0x15, 0x12, 0xb1,
-	0x2d, 0xc4, 0x1e, 0xd7, 0x63, 0xd1, 0x6b, 0x90, 0xff, 0x22, 0xd0, 0xb7, 0xe4, 0xa1, 0x0e, 0x10,
-	0xd2, 0xee, 0x23, 0xcd, 0xbb, 0x2b, 0x12, 0xdc, 0xc2, 0x6e, 0xab, 0xe8, 0x94, 0x1c, 0x60, 0xe4,
-	0x10, 0xd1, 0x46, 0xc4, 0xcb, 0xfd, 0x4f, 0xa1, 0x51, 0x53, 0x5d, 0x66, 0x13, 0xb6, 0x83, 0x50,
-	0x9f, 0x74, 0x32, 0xb6, 0x56, 0xd8, 0xe5, 0x59, 0xe2, 0x3e, 0xc0, 0x44, 0x94, 0xa5, 0xc1, 0x95,
-	0x43, 0xe8, 0xdf, 0x24, 0x3a, 0x36, 0xad, 0x68, 0xc9, 0xa4, 0xe2, 0xff, 0xa6, 0xd9, 0x95, 0xd1,
-	0xd7, 0xa4, 0x8d, 0x0b, 0x69, 0x22, 0x70, 0xc7, 0xe5, 0xe2, 0xa9, 0x33, 0x63, 0x1f, 0x9f, 0x5e,
-	0x6f, 0x3c, 0xe7, 0x66, 0xe3, 0x39, 0xbf, 0x37, 0x9e, 0xf3, 0x7d, 0xeb, 0x35, 0x6e, 0xb6, 0x5e,
-	0xe3, 0xe7, 0xd6, 0x6b, 0x7c, 0x3a, 0x49, 0xb9, 0x5a, 0x7e, 0x5d, 0x04, 0x31, 0x5c, 0x86, 0x92,
-	0xf1, 0x13, 0x4b, 0xc3, 0x05, 0xe2, 0xc2, 0x75, 0xa8, 0x7f, 0x5d, 0xf5, 0x2d, 0x67, 0x72, 0xd1,
-	0xc6, 0xfd, 0x57, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x4d, 0x7c, 0x7f, 0x83, 0x04, 0x00,
-	0x00,
+	// 1096 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x4f, 0x23, 0x37,
+	0x14, 0xc7, 0x09, 0x04, 0x76, 0xf3, 0xc2, 0x8f, 0xc5, 0xd0, 0x76, 0x8a, 0x56, 0x21, 0x4a, 0x7f,
+	0x51, 0xed, 0x32, 0xe9, 0xb2, 0x87, 0xf6, 0x54, 0x2d, 0x09, 0x2c, 0x45, 0x65, 0xb5, 0x68, 0x60,
+	0x5b, 0xb5, 0x3d, 0x44, 0xc6, 0x63, 0x12, 0x8b, 0x89, 0x1d, 0x8d, 0x1d, 0x94, 0x3d, 0x56, 0xbd,
+	0x57, 0xed, 0xbf, 0xd1, 0x7f, 0xa2, 0xd7, 0x3d, 0xf4, 0xb0, 0xc7, 0xaa, 0x87, 0x6d, 0x05, 0xff,
+	0x48, 0xe5, 0x37, 0x1e, 0x32, 0x81, 0xfc, 0x80, 0x53, 0xe2, 0xe7, 0xf7, 0xfd, 0xbc, 0xe7, 0x67,
+	0xfb, 0x8d, 0x61, 0x39, 0xe4, 0xbd, 0x6a, 0x93, 0x4b, 0xae, 0x85, 0xf6, 0x3b, 0xb1, 0x32, 0x8a,
+	0x78, 0x9a, 0x0b, 0xfc, 0xc7, 0x54, 0xe4, 0x6b, 0x2e, 0x58, 0x8b, 0x0a, 0xe9, 0x87, 0xbc, 0xb7,
+	0xb6, 0xda, 0x54, 0x4d, 0x85, 0x53, 0x55, 0xfb, 0x2f, 0xf1, 0x5f, 0x2b, 0x31, 0xa5, 0xdb, 0x4a,
+	0x57, 0x4f, 0xa8, 0xe6, 0xd5, 0xf3, 0x27, 0x27, 0xdc, 0xd0, 0x27, 0x55, 0xa6, 0x84, 0x74, 0xf3,
+	0x0f, 0x6c, 0x88, 0x0e, 0x8d, 0x69, 0xdb, 0x45, 0x58, 0x5b, 0xb1, 0x96, 0x48, 0xc9, 0x66, 0xe3,
+	0x44, 0xa9, 0x33, 0x67, 0x5c, 0xb5, 0x46, 0xdd, 0x52, 0xb1, 0xc9, 0x5a, 0x97, 0xac, 0x55, 0xc5,
+	0x21, 0x8f, 0x9d, 0x81, 0x58, 0x03, 0x53, 0xd2, 0xc4, 0x94, 0x19, 0x67, 0x5b, 0x4c, 0x22, 0x88,
+	0x38, 0x2b, 0xea, 0xc4, 0x82, 0xf1, 0xac, 0x81, 0xcb, 0x6e, 0x5b, 0x67, 0x73, 0x62, 0x54, 0x86,
+	0x51, 0xea, 0xf2, 0xbe, 0xb5, 0xb4, 0xa9, 0x61, 0xad, 0x46, 0xcc, 0x75, 0x37, 0x32, 0x03, 0x69,
+	0x71, 0x63, 0x22, 0xde, 0xe6, 0x72, 0x20, 0x62, 0xdc, 0x1f, 0xaf, 0xa4, 0x19, 0x34, 0x5a, 0x74,
+	0x50, 0x4a, 0xb5, 0xe6, 0xa6, 0x11, 0x09, 0x9d, 0x5a, 0x3f, 0xc4, 0x5c, 0x7a, 0x9c, 0x75, 0x8d,
+	0x50, 0xb2, 0xa1, 0x0d, 0x35, 0x2e, 0xab, 0xca, 0xaf, 0xd3, 0x30, 0xbf, 0x97, 0xec, 0xc5, 0x91,
+	0xa1, 0x86, 0x93, 0xaf, 0x61, 0x2e, 0x29, 0x9c, 0x97, 0x2b, 0xe7, 0x36, 0x8a, 0x5b, 0x65, 0x7f,
+	0xd4, 0xde, 0xf8, 0x87, 0xe8, 0x57, 0xcb, 0xbf, 0x79, 0xb7, 0x3e, 0x15, 0x38, 0x15, 0x39, 0x82,
+	0x85, 0xb4, 0x54, 0x08, 0xf4, 0xa6, 0xcb, 0x33, 0x1b, 0xc5, 0xad, 0xcf, 0x46, 0x63, 0xea, 0x59,
+	0x77, 0x47, 0x1b, 0x64, 0x90, 0x87, 0x50, 0x88, 0xa8, 0x36, 0xbb, 0x1d, 0xc5, 0x5a, 0xde, 0x4c,
+	0x39, 0xb7, 0x91, 0x0f, 0xfa, 0x06, 0xf2, 0x2d, 0x14, 0x70, 0xc9, 0x07, 0x42, 0x1b, 0x2f, 0x3f,
+	0x29, 0xdc, 0xb6, 0x75, 0x7d, 0xc1, 0x0d, 0x0d, 0xa9, 0xa1, 0x2e, 0x5c, 0x5f, 0x5f, 0xf9, 0x6b,
+	0x1e, 0x16, 0x06, 0x32, 0x22, 0x01, 0xcc, 0xa7, 0xd9, 0xec, 0xcb, 0x53, 0xe5, 0xea, 0xb2, 0x31,
+	0x79, 0x41, 0xd6, 0xfb, 0xbb, 0x2d, 0x17, 0x62, 0x80, 0x41, 0x0e, 0x60, 0xde, 0x1e, 0xc6, 0x9a,
+	0x52, 0x67, 0x98, 0x75, 0x52, 0xa4, 0xca, 0x68, 0xe6, 0x81, 0xf3, 0x4e, 0x69, 0x59, 0x35, 0x79,
+	0x09, 0x0b, 0x78, 0x8a, 0xaf, 0x70, 0x33, 0x88, 0xfb, 0x68, 0x34, 0xee, 0x28, 0x75, 0x4f, 0xeb,
+	0x3d, 0xa0, 0x27, 0xdf, 0xc3, 0x8a, 0x89, 0x45, 0xb3, 0xc9, 0x63, 0x1e, 0xbe, 0xb4, 0x37, 0x41,
+	0x67, 0x6a, 0xbb, 0x3e, 0x1a, 0x8b, 0xbe, 0x0e, 0x39, 0x8c, 0x40, 0x9e, 0xc1, 0x7d, 0x7b, 0x64,
+	0x91, 0x36, 0x8b, 0xb4, 0xd2, 0xb8, 0xf3, 0x25, 0x52, 0xd8, 0x95, 0x8a, 0x1c, 0x42, 0x01, 0xaf,
+	0x19, 0x22, 0xe6, 0x10, 0xf1, 0x78, 0xf2, 0x56, 0x58, 0xd4, 0xa1, 0x95, 0xa5, 0xc7, 0xb5, 0x0f,
+	0x21, 0x65, 0x28, 0x4a, 0xde, 0x33, 0x98, 0xe5, 0x7e, 0xe8, 0xdd, 0xc3, 0xe3, 0x95, 0x35, 0x91,
+	0x9f, 0xe0, 0xbd, 0xa6, 0x52, 0xe1, 0xb1, 0x88, 0x8e, 0x45, 0x9b, 0x67, 0x0a, 0x72, 0xff, 0x2e,
+	0x05, 0x19, 0xce, 0x20, 0x47, 0x00, 0x49, 0x57, 0x40, 0x62, 0x01, 0x89, 0x9b, 0xb7, 0x5b, 0x51,
+	0x1d, 0x75, 0xe9, 0x92, 0x32, 0x18, 0x12, 0xc0, 0x22, 0x76, 0xb0, 0xba, 0xea, 0xca, 0xe4, 0x5e,
+	0x00, 0x82, 0x3f, 0x9e, 0x90, 0x2a, 0xfa, 0x3b, 0xde, 0x35, 0x02, 0xd9, 0x83, 0x22, 0x36, 0xab,
+	0x00, 0x7b, 0x95, 0x57, 0xc4, 0x6b, 0xf0, 0xc9, 0x68, 0xe0, 0x8b, 0xbe, 0x73, 0x90, 0x55, 0x92,
+	0x57, 0xb0, 0x44, 0x19, 0xb3, 0xdc, 0xe7, 0x22, 0x8a, 0x30, 0xbb, 0xf9, 0xf2, 0xcc, 0x78, 0xd8,
+	0x76, 0x5f, 0xe0, 0xd2, 0xbb, 0xce, 0x20, 0xdb, 0x50, 0xb0, 0xed, 0xf1, 0x58, 0x75, 0x5e, 0x75,
+	0xbc, 0x85, 0x72, 0x6e, 0xfc, 0x0d, 0x08, 0x52, 0xd7, 0xa0, 0xaf, 0xb2, 0x65, 0xb3, 0x83, 0x7a,
+	0x8b, 0xc6, 0xcd, 0x64, 0x3f, 0x16, 0x27, 0x95, 0x2d, 0xb8, 0xf2, 0x4f, 0xcb, 0x36, 0x48, 0xb0,
+	0x57, 0xdd, 0x1e, 0xde, 0x6f, 0x68, 0x94, 0x6c, 0xc4, 0xd2, 0xa4, 0xab, 0x7e, 0xe8, 0xbc, 0xd3,
+	0xab, 0x9e, 0x55, 0x93, 0x53, 0x20, 0x57, 0x8d, 0xdc, 0xb6, 0xa7, 0xe4, 0x1c, 0x3e, 0x40, 0xe6,
+	0x17, 0x93, 0x4f, 0xcd, 0xee, 0x80, 0xd6, 0x45, 0x18, 0x42, 0x24, 0x14, 0xbc, 0x0e, 0x97, 0xa1,
+	0x90, 0xcd, 0x5a, 0x4c, 0xd9, 0x19, 0x37, 0x99, 0x53, 0xbf, 0x7c, 0x97, 0x53, 0x3f, 0x12, 0x43,
+	0x1a, 0xf0, 0x01, 0x65, 0x46, 0x9c, 0xf3, 0x9b, 0x11, 0xc8, 0x5d, 0x22, 0x8c, 0xa2, 0x90, 0x9f,
+	0x73, 0x40, 0xba, 0x92, 0xa9, 0x28, 0xe2, 0xcc, 0xf0, 0xf0, 0x39, 0x4f, 0xb6, 0x74, 0x05, 0xe1,
+	0x0f, 0xfd, 0xe4, 0x0d, 0xe1, 0xdb, 0x37, 0x84, 0xef, 0xde, 0x10, 0xfe, 0x0e, 0x67, 0x75, 0x25,
+	0x64, 0xed, 0xa9, 0x25, 0xff, 0xf1, 0xef, 0xfa, 0xa3, 0xa6, 0x30, 0xad, 0xee, 0x89, 0xcf, 0x54,
+	0xbb, 0xea, 0xde, 0x1c, 0xc9, 0xcf, 0xa6, 0x0e, 0xcf, 0xaa, 0xe6, 0x75, 0x87, 0xeb, 0x54, 0xa3,
+	0x83, 0x21, 0xc1, 0x2a, 0xbf, 0xe7, 0x80, 0xdc, 0x6c, 0x42, 0xa4, 0xe6, 0xba, 0x98, 0x35, 0xb9,
+	0x0f, 0xca, 0xed, 0x1a, 0x61, 0x5f, 0x46, 0xbe, 0x84, 0x39, 0x1c, 0x68, 0x6f, 0x7a, 0x52, 0xb9,
+	0x30, 0x6a, 0xe0, 0xdc, 0x2b, 0x7f, 0xe6, 0x60, 0x65, 0x48, 0x1b, 0x21, 0x5f, 0x41, 0xbe, 0x73,
+	0xd7, 0x7c, 0x50, 0x41, 0x1e, 0xc3, 0xb2, 0x90, 0x86, 0xc7, 0xe7, 0x34, 0xda, 0x97, 0x47, 0x9c,
+	0x29, 0x19, 0xda, 0xac, 0x6c, 0x23, 0xbd, 0x39, 0x41, 0x9e, 0xc1, 0xbd, 0xa4, 0x55, 0x69, 0xf7,
+	0xa1, 0x1a, 0xf3, 0xc6, 0x48, 0x72, 0x73, 0xc1, 0x52, 0x59, 0xe5, 0x97, 0x69, 0x80, 0x7e, 0xbf,
+	0x22, 0x25, 0x00, 0x5c, 0xda, 0x0e, 0x97, 0xaa, 0x8d, 0xe9, 0x17, 0x82, 0x8c, 0xc5, 0xce, 0xe3,
+	0x07, 0x3e, 0x99, 0x9f, 0x4e, 0xe6, 0xfb, 0x16, 0xf2, 0x03, 0x2c, 0x77, 0x94, 0x16, 0xf6, 0x06,
+	0xec, 0x88, 0x98, 0x33, 0xfb, 0x07, 0x9f, 0x19, 0x8b, 0x5b, 0x8f, 0xc6, 0x54, 0xe1, 0xba, 0x24,
+	0xb8, 0x49, 0x21, 0x3b, 0x30, 0x8b, 0x89, 0x78, 0x79, 0x1b, 0xb5, 0xe6, 0xdb, 0x75, 0xfc, 0xf3,
+	0x6e, 0xfd, 0xd3, 0xdb, 0x9d, 0xab, 0x20, 0x11, 0x93, 0x55, 0x98, 0xc5, 0x5e, 0xe7, 0xcd, 0x62,
+	0x4d, 0x93, 0x41, 0xa5, 0x05, 0xc5, 0x4c, 0x5b, 0xb4, 0x4e, 0x42, 0x86, 0xbc, 0x87, 0x05, 0xc8,
+	0x07, 0xc9, 0x80, 0xd4, 0x21, 0x7f, 0x2a, 0xa2, 0x08, 0x57, 0x5d, 0xdc, 0xfa, 0x7c, 0xcc, 0x93,
+	0xe0, 0xea, 0xc1, 0xb9, 0x2b, 0x4d, 0xfc, 0x3a, 0xdd, 0x5f, 0x2b, 0xae, 0xed, 0xbd, 0xb9, 0x28,
+	0xe5, 0xde, 0x5e, 0x94, 0x72, 0xff, 0x5d, 0x94, 0x72, 0xbf, 0x5d, 0x96, 0xa6, 0xde, 0x5e, 0x96,
+	0xa6, 0xfe, 0xbe, 0x2c, 0x4d, 0xfd, 0xb8, 0x99, 0x59, 0x88, 0xe6, 0x62, 0x33, 0x65, 0xe3, 0x00,
+	0xe1, 0xd5, 0x5e, 0xd5, 0x3e, 0x3f, 0x71, 0x4d, 0x27, 0x73, 0x38, 0xff, 0xf4, 0xff, 0x01, 0x00,
+	0x8e, 0x73, 0x8e, 0xf0, 0x04, 0x0c, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -292,6 +621,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AssetList) > 0 {
+		for iNdEx := len(m.AssetList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.LastEpoch != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEpoch))
 		i--
@@ -344,15 +687,10 @@ func (m *ContractState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.NextOrderId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOrderId))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.PriceList) > 0 {
-		for iNdEx := len(m.PriceList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UncollectedFeeList) > 0 {
+		for iNdEx := len(m.UncollectedFeeList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PriceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UncollectedFeeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -360,13 +698,15 @@ func (m *ContractState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
-	if len(m.PairList) > 0 {
-		for iNdEx := len(m.PairList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ActiveBracketOrdersList) > 0 {
+		for iNdEx := len(m.ActiveBracketOrdersList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PairList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ActiveBracketOrdersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -374,13 +714,15 @@ func (m *ContractState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.TriggeredOrdersList) > 0 {
-		for iNdEx := len(m.TriggeredOrdersList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingBracketOrdersList) > 0 {
+		for iNdEx := len(m.PendingBracketOrdersList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TriggeredOrdersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingBracketOrdersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -388,13 +730,15 @@ func (m *ContractState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.ShortBookList) > 0 {
-		for iNdEx := len(m.ShortBookList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ExecutionStatsList) > 0 {
+		for iNdEx := len(m.ExecutionStatsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ShortBookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ExecutionStatsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -402,13 +746,15 @@ func (m *ContractState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.LongBookList) > 0 {
-		for iNdEx := len(m.LongBookList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PairHaltList) > 0 {
+		for iNdEx := len(m.PairHaltList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LongBookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PairHaltList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -416,46 +762,39 @@ func (m *ContractState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x7a
 		}
 	}
-	{
-		size, err := m.ContractInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.RentChargeList) > 0 {
+		for iNdEx := len(m.RentChargeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RentChargeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractPairPrices) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RentTopUp != nil {
+		{
+			size, err := m.RentTopUp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractPairPrices) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractPairPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AccountFillList) > 0 {
+		for iNdEx := len(m.AccountFillList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AccountFillList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -463,11 +802,140 @@ func (m *ContractPairPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x62
 		}
 	}
-	{
-		size, err := m.PricePair.MarshalToSizedBuffer(dAtA[:i])
+	if m.MatchResult != nil {
+		{
+			size, err := m.MatchResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.OrderCountList) > 0 {
+		for iNdEx := len(m.OrderCountList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderCountList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CandleList) > 0 {
+		for iNdEx := len(m.CandleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CandleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.GoodTilTimeOrdersList) > 0 {
+		for iNdEx := len(m.GoodTilTimeOrdersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GoodTilTimeOrdersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NextOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOrderId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PriceList) > 0 {
+		for iNdEx := len(m.PriceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PairList) > 0 {
+		for iNdEx := len(m.PairList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TriggeredOrdersList) > 0 {
+		for iNdEx := len(m.TriggeredOrdersList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggeredOrdersList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ShortBookList) > 0 {
+		for iNdEx := len(m.ShortBookList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortBookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LongBookList) > 0 {
+		for iNdEx := len(m.LongBookList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LongBookList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ContractInfo.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -479,84 +947,352 @@ func (m *ContractPairPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractPairPrices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ContractPairPrices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractPairPrices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.ContractState) > 0 {
-		for _, e := range m.ContractState {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.LastEpoch != 0 {
-		n += 1 + sovGenesis(uint64(m.LastEpoch))
+	{
+		size, err := m.PricePair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractPairCandles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractPairCandles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractPairCandles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ContractInfo.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.LongBookList) > 0 {
-		for _, e := range m.LongBookList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.ShortBookList) > 0 {
-		for _, e := range m.ShortBookList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.TriggeredOrdersList) > 0 {
-		for _, e := range m.TriggeredOrdersList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.PairList) > 0 {
-		for _, e := range m.PairList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if m.IntervalInSeconds != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IntervalInSeconds))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.PriceList) > 0 {
-		for _, e := range m.PriceList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.NextOrderId != 0 {
-		n += 1 + sovGenesis(uint64(m.NextOrderId))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractPairPrices) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrderCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PositionDirection != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PositionDirection))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fill.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ContractState) > 0 {
+		for _, e := range m.ContractState {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.LastEpoch))
+	}
+	if len(m.AssetList) > 0 {
+		for _, e := range m.AssetList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractInfo.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LongBookList) > 0 {
+		for _, e := range m.LongBookList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ShortBookList) > 0 {
+		for _, e := range m.ShortBookList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TriggeredOrdersList) > 0 {
+		for _, e := range m.TriggeredOrdersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairList) > 0 {
+		for _, e := range m.PairList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceList) > 0 {
+		for _, e := range m.PriceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextOrderId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextOrderId))
+	}
+	if len(m.GoodTilTimeOrdersList) > 0 {
+		for _, e := range m.GoodTilTimeOrdersList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CandleList) > 0 {
+		for _, e := range m.CandleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderCountList) > 0 {
+		for _, e := range m.OrderCountList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MatchResult != nil {
+		l = m.MatchResult.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AccountFillList) > 0 {
+		for _, e := range m.AccountFillList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RentTopUp != nil {
+		l = m.RentTopUp.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RentChargeList) > 0 {
+		for _, e := range m.RentChargeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PairHaltList) > 0 {
+		for _, e := range m.PairHaltList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutionStatsList) > 0 {
+		for _, e := range m.ExecutionStatsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingBracketOrdersList) > 0 {
+		for _, e := range m.PendingBracketOrdersList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActiveBracketOrdersList) > 0 {
+		for _, e := range m.ActiveBracketOrdersList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UncollectedFeeList) > 0 {
+		for _, e := range m.UncollectedFeeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractPairPrices) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -571,6 +1307,65 @@ func (m *ContractPairPrices) Size() (n int) {
 	return n
 }
 
+func (m *ContractPairCandles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.IntervalInSeconds != 0 {
+		n += 1 + sovGenesis(uint64(m.IntervalInSeconds))
+	}
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OrderCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PositionDirection != 0 {
+		n += 1 + sovGenesis(uint64(m.PositionDirection))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovGenesis(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *AccountFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	l = m.Fill.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -608,7 +1403,709 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractState = append(m.ContractState, ContractState{})
+			if err := m.ContractState[len(m.ContractState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
+			}
+			m.LastEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetList = append(m.AssetList, AssetMetadata{})
+			if err := m.AssetList[len(m.AssetList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongBookList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LongBookList = append(m.LongBookList, LongBook{})
+			if err := m.LongBookList[len(m.LongBookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortBookList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortBookList = append(m.ShortBookList, ShortBook{})
+			if err := m.ShortBookList[len(m.ShortBookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredOrdersList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggeredOrdersList = append(m.TriggeredOrdersList, Order{})
+			if err := m.TriggeredOrdersList[len(m.TriggeredOrdersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairList = append(m.PairList, Pair{})
+			if err := m.PairList[len(m.PairList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceList = append(m.PriceList, ContractPairPrices{})
+			if err := m.PriceList[len(m.PriceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOrderId", wireType)
+			}
+			m.NextOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilTimeOrdersList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoodTilTimeOrdersList = append(m.GoodTilTimeOrdersList, Order{})
+			if err := m.GoodTilTimeOrdersList[len(m.GoodTilTimeOrdersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandleList = append(m.CandleList, ContractPairCandles{})
+			if err := m.CandleList[len(m.CandleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCountList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderCountList = append(m.OrderCountList, OrderCount{})
+			if err := m.OrderCountList[len(m.OrderCountList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MatchResult == nil {
+				m.MatchResult = &MatchResult{}
+			}
+			if err := m.MatchResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFillList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountFillList = append(m.AccountFillList, AccountFill{})
+			if err := m.AccountFillList[len(m.AccountFillList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentTopUp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RentTopUp == nil {
+				m.RentTopUp = &RentTopUp{}
+			}
+			if err := m.RentTopUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentChargeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RentChargeList = append(m.RentChargeList, RentCharge{})
+			if err := m.RentChargeList[len(m.RentChargeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairHaltList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairHaltList = append(m.PairHaltList, PairHalt{})
+			if err := m.PairHaltList[len(m.PairHaltList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStatsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionStatsList = append(m.ExecutionStatsList, ContractExecutionStats{})
+			if err := m.ExecutionStatsList[len(m.ExecutionStatsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingBracketOrdersList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -635,13 +2132,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingBracketOrdersList = append(m.PendingBracketOrdersList, Order{})
+			if err := m.PendingBracketOrdersList[len(m.PendingBracketOrdersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveBracketOrdersList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -668,16 +2166,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractState = append(m.ContractState, ContractState{})
-			if err := m.ContractState[len(m.ContractState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ActiveBracketOrdersList = append(m.ActiveBracketOrdersList, Order{})
+			if err := m.ActiveBracketOrdersList[len(m.ActiveBracketOrdersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncollectedFeeList", wireType)
 			}
-			m.LastEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -687,11 +2185,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastEpoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UncollectedFeeList = append(m.UncollectedFeeList, types.DecCoin{})
+			if err := m.UncollectedFeeList[len(m.UncollectedFeeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -713,7 +2226,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractState) Unmarshal(dAtA []byte) error {
+func (m *ContractPairPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -736,15 +2249,15 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractPairPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractPairPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -771,13 +2284,13 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PricePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LongBookList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -804,14 +2317,64 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LongBookList = append(m.LongBookList, LongBook{})
-			if err := m.LongBookList[len(m.LongBookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prices = append(m.Prices, &Price{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractPairCandles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractPairCandles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractPairCandles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortBookList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -838,14 +2401,32 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShortBookList = append(m.ShortBookList, ShortBook{})
-			if err := m.ShortBookList[len(m.ShortBookList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalInSeconds", wireType)
+			}
+			m.IntervalInSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalInSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggeredOrdersList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -872,16 +2453,66 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TriggeredOrdersList = append(m.TriggeredOrdersList, Order{})
-			if err := m.TriggeredOrdersList[len(m.TriggeredOrdersList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -891,31 +2522,80 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairList = append(m.PairList, Pair{})
-			if err := m.PairList[len(m.PairList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionDirection", wireType)
+			}
+			m.PositionDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionDirection |= PositionDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -925,31 +2605,31 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceList = append(m.PriceList, ContractPairPrices{})
-			if err := m.PriceList[len(m.PriceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.NextOrderId = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -959,7 +2639,7 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextOrderId |= uint64(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -985,7 +2665,7 @@ func (m *ContractState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractPairPrices) Unmarshal(dAtA []byte) error {
+func (m *AccountFill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1008,17 +2688,17 @@ func (m *ContractPairPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractPairPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountFill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractPairPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountFill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PricePair", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1028,28 +2708,14 @@ func (m *ContractPairPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PricePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fill", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1076,8 +2742,7 @@ func (m *ContractPairPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, &Price{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fill.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
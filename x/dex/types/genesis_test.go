@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/sei-protocol/sei-chain/x/dex/types"
 	"github.com/stretchr/testify/require"
 )
@@ -214,6 +215,209 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "allocations not adding up to entry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						LongBookList: []types.LongBook{
+							{
+								Price: sdk.NewDec(1),
+								Entry: &types.OrderEntry{
+									Price:       sdk.NewDec(1),
+									Quantity:    sdk.NewDec(3),
+									PriceDenom:  "SEI",
+									AssetDenom:  "ATOM",
+									Allocations: []*types.Allocation{{OrderId: 1, Quantity: sdk.NewDec(2)}},
+								},
+							},
+						},
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+						},
+						NextOrderId: 2,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "order allocated twice",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						LongBookList: []types.LongBook{
+							{
+								Price: sdk.NewDec(1),
+								Entry: &types.OrderEntry{
+									Price:       sdk.NewDec(1),
+									Quantity:    sdk.NewDec(2),
+									PriceDenom:  "SEI",
+									AssetDenom:  "ATOM",
+									Allocations: []*types.Allocation{{OrderId: 1, Quantity: sdk.NewDec(2)}},
+								},
+							},
+						},
+						ShortBookList: []types.ShortBook{
+							{
+								Price: sdk.NewDec(2),
+								Entry: &types.OrderEntry{
+									Price:       sdk.NewDec(2),
+									Quantity:    sdk.NewDec(2),
+									PriceDenom:  "SEI",
+									AssetDenom:  "ATOM",
+									Allocations: []*types.Allocation{{OrderId: 1, Quantity: sdk.NewDec(2)}},
+								},
+							},
+						},
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+						},
+						NextOrderId: 2,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "allocated order not below next order id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						LongBookList: []types.LongBook{
+							{
+								Price: sdk.NewDec(1),
+								Entry: &types.OrderEntry{
+									Price:       sdk.NewDec(1),
+									Quantity:    sdk.NewDec(2),
+									PriceDenom:  "SEI",
+									AssetDenom:  "ATOM",
+									Allocations: []*types.Allocation{{OrderId: 2, Quantity: sdk.NewDec(2)}},
+								},
+							},
+						},
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+						},
+						NextOrderId: 2,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "bracket order without bracket role",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+						},
+						PendingBracketOrdersList: []types.Order{{Id: 2, ParentOrderId: 1}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "bracket order placed before its parent",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+						},
+						ActiveBracketOrdersList: []types.Order{{Id: 1, ParentOrderId: 2, BracketRole: types.BracketRole_STOP_LOSS}},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "consistent dependency siblings",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+							Dependencies: []*types.ContractDependencyInfo{{
+								Dependency:              "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc",
+								ImmediateYoungerSibling: "sei17p9rzwnnfxcjp32un9ug7yhhzgtkhvl9jfksztgw5uh69wac2pgsrtqewe",
+							}},
+						},
+					},
+					{
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei17p9rzwnnfxcjp32un9ug7yhhzgtkhvl9jfksztgw5uh69wac2pgsrtqewe",
+							Dependencies: []*types.ContractDependencyInfo{{
+								Dependency:            "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc",
+								ImmediateElderSibling: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+							}},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "dangling dependency sibling",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+							Dependencies: []*types.ContractDependencyInfo{{
+								Dependency:              "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc",
+								ImmediateYoungerSibling: "sei17p9rzwnnfxcjp32un9ug7yhhzgtkhvl9jfksztgw5uh69wac2pgsrtqewe",
+							}},
+						},
+					},
+					{
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei17p9rzwnnfxcjp32un9ug7yhhzgtkhvl9jfksztgw5uh69wac2pgsrtqewe",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated contract",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ContractState: []types.ContractState{
+					{
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+						},
+					},
+					{
+						ContractInfo: types.ContractInfoV2{
+							ContractAddr: "sei14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sh9m79m",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated asset display denom",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AssetList: []types.AssetMetadata{
+					{Metadata: banktypes.Metadata{Base: "uatom", Display: "atom"}},
+					{Metadata: banktypes.Metadata{Base: "ibc/atom", Display: "atom"}},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -10,13 +11,19 @@ import (
 
 const (
 	ProposalTypeAddAssetMetadata = "AddAssetMetadata"
+	ProposalTypeUpdateFeeRates   = "UpdateFeeRates"
+	ProposalTypeUpdatePairHalts  = "UpdatePairHalts"
 )
 
 func init() {
 	// for routing
 	govtypes.RegisterProposalType(ProposalTypeAddAssetMetadata)
+	govtypes.RegisterProposalType(ProposalTypeUpdateFeeRates)
+	govtypes.RegisterProposalType(ProposalTypeUpdatePairHalts)
 	// for marshal and unmarshal
 	govtypes.RegisterProposalTypeCodec(&AddAssetMetadataProposal{}, "dex/AddAssetMetadataProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateFeeRatesProposal{}, "dex/UpdateFeeRatesProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdatePairHaltsProposal{}, "dex/UpdatePairHaltsProposal")
 }
 
 func (p *AddAssetMetadataProposal) GetTitle() string { return p.Title }
@@ -55,3 +62,91 @@ func (p AddAssetMetadataProposal) String() string {
 `, p.Title, p.Description, assetRecords))
 	return b.String()
 }
+
+func (p *UpdateFeeRatesProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateFeeRatesProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateFeeRatesProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateFeeRatesProposal) ProposalType() string {
+	return ProposalTypeUpdateFeeRates
+}
+
+func (p *UpdateFeeRatesProposal) ValidateBasic() error {
+	if len(p.FeeRateList) == 0 {
+		return errors.New("no fee rates provided in update fee rates proposal")
+	}
+	for _, feeRate := range p.FeeRateList {
+		if _, err := sdk.AccAddressFromBech32(feeRate.ContractAddr); err != nil {
+			return errors.New("contract address format is not bech32")
+		}
+		if feeRate.Pair == nil {
+			return errors.New("empty pair info")
+		}
+		if err := ValidateFeeRate(feeRate.MakerFeeRate); err != nil {
+			return err
+		}
+		if err := ValidateFeeRate(feeRate.TakerFeeRate); err != nil {
+			return err
+		}
+	}
+
+	err := govtypes.ValidateAbstract(p)
+	return err
+}
+
+func (p UpdateFeeRatesProposal) String() string {
+	feeRateRecords := ""
+	for _, feeRate := range p.FeeRateList {
+		feeRateRecords += feeRate.String()
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Fee Rates Proposal:
+  Title:       %s
+  Description: %s
+  Records:     %s
+`, p.Title, p.Description, feeRateRecords))
+	return b.String()
+}
+
+func (p *UpdatePairHaltsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdatePairHaltsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdatePairHaltsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdatePairHaltsProposal) ProposalType() string {
+	return ProposalTypeUpdatePairHalts
+}
+
+func (p *UpdatePairHaltsProposal) ValidateBasic() error {
+	if len(p.PairHaltList) == 0 {
+		return errors.New("no pair halts provided in update pair halts proposal")
+	}
+	for _, pairHalt := range p.PairHaltList {
+		if _, err := sdk.AccAddressFromBech32(pairHalt.ContractAddr); err != nil {
+			return errors.New("contract address format is not bech32")
+		}
+		if pairHalt.Pair == nil {
+			return errors.New("empty pair info")
+		}
+	}
+
+	err := govtypes.ValidateAbstract(p)
+	return err
+}
+
+func (p UpdatePairHaltsProposal) String() string {
+	pairHaltRecords := ""
+	for _, pairHalt := range p.PairHaltList {
+		pairHaltRecords += pairHalt.String()
+	}
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Pair Halts Proposal:
+  Title:       %s
+  Description: %s
+  Records:     %s
+`, p.Title, p.Description, pairHaltRecords))
+	return b.String()
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// names of the dex invariants registered with the crisis module
+const (
+	OrderEntryQuantityInvariantName = "order-entry-quantity"
+	OrderCountInvariantName         = "order-count"
+	RentBalanceInvariantName        = "rent-balance"
+)
+
+// BrokenOrderEntryQuantities describes each order book entry of the contract whose quantity is
+// not the sum of the quantities of its allocations
+func (cs ContractState) BrokenOrderEntryQuantities() []string {
+	broken := []string{}
+	for _, entry := range cs.orderEntries() {
+		if entry == nil {
+			broken = append(broken, fmt.Sprintf("contract %s: order book entry without an order entry", cs.ContractInfo.ContractAddr))
+			continue
+		}
+		total, valid := sdk.ZeroDec(), !entry.Quantity.IsNil()
+		for _, allocation := range entry.Allocations {
+			if allocation.Quantity.IsNil() {
+				valid = false
+				continue
+			}
+			total = total.Add(allocation.Quantity)
+		}
+		if !valid || !entry.Quantity.Equal(total) {
+			broken = append(broken, fmt.Sprintf(
+				"contract %s: entry at price %s of {price:%s,asset:%s} has quantity %s but its allocations add up to %s",
+				cs.ContractInfo.ContractAddr, entry.Price, entry.PriceDenom, entry.AssetDenom, entry.Quantity, total,
+			))
+		}
+	}
+	return broken
+}
+
+// BrokenOrderCounts describes each price level of the contract whose order count is not the
+// number of orders resting at it. Price levels without an order book entry must have a count of zero.
+func (cs ContractState) BrokenOrderCounts() []string {
+	type priceLevel struct {
+		priceDenom string
+		assetDenom string
+		direction  PositionDirection
+		price      string
+	}
+	expected := map[priceLevel]int{}
+	levels := []priceLevel{}
+	addEntry := func(price sdk.Dec, entry *OrderEntry, direction PositionDirection) {
+		if entry == nil {
+			return
+		}
+		level := priceLevel{entry.PriceDenom, entry.AssetDenom, direction, price.String()}
+		expected[level] = len(entry.Allocations)
+		levels = append(levels, level)
+	}
+	for _, elem := range cs.LongBookList {
+		addEntry(elem.Price, elem.Entry, PositionDirection_LONG)
+	}
+	for _, elem := range cs.ShortBookList {
+		addEntry(elem.Price, elem.Entry, PositionDirection_SHORT)
+	}
+
+	broken := []string{}
+	counted := map[priceLevel]uint64{}
+	for _, count := range cs.OrderCountList {
+		level := priceLevel{count.PriceDenom, count.AssetDenom, count.PositionDirection, count.Price.String()}
+		counted[level] = count.Count
+		if _, ok := expected[level]; !ok && count.Count != 0 {
+			broken = append(broken, fmt.Sprintf(
+				"contract %s: %s level at price %s of {price:%s,asset:%s} has no orders but an order count of %d",
+				cs.ContractInfo.ContractAddr, level.direction, level.price, level.priceDenom, level.assetDenom, count.Count,
+			))
+		}
+	}
+	for _, level := range levels {
+		if counted[level] != uint64(expected[level]) {
+			broken = append(broken, fmt.Sprintf(
+				"contract %s: %s level at price %s of {price:%s,asset:%s} has %d orders but an order count of %d",
+				cs.ContractInfo.ContractAddr, level.direction, level.price, level.priceDenom, level.assetDenom, expected[level], counted[level],
+			))
+		}
+	}
+	return broken
+}
+
+// BrokenRentBalances describes the shortfall if the module balance doesn't cover the rent
+// balances of all contracts
+func BrokenRentBalances(contractInfos []ContractInfoV2, moduleBalance sdk.Int) []string {
+	total := sdk.ZeroInt()
+	for _, contractInfo := range contractInfos {
+		total = total.Add(sdk.NewIntFromUint64(contractInfo.RentBalance))
+	}
+	if moduleBalance.GTE(total) {
+		return []string{}
+	}
+	return []string{fmt.Sprintf("module balance %s is less than the total rent balance %s of %d contracts", moduleBalance, total, len(contractInfos))}
+}
+
+// BrokenInvariants runs all dex invariants against the genesis state and returns the broken
+// ones by name. The module balance is the base coin balance of the dex module account.
+func (gs GenesisState) BrokenInvariants(moduleBalance sdk.Int) map[string][]string {
+	contractInfos := []ContractInfoV2{}
+	res := map[string][]string{}
+	for _, contractState := range gs.ContractState {
+		contractInfos = append(contractInfos, contractState.ContractInfo)
+		res[OrderEntryQuantityInvariantName] = append(res[OrderEntryQuantityInvariantName], contractState.BrokenOrderEntryQuantities()...)
+		res[OrderCountInvariantName] = append(res[OrderCountInvariantName], contractState.BrokenOrderCounts()...)
+	}
+	res[RentBalanceInvariantName] = BrokenRentBalances(contractInfos, moduleBalance)
+	for name, broken := range res {
+		if len(broken) == 0 {
+			delete(res, name)
+		}
+	}
+	return res
+}
@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisBrokenInvariants(t *testing.T) {
+	entry := TestEntryOne
+	genState := types.GenesisState{
+		ContractState: []types.ContractState{{
+			ContractInfo: types.ContractInfoV2{ContractAddr: keepertest.TestContract, RentBalance: 100},
+			LongBookList: []types.LongBook{{Price: entry.Price, Entry: &entry}},
+			OrderCountList: []types.OrderCount{{
+				PriceDenom:        entry.PriceDenom,
+				AssetDenom:        entry.AssetDenom,
+				PositionDirection: types.PositionDirection_LONG,
+				Price:             entry.Price,
+				Count:             2,
+			}},
+		}},
+	}
+	require.Empty(t, genState.BrokenInvariants(sdk.NewInt(100)))
+
+	broken := genState.BrokenInvariants(sdk.NewInt(99))
+	require.Equal(t, 1, len(broken))
+	require.Equal(t, 1, len(broken[types.RentBalanceInvariantName]))
+
+	genState.ContractState[0].OrderCountList[0].Count = 1
+	entry.Quantity = sdk.NewDec(4)
+	broken = genState.BrokenInvariants(sdk.NewInt(100))
+	require.Equal(t, 2, len(broken))
+	require.Equal(t, 1, len(broken[types.OrderEntryQuantityInvariantName]))
+	require.Equal(t, 1, len(broken[types.OrderCountInvariantName]))
+}
@@ -22,6 +22,9 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_dex"
+
+	// FeeCollectorName defines the module account that trading fees are collected into
+	FeeCollectorName = "dex_fee_collector"
 )
 
 func KeyPrefix(p string) []byte {
@@ -65,6 +68,60 @@ func OrderBookContractPrefix(long bool, contractAddr string) []byte {
 	return append(prefix, AddressKeyPrefix(contractAddr)...)
 }
 
+// `LongDepth-`/`ShortDepth-` constant + contract + price denom + asset denom
+func DepthPrefix(long bool, contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		DepthContractPrefix(long, contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func DepthContractPrefix(long bool, contractAddr string) []byte {
+	var prefix []byte
+	if long {
+		prefix = KeyPrefix(LongDepthKey)
+	} else {
+		prefix = KeyPrefix(ShortDepthKey)
+	}
+	return append(prefix, AddressKeyPrefix(contractAddr)...)
+}
+
+// `LongAccountOrder-`/`ShortAccountOrder-` constant + contract + price denom + asset denom + account
+func AccountOrderPrefix(long bool, contractAddr string, priceDenom string, assetDenom string, account string) []byte {
+	return append(
+		append(AccountOrderContractPrefix(long, contractAddr), PairPrefix(priceDenom, assetDenom)...),
+		DenomPrefix(account)...,
+	)
+}
+
+func AccountOrderContractPrefix(long bool, contractAddr string) []byte {
+	var prefix []byte
+	if long {
+		prefix = KeyPrefix(LongAccountOrderKey)
+	} else {
+		prefix = KeyPrefix(ShortAccountOrderKey)
+	}
+	return append(prefix, AddressKeyPrefix(contractAddr)...)
+}
+
+// `ActiveBracketOrder-`/`PendingBracketOrder-` constant + contract + price denom + asset denom
+func BracketOrderPrefix(active bool, contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		BracketOrderContractPrefix(active, contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func BracketOrderContractPrefix(active bool, contractAddr string) []byte {
+	var prefix []byte
+	if active {
+		prefix = KeyPrefix(ActiveBracketOrderKey)
+	} else {
+		prefix = KeyPrefix(PendingBracketOrderKey)
+	}
+	return append(prefix, AddressKeyPrefix(contractAddr)...)
+}
+
 // `Price` constant + contract + price denom + asset denom
 func PricePrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
 	return append(
@@ -77,6 +134,60 @@ func PriceContractPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(PriceKey), AddressKeyPrefix(contractAddr)...)
 }
 
+// `Candle-` constant + contract + price denom + asset denom
+func CandlePrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		CandleContractPrefix(contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func CandleContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(CandleKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `AccountFill-` constant + contract + account + price denom + asset denom
+func AccountFillPrefix(contractAddr string, account string, priceDenom string, assetDenom string) []byte {
+	return append(
+		append(AccountFillContractPrefix(contractAddr), AddressKeyPrefix(account)...),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func AccountFillContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(AccountFillKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `AccountFillHeight-` constant + contract, under which fills are indexed by height for pruning
+func AccountFillHeightPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(AccountFillHeightKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `RentTopUp-` constant + contract
+func RentTopUpPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(RentTopUpKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `RentCharge-` constant + contract, under which charges are keyed by height
+func RentChargePrefix(contractAddr string) []byte {
+	return append(KeyPrefix(RentChargeKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `UncollectedFee-` constant + contract, under which uncollected fees are keyed by denom
+func UncollectedFeePrefix(contractAddr string) []byte {
+	return append(KeyPrefix(UncollectedFeeKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `PairHalt-` constant + contract, under which halts are keyed by pair
+func PairHaltContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(PairHaltKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `ExecutionStats-` constant + contract, under which stats are keyed by height
+func ExecutionStatsPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(ExecutionStatsKey), AddressKeyPrefix(contractAddr)...)
+}
+
 func RegisteredPairPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(RegisteredPairKey), AddressKeyPrefix(contractAddr)...)
 }
@@ -110,6 +221,82 @@ func GetSettlementKey(orderID uint64, account string, settlementID uint64) []byt
 	return append(GetSettlementOrderIDPrefix(orderID, account), settlementIDBytes...)
 }
 
+// `TriggerBook-value-` constant + contract + price denom + asset denom
+func TriggerOrderBookPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		TriggerOrderBookContractPrefix(contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func TriggerOrderBookContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(TriggerBookKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `LongTriggerPrice-`/`ShortTriggerPrice-` constant + contract + price denom + asset denom
+func TriggerPricePrefix(long bool, contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(TriggerPriceContractPrefix(long, contractAddr), PairPrefix(priceDenom, assetDenom)...)
+}
+
+func TriggerPriceContractPrefix(long bool, contractAddr string) []byte {
+	var prefix []byte
+	if long {
+		prefix = KeyPrefix(LongTriggerPriceKey)
+	} else {
+		prefix = KeyPrefix(ShortTriggerPriceKey)
+	}
+	return append(prefix, AddressKeyPrefix(contractAddr)...)
+}
+
+// `TriggeredOrder-` constant + contract + price denom + asset denom
+func TriggeredOrderPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(TriggeredOrderContractPrefix(contractAddr), PairPrefix(priceDenom, assetDenom)...)
+}
+
+func TriggeredOrderContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(TriggeredOrderKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `TriggerAccountOrder-` constant + contract + price denom + asset denom + account
+func TriggerAccountOrderPrefix(contractAddr string, priceDenom string, assetDenom string, account string) []byte {
+	return append(
+		append(TriggerAccountOrderContractPrefix(contractAddr), PairPrefix(priceDenom, assetDenom)...),
+		DenomPrefix(account)...,
+	)
+}
+
+func TriggerAccountOrderContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(TriggerAccountOrderKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `GTTOrder-` constant + contract + price denom + asset denom
+func GoodTilTimeOrderPrefix(contractAddr string, priceDenom string, assetDenom string) []byte {
+	return append(
+		GoodTilTimeOrderContractPrefix(contractAddr),
+		PairPrefix(priceDenom, assetDenom)...,
+	)
+}
+
+func GoodTilTimeOrderContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(GoodTilTimeOrderKey), AddressKeyPrefix(contractAddr)...)
+}
+
+// `GTTExpiry-` constant + contract + price denom + asset denom + expiry height/timestamp marker
+func GoodTilTimeExpiryPrefix(contractAddr string, priceDenom string, assetDenom string, byTimestamp bool) []byte {
+	marker := byte(0)
+	if byTimestamp {
+		marker = 1
+	}
+	return append(
+		append(GoodTilTimeExpiryContractPrefix(contractAddr), PairPrefix(priceDenom, assetDenom)...),
+		marker,
+	)
+}
+
+func GoodTilTimeExpiryContractPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(GoodTilTimeExpiryKey), AddressKeyPrefix(contractAddr)...)
+}
+
 func MemOrderPrefixForPair(contractAddr string, priceDenom string, assetDenom string) []byte {
 	return append(
 		append(KeyPrefix(MemOrderKey), AddressKeyPrefix(contractAddr)...),
@@ -132,6 +319,10 @@ func MemCancelPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(MemCancelKey), AddressKeyPrefix(contractAddr)...)
 }
 
+func MemCancelAllPrefix(contractAddr string) []byte {
+	return append(KeyPrefix(MemCancelAllKey), AddressKeyPrefix(contractAddr)...)
+}
+
 func MemDepositPrefix(contractAddr string) []byte {
 	return append(KeyPrefix(MemDepositKey), AddressKeyPrefix(contractAddr)...)
 }
@@ -159,12 +350,32 @@ const (
 
 	ShortBookKey = "ShortBook-value-"
 
+	TriggerBookKey = "TriggerBook-value-"
+
+	LongTriggerPriceKey    = "LongTriggerPrice-"
+	ShortTriggerPriceKey   = "ShortTriggerPrice-"
+	TriggeredOrderKey      = "TriggeredOrder-"
+	TriggerAccountOrderKey = "TriggerAccountOrder-"
+
+	LongDepthKey  = "LongDepth-"
+	ShortDepthKey = "ShortDepth-"
+
+	LongAccountOrderKey  = "LongAccountOrder-"
+	ShortAccountOrderKey = "ShortAccountOrder-"
+
+	GoodTilTimeOrderKey  = "GTTOrder-"
+	GoodTilTimeExpiryKey = "GTTExpiry-"
+
+	PendingBracketOrderKey = "PendingBracketOrder-"
+	ActiveBracketOrderKey  = "ActiveBracketOrder-"
+
 	OrderKey               = "order"
 	AccountActiveOrdersKey = "account-active-orders"
 	CancelKey              = "cancel"
 
 	TwapKey             = "TWAP-"
 	PriceKey            = "Price-"
+	CandleKey           = "Candle-"
 	SettlementEntryKey  = "SettlementEntry-"
 	NextSettlementIDKey = "NextSettlementID-"
 	NextOrderIDKey      = "noid"
@@ -174,7 +385,20 @@ const (
 	LongOrderCountKey   = "loc-"
 	ShortOrderCountKey  = "soc-"
 
-	MemOrderKey   = "MemOrder-"
-	MemDepositKey = "MemDeposit-"
-	MemCancelKey  = "MemCancel-"
+	AccountFillKey       = "AccountFill-"
+	AccountFillHeightKey = "AccountFillHeight-"
+
+	RentTopUpKey  = "RentTopUp-"
+	RentChargeKey = "RentCharge-"
+
+	PairHaltKey = "PairHalt-"
+
+	UncollectedFeeKey = "UncollectedFee-"
+
+	ExecutionStatsKey = "ExecutionStats-"
+
+	MemOrderKey     = "MemOrder-"
+	MemDepositKey   = "MemDeposit-"
+	MemCancelKey    = "MemCancel-"
+	MemCancelAllKey = "MemCancelAll-"
 )
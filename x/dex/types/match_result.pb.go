@@ -29,6 +29,8 @@ type MatchResult struct {
 	Orders        []*Order           `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders"`
 	Settlements   []*SettlementEntry `protobuf:"bytes,4,rep,name=settlements,proto3" json:"settlements"`
 	Cancellations []*Cancellation    `protobuf:"bytes,5,rep,name=cancellations,proto3" json:"cancellations"`
+	// uniform clearing prices of the pairs that matched limit orders in the UNIFORM_PRICE clearing mode
+	ClearingPrices []*Price `protobuf:"bytes,6,rep,name=clearingPrices,proto3" json:"clearing_prices"`
 }
 
 func (m *MatchResult) Reset()         { *m = MatchResult{} }
@@ -99,6 +101,13 @@ func (m *MatchResult) GetCancellations() []*Cancellation {
 	return nil
 }
 
+func (m *MatchResult) GetClearingPrices() []*Price {
+	if m != nil {
+		return m.ClearingPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MatchResult)(nil), "seiprotocol.seichain.dex.MatchResult")
 }
@@ -106,29 +115,31 @@ func init() {
 func init() { proto.RegisterFile("dex/match_result.proto", fileDescriptor_9225b122096d4ce7) }
 
 var fileDescriptor_9225b122096d4ce7 = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x6a, 0xfa, 0x40,
-	0x10, 0xc6, 0x8d, 0xfe, 0xff, 0x42, 0xd7, 0x16, 0xdb, 0x20, 0x25, 0x78, 0x48, 0xc4, 0x43, 0xb1,
-	0x07, 0x13, 0x68, 0x2f, 0xbd, 0x36, 0x52, 0x7a, 0x2a, 0x85, 0xf4, 0x56, 0x0a, 0x12, 0x77, 0x87,
-	0x64, 0x21, 0x66, 0x65, 0x77, 0x04, 0x7d, 0x8b, 0x3e, 0x56, 0x8f, 0x1e, 0x7b, 0x0a, 0x45, 0x6f,
-	0xb9, 0xf4, 0x15, 0xca, 0x6e, 0xb4, 0xd5, 0x83, 0xa7, 0x99, 0xf9, 0xf2, 0xcd, 0xef, 0xcb, 0xb0,
-	0xe4, 0x92, 0xc1, 0x22, 0x98, 0xc6, 0x48, 0xd3, 0xb1, 0x04, 0x35, 0xcf, 0xd0, 0x9f, 0x49, 0x81,
-	0xc2, 0x76, 0x14, 0x70, 0xd3, 0x51, 0x91, 0xf9, 0x0a, 0x38, 0x4d, 0x63, 0x9e, 0xfb, 0x0c, 0x16,
-	0xdd, 0xb6, 0xde, 0x10, 0x92, 0x81, 0xac, 0xac, 0xdd, 0x8e, 0x16, 0x14, 0x20, 0x66, 0x30, 0x85,
-	0x1c, 0x77, 0x6a, 0x22, 0x12, 0x61, 0xda, 0x40, 0x77, 0x95, 0xda, 0xff, 0xae, 0x93, 0xd6, 0x93,
-	0x4e, 0x8b, 0x4c, 0x98, 0xdd, 0x27, 0xcd, 0x14, 0x78, 0x92, 0xa2, 0x63, 0xf5, 0xac, 0x41, 0x23,
-	0x24, 0x65, 0xe1, 0x6d, 0x95, 0x68, 0x5b, 0xed, 0x3b, 0x72, 0x4a, 0x45, 0x8e, 0x32, 0xa6, 0x78,
-	0xcf, 0x98, 0x74, 0xea, 0x3d, 0x6b, 0x70, 0x12, 0x76, 0xca, 0xc2, 0x3b, 0xdf, 0xe9, 0xe3, 0x98,
-	0x31, 0x09, 0x4a, 0x45, 0x07, 0x4e, 0x7b, 0x44, 0x9a, 0xe6, 0x47, 0x95, 0xd3, 0xe8, 0x35, 0x06,
-	0xad, 0x1b, 0xcf, 0x3f, 0x76, 0x95, 0xff, 0xac, 0x7d, 0x55, 0x7c, 0xb5, 0x12, 0x6d, 0xab, 0xfd,
-	0x46, 0x5a, 0x7f, 0xc7, 0x29, 0xe7, 0x9f, 0x21, 0x5d, 0x1f, 0x27, 0xbd, 0xfc, 0x9a, 0x1f, 0x72,
-	0x94, 0xcb, 0xb0, 0x5d, 0x16, 0xde, 0x3e, 0x21, 0xda, 0x1f, 0xec, 0x31, 0x39, 0xa3, 0x71, 0x4e,
-	0x21, 0xcb, 0x62, 0xe4, 0x22, 0x57, 0xce, 0x7f, 0xc3, 0xbf, 0x3a, 0xce, 0x1f, 0xed, 0xd9, 0xc3,
-	0x8b, 0xb2, 0xf0, 0x0e, 0x01, 0xd1, 0xe1, 0x18, 0x3e, 0x7e, 0xac, 0x5d, 0x6b, 0xb5, 0x76, 0xad,
-	0xaf, 0xb5, 0x6b, 0xbd, 0x6f, 0xdc, 0xda, 0x6a, 0xe3, 0xd6, 0x3e, 0x37, 0x6e, 0xed, 0x75, 0x98,
-	0x70, 0x4c, 0xe7, 0x13, 0x9f, 0x8a, 0x69, 0xa0, 0x80, 0x0f, 0x77, 0x71, 0x66, 0x30, 0x79, 0xc1,
-	0x22, 0xd0, 0x6f, 0x8b, 0xcb, 0x19, 0xa8, 0x49, 0xd3, 0x7c, 0xbf, 0xfd, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x40, 0xd8, 0xfe, 0x79, 0x32, 0x02, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x1b, 0xa3, 0x01, 0xaf, 0x6a, 0x35, 0x16, 0x09, 0x1d, 0x92, 0xd2, 0x41, 0xea, 0xd0,
+	0x04, 0x74, 0x71, 0x35, 0x45, 0x9c, 0x44, 0x89, 0x9b, 0x14, 0x42, 0x7a, 0x79, 0x24, 0x07, 0x69,
+	0xae, 0xdc, 0x5d, 0xa1, 0xfd, 0x16, 0x7e, 0x2c, 0x17, 0xa1, 0xa3, 0x53, 0x90, 0x76, 0xcb, 0xa7,
+	0x90, 0x5c, 0x12, 0x6d, 0x85, 0xe2, 0x74, 0xef, 0xfd, 0xf3, 0x7f, 0xbf, 0x7f, 0x2e, 0x2f, 0xe8,
+	0x22, 0x84, 0xb9, 0x33, 0x09, 0x04, 0x8e, 0x7d, 0x06, 0x7c, 0x96, 0x08, 0x7b, 0xca, 0xa8, 0xa0,
+	0xba, 0xc1, 0x81, 0xc8, 0x0a, 0xd3, 0xc4, 0xe6, 0x40, 0x70, 0x1c, 0x90, 0xd4, 0x0e, 0x61, 0xde,
+	0x69, 0x15, 0x13, 0x94, 0x85, 0xc0, 0x4a, 0x6b, 0xa7, 0x5d, 0x08, 0x1c, 0x84, 0x48, 0x60, 0x02,
+	0x69, 0x05, 0x28, 0x6d, 0x53, 0x46, 0x30, 0xd4, 0xb6, 0x88, 0x46, 0x54, 0x96, 0x4e, 0x51, 0x95,
+	0x6a, 0xef, 0x43, 0x45, 0xcd, 0xc7, 0x22, 0xde, 0x93, 0xe9, 0x7a, 0x0f, 0x69, 0x31, 0x90, 0x28,
+	0x16, 0x86, 0xd2, 0x55, 0xfa, 0xaa, 0x8b, 0xf2, 0xcc, 0xaa, 0x14, 0xaf, 0x3a, 0xf5, 0x5b, 0x74,
+	0x84, 0x69, 0x2a, 0x58, 0x80, 0xc5, 0x5d, 0x18, 0x32, 0x63, 0xaf, 0xab, 0xf4, 0x0f, 0xdd, 0x76,
+	0x9e, 0x59, 0xa7, 0xb5, 0xee, 0x07, 0x61, 0xc8, 0x80, 0x73, 0x6f, 0xcb, 0xa9, 0x0f, 0x91, 0x26,
+	0xdf, 0x9c, 0x1b, 0x6a, 0x57, 0xed, 0x37, 0xaf, 0x2d, 0x7b, 0xd7, 0x35, 0xed, 0xa7, 0xc2, 0x57,
+	0xc6, 0x97, 0x23, 0x5e, 0x75, 0xea, 0x23, 0xd4, 0xfc, 0xbd, 0x2d, 0x37, 0xf6, 0x25, 0xe9, 0x6a,
+	0x37, 0xe9, 0xe5, 0xc7, 0x7c, 0x9f, 0x0a, 0xb6, 0x70, 0x5b, 0x79, 0x66, 0x6d, 0x12, 0xbc, 0xcd,
+	0x46, 0xf7, 0xd1, 0x31, 0x0e, 0x52, 0x0c, 0x49, 0x12, 0x08, 0x42, 0x53, 0x6e, 0x1c, 0x48, 0xfe,
+	0xe5, 0x6e, 0xfe, 0x70, 0xc3, 0xee, 0x9e, 0xe5, 0x99, 0xb5, 0x0d, 0xf0, 0xb6, 0x5b, 0x7d, 0x84,
+	0x4e, 0x70, 0x02, 0x01, 0x23, 0x69, 0xf4, 0x5c, 0xac, 0x87, 0x1b, 0xda, 0x7f, 0xdf, 0x42, 0xfa,
+	0xdc, 0xf3, 0x3c, 0xb3, 0x5a, 0xf5, 0xa8, 0x2f, 0x57, 0xcb, 0xbd, 0x3f, 0x2c, 0xf7, 0xe1, 0x7d,
+	0x65, 0x2a, 0xcb, 0x95, 0xa9, 0x7c, 0xad, 0x4c, 0xe5, 0x6d, 0x6d, 0x36, 0x96, 0x6b, 0xb3, 0xf1,
+	0xb9, 0x36, 0x1b, 0xaf, 0x83, 0x88, 0x88, 0x78, 0x36, 0xb6, 0x31, 0x9d, 0x38, 0x1c, 0xc8, 0xa0,
+	0x8e, 0x92, 0x8d, 0xcc, 0x72, 0xe6, 0x4e, 0xf1, 0xd3, 0x88, 0xc5, 0x14, 0xf8, 0x58, 0x93, 0xcf,
+	0x6f, 0xbe, 0x07, 0x00, 0x66, 0xa7, 0x59, 0x98, 0xa1, 0x02, 0x00, 0x00,
 }
 
 func (m *MatchResult) Marshal() (dAtA []byte, err error) {
@@ -151,6 +162,20 @@ func (m *MatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClearingPrices) > 0 {
+		for iNdEx := len(m.ClearingPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClearingPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMatchResult(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Cancellations) > 0 {
 		for iNdEx := len(m.Cancellations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -250,6 +275,12 @@ func (m *MatchResult) Size() (n int) {
 			n += 1 + l + sovMatchResult(uint64(l))
 		}
 	}
+	if len(m.ClearingPrices) > 0 {
+		for _, e := range m.ClearingPrices {
+			l = e.Size()
+			n += 1 + l + sovMatchResult(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -441,6 +472,40 @@ func (m *MatchResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatchResult
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatchResult
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatchResult
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClearingPrices = append(m.ClearingPrices, &Price{})
+			if err := m.ClearingPrices[len(m.ClearingPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMatchResult(dAtA[iNdEx:])
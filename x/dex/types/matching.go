@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetProRataMinAllocationOrZero returns the pair's minimum pro-rata allocation, or zero if it has
+// never been set
+func (m *Pair) GetProRataMinAllocationOrZero() sdk.Dec {
+	if m.ProRataMinAllocation == nil {
+		return sdk.ZeroDec()
+	}
+	return *m.ProRataMinAllocation
+}
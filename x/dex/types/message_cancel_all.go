@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelAll = "cancel_all"
+
+var _ sdk.Msg = &MsgCancelAll{}
+
+func NewMsgCancelAll(
+	creator string,
+	contractAddr string,
+	filter *CancelAllFilter,
+) *MsgCancelAll {
+	return &MsgCancelAll{
+		Creator:      creator,
+		ContractAddr: contractAddr,
+		Filter:       filter,
+	}
+}
+
+func (msg *MsgCancelAll) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelAll) Type() string {
+	return TypeMsgCancelAll
+}
+
+func (msg *MsgCancelAll) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelAll) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelAll) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	if msg.Filter == nil {
+		return nil
+	}
+	filter := msg.Filter
+	if (len(filter.PriceDenom) == 0) != (len(filter.AssetDenom) == 0) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "price denom and asset denom must be specified together")
+	}
+	if len(filter.PriceDenom) > 0 && (sdk.ValidateDenom(filter.PriceDenom) != nil || sdk.ValidateDenom(filter.AssetDenom) != nil) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid filter denoms")
+	}
+	for _, direction := range filter.PositionDirections {
+		if _, ok := PositionDirection_name[int32(direction)]; !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid position direction %d", direction)
+		}
+	}
+	if filter.MinPrice != nil && filter.MinPrice.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min price cannot be negative")
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil && filter.MinPrice.GT(*filter.MaxPrice) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min price cannot be greater than max price")
+	}
+
+	return nil
+}
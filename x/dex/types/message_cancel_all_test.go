@@ -0,0 +1,49 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgCancelAll(t *testing.T) {
+	creator := "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx"
+	contract := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	require.NoError(t, types.NewMsgCancelAll(creator, contract, nil).ValidateBasic())
+	require.NoError(t, types.NewMsgCancelAll(creator, contract, &types.CancelAllFilter{
+		PriceDenom: "denom1",
+		AssetDenom: "denom2",
+	}).ValidateBasic())
+	// denoms must be set together
+	require.Error(t, types.NewMsgCancelAll(creator, contract, &types.CancelAllFilter{
+		PriceDenom: "denom1",
+	}).ValidateBasic())
+	minPrice, maxPrice := sdk.NewDec(2), sdk.OneDec()
+	require.Error(t, types.NewMsgCancelAll(creator, contract, &types.CancelAllFilter{
+		MinPrice: &minPrice,
+		MaxPrice: &maxPrice,
+	}).ValidateBasic())
+}
+
+func TestCancelAllFilterMatches(t *testing.T) {
+	var filter *types.CancelAllFilter
+	require.True(t, filter.MatchesPair(types.Pair{PriceDenom: "denom1", AssetDenom: "denom2"}))
+	require.True(t, filter.Matches(types.PositionDirection_SHORT, sdk.OneDec()))
+
+	minPrice, maxPrice := sdk.OneDec(), sdk.NewDec(2)
+	filter = &types.CancelAllFilter{
+		PriceDenom:         "denom1",
+		AssetDenom:         "denom2",
+		PositionDirections: []types.PositionDirection{types.PositionDirection_LONG},
+		MinPrice:           &minPrice,
+		MaxPrice:           &maxPrice,
+	}
+	require.True(t, filter.MatchesPair(types.Pair{PriceDenom: "denom1", AssetDenom: "denom2"}))
+	require.False(t, filter.MatchesPair(types.Pair{PriceDenom: "denom2", AssetDenom: "denom1"}))
+	require.True(t, filter.Matches(types.PositionDirection_LONG, sdk.NewDec(2)))
+	require.False(t, filter.Matches(types.PositionDirection_SHORT, sdk.NewDec(2)))
+	require.False(t, filter.Matches(types.PositionDirection_LONG, sdk.NewDec(3)))
+	require.False(t, filter.Matches(types.PositionDirection_LONG, sdk.MustNewDecFromStr("0.5")))
+}
@@ -77,9 +77,91 @@ func (msg *MsgPlaceOrders) ValidateBasic() error {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "FOK orders are temporarily disabled")
 		}
 		if order.OrderType == OrderType_STOPLIMIT || order.OrderType == OrderType_STOPLOSS {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stop loss/limit order (%s) are not supported yet", err)
+			if order.TriggerPrice.IsNil() || !order.TriggerPrice.IsPositive() {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stop loss/limit order needs a positive trigger price")
+			}
+			if order.TriggerStatus {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stop loss/limit order cannot be placed as already triggered")
+			}
+		}
+		if err := validateTimeInForce(order); err != nil {
+			return err
+		}
+		if _, ok := SelfTradePrevention_name[int32(order.SelfTradePrevention)]; !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid self-trade prevention mode %d", order.SelfTradePrevention)
 		}
 	}
 
+	return validateBrackets(msg.Orders)
+}
+
+// bracket orders are attached to the closest preceding order in the message that isn't a bracket
+// order itself, and each parent can have at most one take-profit and one stop-loss order
+func validateBrackets(orders []*Order) error {
+	var parent *Order
+	roles := map[BracketRole]struct{}{}
+	for _, order := range orders {
+		if order.ParentOrderId != 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "parent order id is assigned on placement")
+		}
+		if _, ok := BracketRole_name[int32(order.BracketRole)]; !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid bracket role %d", order.BracketRole)
+		}
+		if order.BracketRole == BracketRole_NO_BRACKET {
+			parent = order
+			roles = map[BracketRole]struct{}{}
+			continue
+		}
+		if parent == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bracket order needs to follow its parent order")
+		}
+		if parent.OrderType != OrderType_LIMIT && parent.OrderType != OrderType_MARKET {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "only limit and market orders can have bracket orders")
+		}
+		if order.PriceDenom != parent.PriceDenom || order.AssetDenom != parent.AssetDenom {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bracket order needs to be on the same pair as its parent order")
+		}
+		if order.PositionDirection == parent.PositionDirection {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bracket order needs to be on the opposite side of its parent order")
+		}
+		if _, ok := roles[order.BracketRole]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "order can have at most one %s order", order.BracketRole)
+		}
+		roles[order.BracketRole] = struct{}{}
+		switch order.BracketRole {
+		case BracketRole_TAKE_PROFIT:
+			if order.OrderType != OrderType_LIMIT {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "take-profit order needs to be a limit order")
+			}
+		case BracketRole_STOP_LOSS:
+			if order.OrderType != OrderType_STOPLOSS && order.OrderType != OrderType_STOPLIMIT {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "stop-loss order needs to be a stop loss/limit order")
+			}
+		}
+		if order.TimeInForce == TimeInForce_IOC {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bracket order cannot be immediate-or-cancel")
+		}
+		if order.Quantity.GT(parent.Quantity) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "bracket order quantity cannot exceed that of its parent order")
+		}
+	}
+	return nil
+}
+
+// post-only and time-in-force modes only apply to limit orders
+func validateTimeInForce(order *Order) error {
+	if order.OrderType != OrderType_LIMIT && (order.PostOnly || order.TimeInForce != TimeInForce_GTC) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post-only and time-in-force modes are only supported for limit orders")
+	}
+	if order.PostOnly && order.TimeInForce == TimeInForce_IOC {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "post-only order cannot be immediate-or-cancel")
+	}
+	hasExpiry := order.ExpiryHeight > 0 || order.ExpiryTimestamp > 0
+	if order.TimeInForce == TimeInForce_GTT && !hasExpiry {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "good-til-time order needs an expiry height or timestamp")
+	}
+	if order.TimeInForce != TimeInForce_GTT && hasExpiry {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "only good-til-time order can have an expiry")
+	}
 	return nil
 }
@@ -99,4 +99,131 @@ func TestValidateMsgPlaceOrder(t *testing.T) {
 		},
 	}
 	require.Error(t, msg.ValidateBasic())
+
+	// Stop orders
+	msg = &types.MsgPlaceOrders{
+		Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		ContractAddr: TEST_CONTRACT,
+		Orders: []*types.Order{
+			{
+				Id:           1,
+				Account:      "test",
+				ContractAddr: TEST_CONTRACT,
+				Quantity:     sdk.OneDec(),
+				Price:        sdk.OneDec(),
+				AssetDenom:   "denom1",
+				PriceDenom:   "denom2",
+				OrderType:    types.OrderType_STOPLIMIT,
+				TriggerPrice: sdk.OneDec(),
+			},
+		},
+	}
+	require.NoError(t, msg.ValidateBasic())
+	msg.Orders[0].TriggerPrice = sdk.ZeroDec()
+	require.Error(t, msg.ValidateBasic())
+	msg.Orders[0].TriggerPrice = sdk.Dec{}
+	require.Error(t, msg.ValidateBasic())
+	msg.Orders[0].TriggerPrice = sdk.OneDec()
+	msg.Orders[0].TriggerStatus = true
+	require.Error(t, msg.ValidateBasic())
+
+	// Post-only and time-in-force modes
+	msg = &types.MsgPlaceOrders{
+		Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		ContractAddr: TEST_CONTRACT,
+		Orders: []*types.Order{
+			{
+				Id:           1,
+				Account:      "test",
+				ContractAddr: TEST_CONTRACT,
+				Quantity:     sdk.OneDec(),
+				Price:        sdk.OneDec(),
+				AssetDenom:   "denom1",
+				PriceDenom:   "denom2",
+				OrderType:    types.OrderType_LIMIT,
+				PostOnly:     true,
+			},
+		},
+	}
+	require.NoError(t, msg.ValidateBasic())
+	msg.Orders[0].TimeInForce = types.TimeInForce_IOC
+	require.Error(t, msg.ValidateBasic())
+	msg.Orders[0].PostOnly = false
+	require.NoError(t, msg.ValidateBasic())
+	msg.Orders[0].OrderType = types.OrderType_MARKET
+	require.Error(t, msg.ValidateBasic())
+	msg.Orders[0].OrderType = types.OrderType_LIMIT
+	msg.Orders[0].TimeInForce = types.TimeInForce_GTT
+	require.Error(t, msg.ValidateBasic())
+	msg.Orders[0].ExpiryHeight = 100
+	require.NoError(t, msg.ValidateBasic())
+	msg.Orders[0].ExpiryHeight = 0
+	msg.Orders[0].ExpiryTimestamp = 100
+	require.NoError(t, msg.ValidateBasic())
+	msg.Orders[0].TimeInForce = types.TimeInForce_GTC
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestValidateMsgPlaceOrderBrackets(t *testing.T) {
+	TEST_CONTRACT := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	newOrder := func(direction types.PositionDirection, orderType types.OrderType, role types.BracketRole) *types.Order {
+		return &types.Order{
+			Quantity:          sdk.OneDec(),
+			Price:             sdk.OneDec(),
+			AssetDenom:        "denom1",
+			PriceDenom:        "denom2",
+			PositionDirection: direction,
+			OrderType:         orderType,
+			TriggerPrice:      sdk.OneDec(),
+			BracketRole:       role,
+		}
+	}
+	msg := &types.MsgPlaceOrders{
+		Creator:      "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx",
+		ContractAddr: TEST_CONTRACT,
+		Orders: []*types.Order{
+			newOrder(types.PositionDirection_LONG, types.OrderType_LIMIT, types.BracketRole_NO_BRACKET),
+			newOrder(types.PositionDirection_SHORT, types.OrderType_LIMIT, types.BracketRole_TAKE_PROFIT),
+			newOrder(types.PositionDirection_SHORT, types.OrderType_STOPLOSS, types.BracketRole_STOP_LOSS),
+		},
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	// without a parent
+	msg.Orders = msg.Orders[1:]
+	require.Error(t, msg.ValidateBasic())
+
+	// more than one take-profit order
+	msg.Orders = []*types.Order{
+		newOrder(types.PositionDirection_LONG, types.OrderType_MARKET, types.BracketRole_NO_BRACKET),
+		newOrder(types.PositionDirection_SHORT, types.OrderType_LIMIT, types.BracketRole_TAKE_PROFIT),
+		newOrder(types.PositionDirection_SHORT, types.OrderType_LIMIT, types.BracketRole_TAKE_PROFIT),
+	}
+	require.Error(t, msg.ValidateBasic())
+
+	// on the same side as the parent
+	msg.Orders = msg.Orders[:2]
+	require.NoError(t, msg.ValidateBasic())
+	msg.Orders[1].PositionDirection = types.PositionDirection_LONG
+	require.Error(t, msg.ValidateBasic())
+	msg.Orders[1].PositionDirection = types.PositionDirection_SHORT
+
+	// take-profit orders need to be limit orders
+	msg.Orders[1].OrderType = types.OrderType_STOPLIMIT
+	require.Error(t, msg.ValidateBasic())
+	msg.Orders[1].OrderType = types.OrderType_LIMIT
+
+	// larger than the parent
+	msg.Orders[1].Quantity = sdk.NewDec(2)
+	require.Error(t, msg.ValidateBasic())
+	msg.Orders[1].Quantity = sdk.OneDec()
+
+	// parent ids are assigned on placement
+	msg.Orders[1].ParentOrderId = 1
+	require.Error(t, msg.ValidateBasic())
+	msg.Orders[1].ParentOrderId = 0
+
+	// stop orders can't be parents
+	msg.Orders[0].OrderType = types.OrderType_STOPLOSS
+	require.Error(t, msg.ValidateBasic())
 }
@@ -76,6 +76,21 @@ func (msg *MsgRegisterPairs) ValidateBasic() error {
 			if pair == nil {
 				return errors.New("empty pair info")
 			}
+			if pair.MakerFeeRate != nil || pair.TakerFeeRate != nil {
+				return errors.New("fee rates can only be set through governance")
+			}
+			if _, ok := SelfTradePrevention_name[int32(pair.SelfTradePrevention)]; !ok {
+				return errors.New("invalid self-trade prevention mode")
+			}
+			if _, ok := MatchingAlgorithm_name[int32(pair.MatchingAlgorithm)]; !ok {
+				return errors.New("invalid matching algorithm")
+			}
+			if pair.ProRataMinAllocation != nil && (pair.ProRataMinAllocation.IsNil() || pair.ProRataMinAllocation.IsNegative()) {
+				return errors.New("pro-rata minimum allocation cannot be negative")
+			}
+			if _, ok := ClearingMode_name[int32(pair.ClearingMode)]; !ok {
+				return errors.New("invalid clearing mode")
+			}
 		}
 	}
 
@@ -0,0 +1,66 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgReplaceOrders = "replace_orders"
+
+var _ sdk.Msg = &MsgReplaceOrders{}
+
+func NewMsgReplaceOrders(
+	creator string,
+	contractAddr string,
+	cancellations []*Cancellation,
+	orders []*Order,
+	fund sdk.Coins,
+) *MsgReplaceOrders {
+	return &MsgReplaceOrders{
+		Creator:       creator,
+		ContractAddr:  contractAddr,
+		Cancellations: cancellations,
+		Orders:        orders,
+		Funds:         fund,
+	}
+}
+
+func (msg *MsgReplaceOrders) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReplaceOrders) Type() string {
+	return TypeMsgReplaceOrders
+}
+
+func (msg *MsgReplaceOrders) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReplaceOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Each half is validated the same way as its standalone message
+func (msg *MsgReplaceOrders) ValidateBasic() error {
+	if len(msg.Cancellations) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "at least one order needs to be replaced")
+	}
+	if err := msg.GetCancelOrders().ValidateBasic(); err != nil {
+		return err
+	}
+	return msg.GetPlaceOrders().ValidateBasic()
+}
+
+func (msg *MsgReplaceOrders) GetCancelOrders() *MsgCancelOrders {
+	return NewMsgCancelOrders(msg.Creator, msg.Cancellations, msg.ContractAddr)
+}
+
+func (msg *MsgReplaceOrders) GetPlaceOrders() *MsgPlaceOrders {
+	return NewMsgPlaceOrders(msg.Creator, msg.Orders, msg.ContractAddr, msg.Funds)
+}
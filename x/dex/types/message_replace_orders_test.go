@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgReplaceOrders(t *testing.T) {
+	creator := "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx"
+	contract := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	cancellations := []*types.Cancellation{{Id: 1, Price: sdk.OneDec(), PriceDenom: "denom1", AssetDenom: "denom2"}}
+	orders := []*types.Order{{
+		Price:             sdk.OneDec(),
+		Quantity:          sdk.OneDec(),
+		PriceDenom:        "denom1",
+		AssetDenom:        "denom2",
+		OrderType:         types.OrderType_LIMIT,
+		PositionDirection: types.PositionDirection_LONG,
+		Data:              "{}",
+	}}
+	require.NoError(t, types.NewMsgReplaceOrders(creator, contract, cancellations, orders, nil).ValidateBasic())
+	// both halves are required
+	require.Error(t, types.NewMsgReplaceOrders(creator, contract, []*types.Cancellation{}, orders, nil).ValidateBasic())
+	require.Error(t, types.NewMsgReplaceOrders(creator, contract, cancellations, []*types.Order{}, nil).ValidateBasic())
+	require.Error(t, types.NewMsgReplaceOrders("invalid", contract, cancellations, orders, nil).ValidateBasic())
+}
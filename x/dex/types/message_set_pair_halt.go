@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetPairHalt = "set_pair_halt"
+
+var _ sdk.Msg = &MsgSetPairHalt{}
+
+func NewMsgSetPairHalt(
+	creator string,
+	contractAddr string,
+	pair *Pair,
+	halted bool,
+) *MsgSetPairHalt {
+	return &MsgSetPairHalt{
+		Creator:      creator,
+		ContractAddr: contractAddr,
+		Pair:         pair,
+		Halted:       halted,
+	}
+}
+
+func (msg *MsgSetPairHalt) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPairHalt) Type() string {
+	return TypeMsgSetPairHalt
+}
+
+func (msg *MsgSetPairHalt) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetPairHalt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetPairHalt) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	if msg.Pair == nil {
+		return errors.New("empty pair info")
+	}
+
+	return nil
+}
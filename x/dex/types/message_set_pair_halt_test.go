@@ -0,0 +1,19 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgSetPairHalt(t *testing.T) {
+	sender := "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx"
+	contract := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	pair := &types.Pair{PriceDenom: "usdc", AssetDenom: "atom"}
+	require.NoError(t, types.NewMsgSetPairHalt(sender, contract, pair, true).ValidateBasic())
+	require.NoError(t, types.NewMsgSetPairHalt(sender, contract, pair, false).ValidateBasic())
+	require.Error(t, types.NewMsgSetPairHalt("invalid", contract, pair, true).ValidateBasic())
+	require.Error(t, types.NewMsgSetPairHalt(sender, "invalid", pair, true).ValidateBasic())
+	require.Error(t, types.NewMsgSetPairHalt(sender, contract, nil, true).ValidateBasic())
+}
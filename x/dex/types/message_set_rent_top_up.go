@@ -0,0 +1,77 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetRentTopUp = "set_rent_top_up"
+
+var _ sdk.Msg = &MsgSetRentTopUp{}
+
+func NewMsgSetRentTopUp(
+	sender string,
+	contractAddr string,
+	threshold uint64,
+	amount uint64,
+	spendLimit uint64,
+) *MsgSetRentTopUp {
+	return &MsgSetRentTopUp{
+		Sender:       sender,
+		ContractAddr: contractAddr,
+		Threshold:    threshold,
+		Amount:       amount,
+		SpendLimit:   spendLimit,
+	}
+}
+
+func (msg *MsgSetRentTopUp) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRentTopUp) Type() string {
+	return TypeMsgSetRentTopUp
+}
+
+func (msg *MsgSetRentTopUp) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgSetRentTopUp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRentTopUp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ContractAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	// a zero spend limit removes the top-up, so the other fields don't matter
+	if msg.SpendLimit == 0 {
+		return nil
+	}
+	if msg.Threshold == 0 {
+		return errors.New("top-up threshold must be positive")
+	}
+	if msg.Amount == 0 || msg.Amount > msg.SpendLimit {
+		return errors.New("top-up amount must be positive and no more than the spend limit")
+	}
+	if msg.Amount > MaxAllowedRentBalance {
+		return fmt.Errorf("top-up amount must be no more than the max rent balance of %d", MaxAllowedRentBalance)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgSetRentTopUp(t *testing.T) {
+	sender := "sei1yezq49upxhunjjhudql2fnj5dgvcwjj87pn2wx"
+	contract := "sei1ghd753shjuwexxywmgs4xz7x2q732vcnkm6h2pyv9s6ah3hylvrqladqwc"
+	require.NoError(t, types.NewMsgSetRentTopUp(sender, contract, 100, 10, 50).ValidateBasic())
+	// removing a top-up doesn't need the other fields
+	require.NoError(t, types.NewMsgSetRentTopUp(sender, contract, 0, 0, 0).ValidateBasic())
+	require.Error(t, types.NewMsgSetRentTopUp("invalid", contract, 100, 10, 50).ValidateBasic())
+	require.Error(t, types.NewMsgSetRentTopUp(sender, "invalid", 100, 10, 50).ValidateBasic())
+	require.Error(t, types.NewMsgSetRentTopUp(sender, contract, 0, 10, 50).ValidateBasic())
+	require.Error(t, types.NewMsgSetRentTopUp(sender, contract, 100, 0, 50).ValidateBasic())
+	require.Error(t, types.NewMsgSetRentTopUp(sender, contract, 100, 60, 50).ValidateBasic())
+	require.Error(t, types.NewMsgSetRentTopUp(sender, contract, 100, types.MaxAllowedRentBalance+1, types.MaxAllowedRentBalance+1).ValidateBasic())
+}
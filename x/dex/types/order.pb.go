@@ -25,21 +25,30 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Order struct {
-	Id                uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	Status            OrderStatus                            `protobuf:"varint,2,opt,name=status,proto3,enum=seiprotocol.seichain.dex.OrderStatus" json:"status"`
-	Account           string                                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account"`
-	ContractAddr      string                                 `protobuf:"bytes,4,opt,name=contractAddr,proto3" json:"contract_address"`
-	Price             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
-	Quantity          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity" yaml:"quantity"`
-	PriceDenom        string                                 `protobuf:"bytes,7,opt,name=priceDenom,proto3" json:"price_denom"`
-	AssetDenom        string                                 `protobuf:"bytes,8,opt,name=assetDenom,proto3" json:"asset_denom"`
-	OrderType         OrderType                              `protobuf:"varint,9,opt,name=orderType,proto3,enum=seiprotocol.seichain.dex.OrderType" json:"order_type"`
-	PositionDirection PositionDirection                      `protobuf:"varint,10,opt,name=positionDirection,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"position_direction"`
-	Data              string                                 `protobuf:"bytes,11,opt,name=data,proto3" json:"data"`
-	StatusDescription string                                 `protobuf:"bytes,12,opt,name=statusDescription,proto3" json:"status_description"`
-	Nominal           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=nominal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"nominal" yaml:"nominal"`
-	TriggerPrice      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price" yaml:"trigger_price"`
-	TriggerStatus     bool                                   `protobuf:"varint,15,opt,name=triggerStatus,proto3" json:"trigger_status"`
+	Id                  uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Status              OrderStatus                            `protobuf:"varint,2,opt,name=status,proto3,enum=seiprotocol.seichain.dex.OrderStatus" json:"status"`
+	Account             string                                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account"`
+	ContractAddr        string                                 `protobuf:"bytes,4,opt,name=contractAddr,proto3" json:"contract_address"`
+	Price               github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	Quantity            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity" yaml:"quantity"`
+	PriceDenom          string                                 `protobuf:"bytes,7,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom          string                                 `protobuf:"bytes,8,opt,name=assetDenom,proto3" json:"asset_denom"`
+	OrderType           OrderType                              `protobuf:"varint,9,opt,name=orderType,proto3,enum=seiprotocol.seichain.dex.OrderType" json:"order_type"`
+	PositionDirection   PositionDirection                      `protobuf:"varint,10,opt,name=positionDirection,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"position_direction"`
+	Data                string                                 `protobuf:"bytes,11,opt,name=data,proto3" json:"data"`
+	StatusDescription   string                                 `protobuf:"bytes,12,opt,name=statusDescription,proto3" json:"status_description"`
+	Nominal             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=nominal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"nominal" yaml:"nominal"`
+	TriggerPrice        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price" yaml:"trigger_price"`
+	TriggerStatus       bool                                   `protobuf:"varint,15,opt,name=triggerStatus,proto3" json:"trigger_status"`
+	PostOnly            bool                                   `protobuf:"varint,16,opt,name=postOnly,proto3" json:"post_only"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,17,opt,name=timeInForce,proto3,enum=seiprotocol.seichain.dex.TimeInForce" json:"time_in_force"`
+	ExpiryHeight        uint64                                 `protobuf:"varint,18,opt,name=expiryHeight,proto3" json:"expiry_height"`
+	ExpiryTimestamp     uint64                                 `protobuf:"varint,19,opt,name=expiryTimestamp,proto3" json:"expiry_timestamp"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,20,opt,name=selfTradePrevention,proto3,enum=seiprotocol.seichain.dex.SelfTradePrevention" json:"self_trade_prevention"`
+	// assigned on placement to bracket orders, and kept after they're activated
+	ParentOrderId uint64 `protobuf:"varint,21,opt,name=parentOrderId,proto3" json:"parent_order_id"`
+	// cleared once the bracket order is activated and placed as a regular order
+	BracketRole BracketRole `protobuf:"varint,22,opt,name=bracketRole,proto3,enum=seiprotocol.seichain.dex.BracketRole" json:"bracket_role"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -152,6 +161,55 @@ func (m *Order) GetTriggerStatus() bool {
 	return false
 }
 
+func (m *Order) GetPostOnly() bool {
+	if m != nil {
+		return m.PostOnly
+	}
+	return false
+}
+
+func (m *Order) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_GTC
+}
+
+func (m *Order) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *Order) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
+func (m *Order) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_NO_PREVENTION
+}
+
+func (m *Order) GetParentOrderId() uint64 {
+	if m != nil {
+		return m.ParentOrderId
+	}
+	return 0
+}
+
+func (m *Order) GetBracketRole() BracketRole {
+	if m != nil {
+		return m.BracketRole
+	}
+	return BracketRole_NO_BRACKET
+}
+
 type Cancellation struct {
 	Id                uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
 	Initiator         CancellationInitiator                  `protobuf:"varint,2,opt,name=initiator,proto3,enum=seiprotocol.seichain.dex.CancellationInitiator" json:"initiator"`
@@ -161,6 +219,9 @@ type Cancellation struct {
 	AssetDenom        string                                 `protobuf:"bytes,6,opt,name=assetDenom,proto3" json:"asset_denom"`
 	PositionDirection PositionDirection                      `protobuf:"varint,7,opt,name=positionDirection,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"position_direction"`
 	Price             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// set if the cancelled order is replaced by an order of the same message that can take
+	// over its position in the queue
+	ReplacementOrderId uint64 `protobuf:"varint,9,opt,name=replacementOrderId,proto3" json:"replacement_order_id"`
 }
 
 func (m *Cancellation) Reset()         { *m = Cancellation{} }
@@ -245,6 +306,76 @@ func (m *Cancellation) GetPositionDirection() PositionDirection {
 	return PositionDirection_LONG
 }
 
+func (m *Cancellation) GetReplacementOrderId() uint64 {
+	if m != nil {
+		return m.ReplacementOrderId
+	}
+	return 0
+}
+
+// Selects the resting orders of an account to cancel. Unset fields match everything.
+type CancelAllFilter struct {
+	PriceDenom         string                                  `protobuf:"bytes,1,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom         string                                  `protobuf:"bytes,2,opt,name=assetDenom,proto3" json:"asset_denom"`
+	PositionDirections []PositionDirection                     `protobuf:"varint,3,rep,packed,name=positionDirections,proto3,enum=seiprotocol.seichain.dex.PositionDirection" json:"position_directions"`
+	MinPrice           *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=minPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price" yaml:"min_price"`
+	MaxPrice           *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=maxPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price" yaml:"max_price"`
+}
+
+func (m *CancelAllFilter) Reset()         { *m = CancelAllFilter{} }
+func (m *CancelAllFilter) String() string { return proto.CompactTextString(m) }
+func (*CancelAllFilter) ProtoMessage()    {}
+func (*CancelAllFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d5fab85368797d, []int{2}
+}
+func (m *CancelAllFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelAllFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelAllFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelAllFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAllFilter.Merge(m, src)
+}
+func (m *CancelAllFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelAllFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAllFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelAllFilter proto.InternalMessageInfo
+
+func (m *CancelAllFilter) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *CancelAllFilter) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *CancelAllFilter) GetPositionDirections() []PositionDirection {
+	if m != nil {
+		return m.PositionDirections
+	}
+	return nil
+}
+
 type ActiveOrders struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids"`
 }
@@ -253,7 +384,7 @@ func (m *ActiveOrders) Reset()         { *m = ActiveOrders{} }
 func (m *ActiveOrders) String() string { return proto.CompactTextString(m) }
 func (*ActiveOrders) ProtoMessage()    {}
 func (*ActiveOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c2d5fab85368797d, []int{2}
+	return fileDescriptor_c2d5fab85368797d, []int{3}
 }
 func (m *ActiveOrders) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,60 +423,81 @@ func (m *ActiveOrders) GetIds() []uint64 {
 func init() {
 	proto.RegisterType((*Order)(nil), "seiprotocol.seichain.dex.Order")
 	proto.RegisterType((*Cancellation)(nil), "seiprotocol.seichain.dex.Cancellation")
+	proto.RegisterType((*CancelAllFilter)(nil), "seiprotocol.seichain.dex.CancelAllFilter")
 	proto.RegisterType((*ActiveOrders)(nil), "seiprotocol.seichain.dex.ActiveOrders")
 }
 
 func init() { proto.RegisterFile("dex/order.proto", fileDescriptor_c2d5fab85368797d) }
 
 var fileDescriptor_c2d5fab85368797d = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6a, 0xdb, 0x4c,
-	0x14, 0xb5, 0x1c, 0xff, 0x4e, 0x1c, 0xfb, 0xcb, 0x10, 0x82, 0xbe, 0x50, 0x2c, 0xa3, 0xd2, 0xe2,
-	0x50, 0x62, 0x41, 0xbb, 0x09, 0xa5, 0x9b, 0xa8, 0x86, 0x50, 0x4a, 0x68, 0x3a, 0x2d, 0x14, 0x4a,
-	0x8b, 0x51, 0x34, 0x83, 0x33, 0xd4, 0xd6, 0x38, 0x9a, 0x71, 0x89, 0xe9, 0x4b, 0xf4, 0x05, 0xfa,
-	0x3e, 0xd9, 0x35, 0xcb, 0xd2, 0x85, 0x28, 0xc9, 0x4e, 0xcb, 0x3c, 0x41, 0xd1, 0x95, 0x26, 0xb6,
-	0xf3, 0x43, 0xea, 0x45, 0x36, 0xd6, 0x9d, 0x73, 0xcf, 0x39, 0x57, 0x9a, 0x99, 0x7b, 0x8d, 0x1a,
-	0x94, 0x1d, 0x3b, 0x22, 0xa4, 0x2c, 0xec, 0x8c, 0x42, 0xa1, 0x04, 0x36, 0x25, 0xe3, 0x10, 0xf9,
-	0x62, 0xd0, 0x91, 0x8c, 0xfb, 0x87, 0x1e, 0x0f, 0x3a, 0x94, 0x1d, 0x6f, 0xac, 0xf5, 0x45, 0x5f,
-	0x40, 0xca, 0x49, 0xa2, 0x94, 0xbf, 0x01, 0x06, 0x2c, 0x18, 0x0f, 0x65, 0x0a, 0xd8, 0x3f, 0x2b,
-	0xa8, 0xf8, 0x26, 0x31, 0xc4, 0x1b, 0x28, 0xcf, 0xa9, 0x69, 0xb4, 0x8c, 0x76, 0xc1, 0x45, 0x27,
-	0x91, 0x65, 0xc4, 0x91, 0x95, 0xe7, 0x94, 0xe4, 0x39, 0xc5, 0x7b, 0xa8, 0x24, 0x95, 0xa7, 0xc6,
-	0xd2, 0xcc, 0xb7, 0x8c, 0x76, 0xfd, 0xe9, 0xa3, 0xce, 0x6d, 0x75, 0x3b, 0x60, 0xf6, 0x0e, 0xc8,
-	0x6e, 0x3d, 0xb3, 0xc9, 0xc4, 0x24, 0x7b, 0xe2, 0x4d, 0x54, 0xf6, 0x7c, 0x5f, 0x8c, 0x03, 0x65,
-	0x2e, 0xb5, 0x8c, 0x76, 0xd5, 0x6d, 0x64, 0x44, 0x0d, 0x13, 0x1d, 0xe0, 0x17, 0xa8, 0xe6, 0x8b,
-	0x40, 0x85, 0x9e, 0xaf, 0x76, 0x28, 0x0d, 0xcd, 0x02, 0xf0, 0xcd, 0x8c, 0xff, 0x9f, 0xce, 0xf5,
-	0x3c, 0x4a, 0x43, 0x26, 0x25, 0x99, 0x63, 0xe3, 0xcf, 0xa8, 0x38, 0x0a, 0xb9, 0xcf, 0xcc, 0x22,
-	0xc8, 0x76, 0x4f, 0x22, 0x2b, 0xf7, 0x3b, 0xb2, 0x1e, 0xf7, 0xb9, 0x3a, 0x1c, 0x1f, 0x74, 0x7c,
-	0x31, 0x74, 0x7c, 0x21, 0x87, 0x42, 0x66, 0x8f, 0x2d, 0x49, 0xbf, 0x38, 0x6a, 0x32, 0x62, 0xb2,
-	0xd3, 0x65, 0x7e, 0x1c, 0x59, 0xa9, 0xfc, 0x22, 0xb2, 0x6a, 0x13, 0x6f, 0x38, 0x78, 0x6e, 0xc3,
-	0xd2, 0x26, 0x29, 0x8c, 0x39, 0xaa, 0x1c, 0x8d, 0xbd, 0x40, 0x71, 0x35, 0x31, 0x4b, 0x50, 0x61,
-	0x6f, 0xe1, 0x0a, 0x97, 0x0e, 0x17, 0x91, 0xd5, 0x48, 0x8b, 0x68, 0xc4, 0x26, 0x97, 0x49, 0xec,
-	0x20, 0x04, 0x35, 0xbb, 0x2c, 0x10, 0x43, 0xb3, 0x9c, 0xee, 0x5a, 0x1c, 0x59, 0xcb, 0x80, 0xf6,
-	0x68, 0x02, 0x93, 0x19, 0x4a, 0x22, 0xf0, 0xa4, 0x64, 0x2a, 0x15, 0x54, 0xa6, 0x02, 0x40, 0xb5,
-	0x60, 0x4a, 0xc1, 0x6f, 0x51, 0x15, 0x6e, 0xd6, 0xfb, 0xc9, 0x88, 0x99, 0x55, 0x38, 0xe6, 0x87,
-	0x77, 0x1c, 0x73, 0x42, 0x75, 0xeb, 0x71, 0x64, 0x21, 0x50, 0xf6, 0x92, 0xef, 0x22, 0x53, 0x17,
-	0x7c, 0x84, 0x56, 0x47, 0x42, 0x72, 0xc5, 0x45, 0xd0, 0xe5, 0x21, 0xf3, 0x93, 0xc0, 0x44, 0x60,
-	0xfd, 0xe4, 0x76, 0xeb, 0xfd, 0xab, 0x12, 0x77, 0x3d, 0x8e, 0x2c, 0xac, 0x9d, 0x7a, 0x54, 0xe3,
-	0xe4, 0xba, 0x3b, 0x7e, 0x80, 0x0a, 0xd4, 0x53, 0x9e, 0xb9, 0x0c, 0x1f, 0x5c, 0x89, 0x23, 0x0b,
-	0xd6, 0x04, 0x7e, 0x71, 0x17, 0xad, 0xa6, 0x57, 0xb0, 0xcb, 0xa4, 0x1f, 0xf2, 0x11, 0xbc, 0x50,
-	0x0d, 0xa8, 0x50, 0x23, 0x4d, 0xf6, 0xe8, 0x34, 0x4b, 0xae, 0x0b, 0x30, 0x43, 0xe5, 0x40, 0x0c,
-	0x79, 0xe0, 0x0d, 0xcc, 0x15, 0xd0, 0xbe, 0x5e, 0xf8, 0xd4, 0xb5, 0xc1, 0x45, 0x64, 0xd5, 0xd3,
-	0x43, 0xcf, 0x00, 0x9b, 0xe8, 0x14, 0xfe, 0x86, 0x6a, 0x2a, 0xe4, 0xfd, 0x3e, 0x0b, 0xf7, 0xe1,
-	0x0e, 0xd7, 0xa1, 0xd6, 0x87, 0x85, 0x6b, 0xad, 0x64, 0x2e, 0x3d, 0x7d, 0x97, 0xd7, 0xd2, 0x8a,
-	0x73, 0xb0, 0x4d, 0xe6, 0x8a, 0xe1, 0x6d, 0xa4, 0x65, 0x69, 0x2f, 0x9b, 0x8d, 0x96, 0xd1, 0xae,
-	0xb8, 0x38, 0x8e, 0xac, 0xba, 0x16, 0x66, 0x5d, 0x3d, 0x4f, 0xb4, 0x7f, 0x14, 0x50, 0xed, 0xa5,
-	0x17, 0xf8, 0x6c, 0x30, 0xf0, 0x60, 0xbb, 0xd6, 0x67, 0x06, 0x4b, 0x69, 0x66, 0xa8, 0x7c, 0x42,
-	0x55, 0x1e, 0x70, 0xc5, 0x3d, 0x25, 0xc2, 0x6c, 0xae, 0x38, 0xb7, 0xdf, 0x8a, 0x59, 0xcb, 0x57,
-	0x5a, 0xe6, 0xae, 0xc4, 0x91, 0x35, 0x75, 0x21, 0xd3, 0x30, 0x99, 0x31, 0x7e, 0xc8, 0xc0, 0xfb,
-	0xca, 0x8c, 0xc9, 0x60, 0xa2, 0x03, 0xbc, 0x7d, 0xe3, 0x8c, 0x59, 0xfb, 0x87, 0xf9, 0x32, 0xdf,
-	0x95, 0xc5, 0x45, 0xbb, 0xb2, 0x74, 0x77, 0x57, 0xde, 0xd8, 0x42, 0xe5, 0x7b, 0x6d, 0xa1, 0xcb,
-	0xa1, 0x59, 0xb9, 0x8f, 0xa1, 0x69, 0x6f, 0xa2, 0xda, 0x8e, 0xaf, 0xf8, 0x57, 0x06, 0x23, 0x44,
-	0xe2, 0xff, 0xd1, 0x12, 0xa7, 0xd2, 0x34, 0x5a, 0x4b, 0xed, 0x82, 0x5b, 0x8e, 0x23, 0x2b, 0x59,
-	0x92, 0xe4, 0xc7, 0xdd, 0x3d, 0x39, 0x6b, 0x1a, 0xa7, 0x67, 0x4d, 0xe3, 0xcf, 0x59, 0xd3, 0xf8,
-	0x7e, 0xde, 0xcc, 0x9d, 0x9e, 0x37, 0x73, 0xbf, 0xce, 0x9b, 0xb9, 0x8f, 0x5b, 0x33, 0x2f, 0x23,
-	0x19, 0xdf, 0xd2, 0xdb, 0x00, 0x0b, 0xd8, 0x07, 0xe7, 0xd8, 0x49, 0xfe, 0xeb, 0xe0, 0xbd, 0x0e,
-	0x4a, 0x90, 0x7f, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x4e, 0x8b, 0xbe, 0x40, 0x07, 0x00,
-	0x00,
+	// 1066 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6a, 0x23, 0x37,
+	0x14, 0xce, 0xc4, 0xf9, 0xb1, 0x15, 0xc7, 0x4e, 0x14, 0x6f, 0xaa, 0x0d, 0xc5, 0x63, 0x5c, 0x5a,
+	0x12, 0x4a, 0x6c, 0x68, 0x29, 0x6c, 0x4b, 0x29, 0x64, 0x1a, 0x76, 0x37, 0x94, 0x25, 0xa9, 0x36,
+	0x50, 0xba, 0xb4, 0x0c, 0xca, 0x8c, 0xd6, 0x11, 0x99, 0xbf, 0x1d, 0x29, 0x8b, 0xdd, 0xbe, 0x44,
+	0xdf, 0xa9, 0x37, 0xb9, 0xdc, 0xcb, 0xd2, 0x8b, 0x69, 0x49, 0x2e, 0x0a, 0x73, 0x99, 0x27, 0x28,
+	0x73, 0x34, 0xe3, 0x9f, 0x38, 0xc1, 0x75, 0x61, 0x6f, 0x6c, 0xe9, 0x9c, 0xf3, 0x7d, 0x9f, 0xe4,
+	0x73, 0x74, 0x8e, 0x51, 0xdd, 0xe5, 0xfd, 0x6e, 0x18, 0xbb, 0x3c, 0xee, 0x44, 0x71, 0xa8, 0x42,
+	0x4c, 0x24, 0x17, 0xb0, 0x72, 0x42, 0xaf, 0x23, 0xb9, 0x70, 0xce, 0x99, 0x08, 0x3a, 0x2e, 0xef,
+	0xef, 0x34, 0x7a, 0x61, 0x2f, 0x04, 0x57, 0x37, 0x5b, 0xe9, 0xf8, 0x1d, 0x20, 0xe0, 0xc1, 0xa5,
+	0x2f, 0xb5, 0xa1, 0xfd, 0x57, 0x15, 0x2d, 0x1f, 0x67, 0x84, 0x78, 0x07, 0x2d, 0x0a, 0x97, 0x18,
+	0x2d, 0x63, 0x77, 0xc9, 0x42, 0x57, 0x89, 0x69, 0xa4, 0x89, 0xb9, 0x28, 0x5c, 0xba, 0x28, 0x5c,
+	0xfc, 0x02, 0xad, 0x48, 0xc5, 0xd4, 0xa5, 0x24, 0x8b, 0x2d, 0x63, 0xb7, 0xf6, 0xd9, 0xc7, 0x9d,
+	0x87, 0x74, 0x3b, 0x40, 0xf6, 0x12, 0x82, 0xad, 0x5a, 0x4e, 0x93, 0x83, 0x69, 0xfe, 0x8d, 0xf7,
+	0xd0, 0x2a, 0x73, 0x9c, 0xf0, 0x32, 0x50, 0xa4, 0xd4, 0x32, 0x76, 0x2b, 0x56, 0x3d, 0x0f, 0x2c,
+	0xcc, 0xb4, 0x58, 0xe0, 0xaf, 0x51, 0xd5, 0x09, 0x03, 0x15, 0x33, 0x47, 0x1d, 0xb8, 0x6e, 0x4c,
+	0x96, 0x20, 0x9e, 0xe4, 0xf1, 0x1b, 0x85, 0xcf, 0x66, 0xae, 0x1b, 0x73, 0x29, 0xe9, 0x44, 0x34,
+	0xfe, 0x19, 0x2d, 0x47, 0xb1, 0x70, 0x38, 0x59, 0x06, 0xd8, 0xb3, 0xab, 0xc4, 0x5c, 0xf8, 0x33,
+	0x31, 0x3f, 0xe9, 0x09, 0x75, 0x7e, 0x79, 0xd6, 0x71, 0x42, 0xbf, 0xeb, 0x84, 0xd2, 0x0f, 0x65,
+	0xfe, 0xb5, 0x2f, 0xdd, 0x8b, 0xae, 0x1a, 0x44, 0x5c, 0x76, 0x0e, 0xb9, 0x93, 0x26, 0xa6, 0x86,
+	0xdf, 0x26, 0x66, 0x75, 0xc0, 0x7c, 0xef, 0xab, 0x36, 0x6c, 0xdb, 0x54, 0x9b, 0xb1, 0x40, 0xe5,
+	0x37, 0x97, 0x2c, 0x50, 0x42, 0x0d, 0xc8, 0x0a, 0x28, 0xbc, 0x98, 0x5b, 0x61, 0xc8, 0x70, 0x9b,
+	0x98, 0x75, 0x2d, 0x52, 0x58, 0xda, 0x74, 0xe8, 0xc4, 0x5d, 0x84, 0x40, 0xf3, 0x90, 0x07, 0xa1,
+	0x4f, 0x56, 0xf5, 0xaf, 0x96, 0x26, 0xe6, 0x1a, 0x58, 0x6d, 0x37, 0x33, 0xd3, 0xb1, 0x90, 0x0c,
+	0xc0, 0xa4, 0xe4, 0x4a, 0x03, 0xca, 0x23, 0x00, 0x58, 0x0b, 0xc0, 0x28, 0x04, 0x7f, 0x8f, 0x2a,
+	0x50, 0x59, 0xa7, 0x83, 0x88, 0x93, 0x0a, 0xa4, 0xf9, 0xa3, 0x19, 0x69, 0xce, 0x42, 0xad, 0x5a,
+	0x9a, 0x98, 0x08, 0x90, 0x76, 0x76, 0x2f, 0x3a, 0x62, 0xc1, 0x6f, 0xd0, 0x66, 0x14, 0x4a, 0xa1,
+	0x44, 0x18, 0x1c, 0x8a, 0x98, 0x3b, 0xd9, 0x82, 0x20, 0xa0, 0xfe, 0xf4, 0x61, 0xea, 0x93, 0xbb,
+	0x10, 0x6b, 0x3b, 0x4d, 0x4c, 0x5c, 0x30, 0xd9, 0x6e, 0x61, 0xa7, 0xd3, 0xec, 0xf8, 0x43, 0xb4,
+	0xe4, 0x32, 0xc5, 0xc8, 0x1a, 0x5c, 0xb8, 0x9c, 0x26, 0x26, 0xec, 0x29, 0x7c, 0xe2, 0x43, 0xb4,
+	0xa9, 0x4b, 0xf0, 0x90, 0x4b, 0x27, 0x16, 0x11, 0x1c, 0xa8, 0x0a, 0xa1, 0xa0, 0xa1, 0x9d, 0xb6,
+	0x3b, 0xf2, 0xd2, 0x69, 0x00, 0xe6, 0x68, 0x35, 0x08, 0x7d, 0x11, 0x30, 0x8f, 0xac, 0x03, 0xf6,
+	0xbb, 0xb9, 0xb3, 0x5e, 0x10, 0xdc, 0x26, 0x66, 0x4d, 0x27, 0x3d, 0x37, 0xb4, 0x69, 0xe1, 0xc2,
+	0xbf, 0xa2, 0xaa, 0x8a, 0x45, 0xaf, 0xc7, 0xe3, 0x13, 0xa8, 0xe1, 0x1a, 0x68, 0xfd, 0x30, 0xb7,
+	0xd6, 0x7a, 0xce, 0x62, 0x17, 0xb5, 0xdc, 0xd0, 0x8a, 0x13, 0xe6, 0x36, 0x9d, 0x10, 0xc3, 0x4f,
+	0x50, 0x01, 0xd3, 0x6f, 0x99, 0xd4, 0x5b, 0xc6, 0x6e, 0xd9, 0xc2, 0x69, 0x62, 0xd6, 0x0a, 0x60,
+	0xfe, 0xaa, 0x27, 0x03, 0xf1, 0x1e, 0x2a, 0x47, 0xa1, 0x54, 0xc7, 0x81, 0x37, 0x20, 0x1b, 0x00,
+	0x5a, 0x4f, 0x13, 0xb3, 0x92, 0xd9, 0xec, 0x30, 0xf0, 0x06, 0x74, 0xe8, 0xc6, 0xaf, 0xd0, 0x9a,
+	0x12, 0x3e, 0x3f, 0x0a, 0x9e, 0x86, 0xb1, 0xc3, 0xc9, 0xe6, 0xac, 0xde, 0x72, 0x3a, 0x0a, 0xb6,
+	0x36, 0xe1, 0x66, 0xc2, 0xe7, 0xb6, 0x08, 0xec, 0xd7, 0x99, 0x89, 0x8e, 0x93, 0xe1, 0x2f, 0x50,
+	0x95, 0xf7, 0x23, 0x11, 0x0f, 0x9e, 0x73, 0xd1, 0x3b, 0x57, 0x04, 0x43, 0x63, 0x03, 0x94, 0xb6,
+	0xdb, 0xe7, 0xe0, 0xa0, 0x13, 0x61, 0xf8, 0x1b, 0x54, 0xd7, 0xfb, 0x4c, 0x4b, 0x2a, 0xe6, 0x47,
+	0x64, 0x0b, 0x90, 0x8d, 0xac, 0xdd, 0xe4, 0x48, 0x55, 0xf8, 0xe8, 0xdd, 0x60, 0xfc, 0x0b, 0xda,
+	0x92, 0xdc, 0x7b, 0x7d, 0x1a, 0x33, 0x97, 0x9f, 0xc4, 0xfc, 0x2d, 0x0f, 0xa0, 0xc6, 0x1a, 0x70,
+	0xb5, 0xfd, 0x87, 0xaf, 0xf6, 0x72, 0x1a, 0x64, 0x3d, 0x4e, 0x13, 0xf3, 0x51, 0xc6, 0x66, 0xab,
+	0xcc, 0x63, 0x47, 0x43, 0x17, 0xbd, 0x4f, 0x04, 0x7f, 0x89, 0xd6, 0x23, 0x16, 0xf3, 0x40, 0xc1,
+	0xe3, 0x3c, 0x72, 0xc9, 0x23, 0x38, 0xf9, 0x56, 0x9a, 0x98, 0x75, 0xed, 0xb0, 0xf5, 0x3b, 0x15,
+	0x2e, 0x9d, 0x8c, 0xc4, 0x3f, 0xa2, 0xb5, 0xb3, 0x98, 0x39, 0x17, 0x5c, 0xd1, 0xd0, 0xe3, 0x64,
+	0x7b, 0x56, 0x26, 0xac, 0x51, 0xb0, 0xb5, 0x91, 0x26, 0x66, 0x35, 0x47, 0xdb, 0x71, 0xe8, 0x71,
+	0x3a, 0xce, 0xd5, 0xfe, 0x67, 0x09, 0x55, 0xbf, 0x65, 0x81, 0xc3, 0x3d, 0x8f, 0xc1, 0x31, 0xb7,
+	0xc7, 0x06, 0xcd, 0xca, 0xd8, 0x90, 0xf9, 0x09, 0x55, 0x44, 0x20, 0x94, 0x60, 0x2a, 0x8c, 0xf3,
+	0x39, 0xd3, 0x7d, 0xf8, 0x04, 0xe3, 0x94, 0x47, 0x05, 0x4c, 0x97, 0xda, 0x90, 0x85, 0x8e, 0x96,
+	0xd9, 0xcc, 0x71, 0x62, 0x0e, 0xdc, 0x77, 0x66, 0x4e, 0x6e, 0xa6, 0xc5, 0x02, 0x3f, 0xb9, 0x77,
+	0xe6, 0x34, 0xfe, 0xc3, 0xbc, 0x99, 0xec, 0xd2, 0xcb, 0xf3, 0x76, 0xe9, 0x95, 0xd9, 0x5d, 0xfa,
+	0xde, 0x96, 0xba, 0xfa, 0x5e, 0x5b, 0xea, 0x70, 0x88, 0x96, 0xdf, 0xcb, 0x10, 0x7d, 0x8e, 0x70,
+	0xcc, 0x23, 0x8f, 0x39, 0xdc, 0x1f, 0x2b, 0xdd, 0x0a, 0x94, 0x07, 0x49, 0x13, 0xb3, 0x31, 0xe6,
+	0x1d, 0xd5, 0xef, 0x3d, 0x98, 0xf6, 0xef, 0x25, 0x54, 0xd7, 0x65, 0x71, 0xe0, 0x79, 0x4f, 0x85,
+	0xa7, 0xf8, 0xdd, 0x8c, 0x18, 0xf3, 0x66, 0x64, 0x71, 0x76, 0x46, 0x14, 0xc2, 0x53, 0xbf, 0x99,
+	0x24, 0xa5, 0x56, 0x69, 0xde, 0x94, 0x7c, 0x90, 0x26, 0xe6, 0xd6, 0x74, 0x4a, 0x24, 0xbd, 0x87,
+	0x1f, 0x5f, 0xa0, 0xb2, 0x2f, 0x02, 0x3d, 0x18, 0x74, 0x7d, 0x1e, 0x67, 0xf5, 0x3c, 0x57, 0x5e,
+	0x2a, 0xbe, 0x08, 0x86, 0x43, 0x61, 0x43, 0xe7, 0x66, 0x68, 0x6a, 0xd3, 0xa1, 0x00, 0x88, 0xb1,
+	0xfe, 0xc9, 0xd8, 0x3f, 0xa9, 0xff, 0x23, 0xc6, 0xfa, 0x53, 0x62, 0xac, 0x3f, 0x12, 0xcb, 0x05,
+	0xda, 0x7b, 0xa8, 0x7a, 0xe0, 0x28, 0xf1, 0x96, 0x43, 0x5a, 0x25, 0x7e, 0x8c, 0x4a, 0xc2, 0x95,
+	0xc4, 0x68, 0x95, 0x76, 0x97, 0xac, 0xd5, 0x34, 0x31, 0xb3, 0x2d, 0xcd, 0x3e, 0xac, 0x67, 0x57,
+	0xd7, 0x4d, 0xe3, 0xdd, 0x75, 0xd3, 0xf8, 0xfb, 0xba, 0x69, 0xfc, 0x76, 0xd3, 0x5c, 0x78, 0x77,
+	0xd3, 0x5c, 0xf8, 0xe3, 0xa6, 0xb9, 0xf0, 0x6a, 0x7f, 0xec, 0x5c, 0x92, 0x8b, 0xfd, 0x22, 0x07,
+	0xb0, 0x81, 0x24, 0x74, 0xfb, 0xdd, 0xec, 0xbf, 0x30, 0x1c, 0xf1, 0x6c, 0x05, 0xfc, 0x9f, 0xff,
+	0x3b, 0x00, 0xf0, 0x12, 0x69, 0x75, 0x60, 0x0b, 0x00, 0x00,
 }
 
 func (m *Order) Marshal() (dAtA []byte, err error) {
@@ -368,6 +520,60 @@ func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BracketRole != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.BracketRole))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ParentOrderId != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ParentOrderId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.PostOnly {
+		i--
+		if m.PostOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.TriggerStatus {
 		i--
 		if m.TriggerStatus {
@@ -503,6 +709,11 @@ func (m *Cancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReplacementOrderId != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ReplacementOrderId))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.Price.Size()
 		i -= size
@@ -559,7 +770,7 @@ func (m *Cancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ActiveOrders) Marshal() (dAtA []byte, err error) {
+func (m *CancelAllFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -569,20 +780,44 @@ func (m *ActiveOrders) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ActiveOrders) MarshalTo(dAtA []byte) (int, error) {
+func (m *CancelAllFilter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ActiveOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CancelAllFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		dAtA2 := make([]byte, len(m.Ids)*10)
+	if m.MaxPrice != nil {
+		{
+			size := m.MaxPrice.Size()
+			i -= size
+			if _, err := m.MaxPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinPrice != nil {
+		{
+			size := m.MinPrice.Size()
+			i -= size
+			if _, err := m.MinPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PositionDirections) > 0 {
+		dAtA2 := make([]byte, len(m.PositionDirections)*10)
 		var j1 int
-		for _, num := range m.Ids {
+		for _, num := range m.PositionDirections {
 			for num >= 1<<7 {
 				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -595,6 +830,61 @@ func (m *ActiveOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA2[:j1])
 		i = encodeVarintOrder(dAtA, i, uint64(j1))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActiveOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA4 := make([]byte, len(m.Ids)*10)
+		var j3 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintOrder(dAtA, i, uint64(j3))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -664,6 +954,27 @@ func (m *Order) Size() (n int) {
 	if m.TriggerStatus {
 		n += 2
 	}
+	if m.PostOnly {
+		n += 3
+	}
+	if m.TimeInForce != 0 {
+		n += 2 + sovOrder(uint64(m.TimeInForce))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 2 + sovOrder(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 2 + sovOrder(uint64(m.ExpiryTimestamp))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 2 + sovOrder(uint64(m.SelfTradePrevention))
+	}
+	if m.ParentOrderId != 0 {
+		n += 2 + sovOrder(uint64(m.ParentOrderId))
+	}
+	if m.BracketRole != 0 {
+		n += 2 + sovOrder(uint64(m.BracketRole))
+	}
 	return n
 }
 
@@ -700,6 +1011,41 @@ func (m *Cancellation) Size() (n int) {
 	}
 	l = m.Price.Size()
 	n += 1 + l + sovOrder(uint64(l))
+	if m.ReplacementOrderId != 0 {
+		n += 1 + sovOrder(uint64(m.ReplacementOrderId))
+	}
+	return n
+}
+
+func (m *CancelAllFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if len(m.PositionDirections) > 0 {
+		l = 0
+		for _, e := range m.PositionDirections {
+			l += sovOrder(uint64(e))
+		}
+		n += 1 + sovOrder(uint64(l)) + l
+	}
+	if m.MinPrice != nil {
+		l = m.MinPrice.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.MaxPrice != nil {
+		l = m.MaxPrice.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
 	return n
 }
 
@@ -1178,61 +1524,11 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.TriggerStatus = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOrder(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOrder
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Cancellation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOrder
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Cancellation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cancellation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1242,7 +1538,191 @@ func (m *Cancellation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PostOnly = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentOrderId", wireType)
+			}
+			m.ParentOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BracketRole", wireType)
+			}
+			m.BracketRole = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BracketRole |= BracketRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cancellation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cancellation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cancellation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1447,6 +1927,280 @@ func (m *Cancellation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementOrderId", wireType)
+			}
+			m.ReplacementOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplacementOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelAllFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelAllFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelAllFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v PositionDirection
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrder
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PositionDirection(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PositionDirections = append(m.PositionDirections, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOrder
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOrder
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOrder
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PositionDirections) == 0 {
+					m.PositionDirections = make([]PositionDirection, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PositionDirection
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOrder
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PositionDirection(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PositionDirections = append(m.PositionDirections, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionDirections", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinPrice = &v
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxPrice = &v
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
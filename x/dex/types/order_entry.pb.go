@@ -87,9 +87,10 @@ func (m *OrderEntry) GetAssetDenom() string {
 }
 
 type Allocation struct {
-	OrderId  uint64                                 `protobuf:"varint,1,opt,name=orderId,proto3" json:"order_id"`
-	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity" yaml:"quantity"`
-	Account  string                                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account"`
+	OrderId             uint64                                 `protobuf:"varint,1,opt,name=orderId,proto3" json:"order_id"`
+	Quantity            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity" yaml:"quantity"`
+	Account             string                                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,4,opt,name=selfTradePrevention,proto3,enum=seiprotocol.seichain.dex.SelfTradePrevention" json:"self_trade_prevention"`
 }
 
 func (m *Allocation) Reset()         { *m = Allocation{} }
@@ -139,6 +140,13 @@ func (m *Allocation) GetAccount() string {
 	return ""
 }
 
+func (m *Allocation) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_NO_PREVENTION
+}
+
 func init() {
 	proto.RegisterType((*OrderEntry)(nil), "seiprotocol.seichain.dex.OrderEntry")
 	proto.RegisterType((*Allocation)(nil), "seiprotocol.seichain.dex.Allocation")
@@ -147,32 +155,36 @@ func init() {
 func init() { proto.RegisterFile("dex/order_entry.proto", fileDescriptor_25878922effe12c2) }
 
 var fileDescriptor_25878922effe12c2 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0xb1, 0x6a, 0xe3, 0x40,
-	0x10, 0x95, 0x4e, 0xf6, 0xf9, 0x6e, 0x6d, 0x30, 0x88, 0x3b, 0x10, 0x29, 0x24, 0x23, 0x12, 0xe3,
-	0xc6, 0x12, 0x24, 0x5d, 0xba, 0x08, 0x07, 0x93, 0x22, 0x04, 0xd4, 0x04, 0x02, 0xc1, 0xc8, 0xab,
-	0xc5, 0x5e, 0x22, 0x69, 0x1d, 0xed, 0x1a, 0xac, 0xbf, 0x48, 0x7e, 0x2a, 0xb8, 0x74, 0x19, 0x52,
-	0x88, 0x60, 0x77, 0x2e, 0xfd, 0x05, 0x61, 0x47, 0x91, 0xad, 0x26, 0x45, 0x9a, 0x54, 0x9a, 0x79,
-	0x33, 0x6f, 0xde, 0xec, 0x3c, 0xa1, 0xff, 0x21, 0x59, 0xb8, 0x2c, 0x0d, 0x49, 0x3a, 0x22, 0x89,
-	0x48, 0x33, 0x67, 0x96, 0x32, 0xc1, 0x74, 0x83, 0x13, 0x0a, 0x11, 0x66, 0x91, 0xc3, 0x09, 0xc5,
-	0xd3, 0x80, 0x26, 0x4e, 0x48, 0x16, 0x47, 0xff, 0x26, 0x6c, 0xc2, 0xa0, 0xe4, 0xca, 0xa8, 0xe8,
-	0xb7, 0x9f, 0x35, 0x84, 0x6e, 0xe4, 0x94, 0x4b, 0x39, 0x44, 0xbf, 0x47, 0xf5, 0x59, 0x4a, 0x31,
-	0x31, 0xd4, 0x8e, 0xda, 0xfb, 0xeb, 0x0d, 0x97, 0xb9, 0xa5, 0xbc, 0xe5, 0x56, 0x77, 0x42, 0xc5,
-	0x74, 0x3e, 0x76, 0x30, 0x8b, 0x5d, 0xcc, 0x78, 0xcc, 0xf8, 0xe7, 0xa7, 0xcf, 0xc3, 0x07, 0x57,
-	0x64, 0x33, 0xc2, 0x9d, 0x01, 0xc1, 0xdb, 0xdc, 0x2a, 0xe8, 0xbb, 0xdc, 0x6a, 0x65, 0x41, 0x1c,
-	0x9d, 0xdb, 0x90, 0xda, 0x7e, 0x01, 0xeb, 0x14, 0xfd, 0x79, 0x9c, 0x07, 0x89, 0xa0, 0x22, 0x33,
-	0x7e, 0x81, 0xc2, 0xf5, 0xb7, 0x15, 0xf6, 0x13, 0x76, 0xb9, 0xd5, 0x2e, 0x44, 0x4a, 0xc4, 0xf6,
-	0xf7, 0x45, 0xfd, 0x16, 0x35, 0x83, 0x28, 0x62, 0x38, 0x10, 0x94, 0x25, 0xdc, 0xd0, 0x3a, 0x5a,
-	0xaf, 0x79, 0x7a, 0xec, 0x7c, 0x75, 0x1e, 0xe7, 0x62, 0xdf, 0xec, 0xb5, 0xb7, 0xb9, 0x55, 0x25,
-	0xfb, 0xd5, 0x44, 0x77, 0x11, 0x82, 0xc7, 0x0c, 0x48, 0xc2, 0x62, 0xa3, 0x06, 0xaf, 0x00, 0x06,
-	0xa0, 0xa3, 0x50, 0xc2, 0x7e, 0xa5, 0x45, 0x12, 0x02, 0xce, 0x89, 0x28, 0x08, 0xf5, 0x03, 0x01,
-	0xd0, 0x92, 0x70, 0x68, 0xb1, 0x5f, 0x54, 0x84, 0x0e, 0xeb, 0xe8, 0x5d, 0xd4, 0x00, 0x9f, 0xaf,
-	0x42, 0x70, 0xa5, 0xe6, 0xb5, 0xe4, 0x15, 0x0a, 0xeb, 0x69, 0xe8, 0x97, 0xc5, 0x9f, 0x3c, 0xee,
-	0x09, 0x6a, 0x04, 0x18, 0xb3, 0x79, 0x22, 0x0c, 0x0d, 0x94, 0x9a, 0xdb, 0xdc, 0x2a, 0x21, 0xbf,
-	0x0c, 0xbc, 0xe1, 0x72, 0x6d, 0xaa, 0xab, 0xb5, 0xa9, 0xbe, 0xaf, 0x4d, 0xf5, 0x69, 0x63, 0x2a,
-	0xab, 0x8d, 0xa9, 0xbc, 0x6e, 0x4c, 0xe5, 0xae, 0x5f, 0xd9, 0x88, 0x13, 0xda, 0x2f, 0x3d, 0x81,
-	0x04, 0x4c, 0x71, 0x17, 0xae, 0xfc, 0xc3, 0x61, 0xb9, 0xf1, 0x6f, 0xa8, 0x9f, 0x7d, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0x59, 0x65, 0xd6, 0x5e, 0xf5, 0x02, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x36, 0xad, 0xd5, 0x49, 0x31, 0xb0, 0x5a, 0x58, 0x7b, 0xd8, 0x2d, 0x8b, 0x96, 0x5e,
+	0xb2, 0x0b, 0xf5, 0xe6, 0xcd, 0xa5, 0x52, 0x3c, 0x88, 0xb2, 0x0a, 0x82, 0x20, 0x61, 0x3a, 0xf3,
+	0x4c, 0x07, 0x77, 0x67, 0xe2, 0xce, 0x44, 0x12, 0xff, 0x0a, 0xfd, 0x97, 0x3c, 0xf5, 0xd8, 0xa3,
+	0x78, 0x18, 0x24, 0xb9, 0xe5, 0xd8, 0xbf, 0x40, 0xe6, 0xad, 0x9b, 0x2c, 0x68, 0x0e, 0x5e, 0x7a,
+	0xca, 0xbc, 0xef, 0x7b, 0xdf, 0xfb, 0xf1, 0xbd, 0x2c, 0xd9, 0xe7, 0x30, 0x4d, 0x55, 0xc5, 0xa1,
+	0x1a, 0x82, 0x34, 0xd5, 0x2c, 0x19, 0x57, 0xca, 0x28, 0x3f, 0xd0, 0x20, 0xf0, 0xc5, 0x54, 0x91,
+	0x68, 0x10, 0xec, 0x82, 0x0a, 0x99, 0x70, 0x98, 0x1e, 0xdc, 0x1f, 0xa9, 0x91, 0x42, 0x2a, 0x75,
+	0xaf, 0x3a, 0xff, 0xa0, 0xef, 0xca, 0x80, 0x9c, 0x94, 0xba, 0x06, 0xe2, 0x6f, 0x5d, 0x42, 0x5e,
+	0xba, 0xb2, 0xcf, 0x5c, 0x55, 0xff, 0x3d, 0xd9, 0x19, 0x57, 0x82, 0x41, 0xe0, 0x1d, 0x7a, 0xc7,
+	0x77, 0xb2, 0xb3, 0x4b, 0x1b, 0x75, 0x7e, 0xda, 0xe8, 0x68, 0x24, 0xcc, 0xc5, 0xe4, 0x3c, 0x61,
+	0xaa, 0x4c, 0x99, 0xd2, 0xa5, 0xd2, 0x7f, 0x7e, 0x06, 0x9a, 0x7f, 0x4c, 0xcd, 0x6c, 0x0c, 0x3a,
+	0x39, 0x05, 0xb6, 0xb4, 0x51, 0x2d, 0xbf, 0xb6, 0xd1, 0xde, 0x8c, 0x96, 0xc5, 0x93, 0x18, 0xc3,
+	0x38, 0xaf, 0x61, 0x5f, 0x90, 0xdb, 0x9f, 0x26, 0x54, 0x1a, 0x61, 0x66, 0xc1, 0x16, 0x76, 0x78,
+	0xf1, 0xdf, 0x1d, 0x56, 0x15, 0xae, 0x6d, 0xd4, 0xaf, 0x9b, 0x34, 0x48, 0x9c, 0xaf, 0x48, 0xff,
+	0x2d, 0xe9, 0xd1, 0xa2, 0x50, 0x8c, 0x1a, 0xa1, 0xa4, 0x0e, 0xba, 0x87, 0xdd, 0xe3, 0xde, 0xc9,
+	0xc3, 0x64, 0x93, 0x5f, 0xc9, 0xd3, 0x55, 0x72, 0xd6, 0x5f, 0xda, 0xa8, 0x2d, 0xce, 0xdb, 0x81,
+	0x9f, 0x12, 0x82, 0xcb, 0x9c, 0x82, 0x54, 0x65, 0xb0, 0x8d, 0x5b, 0xa0, 0x02, 0xd1, 0x21, 0x77,
+	0x70, 0xde, 0x4a, 0x71, 0x02, 0xaa, 0x35, 0x98, 0x5a, 0xb0, 0xb3, 0x16, 0x20, 0xda, 0x08, 0xd6,
+	0x29, 0xf1, 0xf7, 0x2d, 0x42, 0xd6, 0xe3, 0xf8, 0x47, 0x64, 0x17, 0x0f, 0xff, 0x9c, 0xe3, 0x55,
+	0xb6, 0xb3, 0x3d, 0xe7, 0x42, 0xfd, 0x5f, 0x10, 0x3c, 0x6f, 0xc8, 0x9b, 0x34, 0xf7, 0x11, 0xd9,
+	0xa5, 0x8c, 0xa9, 0x89, 0x34, 0x41, 0x17, 0x3b, 0xf5, 0x96, 0x36, 0x6a, 0xa0, 0xbc, 0x79, 0xf8,
+	0x5f, 0xc8, 0x3d, 0x0d, 0xc5, 0x87, 0x37, 0x15, 0xe5, 0xf0, 0xaa, 0x82, 0xcf, 0x20, 0xdd, 0x42,
+	0xe8, 0xd9, 0xdd, 0x93, 0xc1, 0xe6, 0x5b, 0xbc, 0xfe, 0x5b, 0x94, 0x3d, 0x58, 0xda, 0x68, 0xdf,
+	0x55, 0x1b, 0x1a, 0xc7, 0x0c, 0xc7, 0x2b, 0x2a, 0xff, 0x57, 0x93, 0xec, 0xec, 0x72, 0x1e, 0x7a,
+	0x57, 0xf3, 0xd0, 0xfb, 0x35, 0x0f, 0xbd, 0xaf, 0x8b, 0xb0, 0x73, 0xb5, 0x08, 0x3b, 0x3f, 0x16,
+	0x61, 0xe7, 0xdd, 0xa0, 0xe5, 0x86, 0x06, 0x31, 0x68, 0x66, 0xc0, 0x00, 0x87, 0x48, 0xa7, 0xa9,
+	0xfb, 0x4e, 0xd0, 0x98, 0xf3, 0x5b, 0xc8, 0x3f, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x28, 0x73,
+	0xc3, 0xa5, 0x82, 0x03, 0x00, 0x00,
 }
 
 func (m *OrderEntry) Marshal() (dAtA []byte, err error) {
@@ -266,6 +278,11 @@ func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintOrderEntry(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Account) > 0 {
 		i -= len(m.Account)
 		copy(dAtA[i:], m.Account)
@@ -344,6 +361,9 @@ func (m *Allocation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrderEntry(uint64(l))
 	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovOrderEntry(uint64(m.SelfTradePrevention))
+	}
 	return n
 }
 
@@ -683,6 +703,25 @@ func (m *Allocation) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrderEntry(dAtA[iNdEx:])
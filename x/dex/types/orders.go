@@ -95,6 +95,84 @@ func (c *CachedSortedOrderBookEntries) SettleQuantity(_ sdk.Context, quantity sd
 	return res, settled
 }
 
+// Reduce quantity of the order book entry currently being pointed at by the specified quantity,
+// split among its allocations in proportion to their quantities. Shares below `minAllocation`
+// are dropped, and whatever the pro-rata pass leaves unallocated, including rounding remainders,
+// is allocated in FIFO order. Settlements are returned in the order of the allocations.
+func (c *CachedSortedOrderBookEntries) SettleQuantityProRata(_ sdk.Context, quantity sdk.Dec, minAllocation sdk.Dec) (res []ToSettle, settled sdk.Dec) {
+	if quantity.IsZero() {
+		return []ToSettle{}, quantity
+	}
+	currentEntry := c.CachedEntries[c.currentPtr].GetOrderEntry()
+	c.currentChanged = true
+
+	if quantity.GTE(currentEntry.Quantity) {
+		res = utils.Map(currentEntry.Allocations, AllocationToSettle)
+		settled = currentEntry.Quantity
+		currentEntry.Quantity = sdk.ZeroDec()
+		currentEntry.Allocations = []*Allocation{}
+		return res, settled
+	}
+
+	amounts := make([]sdk.Dec, len(currentEntry.Allocations))
+	settled = sdk.ZeroDec()
+	for idx, a := range currentEntry.Allocations {
+		share := sdk.MinDec(quantity.Mul(a.Quantity).QuoTruncate(currentEntry.Quantity), a.Quantity)
+		if share.LT(minAllocation) {
+			share = sdk.ZeroDec()
+		}
+		amounts[idx] = share
+		settled = settled.Add(share)
+	}
+	for idx, a := range currentEntry.Allocations {
+		if settled.GTE(quantity) {
+			break
+		}
+		extra := sdk.MinDec(a.Quantity.Sub(amounts[idx]), quantity.Sub(settled))
+		amounts[idx] = amounts[idx].Add(extra)
+		settled = settled.Add(extra)
+	}
+
+	allocations := []*Allocation{}
+	for idx, a := range currentEntry.Allocations {
+		if amounts[idx].IsPositive() {
+			res = append(res, ToSettle{
+				OrderID: a.OrderId,
+				Account: a.Account,
+				Amount:  amounts[idx],
+			})
+			a.Quantity = a.Quantity.Sub(amounts[idx])
+		}
+		if a.Quantity.IsPositive() {
+			allocations = append(allocations, a)
+		}
+	}
+	currentEntry.Quantity = currentEntry.Quantity.Sub(settled)
+	currentEntry.Allocations = allocations
+	return res, settled
+}
+
+// Reduce the allocation of the specified order in the order book entry currently being pointed
+// at, without settling it against anything. The allocation is removed once it has no quantity left.
+func (c *CachedSortedOrderBookEntries) ReduceAllocation(_ sdk.Context, orderID uint64, quantity sdk.Dec) {
+	currentEntry := c.CachedEntries[c.currentPtr].GetOrderEntry()
+	c.currentChanged = true
+
+	allocations := []*Allocation{}
+	for _, a := range currentEntry.Allocations {
+		if a.OrderId == orderID {
+			reduced := sdk.MinDec(quantity, a.Quantity)
+			a.Quantity = a.Quantity.Sub(reduced)
+			currentEntry.Quantity = currentEntry.Quantity.Sub(reduced)
+			if a.Quantity.IsZero() {
+				continue
+			}
+		}
+		allocations = append(allocations, a)
+	}
+	currentEntry.Allocations = allocations
+}
+
 // Discard all dirty changes and reload
 func (c *CachedSortedOrderBookEntries) Refresh(ctx sdk.Context) {
 	c.CachedEntries = c.loader(ctx, sdk.ZeroDec(), false)
@@ -140,6 +218,20 @@ func (c *CachedSortedOrderBookEntries) Next(ctx sdk.Context) OrderBookEntry {
 	return c.CachedEntries[c.currentPtr]
 }
 
+// Peek returns the entry `offset` positions past the current one, loading more entries if needed,
+// without moving the current pointer. Entries whose quantity has hit zero are not skipped.
+func (c *CachedSortedOrderBookEntries) Peek(ctx sdk.Context, offset int) OrderBookEntry {
+	for c.currentPtr+offset >= len(c.CachedEntries) {
+		cached := len(c.CachedEntries)
+		c.load(ctx)
+		// if nothing is loaded, we've reached the end
+		if len(c.CachedEntries) == cached {
+			return nil
+		}
+	}
+	return c.CachedEntries[c.currentPtr+offset]
+}
+
 type OrderBookEntry interface {
 	GetPrice() sdk.Dec
 	GetOrderEntry() *OrderEntry
@@ -173,9 +265,10 @@ func (m *LongBook) DeepCopy() OrderBookEntry {
 	allocations := []*Allocation{}
 	for _, allo := range m.Entry.Allocations {
 		allocations = append(allocations, &Allocation{
-			OrderId:  allo.OrderId,
-			Quantity: allo.Quantity,
-			Account:  allo.Account,
+			OrderId:             allo.OrderId,
+			Quantity:            allo.Quantity,
+			Account:             allo.Account,
+			SelfTradePrevention: allo.SelfTradePrevention,
 		})
 	}
 	newOrderEntry := OrderEntry{
@@ -211,9 +304,10 @@ func (m *ShortBook) DeepCopy() OrderBookEntry {
 	allocations := []*Allocation{}
 	for _, allo := range m.Entry.Allocations {
 		allocations = append(allocations, &Allocation{
-			OrderId:  allo.OrderId,
-			Quantity: allo.Quantity,
-			Account:  allo.Account,
+			OrderId:             allo.OrderId,
+			Quantity:            allo.Quantity,
+			Account:             allo.Account,
+			SelfTradePrevention: allo.SelfTradePrevention,
 		})
 	}
 	newOrderEntry := OrderEntry{
@@ -229,6 +323,17 @@ func (m *ShortBook) DeepCopy() OrderBookEntry {
 	}
 }
 
+// IsExpired returns true if a good-til-time order has reached its expiry height or timestamp
+func (m *Order) IsExpired(ctx sdk.Context) bool {
+	if m.TimeInForce != TimeInForce_GTT {
+		return false
+	}
+	if m.ExpiryHeight > 0 && uint64(ctx.BlockHeight()) >= m.ExpiryHeight {
+		return true
+	}
+	return m.ExpiryTimestamp > 0 && uint64(ctx.BlockTime().Unix()) >= m.ExpiryTimestamp
+}
+
 type ToSettle struct {
 	OrderID uint64
 	Amount  sdk.Dec
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/sei-protocol/sei-chain/testutil/keeper"
@@ -164,3 +165,89 @@ func TestRefreshAndFlush(t *testing.T) {
 	require.Equal(t, TestEntryOne.Price, entry.GetPrice())
 	require.Equal(t, TestEntryOne, *entry.GetOrderEntry())
 }
+
+func TestPeek(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	populateEntries(ctx, dexkeeper)
+	cache := getCachedSortedOrderBookEntries(dexkeeper)
+	// peeking loads entries without moving on from the current one
+	require.Equal(t, TestEntryTwo.Price, cache.Peek(ctx, 0).GetPrice())
+	require.Equal(t, TestEntryOne.Price, cache.Peek(ctx, 1).GetPrice())
+	require.Nil(t, cache.Peek(ctx, 2))
+	entry := cache.Next(ctx)
+	require.Equal(t, TestEntryTwo.Price, entry.GetPrice())
+
+	// a fully settled current entry is still returned until Next moves on from it
+	_, _ = cache.SettleQuantity(ctx, TestEntryTwo.Quantity)
+	require.True(t, cache.Peek(ctx, 0).GetOrderEntry().Quantity.IsZero())
+	entry = cache.Next(ctx)
+	require.Equal(t, TestEntryOne.Price, entry.GetPrice())
+	require.Equal(t, TestEntryOne.Price, cache.Peek(ctx, 0).GetPrice())
+	require.Nil(t, cache.Peek(ctx, 1))
+}
+
+func TestSettleQuantityProRata(t *testing.T) {
+	dexkeeper, ctx := keepertest.DexKeeper(t)
+	dexkeeper.SetLongBook(ctx, keepertest.TestContract, types.LongBook{
+		Price: sdk.NewDec(10),
+		Entry: &types.OrderEntry{
+			Price:      sdk.NewDec(10),
+			Quantity:   sdk.NewDec(10),
+			PriceDenom: keepertest.TestPriceDenom,
+			AssetDenom: keepertest.TestAssetDenom,
+			Allocations: []*types.Allocation{
+				{OrderId: 1, Quantity: sdk.NewDec(6), Account: "abc"},
+				{OrderId: 2, Quantity: sdk.NewDec(3), Account: "def"},
+				{OrderId: 3, Quantity: sdk.NewDec(1), Account: "ghi"},
+			},
+		},
+	})
+	cache := getCachedSortedOrderBookEntries(dexkeeper)
+	entry := cache.Next(ctx)
+	require.NotNil(t, entry)
+
+	// without a minimum, every order gets its proportional share
+	res, settled := cache.SettleQuantityProRata(ctx, sdk.NewDec(5), sdk.ZeroDec())
+	require.Equal(t, sdk.NewDec(5), settled)
+	require.Equal(t, []types.ToSettle{
+		{OrderID: 1, Account: "abc", Amount: sdk.NewDec(3)},
+		{OrderID: 2, Account: "def", Amount: sdk.MustNewDecFromStr("1.5")},
+		{OrderID: 3, Account: "ghi", Amount: sdk.MustNewDecFromStr("0.5")},
+	}, res)
+	require.Equal(t, sdk.NewDec(5), entry.GetOrderEntry().Quantity)
+
+	// shares below the minimum are allocated in FIFO order instead
+	res, settled = cache.SettleQuantityProRata(ctx, sdk.NewDec(2), sdk.OneDec())
+	require.Equal(t, sdk.NewDec(2), settled)
+	require.Equal(t, []types.ToSettle{
+		{OrderID: 1, Account: "abc", Amount: sdk.NewDec(2)},
+	}, res)
+	require.Equal(t, sdk.NewDec(3), entry.GetOrderEntry().Quantity)
+	require.Equal(t, 3, len(entry.GetOrderEntry().Allocations))
+	require.Equal(t, sdk.OneDec(), entry.GetOrderEntry().Allocations[0].Quantity)
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), entry.GetOrderEntry().Allocations[1].Quantity)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), entry.GetOrderEntry().Allocations[2].Quantity)
+
+	// settling the whole entry removes all allocations
+	res, settled = cache.SettleQuantityProRata(ctx, sdk.NewDec(4), sdk.ZeroDec())
+	require.Equal(t, sdk.NewDec(3), settled)
+	require.Equal(t, 3, len(res))
+	require.True(t, entry.GetOrderEntry().Quantity.IsZero())
+	require.Empty(t, entry.GetOrderEntry().Allocations)
+}
+
+func TestOrderIsExpired(t *testing.T) {
+	_, ctx := keepertest.DexKeeper(t)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+	order := types.Order{TimeInForce: types.TimeInForce_GTT, ExpiryHeight: 11}
+	require.False(t, order.IsExpired(ctx))
+	order.ExpiryHeight = 10
+	require.True(t, order.IsExpired(ctx))
+	order = types.Order{TimeInForce: types.TimeInForce_GTT, ExpiryTimestamp: 1001}
+	require.False(t, order.IsExpired(ctx))
+	order.ExpiryTimestamp = 1000
+	require.True(t, order.IsExpired(ctx))
+	// only good-til-time orders expire
+	order.TimeInForce = types.TimeInForce_GTC
+	require.False(t, order.IsExpired(ctx))
+}
@@ -29,6 +29,14 @@ type Pair struct {
 	AssetDenom       string                                  `protobuf:"bytes,2,opt,name=assetDenom,proto3" json:"asset_denom"`
 	PriceTicksize    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=priceTicksize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_tick_size"`
 	QuantityTicksize *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=quantityTicksize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity_tick_size"`
+	// fee rates are charged on the settled notional and can only be set through governance
+	MakerFeeRate        *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=makerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_rate"`
+	TakerFeeRate        *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=takerFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_rate"`
+	SelfTradePrevention SelfTradePrevention                     `protobuf:"varint,7,opt,name=selfTradePrevention,proto3,enum=seiprotocol.seichain.dex.SelfTradePrevention" json:"self_trade_prevention"`
+	MatchingAlgorithm   MatchingAlgorithm                       `protobuf:"varint,8,opt,name=matchingAlgorithm,proto3,enum=seiprotocol.seichain.dex.MatchingAlgorithm" json:"matching_algorithm"`
+	// pro-rata shares below this quantity aren't allocated and are filled in FIFO order instead
+	ProRataMinAllocation *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=proRataMinAllocation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pro_rata_min_allocation"`
+	ClearingMode         ClearingMode                            `protobuf:"varint,10,opt,name=clearingMode,proto3,enum=seiprotocol.seichain.dex.ClearingMode" json:"clearing_mode"`
 }
 
 func (m *Pair) Reset()         { *m = Pair{} }
@@ -78,6 +86,27 @@ func (m *Pair) GetAssetDenom() string {
 	return ""
 }
 
+func (m *Pair) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_NO_PREVENTION
+}
+
+func (m *Pair) GetMatchingAlgorithm() MatchingAlgorithm {
+	if m != nil {
+		return m.MatchingAlgorithm
+	}
+	return MatchingAlgorithm_FIFO
+}
+
+func (m *Pair) GetClearingMode() ClearingMode {
+	if m != nil {
+		return m.ClearingMode
+	}
+	return ClearingMode_CONTINUOUS
+}
+
 type BatchContractPair struct {
 	ContractAddr string  `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_addr"`
 	Pairs        []*Pair `protobuf:"bytes,2,rep,name=pairs,proto3" json:"pairs"`
@@ -138,30 +167,44 @@ func init() {
 func init() { proto.RegisterFile("dex/pair.proto", fileDescriptor_d4350ebee878f69a) }
 
 var fileDescriptor_d4350ebee878f69a = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x4f, 0xf2, 0x40,
-	0x18, 0xa7, 0xc0, 0xfb, 0x26, 0x1c, 0x22, 0xd2, 0x38, 0x34, 0x0e, 0x2d, 0x61, 0x30, 0x2c, 0xf4,
-	0x12, 0x8d, 0xb3, 0xa1, 0x92, 0xb8, 0x9a, 0xc6, 0xc9, 0x85, 0x1c, 0x77, 0x97, 0x72, 0x41, 0x7a,
-	0xf5, 0xee, 0x48, 0xc0, 0xaf, 0xe0, 0xe2, 0x77, 0x72, 0x61, 0x64, 0x34, 0x0e, 0x8d, 0x81, 0xad,
-	0x9f, 0xc2, 0xdc, 0x95, 0x0a, 0xc4, 0x38, 0x38, 0xdd, 0x3d, 0xbf, 0xe7, 0xf7, 0xa7, 0x7d, 0x9e,
-	0x03, 0xc7, 0x84, 0xce, 0x61, 0x82, 0x98, 0xf0, 0x13, 0xc1, 0x15, 0xb7, 0x1d, 0x49, 0x99, 0xb9,
-	0x61, 0xfe, 0xe8, 0x4b, 0xca, 0xf0, 0x18, 0xb1, 0xd8, 0x27, 0x74, 0x7e, 0x76, 0x1a, 0xf1, 0x88,
-	0x9b, 0x16, 0xd4, 0xb7, 0x9c, 0xdf, 0x79, 0x2b, 0x83, 0xea, 0x1d, 0x62, 0xc2, 0x86, 0x00, 0x24,
-	0x82, 0x61, 0x3a, 0xa0, 0x31, 0x9f, 0x3a, 0x56, 0xdb, 0xea, 0xd6, 0x82, 0x66, 0x96, 0x7a, 0x75,
-	0x83, 0x0e, 0x89, 0x86, 0xc3, 0x3d, 0x8a, 0x16, 0x20, 0x29, 0xa9, 0xca, 0x05, 0xe5, 0x9d, 0xc0,
-	0xa0, 0x85, 0x60, 0x47, 0xb1, 0x23, 0xd0, 0x30, 0xf2, 0x7b, 0x86, 0x27, 0x92, 0x3d, 0x53, 0xa7,
-	0x62, 0x34, 0xfd, 0x65, 0xea, 0x59, 0x1f, 0xa9, 0x77, 0x1e, 0x31, 0x35, 0x9e, 0x8d, 0x7c, 0xcc,
-	0xa7, 0x10, 0x73, 0x39, 0xe5, 0x72, 0x7b, 0xf4, 0x24, 0x99, 0x40, 0xb5, 0x48, 0xa8, 0xf4, 0x07,
-	0x14, 0x67, 0xa9, 0xd7, 0xcc, 0x3f, 0x49, 0x31, 0x3c, 0x19, 0x6a, 0xa3, 0xf0, 0xd0, 0xd7, 0x4e,
-	0xc0, 0xc9, 0xd3, 0x0c, 0xc5, 0x8a, 0xa9, 0xc5, 0x77, 0x56, 0xd5, 0x64, 0x0d, 0xfe, 0x9c, 0x65,
-	0x17, 0x4e, 0x7b, 0x71, 0x3f, 0xdc, 0x3b, 0x2f, 0x16, 0x68, 0x05, 0x48, 0xe1, 0xf1, 0x0d, 0x8f,
-	0x95, 0x40, 0x58, 0x99, 0x91, 0x5e, 0x81, 0x23, 0xbc, 0xad, 0xfb, 0x84, 0x88, 0xed, 0x50, 0x5b,
-	0x59, 0xea, 0x35, 0x0a, 0x7c, 0x88, 0x08, 0x11, 0xe1, 0x01, 0xcd, 0xbe, 0x06, 0xff, 0xf4, 0x42,
-	0xa5, 0x53, 0x6e, 0x57, 0xba, 0xf5, 0x0b, 0xd7, 0xff, 0x6d, 0xa5, 0xbe, 0x4e, 0x09, 0x6a, 0x59,
-	0xea, 0xe5, 0x82, 0x30, 0x3f, 0x82, 0xdb, 0xe5, 0xda, 0xb5, 0x56, 0x6b, 0xd7, 0xfa, 0x5c, 0xbb,
-	0xd6, 0xeb, 0xc6, 0x2d, 0xad, 0x36, 0x6e, 0xe9, 0x7d, 0xe3, 0x96, 0x1e, 0x7a, 0x7b, 0xff, 0x2d,
-	0x29, 0xeb, 0x15, 0xb6, 0xa6, 0x30, 0xbe, 0x70, 0x0e, 0xf5, 0x8b, 0x32, 0x23, 0x18, 0xfd, 0x37,
-	0xfd, 0xcb, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x03, 0x32, 0x50, 0x65, 0x02, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x4e, 0xdc, 0x30,
+	0x10, 0xc7, 0x09, 0x9f, 0xc5, 0x7c, 0x95, 0x94, 0xb6, 0x29, 0x87, 0x04, 0x71, 0x40, 0x48, 0xd5,
+	0x26, 0x12, 0x55, 0xcf, 0xed, 0x06, 0xd4, 0xaa, 0x07, 0x24, 0x94, 0x72, 0xaa, 0x54, 0x45, 0xc6,
+	0x1e, 0xb2, 0xd6, 0x26, 0x71, 0xb0, 0x4d, 0x05, 0x48, 0x7d, 0x82, 0x5e, 0xfa, 0x58, 0x1c, 0x39,
+	0x56, 0x55, 0x15, 0x55, 0x70, 0xdb, 0xa7, 0xa8, 0xec, 0x6c, 0x96, 0x2c, 0xcb, 0x1e, 0xb6, 0xa7,
+	0xd8, 0x33, 0xf3, 0xff, 0xff, 0x3c, 0xb3, 0x6b, 0xa3, 0x55, 0x0a, 0x17, 0x41, 0x81, 0x99, 0xf0,
+	0x0b, 0xc1, 0x15, 0xb7, 0x1d, 0x09, 0xcc, 0xac, 0x08, 0x4f, 0x7d, 0x09, 0x8c, 0x74, 0x30, 0xcb,
+	0x7d, 0x0a, 0x17, 0x9b, 0x1b, 0x09, 0x4f, 0xb8, 0x49, 0x05, 0x7a, 0x55, 0xd5, 0x6f, 0xae, 0x69,
+	0x3d, 0xe4, 0xe7, 0x99, 0xac, 0x02, 0xdb, 0x7f, 0x16, 0xd0, 0xec, 0x11, 0x66, 0xc2, 0x0e, 0x10,
+	0x2a, 0x04, 0x23, 0x70, 0x00, 0x39, 0xcf, 0x1c, 0x6b, 0xcb, 0xda, 0x5d, 0x0c, 0xd7, 0x7a, 0xa5,
+	0xb7, 0x64, 0xa2, 0x31, 0xd5, 0xe1, 0xa8, 0x51, 0xa2, 0x05, 0x58, 0x4a, 0x50, 0x95, 0x60, 0xfa,
+	0x5e, 0x60, 0xa2, 0xb5, 0xe0, 0xbe, 0xc4, 0x4e, 0xd0, 0x8a, 0x91, 0x1f, 0x33, 0xd2, 0x95, 0xec,
+	0x0a, 0x9c, 0x19, 0xa3, 0x69, 0x5f, 0x97, 0x9e, 0xf5, 0xbb, 0xf4, 0x76, 0x12, 0xa6, 0x3a, 0xe7,
+	0x27, 0x3e, 0xe1, 0x59, 0x40, 0xb8, 0xcc, 0xb8, 0xec, 0x7f, 0x5a, 0x92, 0x76, 0x03, 0x75, 0x59,
+	0x80, 0xf4, 0x0f, 0x80, 0xf4, 0x4a, 0x6f, 0xad, 0x3a, 0x92, 0x62, 0xa4, 0x1b, 0x6b, 0xa3, 0x68,
+	0xd8, 0xd7, 0x2e, 0xd0, 0xd3, 0xb3, 0x73, 0x9c, 0x2b, 0xa6, 0x2e, 0x07, 0xac, 0x59, 0xc3, 0x3a,
+	0x98, 0x98, 0x65, 0xd7, 0x4e, 0x0d, 0xdc, 0x88, 0xbb, 0x4d, 0xd1, 0x72, 0x86, 0xbb, 0x20, 0x3e,
+	0x00, 0x44, 0x58, 0x81, 0x33, 0x67, 0x68, 0xef, 0x27, 0xa6, 0xad, 0x1a, 0x97, 0xf8, 0x14, 0x20,
+	0x16, 0x58, 0x41, 0x34, 0xe4, 0xaa, 0x29, 0xaa, 0x49, 0x99, 0xff, 0x5f, 0x8a, 0x7a, 0x40, 0x69,
+	0xba, 0xda, 0x57, 0xe8, 0x99, 0x84, 0xf4, 0xf4, 0x58, 0x60, 0x0a, 0x47, 0x02, 0xbe, 0x41, 0xae,
+	0x18, 0xcf, 0x9d, 0x85, 0x2d, 0x6b, 0x77, 0x75, 0xaf, 0xe5, 0x8f, 0xfb, 0xc3, 0xf9, 0x9f, 0x47,
+	0x45, 0xe1, 0xab, 0x5e, 0xe9, 0x3d, 0xd7, 0x6e, 0xb1, 0xd2, 0x99, 0xb8, 0x18, 0xa4, 0xa2, 0xc7,
+	0x20, 0xf6, 0x19, 0x5a, 0xcf, 0xb0, 0x22, 0x1d, 0x96, 0x27, 0xed, 0x34, 0xe1, 0x82, 0xa9, 0x4e,
+	0xe6, 0x3c, 0x31, 0xe4, 0xd7, 0xe3, 0xc9, 0x87, 0x0f, 0x25, 0xe1, 0x0b, 0xfd, 0xcb, 0xd5, 0x4e,
+	0x31, 0xae, 0xe3, 0xd1, 0xa8, 0xbb, 0xfd, 0x1d, 0x6d, 0x14, 0x82, 0x47, 0x58, 0xe1, 0x43, 0x96,
+	0xb7, 0xd3, 0x94, 0x13, 0x6c, 0xfa, 0x5d, 0x34, 0xc3, 0xfd, 0x34, 0xf1, 0x70, 0x5f, 0x16, 0x82,
+	0xeb, 0xb1, 0xe2, 0x38, 0x63, 0x79, 0x8c, 0x07, 0x86, 0xd1, 0xa3, 0x18, 0xfb, 0x2b, 0x5a, 0x26,
+	0x29, 0x60, 0xc1, 0xf2, 0xe4, 0x90, 0x53, 0x70, 0x90, 0x69, 0x76, 0x67, 0x7c, 0xb3, 0xfb, 0x8d,
+	0xea, 0x70, 0xbd, 0x57, 0x7a, 0x2b, 0xb5, 0x3e, 0xce, 0x38, 0x85, 0x68, 0xc8, 0x6e, 0xfb, 0x87,
+	0x85, 0xd6, 0x43, 0xdd, 0xf3, 0x3e, 0xcf, 0x95, 0xc0, 0x44, 0x99, 0xbb, 0xfe, 0x16, 0x2d, 0x93,
+	0xfe, 0xbe, 0x4d, 0xa9, 0xe8, 0xdf, 0xf6, 0xca, 0xac, 0x1f, 0x8f, 0x31, 0xa5, 0x22, 0x1a, 0x2a,
+	0xb3, 0xdf, 0xa1, 0x39, 0xfd, 0xf4, 0x48, 0x67, 0x7a, 0x6b, 0x66, 0x77, 0x69, 0xcf, 0x1d, 0x7f,
+	0x48, 0x4d, 0x09, 0x17, 0x7b, 0xa5, 0x57, 0x09, 0xa2, 0xea, 0x13, 0x7e, 0xbc, 0xbe, 0x75, 0xad,
+	0x9b, 0x5b, 0xd7, 0xfa, 0x7b, 0xeb, 0x5a, 0x3f, 0xef, 0xdc, 0xa9, 0x9b, 0x3b, 0x77, 0xea, 0xd7,
+	0x9d, 0x3b, 0xf5, 0xa5, 0xd5, 0x98, 0xaf, 0x04, 0xd6, 0xaa, 0x6d, 0xcd, 0xc6, 0xf8, 0x06, 0x17,
+	0x81, 0x7e, 0xbb, 0xcc, 0xa8, 0x4f, 0xe6, 0x4d, 0xfe, 0xcd, 0xbf, 0x01, 0x00, 0x85, 0x69, 0x19,
+	0x2f, 0x0f, 0x05, 0x00, 0x00,
 }
 
 func (m *Pair) Marshal() (dAtA []byte, err error) {
@@ -184,6 +227,57 @@ func (m *Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClearingMode != 0 {
+		i = encodeVarintPair(dAtA, i, uint64(m.ClearingMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ProRataMinAllocation != nil {
+		{
+			size := m.ProRataMinAllocation.Size()
+			i -= size
+			if _, err := m.ProRataMinAllocation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MatchingAlgorithm != 0 {
+		i = encodeVarintPair(dAtA, i, uint64(m.MatchingAlgorithm))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintPair(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TakerFeeRate != nil {
+		{
+			size := m.TakerFeeRate.Size()
+			i -= size
+			if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MakerFeeRate != nil {
+		{
+			size := m.MakerFeeRate.Size()
+			i -= size
+			if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPair(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.QuantityTicksize != nil {
 		{
 			size := m.QuantityTicksize.Size()
@@ -302,6 +396,27 @@ func (m *Pair) Size() (n int) {
 		l = m.QuantityTicksize.Size()
 		n += 1 + l + sovPair(uint64(l))
 	}
+	if m.MakerFeeRate != nil {
+		l = m.MakerFeeRate.Size()
+		n += 1 + l + sovPair(uint64(l))
+	}
+	if m.TakerFeeRate != nil {
+		l = m.TakerFeeRate.Size()
+		n += 1 + l + sovPair(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovPair(uint64(m.SelfTradePrevention))
+	}
+	if m.MatchingAlgorithm != 0 {
+		n += 1 + sovPair(uint64(m.MatchingAlgorithm))
+	}
+	if m.ProRataMinAllocation != nil {
+		l = m.ProRataMinAllocation.Size()
+		n += 1 + l + sovPair(uint64(l))
+	}
+	if m.ClearingMode != 0 {
+		n += 1 + sovPair(uint64(m.ClearingMode))
+	}
 	return n
 }
 
@@ -495,6 +610,171 @@ func (m *Pair) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MakerFeeRate = &v
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TakerFeeRate = &v
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchingAlgorithm", wireType)
+			}
+			m.MatchingAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchingAlgorithm |= MatchingAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProRataMinAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPair
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPair
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ProRataMinAllocation = &v
+			if err := m.ProRataMinAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingMode", wireType)
+			}
+			m.ClearingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPair
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClearingMode |= ClearingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPair(dAtA[iNdEx:])
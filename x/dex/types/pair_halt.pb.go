@@ -0,0 +1,455 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/pair_halt.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// trading status of a pair. The pair is halted if any of the reasons below applies
+type PairHalt struct {
+	Pair *Pair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// set through an UpdatePairHaltsProposal
+	HaltedByGovernance bool `protobuf:"varint,2,opt,name=haltedByGovernance,proto3" json:"halted_by_governance"`
+	// set by the contract's creator through MsgSetPairHalt
+	HaltedByCreator bool `protobuf:"varint,3,opt,name=haltedByCreator,proto3" json:"halted_by_creator"`
+	// the circuit breaker pauses matching until this height, exclusive
+	CircuitBreakerUntilHeight uint64 `protobuf:"varint,4,opt,name=circuitBreakerUntilHeight,proto3" json:"circuit_breaker_until_height"`
+}
+
+func (m *PairHalt) Reset()         { *m = PairHalt{} }
+func (m *PairHalt) String() string { return proto.CompactTextString(m) }
+func (*PairHalt) ProtoMessage()    {}
+func (*PairHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_862b33a693eac5fb, []int{0}
+}
+func (m *PairHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairHalt.Merge(m, src)
+}
+func (m *PairHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairHalt proto.InternalMessageInfo
+
+func (m *PairHalt) GetPair() *Pair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *PairHalt) GetHaltedByGovernance() bool {
+	if m != nil {
+		return m.HaltedByGovernance
+	}
+	return false
+}
+
+func (m *PairHalt) GetHaltedByCreator() bool {
+	if m != nil {
+		return m.HaltedByCreator
+	}
+	return false
+}
+
+func (m *PairHalt) GetCircuitBreakerUntilHeight() uint64 {
+	if m != nil {
+		return m.CircuitBreakerUntilHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PairHalt)(nil), "seiprotocol.seichain.dex.PairHalt")
+}
+
+func init() { proto.RegisterFile("dex/pair_halt.proto", fileDescriptor_862b33a693eac5fb) }
+
+var fileDescriptor_862b33a693eac5fb = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4b, 0xc3, 0x30,
+	0x14, 0xc7, 0x97, 0x39, 0x64, 0x54, 0x50, 0xac, 0x13, 0xea, 0x90, 0xb4, 0x78, 0xda, 0x65, 0x2d,
+	0xe8, 0x55, 0x10, 0xe2, 0x61, 0x3b, 0x4a, 0xc1, 0x8b, 0x07, 0x43, 0x9a, 0x3d, 0xda, 0x60, 0x6d,
+	0x46, 0x9a, 0xc9, 0xf6, 0x2d, 0xfc, 0x2e, 0x7e, 0x09, 0x8f, 0x3b, 0x7a, 0x2a, 0xb2, 0xdd, 0xfa,
+	0x29, 0x24, 0xa9, 0x55, 0x10, 0x77, 0x09, 0xef, 0xe5, 0xf7, 0x7b, 0x7f, 0xc2, 0x8b, 0x73, 0x32,
+	0x83, 0x65, 0x34, 0x67, 0x42, 0xd1, 0x8c, 0xe5, 0x3a, 0x9c, 0x2b, 0xa9, 0xa5, 0xeb, 0x95, 0x20,
+	0x6c, 0xc5, 0x65, 0x1e, 0x96, 0x20, 0x78, 0xc6, 0x44, 0x11, 0xce, 0x60, 0x39, 0x1c, 0xa4, 0x32,
+	0x95, 0x16, 0x45, 0xa6, 0x6a, 0xfc, 0xe1, 0x61, 0x1b, 0xd2, 0xf4, 0x17, 0x6f, 0x5d, 0xa7, 0x7f,
+	0xc7, 0x84, 0x9a, 0xb2, 0x5c, 0xbb, 0xd7, 0x4e, 0xcf, 0x20, 0x0f, 0x05, 0x68, 0x74, 0x70, 0x89,
+	0xc3, 0x5d, 0xd9, 0xa1, 0x99, 0x20, 0xfd, 0xba, 0xf2, 0xad, 0x1f, 0xdb, 0xd3, 0x9d, 0x3a, 0xae,
+	0x79, 0x18, 0xcc, 0xc8, 0x6a, 0x22, 0x5f, 0x40, 0x15, 0xac, 0xe0, 0xe0, 0x75, 0x03, 0x34, 0xea,
+	0x13, 0xaf, 0xae, 0xfc, 0x41, 0x43, 0x69, 0xb2, 0xa2, 0xe9, 0x0f, 0x8f, 0xff, 0x99, 0x71, 0x6f,
+	0x9c, 0xa3, 0xf6, 0xf6, 0x56, 0x01, 0xd3, 0x52, 0x79, 0x7b, 0x36, 0xe6, 0xb4, 0xae, 0xfc, 0xe3,
+	0xdf, 0x18, 0xde, 0xc0, 0xf8, 0xaf, 0xed, 0x3e, 0x3a, 0x67, 0x5c, 0x28, 0xbe, 0x10, 0x9a, 0x28,
+	0x60, 0x4f, 0xa0, 0xee, 0x0b, 0x2d, 0xf2, 0x29, 0x88, 0x34, 0xd3, 0x5e, 0x2f, 0x40, 0xa3, 0x1e,
+	0x09, 0xea, 0xca, 0x3f, 0xff, 0x96, 0x68, 0xd2, 0x58, 0x74, 0x61, 0x34, 0x9a, 0x59, 0x2f, 0xde,
+	0x1d, 0x41, 0x26, 0xef, 0x1b, 0x8c, 0xd6, 0x1b, 0x8c, 0x3e, 0x37, 0x18, 0xbd, 0x6e, 0x71, 0x67,
+	0xbd, 0xc5, 0x9d, 0x8f, 0x2d, 0xee, 0x3c, 0x8c, 0x53, 0xa1, 0xb3, 0x45, 0x12, 0x72, 0xf9, 0x1c,
+	0x95, 0x20, 0xc6, 0xed, 0xfe, 0x6c, 0x63, 0x17, 0x18, 0x2d, 0x23, 0xf3, 0x07, 0x7a, 0x35, 0x87,
+	0x32, 0xd9, 0xb7, 0xfc, 0xea, 0x6b, 0x00, 0xa6, 0x5a, 0xa9, 0xe3, 0xdc, 0x01, 0x00, 0x00,
+}
+
+func (m *PairHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CircuitBreakerUntilHeight != 0 {
+		i = encodeVarintPairHalt(dAtA, i, uint64(m.CircuitBreakerUntilHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HaltedByCreator {
+		i--
+		if m.HaltedByCreator {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HaltedByGovernance {
+		i--
+		if m.HaltedByGovernance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pair != nil {
+		{
+			size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPairHalt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPairHalt(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPairHalt(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PairHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pair != nil {
+		l = m.Pair.Size()
+		n += 1 + l + sovPairHalt(uint64(l))
+	}
+	if m.HaltedByGovernance {
+		n += 2
+	}
+	if m.HaltedByCreator {
+		n += 2
+	}
+	if m.CircuitBreakerUntilHeight != 0 {
+		n += 1 + sovPairHalt(uint64(m.CircuitBreakerUntilHeight))
+	}
+	return n
+}
+
+func sovPairHalt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPairHalt(x uint64) (n int) {
+	return sovPairHalt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PairHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPairHalt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairHalt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPairHalt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPairHalt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pair == nil {
+				m.Pair = &Pair{}
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedByGovernance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairHalt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HaltedByGovernance = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedByCreator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairHalt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HaltedByCreator = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerUntilHeight", wireType)
+			}
+			m.CircuitBreakerUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPairHalt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerUntilHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPairHalt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPairHalt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPairHalt(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPairHalt
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPairHalt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPairHalt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPairHalt
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPairHalt
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPairHalt
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPairHalt        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPairHalt          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPairHalt = fmt.Errorf("proto: unexpected end of group")
+)
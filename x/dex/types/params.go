@@ -23,6 +23,14 @@ var (
 	KeyMaxOrderPerPrice           = []byte("KeyMaxOrderPerPrice")
 	KeyMaxPairsPerContract        = []byte("KeyMaxPairsPerContract")
 	KeyDefaultGasPerOrderDataByte = []byte("KeyDefaultGasPerOrderDataByte")
+	KeyCandleRetention            = []byte("KeyCandleRetention")           // number of candles to retain per pair and interval, or 0 to not record candles
+	KeyAccountFillRetention       = []byte("KeyAccountFillRetention")      // number of blocks to index fills by account for, or 0 to not record fills
+	KeyRentLowWaterMark           = []byte("KeyRentLowWaterMark")          // rent balance under which a low_rent event is emitted, or 0 to not emit it
+	KeyRentHistoryRetention       = []byte("KeyRentHistoryRetention")      // number of blocks to keep rent charges for, or 0 to not record them
+	KeyCircuitBreakerThreshold    = []byte("KeyCircuitBreakerThreshold")   // relative clearing price move that pauses matching, or 0 to disable the circuit breaker
+	KeyCircuitBreakerPauseBlocks  = []byte("KeyCircuitBreakerPauseBlocks") // number of blocks matching is paused for by the circuit breaker
+	KeyPriceBand                  = []byte("KeyPriceBand")                 // relative distance from the reference price limit orders can be placed at, or 0 to disable price bands
+	KeyExecutionStatsRetention    = []byte("KeyExecutionStatsRetention")   // number of blocks to keep per-contract execution stats for, or 0 to not record them
 )
 
 const (
@@ -39,10 +47,22 @@ const (
 	DefaultMaxOrderPerPrice           = 10000
 	DefaultMaxPairsPerContract        = 100
 	DefaultDefaultGasPerOrderDataByte = 30
+	DefaultCandleRetention            = 1440    // a day of 1m candles
+	DefaultAccountFillRetention       = 0       // the fill history is opt-in
+	DefaultRentLowWaterMark           = 1000000 // 1 sei
+	DefaultRentHistoryRetention       = 1000
+	DefaultCircuitBreakerPauseBlocks  = 10
+	DefaultExecutionStatsRetention    = 1000
 )
 
 var DefaultSudoCallGasPrice = sdk.NewDecWithPrec(1, 1) // 0.1
 
+// the circuit breaker and price bands are opt-in
+var (
+	DefaultCircuitBreakerThreshold = sdk.ZeroDec()
+	DefaultPriceBand               = sdk.ZeroDec()
+)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -67,6 +87,14 @@ func DefaultParams() Params {
 		MaxOrderPerPrice:           DefaultMaxOrderPerPrice,
 		MaxPairsPerContract:        DefaultMaxPairsPerContract,
 		DefaultGasPerOrderDataByte: DefaultDefaultGasPerOrderDataByte,
+		CandleRetention:            DefaultCandleRetention,
+		AccountFillRetention:       DefaultAccountFillRetention,
+		RentLowWaterMark:           DefaultRentLowWaterMark,
+		RentHistoryRetention:       DefaultRentHistoryRetention,
+		CircuitBreakerThreshold:    DefaultCircuitBreakerThreshold,
+		CircuitBreakerPauseBlocks:  DefaultCircuitBreakerPauseBlocks,
+		PriceBand:                  DefaultPriceBand,
+		ExecutionStatsRetention:    DefaultExecutionStatsRetention,
 	}
 }
 
@@ -87,6 +115,14 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxOrderPerPrice, &p.MaxOrderPerPrice, validateUint64Param),
 		paramtypes.NewParamSetPair(KeyMaxPairsPerContract, &p.MaxPairsPerContract, validateUint64Param),
 		paramtypes.NewParamSetPair(KeyDefaultGasPerOrderDataByte, &p.DefaultGasPerOrderDataByte, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyCandleRetention, &p.CandleRetention, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyAccountFillRetention, &p.AccountFillRetention, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyRentLowWaterMark, &p.RentLowWaterMark, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyRentHistoryRetention, &p.RentHistoryRetention, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerThreshold, &p.CircuitBreakerThreshold, validateNonNegativeDecParam),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerPauseBlocks, &p.CircuitBreakerPauseBlocks, validateUint64Param),
+		paramtypes.NewParamSetPair(KeyPriceBand, &p.PriceBand, validateNonNegativeDecParam),
+		paramtypes.NewParamSetPair(KeyExecutionStatsRetention, &p.ExecutionStatsRetention, validateUint64Param),
 	}
 }
 
@@ -98,6 +134,12 @@ func (p Params) Validate() error {
 	if err := validateSudoCallGasPrice(p.SudoCallGasPrice); err != nil {
 		return err
 	}
+	if err := validateNonNegativeDecParam(p.CircuitBreakerThreshold); err != nil {
+		return err
+	}
+	if err := validateNonNegativeDecParam(p.PriceBand); err != nil {
+		return err
+	}
 	// it's not possible for other params to fail validation if they've already
 	// made it into Params' fields.
 	return nil
@@ -141,3 +183,15 @@ func validateUint64Param(i interface{}) error {
 
 	return nil
 }
+
+// unset values are treated as 0
+func validateNonNegativeDecParam(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("negative parameter value: %s", v)
+	}
+	return nil
+}
@@ -40,6 +40,23 @@ type Params struct {
 	MaxOrderPerPrice           uint64                                 `protobuf:"varint,12,opt,name=max_order_per_price,json=maxOrderPerPrice,proto3" json:"max_order_per_price" yaml:"max_order_per_price"`
 	MaxPairsPerContract        uint64                                 `protobuf:"varint,13,opt,name=max_pairs_per_contract,json=maxPairsPerContract,proto3" json:"max_pairs_per_contract" yaml:"max_pairs_per_contract"`
 	DefaultGasPerOrderDataByte uint64                                 `protobuf:"varint,14,opt,name=default_gas_per_order_data_byte,json=defaultGasPerOrderDataByte,proto3" json:"default_gas_per_order_data_byte" yaml:"default_gas_per_order_data_byte"`
+	// number of candles retained per pair and interval. Candles aren't recorded if it's 0
+	CandleRetention uint64 `protobuf:"varint,15,opt,name=candle_retention,json=candleRetention,proto3" json:"candle_retention" yaml:"candle_retention"`
+	// number of blocks that settled fills are indexed by account for. Fills aren't recorded if it's 0
+	AccountFillRetention uint64 `protobuf:"varint,16,opt,name=account_fill_retention,json=accountFillRetention,proto3" json:"account_fill_retention" yaml:"account_fill_retention"`
+	// a low_rent event is emitted when a contract's rent balance drops below this. Disabled if it's 0
+	RentLowWaterMark uint64 `protobuf:"varint,17,opt,name=rent_low_water_mark,json=rentLowWaterMark,proto3" json:"rent_low_water_mark" yaml:"rent_low_water_mark"`
+	// number of blocks that rent charges of contracts are kept for. Charges aren't recorded if it's 0
+	RentHistoryRetention uint64 `protobuf:"varint,18,opt,name=rent_history_retention,json=rentHistoryRetention,proto3" json:"rent_history_retention" yaml:"rent_history_retention"`
+	// matching of a pair is paused when its clearing price moves away from the reference price by more than
+	// this fraction. Disabled if it's 0
+	CircuitBreakerThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=circuit_breaker_threshold,json=circuitBreakerThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"circuit_breaker_threshold"`
+	// number of blocks that matching is paused for once the circuit breaker trips
+	CircuitBreakerPauseBlocks uint64 `protobuf:"varint,20,opt,name=circuit_breaker_pause_blocks,json=circuitBreakerPauseBlocks,proto3" json:"circuit_breaker_pause_blocks" yaml:"circuit_breaker_pause_blocks"`
+	// limit orders priced further than this fraction away from the reference price are rejected. Disabled if it's 0
+	PriceBand github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=price_band,json=priceBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_band"`
+	// number of blocks that per-contract execution stats are kept for. Stats aren't recorded if it's 0
+	ExecutionStatsRetention uint64 `protobuf:"varint,22,opt,name=execution_stats_retention,json=executionStatsRetention,proto3" json:"execution_stats_retention" yaml:"execution_stats_retention"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -165,6 +182,48 @@ func (m *Params) GetDefaultGasPerOrderDataByte() uint64 {
 	return 0
 }
 
+func (m *Params) GetCandleRetention() uint64 {
+	if m != nil {
+		return m.CandleRetention
+	}
+	return 0
+}
+
+func (m *Params) GetAccountFillRetention() uint64 {
+	if m != nil {
+		return m.AccountFillRetention
+	}
+	return 0
+}
+
+func (m *Params) GetRentLowWaterMark() uint64 {
+	if m != nil {
+		return m.RentLowWaterMark
+	}
+	return 0
+}
+
+func (m *Params) GetRentHistoryRetention() uint64 {
+	if m != nil {
+		return m.RentHistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetCircuitBreakerPauseBlocks() uint64 {
+	if m != nil {
+		return m.CircuitBreakerPauseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetExecutionStatsRetention() uint64 {
+	if m != nil {
+		return m.ExecutionStatsRetention
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.dex.Params")
 }
@@ -172,57 +231,73 @@ func init() {
 func init() { proto.RegisterFile("dex/params.proto", fileDescriptor_e49286500ccff43e) }
 
 var fileDescriptor_e49286500ccff43e = []byte{
-	// 787 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xc1, 0x6f, 0x1b, 0x45,
-	0x14, 0xc6, 0xbd, 0x50, 0x42, 0x3b, 0x94, 0xca, 0xda, 0x34, 0xc9, 0x92, 0x16, 0x4f, 0x35, 0x48,
-	0x55, 0x2f, 0xb1, 0x0f, 0x08, 0x21, 0x8a, 0x10, 0xc2, 0x49, 0x94, 0x4b, 0x11, 0xd6, 0x54, 0x1c,
-	0xe0, 0xb2, 0x1a, 0xef, 0x3e, 0x9c, 0x51, 0x66, 0x67, 0x56, 0x33, 0x63, 0x61, 0x9f, 0xb9, 0x70,
-	0x44, 0x9c, 0x38, 0xf6, 0xcf, 0xe9, 0xb1, 0x47, 0xc4, 0x61, 0x84, 0x92, 0x0b, 0xda, 0xe3, 0xfe,
-	0x05, 0x68, 0x66, 0x6d, 0x96, 0x24, 0x6b, 0xf7, 0x94, 0xcd, 0xf7, 0xfb, 0xb4, 0xdf, 0x7b, 0xde,
-	0x79, 0x6f, 0x50, 0x3f, 0x87, 0xc5, 0xa8, 0x64, 0x9a, 0x15, 0x66, 0x58, 0x6a, 0x65, 0x55, 0x9c,
-	0x18, 0xe0, 0xe1, 0x29, 0x53, 0x62, 0x68, 0x80, 0x67, 0xe7, 0x8c, 0xcb, 0x61, 0x0e, 0x8b, 0xc3,
-	0x87, 0x33, 0x35, 0x53, 0x01, 0x8d, 0xfc, 0x53, 0xe3, 0x27, 0xf5, 0x7d, 0xb4, 0x33, 0x09, 0x2f,
-	0x88, 0x97, 0x28, 0x29, 0x35, 0xcf, 0x20, 0x35, 0x92, 0x95, 0xe6, 0x5c, 0xd9, 0x54, 0x83, 0x05,
-	0x69, 0xb9, 0x92, 0x49, 0xf4, 0x24, 0x7a, 0x76, 0x67, 0xfc, 0x75, 0xe5, 0xf0, 0x46, 0x4f, 0xed,
-	0x30, 0x5e, 0xb2, 0x42, 0x3c, 0x27, 0x9b, 0x1c, 0x84, 0xee, 0x07, 0xf4, 0x72, 0x45, 0xe8, 0x1a,
-	0xc4, 0x16, 0xed, 0x9a, 0x79, 0xae, 0xd2, 0x8c, 0x09, 0x91, 0xce, 0x98, 0x49, 0x83, 0x2f, 0x79,
-	0xe7, 0x49, 0xf4, 0xec, 0xde, 0xf8, 0xf4, 0xb5, 0xc3, 0xbd, 0xbf, 0x1c, 0x7e, 0x3a, 0xe3, 0xf6,
-	0x7c, 0x3e, 0x1d, 0x66, 0xaa, 0x18, 0x65, 0xca, 0x14, 0xca, 0xac, 0xfe, 0x1c, 0x99, 0xfc, 0x62,
-	0x64, 0x97, 0x25, 0x98, 0xe1, 0x09, 0x64, 0x95, 0xc3, 0x5d, 0x2f, 0xa3, 0x7d, 0x2f, 0x1e, 0x33,
-	0x21, 0xce, 0x98, 0x99, 0x78, 0x25, 0x16, 0x68, 0x6f, 0x0a, 0x33, 0x2e, 0xd3, 0xa9, 0x50, 0xd9,
-	0x45, 0xb0, 0x0a, 0x5e, 0x70, 0x9b, 0xbc, 0x1b, 0xba, 0xfd, 0xa2, 0x72, 0xb8, 0xdb, 0x50, 0x3b,
-	0xfc, 0xb8, 0x69, 0xb5, 0x13, 0x13, 0x1a, 0x07, 0x7d, 0xec, 0xe5, 0x33, 0x66, 0x5e, 0x78, 0x31,
-	0xce, 0xd1, 0x2e, 0xc8, 0xfc, 0x56, 0xd6, 0x9d, 0x90, 0xf5, 0x99, 0xaf, 0xba, 0x03, 0xd7, 0x0e,
-	0x1f, 0x36, 0x49, 0x1d, 0x90, 0xd0, 0x3e, 0xc8, 0xfc, 0x7a, 0x8a, 0x40, 0x7b, 0x39, 0xfc, 0xc4,
-	0xe6, 0xc2, 0x36, 0xad, 0x83, 0x4e, 0x95, 0xce, 0x41, 0x27, 0xef, 0xb5, 0x3d, 0x75, 0x1a, 0xda,
-	0x9e, 0x3a, 0x31, 0xa1, 0xf1, 0x4a, 0xf7, 0x3f, 0x1f, 0xe8, 0xef, 0xbc, 0x18, 0x97, 0x68, 0xff,
-	0xa6, 0x3b, 0x63, 0x32, 0x03, 0x91, 0xec, 0x84, 0xb8, 0x2f, 0x2b, 0x87, 0x37, 0x38, 0x6a, 0x87,
-	0x3f, 0xee, 0xce, 0x6b, 0x38, 0xa1, 0xbb, 0xd7, 0x02, 0x8f, 0x83, 0x1a, 0xff, 0x80, 0xfa, 0x05,
-	0x97, 0xa9, 0x06, 0x69, 0xd3, 0x1c, 0x4a, 0x65, 0xb8, 0x4d, 0xde, 0x0f, 0x59, 0xa3, 0xca, 0xe1,
-	0x5b, 0xac, 0x76, 0xf8, 0xa0, 0x49, 0xb9, 0x49, 0x08, 0x7d, 0x50, 0x70, 0x49, 0x41, 0xda, 0x93,
-	0x46, 0x88, 0x7f, 0x8d, 0xd0, 0x63, 0x5f, 0x03, 0x13, 0x42, 0xfd, 0xec, 0xd3, 0x42, 0x35, 0x06,
-	0xac, 0x15, 0x50, 0x80, 0xb4, 0xc9, 0xdd, 0x90, 0x73, 0x56, 0x39, 0xbc, 0xd5, 0x57, 0x3b, 0xfc,
-	0x49, 0x93, 0xb9, 0xcd, 0x45, 0xe8, 0x47, 0x33, 0x66, 0xbe, 0x59, 0xd3, 0x09, 0xe8, 0x97, 0xff,
-	0xb1, 0x98, 0xa3, 0x87, 0xbe, 0xde, 0x52, 0xab, 0x0c, 0x8c, 0x61, 0x53, 0x01, 0xa1, 0xf6, 0xe4,
-	0x5e, 0xa8, 0xe0, 0xf3, 0xca, 0xe1, 0x4e, 0x5e, 0x3b, 0xfc, 0xa8, 0xed, 0xf6, 0x26, 0x25, 0x34,
-	0x2e, 0xb8, 0x9c, 0xb4, 0xaa, 0x6f, 0x3e, 0xfe, 0x25, 0x42, 0x8f, 0xc2, 0x17, 0x4e, 0xa7, 0x4a,
-	0x5d, 0xa4, 0x20, 0xad, 0xe6, 0xd0, 0x7c, 0x08, 0xa1, 0x58, 0x9e, 0xa0, 0x10, 0x79, 0x5a, 0x39,
-	0xbc, 0xcd, 0x56, 0x3b, 0x4c, 0x9a, 0xe4, 0x2d, 0x26, 0x42, 0x0f, 0x02, 0x1d, 0x2b, 0x75, 0x71,
-	0xda, 0xb0, 0x09, 0xe8, 0x17, 0x8a, 0xe5, 0xf1, 0x1c, 0x1d, 0x64, 0x4a, 0x5a, 0xcd, 0x32, 0x9b,
-	0xce, 0xa5, 0x99, 0x9b, 0xd2, 0x9f, 0xf7, 0x4c, 0x19, 0x9b, 0x7c, 0x10, 0x0a, 0xf8, 0xaa, 0x72,
-	0x78, 0x93, 0xa5, 0x76, 0x78, 0xd0, 0x84, 0x6f, 0x30, 0x10, 0xba, 0xb7, 0x26, 0xdf, 0xaf, 0xc1,
-	0xb1, 0x32, 0x61, 0x26, 0x0b, 0xb6, 0x68, 0x4e, 0x78, 0x28, 0xb3, 0xd9, 0x3b, 0xf7, 0xdb, 0x99,
-	0xec, 0xc0, 0xed, 0x4c, 0x76, 0x40, 0x42, 0xfb, 0x05, 0x5b, 0x84, 0xe9, 0x98, 0x80, 0x6e, 0xf6,
-	0x4c, 0x89, 0xf6, 0xbd, 0xb3, 0x64, 0x5c, 0xaf, 0x4e, 0xf8, 0xaa, 0x98, 0xe4, 0xc3, 0x76, 0x4a,
-	0xba, 0x1d, 0xed, 0x94, 0x74, 0x73, 0x42, 0x7d, 0x85, 0x13, 0xaf, 0xfb, 0x19, 0x59, 0xa9, 0xf1,
-	0xef, 0x11, 0xc2, 0x9d, 0x63, 0x9c, 0xe6, 0xcc, 0xb2, 0x74, 0xba, 0xb4, 0x90, 0x3c, 0x08, 0xd9,
-	0xdf, 0x56, 0x0e, 0xbf, 0xcd, 0x5a, 0x3b, 0xfc, 0x74, 0xcb, 0x6a, 0x68, 0x8d, 0x84, 0x1e, 0xde,
-	0x5e, 0x12, 0x27, 0xcc, 0xb2, 0xf1, 0xd2, 0xc2, 0xf3, 0xbb, 0x7f, 0xbc, 0xc2, 0xbd, 0x7f, 0x5e,
-	0xe1, 0x68, 0x7c, 0xf6, 0xfa, 0x72, 0x10, 0xbd, 0xb9, 0x1c, 0x44, 0x7f, 0x5f, 0x0e, 0xa2, 0xdf,
-	0xae, 0x06, 0xbd, 0x37, 0x57, 0x83, 0xde, 0x9f, 0x57, 0x83, 0xde, 0x8f, 0x47, 0xff, 0xdb, 0xf1,
-	0x06, 0xf8, 0xd1, 0xfa, 0x2a, 0x0b, 0xff, 0x84, 0xbb, 0x6c, 0xb4, 0x18, 0xf9, 0x4b, 0x2f, 0xac,
-	0xfb, 0xe9, 0x4e, 0xe0, 0x9f, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xff, 0x27, 0x82, 0x3c, 0x08,
-	0x07, 0x00, 0x00,
+	// 1052 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x41, 0x6f, 0x23, 0x35,
+	0x14, 0xc7, 0x1b, 0x58, 0xca, 0xd6, 0xc0, 0x6e, 0x98, 0xa6, 0xed, 0xb4, 0xbb, 0xc4, 0x95, 0x91,
+	0x56, 0x7b, 0x69, 0x73, 0x40, 0x08, 0xb1, 0x80, 0xd0, 0xa6, 0x2d, 0x45, 0xa2, 0x2b, 0x22, 0x17,
+	0x84, 0x58, 0x0e, 0x23, 0x67, 0xc6, 0x9b, 0x58, 0xf1, 0x8c, 0x07, 0xdb, 0x51, 0x93, 0x03, 0x27,
+	0x0e, 0x20, 0x71, 0x41, 0x9c, 0x38, 0xee, 0xc7, 0xd9, 0xe3, 0x1e, 0x11, 0x07, 0x0b, 0xb5, 0x17,
+	0x94, 0xe3, 0x7c, 0x02, 0x64, 0x4f, 0x52, 0xb7, 0xe9, 0x24, 0x68, 0x4f, 0x9d, 0xbe, 0xdf, 0x3f,
+	0xf3, 0x7f, 0xcf, 0xe3, 0xf7, 0x6c, 0x50, 0x4f, 0xe8, 0xa8, 0x95, 0x13, 0x49, 0x52, 0xb5, 0x9f,
+	0x4b, 0xa1, 0x45, 0x10, 0x2a, 0xca, 0xdc, 0x53, 0x2c, 0xf8, 0xbe, 0xa2, 0x2c, 0xee, 0x13, 0x96,
+	0xed, 0x27, 0x74, 0xb4, 0xd3, 0xe8, 0x89, 0x9e, 0x70, 0xa8, 0x65, 0x9f, 0x4a, 0x3d, 0xfa, 0xad,
+	0x01, 0x56, 0x3b, 0xee, 0x05, 0xc1, 0x18, 0x84, 0xb9, 0x64, 0x31, 0x8d, 0x54, 0x46, 0x72, 0xd5,
+	0x17, 0x3a, 0x92, 0x54, 0xd3, 0x4c, 0x33, 0x91, 0x85, 0xb5, 0xdd, 0xda, 0xc3, 0x5b, 0xed, 0xcf,
+	0x27, 0x06, 0x2e, 0xd4, 0x14, 0x06, 0xc2, 0x31, 0x49, 0xf9, 0x23, 0xb4, 0x48, 0x81, 0xf0, 0xa6,
+	0x43, 0xa7, 0x53, 0x82, 0x67, 0x20, 0xd0, 0x60, 0x5d, 0x0d, 0x13, 0x11, 0xc5, 0x84, 0xf3, 0xa8,
+	0x47, 0x54, 0xe4, 0x74, 0xe1, 0x6b, 0xbb, 0xb5, 0x87, 0x6b, 0xed, 0xa3, 0x17, 0x06, 0xae, 0xfc,
+	0x6d, 0xe0, 0x83, 0x1e, 0xd3, 0xfd, 0x61, 0x77, 0x3f, 0x16, 0x69, 0x2b, 0x16, 0x2a, 0x15, 0x6a,
+	0xfa, 0x67, 0x4f, 0x25, 0x83, 0x96, 0x1e, 0xe7, 0x54, 0xed, 0x1f, 0xd2, 0x78, 0x62, 0x60, 0xd5,
+	0xcb, 0x70, 0xdd, 0x06, 0x0f, 0x08, 0xe7, 0xc7, 0x44, 0x75, 0x6c, 0x24, 0xe0, 0x60, 0xa3, 0x4b,
+	0x7b, 0x2c, 0x8b, 0xba, 0x5c, 0xc4, 0x03, 0x27, 0xe5, 0x2c, 0x65, 0x3a, 0x7c, 0xdd, 0x55, 0xfb,
+	0xf1, 0xc4, 0xc0, 0x6a, 0x41, 0x61, 0xe0, 0xfd, 0xb2, 0xd4, 0x4a, 0x8c, 0x70, 0xe0, 0xe2, 0x6d,
+	0x1b, 0x3e, 0x26, 0xea, 0xc4, 0x06, 0x83, 0x04, 0xac, 0xd3, 0x2c, 0xb9, 0xe1, 0x75, 0xcb, 0x79,
+	0x7d, 0x68, 0xb3, 0xae, 0xc0, 0x85, 0x81, 0x3b, 0xa5, 0x53, 0x05, 0x44, 0xb8, 0x4e, 0xb3, 0xe4,
+	0xba, 0x0b, 0x07, 0x1b, 0x09, 0x7d, 0x46, 0x86, 0x5c, 0x97, 0xa5, 0x53, 0x19, 0x09, 0x99, 0x50,
+	0x19, 0xbe, 0xe1, 0x6b, 0xaa, 0x14, 0xf8, 0x9a, 0x2a, 0x31, 0xc2, 0xc1, 0x34, 0x6e, 0x97, 0x8f,
+	0xca, 0xaf, 0x6d, 0x30, 0xc8, 0xc1, 0xe6, 0xbc, 0x3a, 0x26, 0x59, 0x4c, 0x79, 0xb8, 0xea, 0xec,
+	0x3e, 0x99, 0x18, 0xb8, 0x40, 0x51, 0x18, 0xf8, 0x5e, 0xb5, 0x5f, 0xc9, 0x11, 0x5e, 0xbf, 0x66,
+	0x78, 0xe0, 0xa2, 0xc1, 0xf7, 0xa0, 0x9e, 0xb2, 0x2c, 0x92, 0x34, 0xd3, 0x51, 0x42, 0x73, 0xa1,
+	0x98, 0x0e, 0xdf, 0x74, 0x5e, 0xad, 0x89, 0x81, 0x37, 0x58, 0x61, 0xe0, 0x56, 0xe9, 0x32, 0x4f,
+	0x10, 0xbe, 0x93, 0xb2, 0x0c, 0xd3, 0x4c, 0x1f, 0x96, 0x81, 0xe0, 0xd7, 0x1a, 0xb8, 0x6f, 0x73,
+	0x20, 0x9c, 0x8b, 0x33, 0xeb, 0xe6, 0xb2, 0x51, 0x54, 0x6b, 0x4e, 0x53, 0x9a, 0xe9, 0xf0, 0xb6,
+	0xf3, 0x39, 0x9e, 0x18, 0xb8, 0x54, 0x57, 0x18, 0xf8, 0x7e, 0xe9, 0xb9, 0x4c, 0x85, 0xf0, 0x76,
+	0x8f, 0xa8, 0xc7, 0x33, 0xda, 0xa1, 0xf2, 0xf4, 0x92, 0x05, 0x0c, 0x34, 0x6c, 0xbe, 0xb9, 0x14,
+	0x31, 0x55, 0x8a, 0x74, 0x39, 0x75, 0xb9, 0x87, 0x6b, 0x2e, 0x83, 0x8f, 0x26, 0x06, 0x56, 0xf2,
+	0xc2, 0xc0, 0x7b, 0xbe, 0xda, 0x79, 0x8a, 0x70, 0x90, 0xb2, 0xac, 0xe3, 0xa3, 0xb6, 0xf8, 0xe0,
+	0xe7, 0x1a, 0xb8, 0xe7, 0xbe, 0x70, 0xd4, 0x15, 0x62, 0x10, 0xd1, 0x4c, 0x4b, 0x46, 0xcb, 0x0f,
+	0xc1, 0x05, 0x49, 0x42, 0xe0, 0x2c, 0x8f, 0x26, 0x06, 0x2e, 0x93, 0x15, 0x06, 0xa2, 0xd2, 0x79,
+	0x89, 0x08, 0xe1, 0x2d, 0x47, 0xdb, 0x42, 0x0c, 0x8e, 0x4a, 0xd6, 0xa1, 0xf2, 0x44, 0x90, 0x24,
+	0x18, 0x82, 0xad, 0x58, 0x64, 0x5a, 0x92, 0x58, 0x47, 0xc3, 0x4c, 0x0d, 0x55, 0x6e, 0xf7, 0x7b,
+	0x2c, 0x94, 0x0e, 0xdf, 0x72, 0x09, 0x7c, 0x36, 0x31, 0x70, 0x91, 0xa4, 0x30, 0xb0, 0x59, 0x9a,
+	0x2f, 0x10, 0x20, 0xbc, 0x31, 0x23, 0xdf, 0xce, 0xc0, 0x81, 0x50, 0xae, 0x27, 0x53, 0x32, 0x2a,
+	0x77, 0xb8, 0x4b, 0xb3, 0x9c, 0x3b, 0x6f, 0xfb, 0x9e, 0xac, 0xc0, 0xbe, 0x27, 0x2b, 0x20, 0xc2,
+	0xf5, 0x94, 0x8c, 0x5c, 0x77, 0x74, 0xa8, 0x2c, 0xe7, 0x4c, 0x0e, 0x36, 0xad, 0x32, 0x27, 0x4c,
+	0x4e, 0x77, 0xf8, 0x34, 0x99, 0xf0, 0x1d, 0xdf, 0x25, 0xd5, 0x0a, 0xdf, 0x25, 0xd5, 0x1c, 0x61,
+	0x9b, 0x61, 0xc7, 0xc6, 0x6d, 0x8f, 0x4c, 0xa3, 0xc1, 0x1f, 0x35, 0x00, 0x2b, 0xdb, 0x38, 0x4a,
+	0x88, 0x26, 0x51, 0x77, 0xac, 0x69, 0x78, 0xc7, 0x79, 0x3f, 0x99, 0x18, 0xf8, 0x7f, 0xd2, 0xc2,
+	0xc0, 0x07, 0x4b, 0x46, 0x83, 0x17, 0x22, 0xbc, 0x73, 0x73, 0x48, 0x1c, 0x12, 0x4d, 0xda, 0x63,
+	0x4d, 0x83, 0xa7, 0xa0, 0x1e, 0x93, 0x2c, 0x71, 0xbb, 0x71, 0x76, 0xae, 0xdc, 0xf5, 0xad, 0x3b,
+	0xcf, 0x7c, 0xeb, 0xce, 0x13, 0x84, 0xef, 0x96, 0x21, 0x7f, 0x80, 0xfc, 0x08, 0x36, 0x49, 0x1c,
+	0x8b, 0x61, 0xa6, 0xa3, 0x67, 0x8c, 0xf3, 0x2b, 0x0e, 0x75, 0xbf, 0xc4, 0xd5, 0x0a, 0xbf, 0xc4,
+	0xd5, 0x1c, 0xe1, 0xc6, 0x14, 0x7c, 0xc1, 0x38, 0xf7, 0x96, 0x09, 0x58, 0x77, 0xf3, 0x84, 0x8b,
+	0xb3, 0xe8, 0x8c, 0x68, 0x2a, 0xa3, 0x94, 0xc8, 0x41, 0xf8, 0xae, 0xdf, 0x3b, 0x15, 0xd8, 0xef,
+	0x9d, 0x0a, 0x88, 0x70, 0xdd, 0x46, 0x4f, 0xc4, 0xd9, 0x77, 0x36, 0xf6, 0x84, 0xc8, 0x81, 0x2d,
+	0xcc, 0x29, 0xfb, 0x4c, 0x69, 0x21, 0xc7, 0x57, 0x0a, 0x0b, 0x7c, 0x61, 0xd5, 0x0a, 0x5f, 0x58,
+	0x35, 0x47, 0xb8, 0x61, 0xc1, 0x97, 0x65, 0xdc, 0x17, 0xf6, 0x4b, 0x0d, 0x6c, 0xc7, 0x4c, 0xc6,
+	0x43, 0xa6, 0xa3, 0xae, 0xa4, 0x64, 0x40, 0x65, 0xa4, 0xfb, 0x92, 0xaa, 0xbe, 0xe0, 0x49, 0xb8,
+	0xee, 0xce, 0xe4, 0xaf, 0x5e, 0xf9, 0x4c, 0x5e, 0xfc, 0x4a, 0xbc, 0x35, 0x45, 0xed, 0x92, 0x7c,
+	0x33, 0x03, 0x6e, 0x22, 0xcf, 0xff, 0x2c, 0x27, 0x43, 0x45, 0xcb, 0x93, 0x50, 0x85, 0x0d, 0x3f,
+	0x91, 0x97, 0xe9, 0xfc, 0x44, 0x5e, 0xa6, 0x42, 0x78, 0xfb, 0x7a, 0x22, 0x1d, 0x0b, 0xdd, 0xf1,
+	0xaa, 0x82, 0x1f, 0x00, 0x28, 0xaf, 0x35, 0x5d, 0x92, 0x25, 0xe1, 0x86, 0x5b, 0x84, 0x4f, 0x5f,
+	0x79, 0x11, 0xae, 0xbc, 0x03, 0xaf, 0xb9, 0xe7, 0x36, 0xc9, 0x92, 0xe0, 0x27, 0xb0, 0x4d, 0x47,
+	0x34, 0x1e, 0xda, 0xe5, 0x8f, 0x94, 0x26, 0x5a, 0x5d, 0xf9, 0xce, 0x9b, 0xae, 0xc6, 0xc7, 0x76,
+	0x09, 0x17, 0x8a, 0x0a, 0x03, 0x77, 0xcb, 0x02, 0x17, 0x4a, 0x10, 0xde, 0xba, 0x64, 0xa7, 0x16,
+	0x5d, 0x7e, 0xf0, 0x47, 0xb7, 0xff, 0x7c, 0x0e, 0x57, 0xfe, 0x7d, 0x0e, 0x6b, 0xed, 0xe3, 0x17,
+	0xe7, 0xcd, 0xda, 0xcb, 0xf3, 0x66, 0xed, 0x9f, 0xf3, 0x66, 0xed, 0xf7, 0x8b, 0xe6, 0xca, 0xcb,
+	0x8b, 0xe6, 0xca, 0x5f, 0x17, 0xcd, 0x95, 0xa7, 0x7b, 0x57, 0x6a, 0x54, 0x94, 0xed, 0xcd, 0xee,
+	0x98, 0xee, 0x1f, 0x77, 0xc9, 0x6c, 0x8d, 0x5a, 0xf6, 0x36, 0xea, 0xca, 0xed, 0xae, 0x3a, 0xfe,
+	0xc1, 0x7f, 0x03, 0x00, 0xc3, 0xc1, 0xad, 0x37, 0xa1, 0x0a, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -286,6 +361,30 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DefaultGasPerOrderDataByte != that1.DefaultGasPerOrderDataByte {
 		return false
 	}
+	if this.CandleRetention != that1.CandleRetention {
+		return false
+	}
+	if this.AccountFillRetention != that1.AccountFillRetention {
+		return false
+	}
+	if this.RentLowWaterMark != that1.RentLowWaterMark {
+		return false
+	}
+	if this.RentHistoryRetention != that1.RentHistoryRetention {
+		return false
+	}
+	if !this.CircuitBreakerThreshold.Equal(that1.CircuitBreakerThreshold) {
+		return false
+	}
+	if this.CircuitBreakerPauseBlocks != that1.CircuitBreakerPauseBlocks {
+		return false
+	}
+	if !this.PriceBand.Equal(that1.PriceBand) {
+		return false
+	}
+	if this.ExecutionStatsRetention != that1.ExecutionStatsRetention {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -308,6 +407,70 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionStatsRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExecutionStatsRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.PriceBand.Size()
+		i -= size
+		if _, err := m.PriceBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.CircuitBreakerPauseBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CircuitBreakerPauseBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.CircuitBreakerThreshold.Size()
+		i -= size
+		if _, err := m.CircuitBreakerThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.RentHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RentHistoryRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.RentLowWaterMark != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RentLowWaterMark))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.AccountFillRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccountFillRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.CandleRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CandleRetention))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.DefaultGasPerOrderDataByte != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DefaultGasPerOrderDataByte))
 		i--
@@ -444,6 +607,28 @@ func (m *Params) Size() (n int) {
 	if m.DefaultGasPerOrderDataByte != 0 {
 		n += 1 + sovParams(uint64(m.DefaultGasPerOrderDataByte))
 	}
+	if m.CandleRetention != 0 {
+		n += 1 + sovParams(uint64(m.CandleRetention))
+	}
+	if m.AccountFillRetention != 0 {
+		n += 2 + sovParams(uint64(m.AccountFillRetention))
+	}
+	if m.RentLowWaterMark != 0 {
+		n += 2 + sovParams(uint64(m.RentLowWaterMark))
+	}
+	if m.RentHistoryRetention != 0 {
+		n += 2 + sovParams(uint64(m.RentHistoryRetention))
+	}
+	l = m.CircuitBreakerThreshold.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.CircuitBreakerPauseBlocks != 0 {
+		n += 2 + sovParams(uint64(m.CircuitBreakerPauseBlocks))
+	}
+	l = m.PriceBand.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.ExecutionStatsRetention != 0 {
+		n += 2 + sovParams(uint64(m.ExecutionStatsRetention))
+	}
 	return n
 }
 
@@ -763,6 +948,188 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleRetention", wireType)
+			}
+			m.CandleRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CandleRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFillRetention", wireType)
+			}
+			m.AccountFillRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountFillRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentLowWaterMark", wireType)
+			}
+			m.RentLowWaterMark = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RentLowWaterMark |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentHistoryRetention", wireType)
+			}
+			m.RentHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RentHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerPauseBlocks", wireType)
+			}
+			m.CircuitBreakerPauseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerPauseBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStatsRetention", wireType)
+			}
+			m.ExecutionStatsRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionStatsRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
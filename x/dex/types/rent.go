@@ -0,0 +1,12 @@
+package types
+
+import (
+	"math"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+// MaxAllowedRentBalance caps the rent balance of a contract so that its amplification by cosmwasm's
+// gas multiplier doesn't overflow uint64.
+// TODO: replace with a wasm keeper query once its gas registry is made public
+const MaxAllowedRentBalance = uint64(math.MaxUint64) / wasmkeeper.DefaultGasMultiplier
@@ -0,0 +1,713 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/rent.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// allowance granted by a funding account to top up a contract's rent automatically
+type RentTopUp struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+	Funder       string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder"`
+	// rent is topped up at the end of a block if the balance is below the threshold
+	Threshold uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold"`
+	// amount pulled from the funder per top-up
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount"`
+	// remaining amount that can be pulled from the funder
+	SpendLimit uint64 `protobuf:"varint,5,opt,name=spendLimit,proto3" json:"spend_limit"`
+}
+
+func (m *RentTopUp) Reset()         { *m = RentTopUp{} }
+func (m *RentTopUp) String() string { return proto.CompactTextString(m) }
+func (*RentTopUp) ProtoMessage()    {}
+func (*RentTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b7f75d2683d900, []int{0}
+}
+func (m *RentTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RentTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RentTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RentTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RentTopUp.Merge(m, src)
+}
+func (m *RentTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *RentTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RentTopUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RentTopUp proto.InternalMessageInfo
+
+func (m *RentTopUp) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *RentTopUp) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *RentTopUp) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *RentTopUp) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *RentTopUp) GetSpendLimit() uint64 {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return 0
+}
+
+// rent charged to a contract in a block
+type RentCharge struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount"`
+	// rent balance left after the charge
+	RentBalance uint64 `protobuf:"varint,3,opt,name=rentBalance,proto3" json:"rent_balance"`
+}
+
+func (m *RentCharge) Reset()         { *m = RentCharge{} }
+func (m *RentCharge) String() string { return proto.CompactTextString(m) }
+func (*RentCharge) ProtoMessage()    {}
+func (*RentCharge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b7f75d2683d900, []int{1}
+}
+func (m *RentCharge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RentCharge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RentCharge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RentCharge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RentCharge.Merge(m, src)
+}
+func (m *RentCharge) XXX_Size() int {
+	return m.Size()
+}
+func (m *RentCharge) XXX_DiscardUnknown() {
+	xxx_messageInfo_RentCharge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RentCharge proto.InternalMessageInfo
+
+func (m *RentCharge) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RentCharge) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *RentCharge) GetRentBalance() uint64 {
+	if m != nil {
+		return m.RentBalance
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RentTopUp)(nil), "seiprotocol.seichain.dex.RentTopUp")
+	proto.RegisterType((*RentCharge)(nil), "seiprotocol.seichain.dex.RentCharge")
+}
+
+func init() { proto.RegisterFile("dex/rent.proto", fileDescriptor_a7b7f75d2683d900) }
+
+var fileDescriptor_a7b7f75d2683d900 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0xeb, 0x52, 0x2a, 0xd5, 0x2d, 0x50, 0x45, 0x1d, 0x22, 0x86, 0xa4, 0xea, 0x54, 0x09,
+	0x35, 0x91, 0x60, 0x61, 0x25, 0x0c, 0x2c, 0x4c, 0x16, 0x2c, 0x2c, 0x55, 0x1a, 0x1f, 0x89, 0xa5,
+	0xd4, 0x8e, 0x6c, 0x57, 0x2a, 0x6f, 0xc0, 0xc8, 0x63, 0x31, 0x76, 0x64, 0x8a, 0x50, 0xbb, 0x85,
+	0x97, 0x40, 0x76, 0x52, 0xb5, 0x0c, 0x4c, 0x77, 0xfe, 0xdd, 0xe7, 0xfb, 0xa3, 0x0f, 0x9f, 0x53,
+	0x58, 0x87, 0x12, 0xb8, 0x0e, 0x0a, 0x29, 0xb4, 0x70, 0x5c, 0x05, 0xcc, 0x66, 0x89, 0xc8, 0x03,
+	0x05, 0x2c, 0xc9, 0x62, 0xc6, 0x03, 0x0a, 0xeb, 0xcb, 0x51, 0x2a, 0x52, 0x61, 0x4b, 0xa1, 0xc9,
+	0x6a, 0xfd, 0xe4, 0x07, 0xe1, 0x1e, 0x01, 0xae, 0x9f, 0x44, 0xf1, 0x5c, 0x38, 0xb7, 0x78, 0x90,
+	0x08, 0xae, 0x65, 0x9c, 0xe8, 0x3b, 0x4a, 0xa5, 0x8b, 0xc6, 0x68, 0xda, 0x8b, 0x46, 0x55, 0xe9,
+	0x0f, 0xf7, 0x7c, 0x1e, 0x53, 0x2a, 0x41, 0x29, 0xf2, 0x47, 0xe9, 0x4c, 0x70, 0xf7, 0x75, 0xc5,
+	0x29, 0x48, 0xb7, 0x6d, 0xff, 0xe0, 0xaa, 0xf4, 0x1b, 0x42, 0x9a, 0xe8, 0x5c, 0xe1, 0x9e, 0xce,
+	0x24, 0xa8, 0x4c, 0xe4, 0xd4, 0x3d, 0x19, 0xa3, 0x69, 0x27, 0x3a, 0xab, 0x4a, 0xff, 0x00, 0xc9,
+	0x21, 0x35, 0x0d, 0xe3, 0xa5, 0x58, 0x71, 0xed, 0x76, 0xac, 0xd2, 0x36, 0xac, 0x09, 0x69, 0xa2,
+	0x13, 0x62, 0xac, 0x0a, 0xe0, 0xf4, 0x91, 0x2d, 0x99, 0x76, 0x4f, 0xad, 0xee, 0xa2, 0x2a, 0xfd,
+	0xbe, 0xa5, 0xf3, 0xdc, 0x60, 0x72, 0x24, 0x99, 0xbc, 0x23, 0x8c, 0xcd, 0xb5, 0xf7, 0x59, 0x2c,
+	0x53, 0x30, 0x33, 0x32, 0x60, 0x69, 0xa6, 0x5d, 0x74, 0x98, 0x51, 0x13, 0xd2, 0xc4, 0xa3, 0x3d,
+	0xda, 0xff, 0xee, 0x71, 0x8d, 0xfb, 0xc6, 0x82, 0x28, 0xce, 0x63, 0x9e, 0x40, 0x73, 0xda, 0xb0,
+	0x2a, 0xfd, 0x81, 0xc1, 0xf3, 0x45, 0xcd, 0xc9, 0xb1, 0x28, 0x7a, 0xf8, 0xdc, 0x7a, 0x68, 0xb3,
+	0xf5, 0xd0, 0xf7, 0xd6, 0x43, 0x1f, 0x3b, 0xaf, 0xb5, 0xd9, 0x79, 0xad, 0xaf, 0x9d, 0xd7, 0x7a,
+	0x99, 0xa5, 0x4c, 0x67, 0xab, 0x45, 0x90, 0x88, 0x65, 0xa8, 0x80, 0xcd, 0xf6, 0x76, 0xda, 0x87,
+	0xf5, 0x33, 0x5c, 0x87, 0xc6, 0x76, 0xfd, 0x56, 0x80, 0x5a, 0x74, 0x6d, 0xfd, 0xe6, 0x77, 0x00,
+	0x4c, 0x5f, 0x60, 0x8b, 0x0a, 0x02, 0x00, 0x00,
+}
+
+func (m *RentTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RentTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RentTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendLimit != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.SpendLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintRent(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RentCharge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RentCharge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RentCharge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RentBalance != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.RentBalance))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Amount != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintRent(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RentTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovRent(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovRent(uint64(m.Threshold))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRent(uint64(m.Amount))
+	}
+	if m.SpendLimit != 0 {
+		n += 1 + sovRent(uint64(m.SpendLimit))
+	}
+	return n
+}
+
+func (m *RentCharge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRent(uint64(m.Height))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovRent(uint64(m.Amount))
+	}
+	if m.RentBalance != 0 {
+		n += 1 + sovRent(uint64(m.RentBalance))
+	}
+	return n
+}
+
+func sovRent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRent(x uint64) (n int) {
+	return sovRent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RentTopUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RentTopUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RentTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			m.SpendLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RentCharge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RentCharge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RentCharge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentBalance", wireType)
+			}
+			m.RentBalance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RentBalance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRent = fmt.Errorf("proto: unexpected end of group")
+)
@@ -27,6 +27,7 @@ func NewSettlementEntry(
 		AssetDenom:             assetDenom,
 		Quantity:               quantity,
 		ExecutionCostOrProceed: executionCostOrProceed,
+		Fee:                    sdk.ZeroDec(),
 		ExpectedCostOrProceed:  expectedCostOrProceed,
 		Account:                account,
 		OrderType:              GetContractOrderType(orderType),
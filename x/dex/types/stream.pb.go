@@ -0,0 +1,1487 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dex/stream.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SubscribeOrderBookRequest struct {
+	ContractAddr string `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contract_address"`
+	// only stream updates of this pair if both denoms are set
+	PriceDenom string `protobuf:"bytes,2,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom string `protobuf:"bytes,3,opt,name=assetDenom,proto3" json:"asset_denom"`
+	// first height to stream, which can be in the past to resume a subscription. Defaults to the
+	// next block if unset
+	StartHeight int64 `protobuf:"varint,4,opt,name=startHeight,proto3" json:"start_height"`
+}
+
+func (m *SubscribeOrderBookRequest) Reset()         { *m = SubscribeOrderBookRequest{} }
+func (m *SubscribeOrderBookRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeOrderBookRequest) ProtoMessage()    {}
+func (*SubscribeOrderBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930408297a610595, []int{0}
+}
+func (m *SubscribeOrderBookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeOrderBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeOrderBookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeOrderBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeOrderBookRequest.Merge(m, src)
+}
+func (m *SubscribeOrderBookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeOrderBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeOrderBookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeOrderBookRequest proto.InternalMessageInfo
+
+func (m *SubscribeOrderBookRequest) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *SubscribeOrderBookRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *SubscribeOrderBookRequest) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *SubscribeOrderBookRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// Sent for every block in which at least one of the subscribed pairs has changed
+type SubscribeOrderBookResponse struct {
+	Height       int64                  `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	ContractAddr string                 `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	Pairs        []*PairOrderBookUpdate `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs"`
+}
+
+func (m *SubscribeOrderBookResponse) Reset()         { *m = SubscribeOrderBookResponse{} }
+func (m *SubscribeOrderBookResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeOrderBookResponse) ProtoMessage()    {}
+func (*SubscribeOrderBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930408297a610595, []int{1}
+}
+func (m *SubscribeOrderBookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeOrderBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeOrderBookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeOrderBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeOrderBookResponse.Merge(m, src)
+}
+func (m *SubscribeOrderBookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeOrderBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeOrderBookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeOrderBookResponse proto.InternalMessageInfo
+
+func (m *SubscribeOrderBookResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SubscribeOrderBookResponse) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *SubscribeOrderBookResponse) GetPairs() []*PairOrderBookUpdate {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+type PairOrderBookUpdate struct {
+	PriceDenom string `protobuf:"bytes,1,opt,name=priceDenom,proto3" json:"price_denom"`
+	AssetDenom string `protobuf:"bytes,2,opt,name=assetDenom,proto3" json:"asset_denom"`
+	// price levels whose total quantity has changed, with a zero quantity for removed levels
+	LongLevels  []*DepthLevel      `protobuf:"bytes,3,rep,name=longLevels,proto3" json:"long_levels"`
+	ShortLevels []*DepthLevel      `protobuf:"bytes,4,rep,name=shortLevels,proto3" json:"short_levels"`
+	Settlements []*SettlementEntry `protobuf:"bytes,5,rep,name=settlements,proto3" json:"settlements"`
+}
+
+func (m *PairOrderBookUpdate) Reset()         { *m = PairOrderBookUpdate{} }
+func (m *PairOrderBookUpdate) String() string { return proto.CompactTextString(m) }
+func (*PairOrderBookUpdate) ProtoMessage()    {}
+func (*PairOrderBookUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930408297a610595, []int{2}
+}
+func (m *PairOrderBookUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairOrderBookUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairOrderBookUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairOrderBookUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairOrderBookUpdate.Merge(m, src)
+}
+func (m *PairOrderBookUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairOrderBookUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairOrderBookUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairOrderBookUpdate proto.InternalMessageInfo
+
+func (m *PairOrderBookUpdate) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *PairOrderBookUpdate) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *PairOrderBookUpdate) GetLongLevels() []*DepthLevel {
+	if m != nil {
+		return m.LongLevels
+	}
+	return nil
+}
+
+func (m *PairOrderBookUpdate) GetShortLevels() []*DepthLevel {
+	if m != nil {
+		return m.ShortLevels
+	}
+	return nil
+}
+
+func (m *PairOrderBookUpdate) GetSettlements() []*SettlementEntry {
+	if m != nil {
+		return m.Settlements
+	}
+	return nil
+}
+
+type DepthLevel struct {
+	Price    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	Quantity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=quantity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quantity" yaml:"quantity"`
+}
+
+func (m *DepthLevel) Reset()         { *m = DepthLevel{} }
+func (m *DepthLevel) String() string { return proto.CompactTextString(m) }
+func (*DepthLevel) ProtoMessage()    {}
+func (*DepthLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930408297a610595, []int{3}
+}
+func (m *DepthLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepthLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepthLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepthLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepthLevel.Merge(m, src)
+}
+func (m *DepthLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepthLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepthLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepthLevel proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SubscribeOrderBookRequest)(nil), "seiprotocol.seichain.dex.SubscribeOrderBookRequest")
+	proto.RegisterType((*SubscribeOrderBookResponse)(nil), "seiprotocol.seichain.dex.SubscribeOrderBookResponse")
+	proto.RegisterType((*PairOrderBookUpdate)(nil), "seiprotocol.seichain.dex.PairOrderBookUpdate")
+	proto.RegisterType((*DepthLevel)(nil), "seiprotocol.seichain.dex.DepthLevel")
+}
+
+func init() { proto.RegisterFile("dex/stream.proto", fileDescriptor_930408297a610595) }
+
+var fileDescriptor_930408297a610595 = []byte{
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd4, 0x3e,
+	0x1c, 0x3d, 0xf7, 0xda, 0xea, 0x5b, 0x5f, 0xa5, 0x56, 0xfe, 0x76, 0x08, 0x37, 0x24, 0x55, 0x84,
+	0x50, 0x19, 0x2e, 0x41, 0x2d, 0x03, 0x62, 0x23, 0x2a, 0x2a, 0x03, 0xbf, 0x94, 0xaa, 0x12, 0x42,
+	0xa0, 0x53, 0x2e, 0xf9, 0xe8, 0x62, 0x35, 0x89, 0x53, 0xdb, 0x87, 0x7a, 0x23, 0x0b, 0x13, 0x03,
+	0x7f, 0x56, 0xc5, 0xd4, 0x09, 0x21, 0x86, 0x08, 0xb5, 0xdb, 0xb1, 0xf5, 0x2f, 0x40, 0xb1, 0x93,
+	0xbb, 0x40, 0x7b, 0xc0, 0x4d, 0x8e, 0x9f, 0xdf, 0x7b, 0xf6, 0xe7, 0x7d, 0x62, 0xe3, 0xcd, 0x08,
+	0x4e, 0x5d, 0x21, 0x39, 0x04, 0xa9, 0x93, 0x73, 0x26, 0x19, 0x31, 0x04, 0x50, 0xf5, 0x15, 0xb2,
+	0xc4, 0x11, 0x40, 0xc3, 0x38, 0xa0, 0x99, 0x13, 0xc1, 0x69, 0x77, 0x6b, 0xc8, 0x86, 0x4c, 0x2d,
+	0xb9, 0xe5, 0x97, 0xe6, 0x77, 0xb7, 0x94, 0x03, 0x48, 0x99, 0x40, 0x0a, 0x99, 0xd4, 0xa8, 0xfd,
+	0x03, 0xe1, 0x5b, 0x87, 0xa3, 0x81, 0x08, 0x39, 0x1d, 0xc0, 0x0b, 0x1e, 0x01, 0xf7, 0x18, 0x3b,
+	0xf6, 0xe1, 0x64, 0x04, 0x42, 0x92, 0x07, 0x78, 0x3d, 0x64, 0x99, 0xe4, 0x41, 0x28, 0x1f, 0x45,
+	0x11, 0x37, 0xd0, 0x36, 0xda, 0x59, 0xf3, 0xb6, 0x26, 0x85, 0xb5, 0x59, 0xe3, 0xfd, 0x20, 0x8a,
+	0x38, 0x08, 0xe1, 0xff, 0xc2, 0x24, 0x2e, 0xc6, 0x39, 0xa7, 0x21, 0xec, 0x43, 0xc6, 0x52, 0x63,
+	0x49, 0xe9, 0x36, 0x26, 0x85, 0xd5, 0x51, 0x68, 0x3f, 0x2a, 0x61, 0xbf, 0x41, 0x29, 0x05, 0x81,
+	0x10, 0x20, 0xb5, 0xa0, 0x3d, 0x13, 0x28, 0xb4, 0x16, 0xcc, 0x28, 0x64, 0x17, 0x77, 0x84, 0x0c,
+	0xb8, 0x7c, 0x02, 0x74, 0x18, 0x4b, 0x63, 0x79, 0x1b, 0xed, 0xb4, 0xbd, 0xcd, 0x49, 0x61, 0xad,
+	0x2b, 0xb8, 0x1f, 0x2b, 0xdc, 0x6f, 0x92, 0xec, 0xcf, 0x08, 0x77, 0x6f, 0xaa, 0x56, 0xe4, 0x2c,
+	0x13, 0x40, 0x6c, 0xbc, 0xaa, 0x55, 0xaa, 0xd0, 0xb6, 0x87, 0x27, 0x85, 0x55, 0x21, 0x7e, 0x35,
+	0x5e, 0x8b, 0x64, 0xe9, 0x9f, 0x23, 0x79, 0x8e, 0x57, 0xf2, 0x80, 0x72, 0x61, 0xb4, 0xb7, 0xdb,
+	0x3b, 0x9d, 0xdd, 0x9e, 0x33, 0xaf, 0x81, 0xce, 0xcb, 0x80, 0xf2, 0xe9, 0xe9, 0x8e, 0xf2, 0x28,
+	0x90, 0xe0, 0xad, 0x4d, 0x0a, 0x4b, 0xeb, 0x7d, 0x3d, 0xd8, 0x1f, 0xda, 0xf8, 0xff, 0x1b, 0x98,
+	0xbf, 0x45, 0x8f, 0x16, 0x8d, 0x7e, 0xe9, 0xef, 0xd1, 0x1f, 0x61, 0x9c, 0xb0, 0x6c, 0xf8, 0x14,
+	0xde, 0x41, 0x52, 0x97, 0x73, 0x7b, 0x7e, 0x39, 0xfb, 0x90, 0xcb, 0x58, 0x91, 0xb5, 0x6d, 0xa9,
+	0xed, 0x27, 0x4a, 0xec, 0x37, 0x8c, 0xc8, 0x2b, 0xdc, 0x11, 0x31, 0xe3, 0xb2, 0xf2, 0x5d, 0x5e,
+	0xc0, 0x57, 0xf7, 0xbd, 0x14, 0xd7, 0xc6, 0x4d, 0x2b, 0xf2, 0x06, 0x77, 0x66, 0x7f, 0xbe, 0x30,
+	0x56, 0x94, 0xf3, 0xdd, 0xf9, 0xce, 0x87, 0x53, 0xf2, 0xe3, 0x4c, 0xf2, 0xb1, 0x3e, 0x76, 0xc3,
+	0xc1, 0x6f, 0x4e, 0xec, 0x2f, 0x08, 0xe3, 0xd9, 0x59, 0xc8, 0x5b, 0xbc, 0xa2, 0xc2, 0xad, 0xa2,
+	0x3f, 0x38, 0x2b, 0xac, 0xd6, 0xb7, 0xc2, 0xba, 0x33, 0xa4, 0x32, 0x1e, 0x0d, 0x9c, 0x90, 0xa5,
+	0x6e, 0xc8, 0x44, 0xca, 0x44, 0x35, 0xf4, 0x44, 0x74, 0xec, 0xca, 0x71, 0x0e, 0xc2, 0xd9, 0x87,
+	0x50, 0xb5, 0xb9, 0x94, 0x5f, 0x15, 0xd6, 0xfa, 0x38, 0x48, 0x93, 0x87, 0xb6, 0x9a, 0xda, 0xbe,
+	0x86, 0x09, 0xc5, 0xff, 0x9d, 0x8c, 0x82, 0x4c, 0x52, 0x39, 0xae, 0x7a, 0xf5, 0x6c, 0xe1, 0x1d,
+	0xa6, 0x0e, 0x57, 0x85, 0xb5, 0xa1, 0x37, 0xa9, 0x11, 0xdb, 0x9f, 0x2e, 0xee, 0x7e, 0x44, 0x78,
+	0xf5, 0x50, 0xbd, 0x39, 0xe4, 0x3d, 0xc2, 0xe4, 0xfa, 0xcd, 0x21, 0x7b, 0x7f, 0xc8, 0x70, 0xde,
+	0xab, 0xd2, 0xbd, 0xbf, 0x98, 0x48, 0x5f, 0xce, 0x7b, 0xc8, 0x3b, 0x38, 0xbb, 0x30, 0xd1, 0xf9,
+	0x85, 0x89, 0xbe, 0x5f, 0x98, 0xe8, 0xd3, 0xa5, 0xd9, 0x3a, 0xbf, 0x34, 0x5b, 0x5f, 0x2f, 0xcd,
+	0xd6, 0xeb, 0x5e, 0xa3, 0x72, 0x01, 0xb4, 0x57, 0x9b, 0xab, 0x89, 0x72, 0x77, 0x4f, 0xdd, 0xf2,
+	0xfd, 0x53, 0x21, 0x0c, 0x56, 0xd5, 0xfa, 0xde, 0xcf, 0x01, 0x00, 0xda, 0xf9, 0x99, 0x48, 0x55,
+	0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamClient interface {
+	// Pushes the depth changes and executed trades of a contract's pairs after each block
+	SubscribeOrderBook(ctx context.Context, in *SubscribeOrderBookRequest, opts ...grpc.CallOption) (Stream_SubscribeOrderBookClient, error)
+}
+
+type streamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamClient(cc grpc1.ClientConn) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) SubscribeOrderBook(ctx context.Context, in *SubscribeOrderBookRequest, opts ...grpc.CallOption) (Stream_SubscribeOrderBookClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Stream_serviceDesc.Streams[0], "/seiprotocol.seichain.dex.Stream/SubscribeOrderBook", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamSubscribeOrderBookClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stream_SubscribeOrderBookClient interface {
+	Recv() (*SubscribeOrderBookResponse, error)
+	grpc.ClientStream
+}
+
+type streamSubscribeOrderBookClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamSubscribeOrderBookClient) Recv() (*SubscribeOrderBookResponse, error) {
+	m := new(SubscribeOrderBookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+type StreamServer interface {
+	// Pushes the depth changes and executed trades of a contract's pairs after each block
+	SubscribeOrderBook(*SubscribeOrderBookRequest, Stream_SubscribeOrderBookServer) error
+}
+
+// UnimplementedStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (*UnimplementedStreamServer) SubscribeOrderBook(req *SubscribeOrderBookRequest, srv Stream_SubscribeOrderBookServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOrderBook not implemented")
+}
+
+func RegisterStreamServer(s grpc1.Server, srv StreamServer) {
+	s.RegisterService(&_Stream_serviceDesc, srv)
+}
+
+func _Stream_SubscribeOrderBook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeOrderBookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServer).SubscribeOrderBook(m, &streamSubscribeOrderBookServer{stream})
+}
+
+type Stream_SubscribeOrderBookServer interface {
+	Send(*SubscribeOrderBookResponse) error
+	grpc.ServerStream
+}
+
+type streamSubscribeOrderBookServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamSubscribeOrderBookServer) Send(m *SubscribeOrderBookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Stream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seiprotocol.seichain.dex.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeOrderBook",
+			Handler:       _Stream_SubscribeOrderBook_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "dex/stream.proto",
+}
+
+func (m *SubscribeOrderBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeOrderBookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeOrderBookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeOrderBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairOrderBookUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairOrderBookUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairOrderBookUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ShortLevels) > 0 {
+		for iNdEx := len(m.ShortLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShortLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LongLevels) > 0 {
+		for iNdEx := len(m.LongLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LongLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepthLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepthLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepthLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeOrderBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovStream(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *SubscribeOrderBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStream(uint64(m.Height))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairOrderBookUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if len(m.LongLevels) > 0 {
+		for _, e := range m.LongLevels {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if len(m.ShortLevels) > 0 {
+		for _, e := range m.ShortLevels {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DepthLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovStream(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovStream(uint64(l))
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeOrderBookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeOrderBookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeOrderBookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeOrderBookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeOrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, &PairOrderBookUpdate{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairOrderBookUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairOrderBookUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairOrderBookUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongLevels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LongLevels = append(m.LongLevels, &DepthLevel{})
+			if err := m.LongLevels[len(m.LongLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortLevels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortLevels = append(m.ShortLevels, &DepthLevel{})
+			if err := m.ShortLevels[len(m.ShortLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlements = append(m.Settlements, &SettlementEntry{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepthLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepthLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepthLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
@@ -238,6 +238,224 @@ func (m *MsgCancelOrdersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelOrdersResponse proto.InternalMessageInfo
 
+type MsgCancelAll struct {
+	Creator      string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator"`
+	ContractAddr string           `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	Filter       *CancelAllFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter"`
+}
+
+func (m *MsgCancelAll) Reset()         { *m = MsgCancelAll{} }
+func (m *MsgCancelAll) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAll) ProtoMessage()    {}
+func (*MsgCancelAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{4}
+}
+func (m *MsgCancelAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAll.Merge(m, src)
+}
+func (m *MsgCancelAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAll proto.InternalMessageInfo
+
+func (m *MsgCancelAll) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelAll) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *MsgCancelAll) GetFilter() *CancelAllFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type MsgCancelAllResponse struct {
+}
+
+func (m *MsgCancelAllResponse) Reset()         { *m = MsgCancelAllResponse{} }
+func (m *MsgCancelAllResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAllResponse) ProtoMessage()    {}
+func (*MsgCancelAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{5}
+}
+func (m *MsgCancelAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAllResponse.Merge(m, src)
+}
+func (m *MsgCancelAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAllResponse proto.InternalMessageInfo
+
+// Cancels the given orders and places the new ones in the same block. A new limit order keeps the
+// queue position of a cancelled order at the same price if it doesn't increase the quantity.
+type MsgReplaceOrders struct {
+	Creator       string                                   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator"`
+	ContractAddr  string                                   `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	Cancellations []*Cancellation                          `protobuf:"bytes,3,rep,name=cancellations,proto3" json:"cancellations"`
+	Orders        []*Order                                 `protobuf:"bytes,4,rep,name=orders,proto3" json:"orders"`
+	Funds         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
+}
+
+func (m *MsgReplaceOrders) Reset()         { *m = MsgReplaceOrders{} }
+func (m *MsgReplaceOrders) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrders) ProtoMessage()    {}
+func (*MsgReplaceOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{6}
+}
+func (m *MsgReplaceOrders) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceOrders.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrders.Merge(m, src)
+}
+func (m *MsgReplaceOrders) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceOrders proto.InternalMessageInfo
+
+func (m *MsgReplaceOrders) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgReplaceOrders) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *MsgReplaceOrders) GetCancellations() []*Cancellation {
+	if m != nil {
+		return m.Cancellations
+	}
+	return nil
+}
+
+func (m *MsgReplaceOrders) GetOrders() []*Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *MsgReplaceOrders) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+type MsgReplaceOrdersResponse struct {
+	OrderIds []uint64 `protobuf:"varint,1,rep,packed,name=orderIds,proto3" json:"order_ids" yaml:"order_ids"`
+}
+
+func (m *MsgReplaceOrdersResponse) Reset()         { *m = MsgReplaceOrdersResponse{} }
+func (m *MsgReplaceOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrdersResponse) ProtoMessage()    {}
+func (*MsgReplaceOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{7}
+}
+func (m *MsgReplaceOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrdersResponse.Merge(m, src)
+}
+func (m *MsgReplaceOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceOrdersResponse proto.InternalMessageInfo
+
+func (m *MsgReplaceOrdersResponse) GetOrderIds() []uint64 {
+	if m != nil {
+		return m.OrderIds
+	}
+	return nil
+}
+
 type MsgRegisterContract struct {
 	Creator  string          `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Contract *ContractInfoV2 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
@@ -247,7 +465,7 @@ func (m *MsgRegisterContract) Reset()         { *m = MsgRegisterContract{} }
 func (m *MsgRegisterContract) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterContract) ProtoMessage()    {}
 func (*MsgRegisterContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{4}
+	return fileDescriptor_463701e671e5a5e0, []int{8}
 }
 func (m *MsgRegisterContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +515,7 @@ func (m *MsgRegisterContractResponse) Reset()         { *m = MsgRegisterContract
 func (m *MsgRegisterContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterContractResponse) ProtoMessage()    {}
 func (*MsgRegisterContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{5}
+	return fileDescriptor_463701e671e5a5e0, []int{9}
 }
 func (m *MsgRegisterContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +554,7 @@ func (m *MsgContractDepositRent) Reset()         { *m = MsgContractDepositRent{}
 func (m *MsgContractDepositRent) String() string { return proto.CompactTextString(m) }
 func (*MsgContractDepositRent) ProtoMessage()    {}
 func (*MsgContractDepositRent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{6}
+	return fileDescriptor_463701e671e5a5e0, []int{10}
 }
 func (m *MsgContractDepositRent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +611,7 @@ func (m *MsgContractDepositRentResponse) Reset()         { *m = MsgContractDepos
 func (m *MsgContractDepositRentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgContractDepositRentResponse) ProtoMessage()    {}
 func (*MsgContractDepositRentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{7}
+	return fileDescriptor_463701e671e5a5e0, []int{11}
 }
 func (m *MsgContractDepositRentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +649,7 @@ func (m *MsgUnregisterContract) Reset()         { *m = MsgUnregisterContract{} }
 func (m *MsgUnregisterContract) String() string { return proto.CompactTextString(m) }
 func (*MsgUnregisterContract) ProtoMessage()    {}
 func (*MsgUnregisterContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{8}
+	return fileDescriptor_463701e671e5a5e0, []int{12}
 }
 func (m *MsgUnregisterContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +699,7 @@ func (m *MsgUnregisterContractResponse) Reset()         { *m = MsgUnregisterCont
 func (m *MsgUnregisterContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnregisterContractResponse) ProtoMessage()    {}
 func (*MsgUnregisterContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{9}
+	return fileDescriptor_463701e671e5a5e0, []int{13}
 }
 func (m *MsgUnregisterContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +737,7 @@ func (m *MsgRegisterPairs) Reset()         { *m = MsgRegisterPairs{} }
 func (m *MsgRegisterPairs) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterPairs) ProtoMessage()    {}
 func (*MsgRegisterPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{10}
+	return fileDescriptor_463701e671e5a5e0, []int{14}
 }
 func (m *MsgRegisterPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +787,7 @@ func (m *MsgRegisterPairsResponse) Reset()         { *m = MsgRegisterPairsRespon
 func (m *MsgRegisterPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterPairsResponse) ProtoMessage()    {}
 func (*MsgRegisterPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{11}
+	return fileDescriptor_463701e671e5a5e0, []int{15}
 }
 func (m *MsgRegisterPairsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +825,7 @@ func (m *MsgUpdatePriceTickSize) Reset()         { *m = MsgUpdatePriceTickSize{}
 func (m *MsgUpdatePriceTickSize) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdatePriceTickSize) ProtoMessage()    {}
 func (*MsgUpdatePriceTickSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{12}
+	return fileDescriptor_463701e671e5a5e0, []int{16}
 }
 func (m *MsgUpdatePriceTickSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +877,7 @@ func (m *MsgUpdateQuantityTickSize) Reset()         { *m = MsgUpdateQuantityTick
 func (m *MsgUpdateQuantityTickSize) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateQuantityTickSize) ProtoMessage()    {}
 func (*MsgUpdateQuantityTickSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{13}
+	return fileDescriptor_463701e671e5a5e0, []int{17}
 }
 func (m *MsgUpdateQuantityTickSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +927,7 @@ func (m *MsgUpdateTickSizeResponse) Reset()         { *m = MsgUpdateTickSizeResp
 func (m *MsgUpdateTickSizeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateTickSizeResponse) ProtoMessage()    {}
 func (*MsgUpdateTickSizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{14}
+	return fileDescriptor_463701e671e5a5e0, []int{18}
 }
 func (m *MsgUpdateTickSizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,7 +965,7 @@ func (m *MsgUnsuspendContract) Reset()         { *m = MsgUnsuspendContract{} }
 func (m *MsgUnsuspendContract) String() string { return proto.CompactTextString(m) }
 func (*MsgUnsuspendContract) ProtoMessage()    {}
 func (*MsgUnsuspendContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{15}
+	return fileDescriptor_463701e671e5a5e0, []int{19}
 }
 func (m *MsgUnsuspendContract) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +1015,7 @@ func (m *MsgUnsuspendContractResponse) Reset()         { *m = MsgUnsuspendContra
 func (m *MsgUnsuspendContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnsuspendContractResponse) ProtoMessage()    {}
 func (*MsgUnsuspendContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_463701e671e5a5e0, []int{16}
+	return fileDescriptor_463701e671e5a5e0, []int{20}
 }
 func (m *MsgUnsuspendContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,144 +1044,409 @@ func (m *MsgUnsuspendContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnsuspendContractResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgPlaceOrders)(nil), "seiprotocol.seichain.dex.MsgPlaceOrders")
-	proto.RegisterType((*MsgPlaceOrdersResponse)(nil), "seiprotocol.seichain.dex.MsgPlaceOrdersResponse")
-	proto.RegisterType((*MsgCancelOrders)(nil), "seiprotocol.seichain.dex.MsgCancelOrders")
-	proto.RegisterType((*MsgCancelOrdersResponse)(nil), "seiprotocol.seichain.dex.MsgCancelOrdersResponse")
-	proto.RegisterType((*MsgRegisterContract)(nil), "seiprotocol.seichain.dex.MsgRegisterContract")
-	proto.RegisterType((*MsgRegisterContractResponse)(nil), "seiprotocol.seichain.dex.MsgRegisterContractResponse")
-	proto.RegisterType((*MsgContractDepositRent)(nil), "seiprotocol.seichain.dex.MsgContractDepositRent")
-	proto.RegisterType((*MsgContractDepositRentResponse)(nil), "seiprotocol.seichain.dex.MsgContractDepositRentResponse")
-	proto.RegisterType((*MsgUnregisterContract)(nil), "seiprotocol.seichain.dex.MsgUnregisterContract")
-	proto.RegisterType((*MsgUnregisterContractResponse)(nil), "seiprotocol.seichain.dex.MsgUnregisterContractResponse")
-	proto.RegisterType((*MsgRegisterPairs)(nil), "seiprotocol.seichain.dex.MsgRegisterPairs")
-	proto.RegisterType((*MsgRegisterPairsResponse)(nil), "seiprotocol.seichain.dex.MsgRegisterPairsResponse")
-	proto.RegisterType((*MsgUpdatePriceTickSize)(nil), "seiprotocol.seichain.dex.MsgUpdatePriceTickSize")
-	proto.RegisterType((*MsgUpdateQuantityTickSize)(nil), "seiprotocol.seichain.dex.MsgUpdateQuantityTickSize")
-	proto.RegisterType((*MsgUpdateTickSizeResponse)(nil), "seiprotocol.seichain.dex.MsgUpdateTickSizeResponse")
-	proto.RegisterType((*MsgUnsuspendContract)(nil), "seiprotocol.seichain.dex.MsgUnsuspendContract")
-	proto.RegisterType((*MsgUnsuspendContractResponse)(nil), "seiprotocol.seichain.dex.MsgUnsuspendContractResponse")
+// MsgSetRentTopUp is signed by the funding account. A spend limit of 0 removes the top-up.
+type MsgSetRentTopUp struct {
+	Sender       string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender"`
+	ContractAddr string `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	Threshold    uint64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold"`
+	Amount       uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount"`
+	SpendLimit   uint64 `protobuf:"varint,5,opt,name=spendLimit,proto3" json:"spend_limit"`
 }
 
-func init() { proto.RegisterFile("dex/tx.proto", fileDescriptor_463701e671e5a5e0) }
-
-var fileDescriptor_463701e671e5a5e0 = []byte{
-	// 962 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x9b, 0x52, 0xba, 0xaf, 0xdd, 0x6e, 0xeb, 0x74, 0x17, 0xd7, 0xcb, 0xda, 0xc1, 0x12,
-	0x28, 0x80, 0x6a, 0xd3, 0xac, 0x80, 0x05, 0x89, 0x03, 0xc9, 0x4a, 0x68, 0x25, 0x22, 0x8a, 0x61,
-	0x41, 0xe2, 0x12, 0x39, 0xf6, 0xac, 0x3b, 0x34, 0xf5, 0x44, 0x9e, 0x09, 0x9b, 0x2e, 0x08, 0x09,
-	0x7e, 0x01, 0x07, 0x4e, 0x1c, 0x39, 0xf2, 0x0b, 0x90, 0x38, 0x72, 0xd9, 0x0b, 0xd2, 0x4a, 0x5c,
-	0x38, 0x19, 0xd4, 0xde, 0x72, 0xec, 0x2f, 0x40, 0x1e, 0xdb, 0x43, 0xec, 0x38, 0xc1, 0xd9, 0x03,
-	0x12, 0x27, 0x8f, 0x5f, 0xde, 0xf7, 0xbe, 0xf7, 0xbe, 0x37, 0xf3, 0xc6, 0x81, 0x2d, 0x0f, 0x8d,
-	0x2d, 0x36, 0x36, 0x87, 0x21, 0x61, 0x44, 0x56, 0x28, 0xc2, 0x7c, 0xe5, 0x92, 0x81, 0x49, 0x11,
-	0x76, 0x8f, 0x1d, 0x1c, 0x98, 0x1e, 0x1a, 0xab, 0x9a, 0x4b, 0xe8, 0x29, 0xa1, 0x56, 0xdf, 0xa1,
-	0xc8, 0xfa, 0xe2, 0xb0, 0x8f, 0x98, 0x73, 0x68, 0xb9, 0x04, 0x07, 0x09, 0x52, 0xdd, 0xf3, 0x89,
-	0x4f, 0xf8, 0xd2, 0x8a, 0x57, 0xa9, 0x55, 0x8e, 0xa3, 0xbb, 0x24, 0x60, 0xa1, 0xe3, 0xb2, 0xd4,
-	0x76, 0x2d, 0xb6, 0x91, 0xd0, 0x43, 0x61, 0x6a, 0xd8, 0x8e, 0x0d, 0x43, 0x07, 0x67, 0xef, 0x75,
-	0x9e, 0x12, 0x76, 0x4f, 0x7a, 0x14, 0x3f, 0x42, 0x89, 0xd1, 0xf8, 0x71, 0x15, 0xb6, 0xbb, 0xd4,
-	0x3f, 0x1a, 0x38, 0x2e, 0xfa, 0x20, 0x06, 0x53, 0xf9, 0x45, 0x78, 0xd6, 0x0d, 0x91, 0xc3, 0x48,
-	0xa8, 0x48, 0x0d, 0xa9, 0x79, 0xa5, 0xbd, 0x39, 0x89, 0xf4, 0xcc, 0x64, 0x67, 0x0b, 0xb9, 0x03,
-	0xeb, 0x9c, 0x8d, 0x2a, 0xab, 0x8d, 0x5a, 0x73, 0xb3, 0xa5, 0x9b, 0xf3, 0x8a, 0x34, 0x79, 0xe0,
-	0x36, 0x4c, 0x22, 0x3d, 0x85, 0xd8, 0xe9, 0x53, 0xbe, 0x03, 0x5b, 0x59, 0x19, 0xef, 0x7a, 0x5e,
-	0xa8, 0xd4, 0x38, 0xe1, 0xde, 0x24, 0xd2, 0x77, 0x32, 0x7b, 0xcf, 0xf1, 0xbc, 0x10, 0x51, 0x6a,
-	0xe7, 0x3c, 0xe5, 0xcf, 0xe1, 0x99, 0x07, 0xa3, 0xc0, 0xa3, 0xca, 0x1a, 0x67, 0xdf, 0x37, 0x13,
-	0x21, 0xcd, 0x58, 0x48, 0x33, 0x15, 0xd2, 0xec, 0x10, 0x1c, 0xb4, 0xdf, 0x7a, 0x1c, 0xe9, 0x2b,
-	0x93, 0x48, 0x4f, 0xfc, 0x7f, 0xfa, 0x53, 0x6f, 0xfa, 0x98, 0x1d, 0x8f, 0xfa, 0xa6, 0x4b, 0x4e,
-	0xad, 0x54, 0xfe, 0xe4, 0x71, 0x40, 0xbd, 0x13, 0x8b, 0x9d, 0x0d, 0x11, 0xe5, 0x48, 0x6a, 0x27,
-	0x10, 0xe3, 0x53, 0xb8, 0x91, 0xd7, 0xc8, 0x46, 0x74, 0x48, 0x02, 0x8a, 0xe4, 0x77, 0x60, 0x83,
-	0x57, 0x72, 0xcf, 0xa3, 0x8a, 0xd4, 0xa8, 0x35, 0xd7, 0xda, 0x2f, 0x4c, 0x22, 0xfd, 0x0a, 0xb7,
-	0xf5, 0xb0, 0x47, 0x2f, 0x23, 0x7d, 0xe7, 0xcc, 0x39, 0x1d, 0xbc, 0x6d, 0x08, 0x93, 0x61, 0x0b,
-	0x88, 0xf1, 0xbb, 0x04, 0xd7, 0xba, 0xd4, 0xef, 0x38, 0x81, 0x8b, 0x06, 0xcb, 0xc9, 0xdf, 0x83,
-	0xab, 0x2e, 0x87, 0x0d, 0x1c, 0x86, 0x49, 0x90, 0x75, 0xe1, 0xa5, 0xf9, 0x5d, 0xe8, 0x4c, 0xb9,
-	0xb7, 0x77, 0x27, 0x91, 0x9e, 0x0f, 0x60, 0xe7, 0x5f, 0x9f, 0xbe, 0x35, 0xc6, 0x3e, 0x3c, 0x57,
-	0x28, 0x2a, 0xd3, 0xcb, 0x18, 0x41, 0xbd, 0x4b, 0x7d, 0x1b, 0xf9, 0x98, 0x32, 0x14, 0x76, 0x52,
-	0x94, 0xac, 0x14, 0x6a, 0xfe, 0xa7, 0xcc, 0xbb, 0xb0, 0x91, 0xc5, 0x56, 0x56, 0x1b, 0x52, 0x73,
-	0xb3, 0xd5, 0x5c, 0x50, 0x61, 0xea, 0x79, 0x2f, 0x78, 0x40, 0x3e, 0x69, 0xd9, 0x02, 0x69, 0xdc,
-	0x82, 0x9b, 0x25, 0xb4, 0x22, 0xab, 0x1f, 0x24, 0xde, 0xe0, 0xcc, 0x7e, 0x17, 0x0d, 0x09, 0xc5,
-	0xcc, 0x46, 0x01, 0x9b, 0x51, 0x41, 0xaa, 0xbc, 0x41, 0x0d, 0x58, 0x77, 0x4e, 0xc9, 0x28, 0x48,
-	0xf2, 0x5e, 0x4b, 0xb6, 0x7f, 0x62, 0xb1, 0xd3, 0x67, 0xec, 0x43, 0x51, 0xe0, 0xa1, 0x4c, 0x5d,
-	0xee, 0x93, 0x58, 0xec, 0xf4, 0x69, 0x34, 0x40, 0x2b, 0xcf, 0x4d, 0xa4, 0x3f, 0x86, 0xeb, 0x5d,
-	0xea, 0xdf, 0x0f, 0xc2, 0xa2, 0xac, 0x15, 0xb7, 0x52, 0xb1, 0xc6, 0xd5, 0xca, 0x9d, 0xd6, 0xe1,
-	0x56, 0x29, 0xb3, 0x48, 0xed, 0x57, 0x09, 0x76, 0xa6, 0x94, 0x3f, 0x72, 0x70, 0x48, 0x17, 0x74,
-	0xfb, 0x7b, 0x09, 0x76, 0xfb, 0x0e, 0x73, 0x8f, 0x33, 0x96, 0x78, 0x7c, 0x29, 0x35, 0xbe, 0xb3,
-	0x5f, 0x9d, 0xdf, 0xf7, 0x76, 0x0c, 0xc9, 0xb8, 0x63, 0x0e, 0x71, 0xe6, 0xeb, 0x3c, 0x5a, 0x4f,
-	0x94, 0x11, 0xc7, 0xbb, 0x8c, 0x74, 0x35, 0x39, 0x93, 0x25, 0x3f, 0x1a, 0xf6, 0x6c, 0x02, 0x86,
-	0x0a, 0x4a, 0xb1, 0x08, 0x51, 0xe1, 0xcf, 0xc9, 0xde, 0xb9, 0x3f, 0xf4, 0x1c, 0x86, 0x8e, 0x42,
-	0xec, 0xa2, 0x8f, 0xb1, 0x7b, 0xf2, 0x11, 0x7e, 0x84, 0xaa, 0xca, 0xff, 0x10, 0xb6, 0x58, 0x0a,
-	0x79, 0x1f, 0x53, 0x96, 0x1e, 0x64, 0x63, 0x7e, 0xb9, 0x19, 0x41, 0xdb, 0x4a, 0xab, 0xdc, 0x16,
-	0x53, 0xbd, 0x37, 0xc0, 0x94, 0x5d, 0x46, 0xfa, 0xf5, 0xa4, 0xc0, 0xbc, 0xdd, 0xb0, 0x73, 0x44,
-	0xc6, 0x2f, 0x12, 0xec, 0x8b, 0xd4, 0x3f, 0x1c, 0x39, 0x01, 0xc3, 0xec, 0xec, 0x7f, 0x93, 0xfd,
-	0xcd, 0xa9, 0xe4, 0xb3, 0x98, 0xa2, 0x2b, 0x0f, 0x61, 0x8f, 0x6f, 0x4c, 0x3a, 0xa2, 0x43, 0x14,
-	0x78, 0xff, 0xdd, 0x89, 0xd0, 0xe0, 0xf9, 0x32, 0xe2, 0x2c, 0xb1, 0xd6, 0x6f, 0x1b, 0x50, 0xeb,
-	0x52, 0x5f, 0xc6, 0xb0, 0x39, 0x7d, 0xe7, 0x2e, 0x18, 0x6a, 0xf9, 0x9b, 0x47, 0x7d, 0xad, 0xaa,
-	0xa7, 0xb8, 0xa3, 0x06, 0xb0, 0x95, 0xbb, 0x60, 0x5e, 0x5e, 0x18, 0x61, 0xda, 0x55, 0x3d, 0xac,
-	0xec, 0x2a, 0xd8, 0xc6, 0xb0, 0x33, 0x33, 0xde, 0x0f, 0x16, 0x86, 0x29, 0xba, 0xab, 0xaf, 0x2f,
-	0xe5, 0x2e, 0x98, 0xbf, 0x91, 0xa0, 0x5e, 0x36, 0xc2, 0x17, 0x2b, 0x56, 0x82, 0x50, 0xef, 0x2c,
-	0x8b, 0x10, 0x39, 0x7c, 0x0d, 0x72, 0xc9, 0x1c, 0xb6, 0x16, 0xc6, 0x9b, 0x05, 0xa8, 0x6f, 0x2e,
-	0x09, 0x10, 0xfc, 0x04, 0xae, 0xe6, 0x67, 0xed, 0x2b, 0x95, 0xb4, 0xe4, 0xbe, 0x6a, 0xab, 0xba,
-	0xaf, 0x20, 0xfc, 0x0a, 0xea, 0x65, 0xa3, 0x6f, 0xb1, 0xe6, 0x25, 0x08, 0xf5, 0x76, 0x05, 0x44,
-	0xf1, 0x98, 0xcb, 0xdf, 0x4a, 0x70, 0x63, 0xce, 0xf8, 0xaa, 0x12, 0xaf, 0x08, 0x7a, 0xba, 0x24,
-	0xbe, 0x84, 0xdd, 0xd9, 0x41, 0x63, 0xfe, 0x4b, 0x07, 0x0b, 0xfe, 0xea, 0x1b, 0xcb, 0xf9, 0x67,
-	0xe4, 0xed, 0xf7, 0x1e, 0x9f, 0x6b, 0xd2, 0x93, 0x73, 0x4d, 0xfa, 0xeb, 0x5c, 0x93, 0xbe, 0xbb,
-	0xd0, 0x56, 0x9e, 0x5c, 0x68, 0x2b, 0x7f, 0x5c, 0x68, 0x2b, 0x9f, 0x1d, 0x4c, 0x7d, 0xe5, 0x52,
-	0x84, 0x0f, 0xb2, 0xe0, 0xfc, 0x85, 0x47, 0xb7, 0xc6, 0x16, 0xff, 0x4b, 0x10, 0x7f, 0xf0, 0xf6,
-	0xd7, 0xf9, 0xef, 0xb7, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x87, 0xb0, 0xd3, 0x48, 0xb9, 0x0c,
-	0x00, 0x00,
+func (m *MsgSetRentTopUp) Reset()         { *m = MsgSetRentTopUp{} }
+func (m *MsgSetRentTopUp) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRentTopUp) ProtoMessage()    {}
+func (*MsgSetRentTopUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{21}
+}
+func (m *MsgSetRentTopUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRentTopUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRentTopUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRentTopUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRentTopUp.Merge(m, src)
+}
+func (m *MsgSetRentTopUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRentTopUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRentTopUp.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetRentTopUp proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	PlaceOrders(ctx context.Context, in *MsgPlaceOrders, opts ...grpc.CallOption) (*MsgPlaceOrdersResponse, error)
-	CancelOrders(ctx context.Context, in *MsgCancelOrders, opts ...grpc.CallOption) (*MsgCancelOrdersResponse, error)
-	RegisterContract(ctx context.Context, in *MsgRegisterContract, opts ...grpc.CallOption) (*MsgRegisterContractResponse, error)
-	ContractDepositRent(ctx context.Context, in *MsgContractDepositRent, opts ...grpc.CallOption) (*MsgContractDepositRentResponse, error)
-	UnregisterContract(ctx context.Context, in *MsgUnregisterContract, opts ...grpc.CallOption) (*MsgUnregisterContractResponse, error)
-	RegisterPairs(ctx context.Context, in *MsgRegisterPairs, opts ...grpc.CallOption) (*MsgRegisterPairsResponse, error)
-	UpdatePriceTickSize(ctx context.Context, in *MsgUpdatePriceTickSize, opts ...grpc.CallOption) (*MsgUpdateTickSizeResponse, error)
-	UpdateQuantityTickSize(ctx context.Context, in *MsgUpdateQuantityTickSize, opts ...grpc.CallOption) (*MsgUpdateTickSizeResponse, error)
-	UnsuspendContract(ctx context.Context, in *MsgUnsuspendContract, opts ...grpc.CallOption) (*MsgUnsuspendContractResponse, error)
+func (m *MsgSetRentTopUp) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetRentTopUp) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetRentTopUp) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
 }
 
-func (c *msgClient) PlaceOrders(ctx context.Context, in *MsgPlaceOrders, opts ...grpc.CallOption) (*MsgPlaceOrdersResponse, error) {
-	out := new(MsgPlaceOrdersResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/PlaceOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRentTopUp) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) CancelOrders(ctx context.Context, in *MsgCancelOrders, opts ...grpc.CallOption) (*MsgCancelOrdersResponse, error) {
-	out := new(MsgCancelOrdersResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/CancelOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRentTopUp) GetSpendLimit() uint64 {
+	if m != nil {
+		return m.SpendLimit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RegisterContract(ctx context.Context, in *MsgRegisterContract, opts ...grpc.CallOption) (*MsgRegisterContractResponse, error) {
-	out := new(MsgRegisterContractResponse)
+type MsgSetRentTopUpResponse struct {
+}
+
+func (m *MsgSetRentTopUpResponse) Reset()         { *m = MsgSetRentTopUpResponse{} }
+func (m *MsgSetRentTopUpResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRentTopUpResponse) ProtoMessage()    {}
+func (*MsgSetRentTopUpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{22}
+}
+func (m *MsgSetRentTopUpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRentTopUpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRentTopUpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRentTopUpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRentTopUpResponse.Merge(m, src)
+}
+func (m *MsgSetRentTopUpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRentTopUpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRentTopUpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRentTopUpResponse proto.InternalMessageInfo
+
+// MsgSetPairHalt halts or resumes trading of a pair. It can only be sent by the contract's creator.
+type MsgSetPairHalt struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator"`
+	ContractAddr string `protobuf:"bytes,2,opt,name=contractAddr,proto3" json:"contract_address"`
+	Pair         *Pair  `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair"`
+	Halted       bool   `protobuf:"varint,4,opt,name=halted,proto3" json:"halted"`
+}
+
+func (m *MsgSetPairHalt) Reset()         { *m = MsgSetPairHalt{} }
+func (m *MsgSetPairHalt) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPairHalt) ProtoMessage()    {}
+func (*MsgSetPairHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{23}
+}
+func (m *MsgSetPairHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPairHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPairHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPairHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPairHalt.Merge(m, src)
+}
+func (m *MsgSetPairHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPairHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPairHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPairHalt proto.InternalMessageInfo
+
+func (m *MsgSetPairHalt) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetPairHalt) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *MsgSetPairHalt) GetPair() *Pair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *MsgSetPairHalt) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+type MsgSetPairHaltResponse struct {
+}
+
+func (m *MsgSetPairHaltResponse) Reset()         { *m = MsgSetPairHaltResponse{} }
+func (m *MsgSetPairHaltResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPairHaltResponse) ProtoMessage()    {}
+func (*MsgSetPairHaltResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_463701e671e5a5e0, []int{24}
+}
+func (m *MsgSetPairHaltResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPairHaltResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPairHaltResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPairHaltResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPairHaltResponse.Merge(m, src)
+}
+func (m *MsgSetPairHaltResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPairHaltResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPairHaltResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPairHaltResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgPlaceOrders)(nil), "seiprotocol.seichain.dex.MsgPlaceOrders")
+	proto.RegisterType((*MsgPlaceOrdersResponse)(nil), "seiprotocol.seichain.dex.MsgPlaceOrdersResponse")
+	proto.RegisterType((*MsgCancelOrders)(nil), "seiprotocol.seichain.dex.MsgCancelOrders")
+	proto.RegisterType((*MsgCancelOrdersResponse)(nil), "seiprotocol.seichain.dex.MsgCancelOrdersResponse")
+	proto.RegisterType((*MsgCancelAll)(nil), "seiprotocol.seichain.dex.MsgCancelAll")
+	proto.RegisterType((*MsgCancelAllResponse)(nil), "seiprotocol.seichain.dex.MsgCancelAllResponse")
+	proto.RegisterType((*MsgReplaceOrders)(nil), "seiprotocol.seichain.dex.MsgReplaceOrders")
+	proto.RegisterType((*MsgReplaceOrdersResponse)(nil), "seiprotocol.seichain.dex.MsgReplaceOrdersResponse")
+	proto.RegisterType((*MsgRegisterContract)(nil), "seiprotocol.seichain.dex.MsgRegisterContract")
+	proto.RegisterType((*MsgRegisterContractResponse)(nil), "seiprotocol.seichain.dex.MsgRegisterContractResponse")
+	proto.RegisterType((*MsgContractDepositRent)(nil), "seiprotocol.seichain.dex.MsgContractDepositRent")
+	proto.RegisterType((*MsgContractDepositRentResponse)(nil), "seiprotocol.seichain.dex.MsgContractDepositRentResponse")
+	proto.RegisterType((*MsgUnregisterContract)(nil), "seiprotocol.seichain.dex.MsgUnregisterContract")
+	proto.RegisterType((*MsgUnregisterContractResponse)(nil), "seiprotocol.seichain.dex.MsgUnregisterContractResponse")
+	proto.RegisterType((*MsgRegisterPairs)(nil), "seiprotocol.seichain.dex.MsgRegisterPairs")
+	proto.RegisterType((*MsgRegisterPairsResponse)(nil), "seiprotocol.seichain.dex.MsgRegisterPairsResponse")
+	proto.RegisterType((*MsgUpdatePriceTickSize)(nil), "seiprotocol.seichain.dex.MsgUpdatePriceTickSize")
+	proto.RegisterType((*MsgUpdateQuantityTickSize)(nil), "seiprotocol.seichain.dex.MsgUpdateQuantityTickSize")
+	proto.RegisterType((*MsgUpdateTickSizeResponse)(nil), "seiprotocol.seichain.dex.MsgUpdateTickSizeResponse")
+	proto.RegisterType((*MsgUnsuspendContract)(nil), "seiprotocol.seichain.dex.MsgUnsuspendContract")
+	proto.RegisterType((*MsgUnsuspendContractResponse)(nil), "seiprotocol.seichain.dex.MsgUnsuspendContractResponse")
+	proto.RegisterType((*MsgSetRentTopUp)(nil), "seiprotocol.seichain.dex.MsgSetRentTopUp")
+	proto.RegisterType((*MsgSetRentTopUpResponse)(nil), "seiprotocol.seichain.dex.MsgSetRentTopUpResponse")
+	proto.RegisterType((*MsgSetPairHalt)(nil), "seiprotocol.seichain.dex.MsgSetPairHalt")
+	proto.RegisterType((*MsgSetPairHaltResponse)(nil), "seiprotocol.seichain.dex.MsgSetPairHaltResponse")
+}
+
+func init() { proto.RegisterFile("dex/tx.proto", fileDescriptor_463701e671e5a5e0) }
+
+var fileDescriptor_463701e671e5a5e0 = []byte{
+	// 1244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
+	0x1b, 0x8f, 0xbb, 0xdb, 0xbc, 0xc9, 0xb3, 0x69, 0x3e, 0x9c, 0x34, 0xef, 0xc6, 0xa5, 0xeb, 0x60,
+	0x89, 0x6a, 0xdb, 0x2a, 0x76, 0xb3, 0x15, 0x50, 0x10, 0x1c, 0xb2, 0xa9, 0x80, 0x4a, 0x5d, 0x11,
+	0x9c, 0x06, 0x04, 0x97, 0x95, 0x63, 0x4f, 0x76, 0x87, 0x38, 0xf6, 0xca, 0x33, 0x4b, 0x37, 0x05,
+	0x21, 0x81, 0xc4, 0x9d, 0x03, 0x27, 0x8e, 0x1c, 0xf9, 0x0b, 0x90, 0x90, 0xb8, 0x70, 0xe9, 0xb1,
+	0x52, 0x2f, 0x9c, 0x0c, 0x4a, 0x6e, 0x7b, 0x23, 0x67, 0x0e, 0xc8, 0x63, 0x7b, 0x62, 0xef, 0x57,
+	0xbc, 0xa1, 0x41, 0xe2, 0x92, 0xf1, 0x3e, 0xfe, 0x3d, 0xdf, 0xf3, 0x7c, 0x38, 0x30, 0x63, 0xa1,
+	0x8e, 0x46, 0x3b, 0x6a, 0xcb, 0x73, 0xa9, 0x2b, 0x16, 0x09, 0xc2, 0xec, 0xc9, 0x74, 0x6d, 0x95,
+	0x20, 0x6c, 0x36, 0x0d, 0xec, 0xa8, 0x16, 0xea, 0x48, 0x25, 0xd3, 0x25, 0x07, 0x2e, 0xd1, 0x76,
+	0x0d, 0x82, 0xb4, 0xcf, 0xd6, 0x77, 0x11, 0x35, 0xd6, 0x35, 0xd3, 0xc5, 0x4e, 0xc8, 0x29, 0x2d,
+	0x35, 0xdc, 0x86, 0xcb, 0x1e, 0xb5, 0xe0, 0x29, 0xa2, 0x8a, 0x81, 0x74, 0xd3, 0x75, 0xa8, 0x67,
+	0x98, 0x34, 0xa2, 0xcd, 0x05, 0x34, 0xd7, 0xb3, 0x90, 0x17, 0x11, 0x66, 0x03, 0x42, 0xcb, 0xc0,
+	0xf1, 0xef, 0x45, 0x66, 0x12, 0x36, 0xf7, 0xeb, 0x04, 0x3f, 0x41, 0x21, 0x51, 0xf9, 0xe1, 0x12,
+	0xcc, 0xd6, 0x48, 0x63, 0xcb, 0x36, 0x4c, 0xf4, 0x7e, 0xc0, 0x4c, 0xc4, 0x57, 0xe0, 0x7f, 0xa6,
+	0x87, 0x0c, 0xea, 0x7a, 0x45, 0x61, 0x55, 0x28, 0x4f, 0x57, 0x0b, 0x5d, 0x5f, 0x8e, 0x49, 0x7a,
+	0xfc, 0x20, 0x6e, 0xc2, 0x24, 0xd3, 0x46, 0x8a, 0x97, 0x56, 0x73, 0xe5, 0x42, 0x45, 0x56, 0x87,
+	0x39, 0xa9, 0x32, 0xc1, 0x55, 0xe8, 0xfa, 0x72, 0xc4, 0xa2, 0x47, 0xa7, 0x78, 0x0f, 0x66, 0x62,
+	0x37, 0x36, 0x2c, 0xcb, 0x2b, 0xe6, 0x98, 0xc2, 0xa5, 0xae, 0x2f, 0xcf, 0xc7, 0xf4, 0xba, 0x61,
+	0x59, 0x1e, 0x22, 0x44, 0x4f, 0x21, 0xc5, 0x4f, 0xe1, 0xf2, 0x5e, 0xdb, 0xb1, 0x48, 0x31, 0xcf,
+	0xb4, 0xaf, 0xa8, 0x61, 0x20, 0xd5, 0x20, 0x90, 0x6a, 0x14, 0x48, 0x75, 0xd3, 0xc5, 0x4e, 0xf5,
+	0x8d, 0xa7, 0xbe, 0x3c, 0xd1, 0xf5, 0xe5, 0x10, 0xff, 0xe3, 0xef, 0x72, 0xb9, 0x81, 0x69, 0xb3,
+	0xbd, 0xab, 0x9a, 0xee, 0x81, 0x16, 0x85, 0x3f, 0x3c, 0xd6, 0x88, 0xb5, 0xaf, 0xd1, 0xc3, 0x16,
+	0x22, 0x8c, 0x93, 0xe8, 0x21, 0x8b, 0xf2, 0x11, 0x2c, 0xa7, 0x63, 0xa4, 0x23, 0xd2, 0x72, 0x1d,
+	0x82, 0xc4, 0xb7, 0x61, 0x8a, 0x79, 0xf2, 0xc0, 0x22, 0x45, 0x61, 0x35, 0x57, 0xce, 0x57, 0x5f,
+	0xee, 0xfa, 0xf2, 0x34, 0xa3, 0xd5, 0xb1, 0x45, 0x4e, 0x7c, 0x79, 0xfe, 0xd0, 0x38, 0xb0, 0xdf,
+	0x54, 0x38, 0x49, 0xd1, 0x39, 0x8b, 0xf2, 0x5c, 0x80, 0xb9, 0x1a, 0x69, 0x6c, 0x1a, 0x8e, 0x89,
+	0xec, 0xf1, 0xc2, 0x5f, 0x87, 0x2b, 0x26, 0x63, 0xb3, 0x0d, 0x8a, 0x5d, 0x27, 0xce, 0xc2, 0x8d,
+	0xe1, 0x59, 0xd8, 0x4c, 0xc0, 0xab, 0x0b, 0x5d, 0x5f, 0x4e, 0x0b, 0xd0, 0xd3, 0x3f, 0xcf, 0x9f,
+	0x1a, 0x65, 0x05, 0xfe, 0xdf, 0xe3, 0x54, 0x1c, 0x2f, 0xe5, 0x17, 0x01, 0x66, 0xf8, 0xbb, 0x0d,
+	0xdb, 0xce, 0xea, 0x6d, 0xaf, 0x31, 0x97, 0x32, 0xdf, 0x93, 0x1a, 0x4c, 0xee, 0x61, 0x9b, 0xa2,
+	0xd0, 0x81, 0x42, 0xe5, 0xe6, 0x59, 0x01, 0xda, 0xb0, 0xed, 0x77, 0x18, 0x43, 0x78, 0x61, 0x43,
+	0x66, 0x3d, 0x3a, 0x95, 0x65, 0x58, 0x4a, 0xda, 0xcf, 0x1d, 0xfb, 0x26, 0x07, 0xf3, 0x35, 0xd2,
+	0xd0, 0x51, 0x6b, 0xfc, 0x4a, 0x3a, 0xbf, 0x73, 0x7d, 0x97, 0x20, 0xf7, 0x82, 0x2f, 0xc1, 0x69,
+	0x91, 0xe7, 0xcf, 0x5f, 0xe4, 0xbc, 0x54, 0x2f, 0x5f, 0x7c, 0xa9, 0x7e, 0x0c, 0xc5, 0xde, 0x34,
+	0xbc, 0xa8, 0x62, 0x6d, 0xc3, 0x22, 0x13, 0xdd, 0xc0, 0x84, 0x22, 0x6f, 0x33, 0xca, 0x83, 0x58,
+	0xec, 0x49, 0xf2, 0x69, 0x5e, 0xef, 0xc3, 0x54, 0x9c, 0x2d, 0x96, 0xd3, 0x42, 0xa5, 0x3c, 0x22,
+	0x31, 0x11, 0xf2, 0x81, 0xb3, 0xe7, 0x7e, 0x58, 0xd1, 0x39, 0xa7, 0x72, 0x1d, 0xae, 0x0d, 0x50,
+	0xcb, 0x2f, 0xde, 0xf7, 0x02, 0x6b, 0x4e, 0x31, 0xfd, 0x3e, 0x6a, 0xb9, 0x04, 0x53, 0x1d, 0x39,
+	0xb4, 0xef, 0x5e, 0x09, 0x99, 0xef, 0x95, 0x02, 0x93, 0xc6, 0x81, 0xdb, 0x76, 0x42, 0xbb, 0xf3,
+	0x61, 0x56, 0x43, 0x8a, 0x1e, 0x9d, 0x01, 0x86, 0x20, 0xc7, 0x42, 0x71, 0x67, 0x60, 0x98, 0x90,
+	0xa2, 0x47, 0xa7, 0xb2, 0x0a, 0xa5, 0xc1, 0xb6, 0x71, 0xf3, 0x3b, 0x70, 0xb5, 0x46, 0x1a, 0x3b,
+	0x8e, 0xd7, 0x1b, 0xd6, 0x8b, 0xae, 0x1d, 0x45, 0x86, 0xeb, 0x03, 0x35, 0x73, 0xd3, 0x7e, 0x15,
+	0xa2, 0x92, 0x0e, 0xdf, 0x6f, 0x19, 0xd8, 0x23, 0x23, 0xb2, 0xfd, 0x9d, 0x00, 0x0b, 0xbb, 0x06,
+	0x35, 0x9b, 0xb1, 0x96, 0x60, 0xf4, 0x46, 0x05, 0x79, 0x7b, 0x78, 0xde, 0xab, 0x01, 0x4b, 0xac,
+	0x3b, 0xd0, 0xc1, 0x8b, 0x60, 0x91, 0x49, 0xab, 0x73, 0x37, 0x02, 0x79, 0x27, 0xbe, 0x2c, 0x85,
+	0x57, 0x74, 0xc0, 0x4b, 0x45, 0xef, 0x37, 0x40, 0x91, 0xa0, 0xd8, 0xeb, 0x04, 0xf7, 0xf0, 0xa7,
+	0xf0, 0xee, 0xec, 0xb4, 0x2c, 0x83, 0xa2, 0x2d, 0x0f, 0x9b, 0xe8, 0x11, 0x36, 0xf7, 0xb7, 0xf1,
+	0x13, 0x94, 0x35, 0xfc, 0x8f, 0x61, 0x86, 0x46, 0x2c, 0x0f, 0x31, 0xa1, 0xd1, 0x10, 0x52, 0x86,
+	0xbb, 0x1b, 0x2b, 0xa8, 0x6a, 0x91, 0x97, 0xb3, 0x7c, 0x23, 0xa9, 0xdb, 0x98, 0xd0, 0x13, 0x5f,
+	0xbe, 0x1a, 0x3a, 0x98, 0xa6, 0x2b, 0x7a, 0x4a, 0x91, 0xf2, 0xb3, 0x00, 0x2b, 0xdc, 0xf4, 0x0f,
+	0xda, 0x86, 0x43, 0x31, 0x3d, 0xfc, 0xcf, 0x58, 0x7f, 0x2d, 0x61, 0x7c, 0x2c, 0x93, 0x67, 0xe5,
+	0x31, 0x1b, 0x31, 0x3b, 0x0e, 0x69, 0x93, 0x16, 0x72, 0xac, 0x7f, 0xaf, 0x22, 0x4a, 0xf0, 0xd2,
+	0x20, 0xc5, 0xdc, 0xb0, 0x3f, 0xc3, 0x6d, 0x65, 0x1b, 0xb1, 0x12, 0x7e, 0xe4, 0xb6, 0x76, 0x5a,
+	0x89, 0x2e, 0x20, 0x0c, 0xeb, 0x02, 0xff, 0x60, 0xbe, 0xdd, 0x86, 0x69, 0xda, 0xf4, 0x10, 0x69,
+	0xba, 0xb6, 0xc5, 0xda, 0x4c, 0xbe, 0x7a, 0x25, 0x68, 0xd9, 0x9c, 0xa8, 0x9f, 0x3e, 0x26, 0x9a,
+	0x56, 0x7e, 0x68, 0xd3, 0xd2, 0x00, 0x98, 0x6f, 0x0f, 0xf1, 0x01, 0xa6, 0xc5, 0xcb, 0x0c, 0x37,
+	0xd7, 0xf5, 0xe5, 0x02, 0xa3, 0xd6, 0xed, 0x80, 0xac, 0x27, 0x20, 0xd1, 0x2e, 0x93, 0x74, 0x99,
+	0x87, 0xe3, 0xb9, 0xc0, 0x56, 0xe7, 0x6d, 0xc4, 0xca, 0xf6, 0x3d, 0xc3, 0xbe, 0xf8, 0x14, 0x89,
+	0x6f, 0x41, 0x3e, 0x6a, 0x2b, 0xc1, 0x38, 0x29, 0x0d, 0xbf, 0xa9, 0xac, 0x93, 0x4c, 0x75, 0x7d,
+	0x99, 0xe1, 0x75, 0xf6, 0x37, 0x88, 0x50, 0xd3, 0xb0, 0x29, 0xb2, 0x58, 0x84, 0xa6, 0xc2, 0x08,
+	0x85, 0x14, 0x3d, 0x3a, 0x95, 0x22, 0x2c, 0xa7, 0x9d, 0x8a, 0xfd, 0xad, 0xfc, 0x55, 0x80, 0x5c,
+	0x8d, 0x34, 0x44, 0x0c, 0x85, 0xe4, 0xe7, 0xc2, 0x88, 0x99, 0x96, 0x5e, 0x9a, 0xa5, 0x3b, 0x59,
+	0x91, 0x7c, 0x62, 0xdb, 0x30, 0x93, 0xda, 0x8d, 0x6f, 0x8e, 0x94, 0x90, 0x84, 0x4a, 0xeb, 0x99,
+	0xa1, 0x5c, 0x9b, 0x09, 0xd3, 0xa7, 0x8b, 0xe9, 0x8d, 0x0c, 0xfc, 0x1b, 0xb6, 0x2d, 0xa9, 0xd9,
+	0x70, 0x5c, 0x89, 0x0b, 0x57, 0xd2, 0x4b, 0xe2, 0xad, 0x91, 0x02, 0x52, 0x58, 0xa9, 0x92, 0x1d,
+	0xcb, 0x15, 0x76, 0x60, 0xbe, 0x6f, 0x67, 0x59, 0x3b, 0x43, 0x4e, 0x1a, 0x2e, 0xbd, 0x3a, 0x16,
+	0x9c, 0x6b, 0xfe, 0x4a, 0x80, 0xc5, 0x41, 0x7b, 0xc9, 0xe8, 0x7b, 0x30, 0x80, 0x43, 0xba, 0x37,
+	0x2e, 0x07, 0xb7, 0xe1, 0x4b, 0x10, 0x07, 0x2c, 0x17, 0xda, 0x48, 0x79, 0xfd, 0x0c, 0xd2, 0xeb,
+	0x63, 0x32, 0xa4, 0xd3, 0x9d, 0x5c, 0x20, 0x6e, 0x65, 0x8a, 0x25, 0xc3, 0x4a, 0x95, 0xec, 0x58,
+	0xae, 0xf0, 0x0b, 0x58, 0x1c, 0x34, 0xcf, 0x47, 0xc7, 0x7c, 0x00, 0x87, 0x74, 0x37, 0x03, 0x47,
+	0xef, 0xec, 0x12, 0xbf, 0x16, 0x60, 0x79, 0xc8, 0x4c, 0xce, 0x22, 0xaf, 0x97, 0xe9, 0x7c, 0x46,
+	0x7c, 0x0e, 0x0b, 0xfd, 0xd3, 0x53, 0x3d, 0x23, 0x83, 0x3d, 0x78, 0xe9, 0xb5, 0xf1, 0xf0, 0xc9,
+	0x96, 0x95, 0x1a, 0x90, 0xa3, 0x5b, 0x56, 0x12, 0x2a, 0xad, 0x67, 0x86, 0x72, 0x6d, 0x18, 0x0a,
+	0xc9, 0xf9, 0x53, 0x3e, 0x4b, 0x42, 0x8c, 0x94, 0xee, 0x64, 0x45, 0xc6, 0xaa, 0xaa, 0xef, 0x3e,
+	0x3d, 0x2a, 0x09, 0xcf, 0x8e, 0x4a, 0xc2, 0x1f, 0x47, 0x25, 0xe1, 0xdb, 0xe3, 0xd2, 0xc4, 0xb3,
+	0xe3, 0xd2, 0xc4, 0x6f, 0xc7, 0xa5, 0x89, 0x4f, 0xd6, 0x12, 0x1f, 0x69, 0x04, 0xe1, 0xb5, 0x58,
+	0x2c, 0xfb, 0xc1, 0xe4, 0x6a, 0x1d, 0x8d, 0xfd, 0xf3, 0x29, 0xf8, 0x5e, 0xdb, 0x9d, 0x64, 0xef,
+	0xef, 0xfe, 0x3d, 0x00, 0x45, 0xaa, 0x1a, 0xda, 0x23, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	PlaceOrders(ctx context.Context, in *MsgPlaceOrders, opts ...grpc.CallOption) (*MsgPlaceOrdersResponse, error)
+	CancelOrders(ctx context.Context, in *MsgCancelOrders, opts ...grpc.CallOption) (*MsgCancelOrdersResponse, error)
+	CancelAll(ctx context.Context, in *MsgCancelAll, opts ...grpc.CallOption) (*MsgCancelAllResponse, error)
+	ReplaceOrders(ctx context.Context, in *MsgReplaceOrders, opts ...grpc.CallOption) (*MsgReplaceOrdersResponse, error)
+	RegisterContract(ctx context.Context, in *MsgRegisterContract, opts ...grpc.CallOption) (*MsgRegisterContractResponse, error)
+	ContractDepositRent(ctx context.Context, in *MsgContractDepositRent, opts ...grpc.CallOption) (*MsgContractDepositRentResponse, error)
+	UnregisterContract(ctx context.Context, in *MsgUnregisterContract, opts ...grpc.CallOption) (*MsgUnregisterContractResponse, error)
+	RegisterPairs(ctx context.Context, in *MsgRegisterPairs, opts ...grpc.CallOption) (*MsgRegisterPairsResponse, error)
+	UpdatePriceTickSize(ctx context.Context, in *MsgUpdatePriceTickSize, opts ...grpc.CallOption) (*MsgUpdateTickSizeResponse, error)
+	UpdateQuantityTickSize(ctx context.Context, in *MsgUpdateQuantityTickSize, opts ...grpc.CallOption) (*MsgUpdateTickSizeResponse, error)
+	UnsuspendContract(ctx context.Context, in *MsgUnsuspendContract, opts ...grpc.CallOption) (*MsgUnsuspendContractResponse, error)
+	SetRentTopUp(ctx context.Context, in *MsgSetRentTopUp, opts ...grpc.CallOption) (*MsgSetRentTopUpResponse, error)
+	SetPairHalt(ctx context.Context, in *MsgSetPairHalt, opts ...grpc.CallOption) (*MsgSetPairHaltResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) PlaceOrders(ctx context.Context, in *MsgPlaceOrders, opts ...grpc.CallOption) (*MsgPlaceOrdersResponse, error) {
+	out := new(MsgPlaceOrdersResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/PlaceOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOrders(ctx context.Context, in *MsgCancelOrders, opts ...grpc.CallOption) (*MsgCancelOrdersResponse, error) {
+	out := new(MsgCancelOrdersResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/CancelOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAll(ctx context.Context, in *MsgCancelAll, opts ...grpc.CallOption) (*MsgCancelAllResponse, error) {
+	out := new(MsgCancelAllResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/CancelAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReplaceOrders(ctx context.Context, in *MsgReplaceOrders, opts ...grpc.CallOption) (*MsgReplaceOrdersResponse, error) {
+	out := new(MsgReplaceOrdersResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/ReplaceOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterContract(ctx context.Context, in *MsgRegisterContract, opts ...grpc.CallOption) (*MsgRegisterContractResponse, error) {
+	out := new(MsgRegisterContractResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/RegisterContract", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1025,10 +1508,30 @@ func (c *msgClient) UnsuspendContract(ctx context.Context, in *MsgUnsuspendContr
 	return out, nil
 }
 
+func (c *msgClient) SetRentTopUp(ctx context.Context, in *MsgSetRentTopUp, opts ...grpc.CallOption) (*MsgSetRentTopUpResponse, error) {
+	out := new(MsgSetRentTopUpResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/SetRentTopUp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPairHalt(ctx context.Context, in *MsgSetPairHalt, opts ...grpc.CallOption) (*MsgSetPairHaltResponse, error) {
+	out := new(MsgSetPairHaltResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.dex.Msg/SetPairHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	PlaceOrders(context.Context, *MsgPlaceOrders) (*MsgPlaceOrdersResponse, error)
 	CancelOrders(context.Context, *MsgCancelOrders) (*MsgCancelOrdersResponse, error)
+	CancelAll(context.Context, *MsgCancelAll) (*MsgCancelAllResponse, error)
+	ReplaceOrders(context.Context, *MsgReplaceOrders) (*MsgReplaceOrdersResponse, error)
 	RegisterContract(context.Context, *MsgRegisterContract) (*MsgRegisterContractResponse, error)
 	ContractDepositRent(context.Context, *MsgContractDepositRent) (*MsgContractDepositRentResponse, error)
 	UnregisterContract(context.Context, *MsgUnregisterContract) (*MsgUnregisterContractResponse, error)
@@ -1036,6 +1539,8 @@ type MsgServer interface {
 	UpdatePriceTickSize(context.Context, *MsgUpdatePriceTickSize) (*MsgUpdateTickSizeResponse, error)
 	UpdateQuantityTickSize(context.Context, *MsgUpdateQuantityTickSize) (*MsgUpdateTickSizeResponse, error)
 	UnsuspendContract(context.Context, *MsgUnsuspendContract) (*MsgUnsuspendContractResponse, error)
+	SetRentTopUp(context.Context, *MsgSetRentTopUp) (*MsgSetRentTopUpResponse, error)
+	SetPairHalt(context.Context, *MsgSetPairHalt) (*MsgSetPairHaltResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1048,6 +1553,12 @@ func (*UnimplementedMsgServer) PlaceOrders(ctx context.Context, req *MsgPlaceOrd
 func (*UnimplementedMsgServer) CancelOrders(ctx context.Context, req *MsgCancelOrders) (*MsgCancelOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrders not implemented")
 }
+func (*UnimplementedMsgServer) CancelAll(ctx context.Context, req *MsgCancelAll) (*MsgCancelAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAll not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceOrders(ctx context.Context, req *MsgReplaceOrders) (*MsgReplaceOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrders not implemented")
+}
 func (*UnimplementedMsgServer) RegisterContract(ctx context.Context, req *MsgRegisterContract) (*MsgRegisterContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterContract not implemented")
 }
@@ -1069,6 +1580,12 @@ func (*UnimplementedMsgServer) UpdateQuantityTickSize(ctx context.Context, req *
 func (*UnimplementedMsgServer) UnsuspendContract(ctx context.Context, req *MsgUnsuspendContract) (*MsgUnsuspendContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendContract not implemented")
 }
+func (*UnimplementedMsgServer) SetRentTopUp(ctx context.Context, req *MsgSetRentTopUp) (*MsgSetRentTopUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRentTopUp not implemented")
+}
+func (*UnimplementedMsgServer) SetPairHalt(ctx context.Context, req *MsgSetPairHalt) (*MsgSetPairHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPairHalt not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1110,6 +1627,42 @@ func _Msg_CancelOrders_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Msg/CancelAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAll(ctx, req.(*MsgCancelAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplaceOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceOrders)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Msg/ReplaceOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceOrders(ctx, req.(*MsgReplaceOrders))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RegisterContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRegisterContract)
 	if err := dec(in); err != nil {
@@ -1236,6 +1789,42 @@ func _Msg_UnsuspendContract_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRentTopUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRentTopUp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRentTopUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Msg/SetRentTopUp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRentTopUp(ctx, req.(*MsgSetRentTopUp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPairHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPairHalt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPairHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.dex.Msg/SetPairHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPairHalt(ctx, req.(*MsgSetPairHalt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seiprotocol.seichain.dex.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1248,6 +1837,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrders",
 			Handler:    _Msg_CancelOrders_Handler,
 		},
+		{
+			MethodName: "CancelAll",
+			Handler:    _Msg_CancelAll_Handler,
+		},
+		{
+			MethodName: "ReplaceOrders",
+			Handler:    _Msg_ReplaceOrders_Handler,
+		},
 		{
 			MethodName: "RegisterContract",
 			Handler:    _Msg_RegisterContract_Handler,
@@ -1276,6 +1873,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnsuspendContract",
 			Handler:    _Msg_UnsuspendContract_Handler,
 		},
+		{
+			MethodName: "SetRentTopUp",
+			Handler:    _Msg_SetRentTopUp_Handler,
+		},
+		{
+			MethodName: "SetPairHalt",
+			Handler:    _Msg_SetPairHalt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dex/tx.proto",
@@ -1461,7 +2066,7 @@ func (m *MsgCancelOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterContract) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelAll) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1471,19 +2076,19 @@ func (m *MsgRegisterContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelAll) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Contract != nil {
+	if m.Filter != nil {
 		{
-			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1491,7 +2096,199 @@ func (m *MsgRegisterContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceOrders) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrders) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Cancellations) > 0 {
+		for iNdEx := len(m.Cancellations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cancellations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		dAtA5 := make([]byte, len(m.OrderIds)*10)
+		var j4 int
+		for _, num := range m.OrderIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Contract != nil {
+		{
+			size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -1889,63 +2686,220 @@ func (m *MsgUnsuspendContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetRentTopUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgPlaceOrders) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetRentTopUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRentTopUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.SpendLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpendLimit))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ContractAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Funds) > 0 {
-		for _, e := range m.Funds {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPlaceOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.OrderIds) > 0 {
-		l = 0
-		for _, e := range m.OrderIds {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+func (m *MsgSetRentTopUpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelOrders) Size() (n int) {
+func (m *MsgSetRentTopUpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRentTopUpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPairHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPairHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPairHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Pair != nil {
+		{
+			size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddr) > 0 {
+		i -= len(m.ContractAddr)
+		copy(dAtA[i:], m.ContractAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPairHaltResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPairHaltResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPairHaltResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPlaceOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPlaceOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		l = 0
+		for _, e := range m.OrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCancelOrders) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1977,6 +2931,87 @@ func (m *MsgCancelOrdersResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceOrders) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Cancellations) > 0 {
+		for _, e := range m.Cancellations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReplaceOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderIds) > 0 {
+		l = 0
+		for _, e := range m.OrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *MsgRegisterContract) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2159,27 +3194,95 @@ func (m *MsgUnsuspendContractResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetRentTopUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	if m.SpendLimit != 0 {
+		n += 1 + sovTx(uint64(m.SpendLimit))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSetRentTopUpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgPlaceOrders) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
+
+func (m *MsgSetPairHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pair != nil {
+		l = m.Pair.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Halted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetPairHaltResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPlaceOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
 				break
@@ -2290,13 +3393,1182 @@ func (m *MsgPlaceOrders) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OrderIds = append(m.OrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OrderIds) == 0 {
+					m.OrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OrderIds = append(m.OrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancellations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cancellations = append(m.Cancellations, &Cancellation{})
+			if err := m.Cancellations[len(m.Cancellations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &CancelAllFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancellations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cancellations = append(m.Cancellations, &Cancellation{})
+			if err := m.Cancellations[len(m.Cancellations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OrderIds = append(m.OrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OrderIds) == 0 {
+					m.OrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OrderIds = append(m.OrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contract == nil {
+				m.Contract = &ContractInfoV2{}
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgContractDepositRent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgContractDepositRent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2306,25 +4578,23 @@ func (m *MsgPlaceOrders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funds = append(m.Funds, types.Coin{})
-			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2347,7 +4617,7 @@ func (m *MsgPlaceOrders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgContractDepositRentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2370,88 +4640,12 @@ func (m *MsgPlaceOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgContractDepositRentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgContractDepositRentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.OrderIds = append(m.OrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.OrderIds) == 0 {
-					m.OrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.OrderIds = append(m.OrderIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderIds", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2473,7 +4667,7 @@ func (m *MsgPlaceOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2496,10 +4690,10 @@ func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrders: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2535,40 +4729,6 @@ func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancellations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cancellations = append(m.Cancellations, &Cancellation{})
-			if err := m.Cancellations[len(m.Cancellations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
 			}
@@ -2621,7 +4781,7 @@ func (m *MsgCancelOrders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2644,10 +4804,10 @@ func (m *MsgCancelOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2671,7 +4831,7 @@ func (m *MsgCancelOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2694,10 +4854,10 @@ func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterPairs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterPairs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2732,9 +4892,9 @@ func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batchcontractpair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2761,10 +4921,8 @@ func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Contract == nil {
-				m.Contract = &ContractInfoV2{}
-			}
-			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Batchcontractpair = append(m.Batchcontractpair, BatchContractPair{})
+			if err := m.Batchcontractpair[len(m.Batchcontractpair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2789,7 +4947,7 @@ func (m *MsgRegisterContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2812,10 +4970,10 @@ func (m *MsgRegisterContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2839,7 +4997,7 @@ func (m *MsgRegisterContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2862,15 +5020,15 @@ func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgContractDepositRent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePriceTickSize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgContractDepositRent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePriceTickSize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2898,32 +5056,13 @@ func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSizeList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2933,74 +5072,26 @@ func (m *MsgContractDepositRent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			m.TickSizeList = append(m.TickSizeList, TickSize{})
+			if err := m.TickSizeList[len(m.TickSizeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgContractDepositRentResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgContractDepositRentResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgContractDepositRentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3022,7 +5113,7 @@ func (m *MsgContractDepositRentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3045,10 +5136,10 @@ func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateQuantityTickSize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateQuantityTickSize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3085,9 +5176,9 @@ func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSizeList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3097,23 +5188,25 @@ func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			m.TickSizeList = append(m.TickSizeList, TickSize{})
+			if err := m.TickSizeList[len(m.TickSizeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3136,7 +5229,7 @@ func (m *MsgUnregisterContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTickSizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3159,10 +5252,10 @@ func (m *MsgUnregisterContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTickSizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTickSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3186,7 +5279,7 @@ func (m *MsgUnregisterContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
+func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3209,10 +5302,10 @@ func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnsuspendContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnsuspendContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3247,11 +5340,11 @@ func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batchcontractpair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3261,25 +5354,23 @@ func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batchcontractpair = append(m.Batchcontractpair, BatchContractPair{})
-			if err := m.Batchcontractpair[len(m.Batchcontractpair)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3302,7 +5393,7 @@ func (m *MsgRegisterPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnsuspendContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3325,10 +5416,10 @@ func (m *MsgRegisterPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnsuspendContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnsuspendContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3352,7 +5443,7 @@ func (m *MsgRegisterPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRentTopUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3375,15 +5466,15 @@ func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePriceTickSize: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRentTopUp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePriceTickSize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRentTopUp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3411,13 +5502,13 @@ func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickSizeList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3427,81 +5518,29 @@ func (m *MsgUpdatePriceTickSize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TickSizeList = append(m.TickSizeList, TickSize{})
-			if err := m.TickSizeList[len(m.TickSizeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateQuantityTickSize: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateQuantityTickSize: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var stringLen uint64
+			m.Threshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3511,29 +5550,16 @@ func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Threshold |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickSizeList", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3543,26 +5569,30 @@ func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
-			m.TickSizeList = append(m.TickSizeList, TickSize{})
-			if err := m.TickSizeList[len(m.TickSizeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SpendLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3584,7 +5614,7 @@ func (m *MsgUpdateQuantityTickSize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTickSizeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRentTopUpResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3607,10 +5637,10 @@ func (m *MsgUpdateTickSizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTickSizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRentTopUpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTickSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRentTopUpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3634,7 +5664,7 @@ func (m *MsgUpdateTickSizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPairHalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3657,10 +5687,10 @@ func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnsuspendContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPairHalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnsuspendContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPairHalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3727,6 +5757,62 @@ func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pair == nil {
+				m.Pair = &Pair{}
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3748,7 +5834,7 @@ func (m *MsgUnsuspendContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnsuspendContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPairHaltResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3771,10 +5857,10 @@ func (m *MsgUnsuspendContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnsuspendContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPairHaltResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnsuspendContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPairHaltResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -46,9 +46,13 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		}
 
 		voteTargets := make(map[string]types.Denom)
+		// pickReferenceDenom drops the failed targets from voteTargets, so the per-denom
+		// params are kept in a separate map for the tally
+		denomInfos := make(map[string]types.Denom)
 		totalTargets := 0
 		k.IterateVoteTargets(ctx, func(denom string, denomInfo types.Denom) bool {
 			voteTargets[denom] = denomInfo
+			denomInfos[denom] = denomInfo
 			totalTargets++
 			return false
 		})
@@ -66,6 +70,11 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 			voteMapRD := ballotRD.ToMap()
 
 			exchangeRateRD := ballotRD.WeightedMedianWithAssertion()
+			// limit the move of the reference rate first, so that the cross exchange rates are
+			// derived from the reference rate that is actually stored
+			if previousRate, _, err := k.GetBaseExchangeRate(ctx, referenceDenom); err == nil {
+				exchangeRateRD = denomInfos[referenceDenom].ClampExchangeRate(exchangeRateRD, previousRate)
+			}
 
 			// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
 			keys := make([]string, len(voteMap))
@@ -83,11 +92,18 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 				}
 
 				// Get weighted median of cross exchange rates
-				exchangeRate := Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+				exchangeRate := Tally(ctx, ballot, denomInfos[denom].GetRewardBand(params.RewardBand), validatorClaimMap)
 
-				// Transform into the original form base/quote
-				if denom != referenceDenom {
+				if denom == referenceDenom {
+					exchangeRate = exchangeRateRD
+				} else {
+					// Transform into the original form base/quote
 					exchangeRate = exchangeRateRD.Quo(exchangeRate)
+
+					// Limit the move from the previous exchange rate to the max change of the denom
+					if previousRate, _, err := k.GetBaseExchangeRate(ctx, denom); err == nil {
+						exchangeRate = denomInfos[denom].ClampExchangeRate(exchangeRate, previousRate)
+					}
 				}
 
 				// Set the exchange rate, emit ABCI event
@@ -107,9 +123,13 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		for _, denom := range belowThresholdKeys {
 			ballot := belowThresholdVoteMap[denom]
 			// perform tally for below threshold assets to calculate total win count
-			Tally(ctx, ballot, params.RewardBand, validatorClaimMap)
+			Tally(ctx, ballot, denomInfos[denom].GetRewardBand(params.RewardBand), validatorClaimMap)
 		}
 
+		//---------------------------
+		// Distribute rewards to ballot winners
+		k.RewardBallotWinners(ctx, validatorClaimMap)
+
 		//---------------------------
 		// Do miss counting & slashing
 		for _, claim := range validatorClaimMap {
@@ -134,6 +154,9 @@ func MidBlocker(ctx sdk.Context, k keeper.Keeper) {
 		// Update vote targets
 		k.ApplyWhitelist(ctx, params.Whitelist, voteTargets)
 
+		// Drop the exchange rates that haven't been updated for too long
+		k.RemoveStaleExchangeRates(ctx)
+
 		priceSnapshotItems := []types.PriceSnapshotItem{}
 		k.IterateBaseExchangeRates(ctx, func(denom string, exchangeRate types.OracleExchangeRate) bool {
 			priceSnapshotItem := types.PriceSnapshotItem{
@@ -163,4 +186,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if utils.IsPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetCounters(ctx)
 	}
+	// changes to commit-reveal apply from the next vote period on
+	if utils.IsPeriodLastBlock(ctx, params.VotePeriod) {
+		k.SetCommitRevealActive(ctx, params.CommitRevealEnabled)
+	}
 }
@@ -631,6 +631,129 @@ func TestOraclePriceSnapshot(t *testing.T) {
 	require.Equal(t, expected2, input.OracleKeeper.GetPriceSnapshot(input.Ctx, 200))
 }
 
+func TestOracleRewardDistribution(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.RewardDistributionRate = sdk.NewDecWithPrec(1, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	rewardPool := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 300))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[4], types.ModuleName, rewardPool))
+
+	rates := sdk.DecCoins{
+		{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate},
+		{Denom: utils.MicroEthDenom, Amount: randomExchangeRate},
+	}
+	for i := 0; i < 3; i++ {
+		makeAggregateVote(t, input, h, 0, rates, i)
+	}
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// all validators won with the same power, so 10% of the pool is split evenly
+	for i := 0; i < 3; i++ {
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 10)), input.OracleKeeper.GetAccruedRewards(input.Ctx, keeper.ValAddrs[i]))
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 270)), input.OracleKeeper.GetRewardPoolLegacy(input.Ctx))
+}
+
+func TestOracleDenomOverrides(t *testing.T) {
+	input, h := setup(t)
+	voteThreshold := sdk.NewDecWithPrec(9, 1)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{
+		{Name: utils.MicroAtomDenom, VoteThreshold: &voteThreshold},
+		{Name: utils.MicroEthDenom, MinVoters: 3},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ClearVoteTargets(input.Ctx)
+	for _, denom := range params.Whitelist {
+		input.OracleKeeper.SetVoteTargetDenom(input.Ctx, denom)
+	}
+
+	rates := sdk.DecCoins{
+		{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate},
+		{Denom: utils.MicroEthDenom, Amount: randomExchangeRate},
+	}
+	// two of three validators pass the default threshold, but neither the 90% threshold
+	// of uatom nor the three voters of ueth
+	makeAggregateVote(t, input, h, 0, rates, 0)
+	makeAggregateVote(t, input, h, 0, rates, 1)
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	_, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.Error(t, err)
+	_, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroEthDenom)
+	require.Error(t, err)
+
+	// limit the move of uatom to 10% of its previous rate
+	maxChange := sdk.NewDecWithPrec(1, 1)
+	require.NoError(t, input.OracleKeeper.UpdateDenomParams(input.Ctx, []types.Denom{{Name: utils.MicroAtomDenom, MaxChange: &maxChange}}))
+	// the vote targets pick up the change at the end of the next vote period
+	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	voteTarget, err := input.OracleKeeper.GetVoteTarget(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, types.Denom{Name: utils.MicroAtomDenom, MaxChange: &maxChange}, voteTarget)
+
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom, randomExchangeRate)
+	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+	rates = sdk.DecCoins{
+		{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate.MulInt64(2)},
+		{Denom: utils.MicroEthDenom, Amount: randomExchangeRate},
+	}
+	for i := 0; i < 3; i++ {
+		makeAggregateVote(t, input, h, input.Ctx.BlockHeight(), rates, i)
+	}
+
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// uatom is the reference denom, so its rate is clamped before ueth's is derived from it at
+	// the voted cross rate of 2
+	rate, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate.Mul(sdk.NewDecWithPrec(11, 1)), rate)
+	rate, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroEthDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate.Mul(sdk.NewDecWithPrec(55, 2)), rate)
+}
+
+func TestOracleStaleExchangeRate(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxStaleness = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	rates := sdk.DecCoins{{Denom: utils.MicroAtomDenom, Amount: randomExchangeRate}}
+	for i := 0; i < 3; i++ {
+		makeAggregateVote(t, input, h, 0, rates, i)
+	}
+	oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	_, _, err := input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+
+	// the ballots of the next vote periods fail, so the rate ages until it is dropped
+	for height := int64(1); height <= 3; height++ {
+		input.Ctx = input.Ctx.WithBlockHeight(height)
+		makeAggregateVote(t, input, h, height, rates, 0)
+		oracle.MidBlocker(input.Ctx, input.OracleKeeper)
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+		_, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+		if height <= 2 {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
 func makeAggregateVote(t *testing.T, input keeper.TestInput, h sdk.Handler, height int64, rates sdk.DecCoins, idx int) {
 	voteMsg := types.NewMsgAggregateExchangeRateVote(rates.String(), keeper.Addrs[idx], keeper.ValAddrs[idx])
 	_, err := h(input.Ctx.WithBlockHeight(height), voteMsg)
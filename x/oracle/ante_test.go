@@ -20,6 +20,7 @@ import (
 func TestOracleVoteAloneAnteHandler(t *testing.T) {
 
 	testOracleMsg := oracletypes.MsgAggregateExchangeRateVote{}
+	testOraclePrevoteMsg := oracletypes.MsgAggregateExchangeRatePrevote{}
 	testNonOracleMsg := banktypes.MsgSend{}
 	testNonOracleMsg2 := banktypes.MsgSend{}
 
@@ -31,6 +32,8 @@ func TestOracleVoteAloneAnteHandler(t *testing.T) {
 		tx     sdk.Tx
 	}{
 		{"only oracle vote", false, app.NewTestTx([]sdk.Msg{&testOracleMsg})},
+		{"oracle vote and prevote", false, app.NewTestTx([]sdk.Msg{&testOracleMsg, &testOraclePrevoteMsg})},
+		{"prevote mixed with other messages", true, app.NewTestTx([]sdk.Msg{&testOraclePrevoteMsg, &testNonOracleMsg})},
 		{"only non-oracle msgs", false, app.NewTestTx([]sdk.Msg{&testNonOracleMsg, &testNonOracleMsg2})},
 		{"mixed messages", true, app.NewTestTx([]sdk.Msg{&testNonOracleMsg, &testOracleMsg, &testNonOracleMsg2})},
 	}
@@ -91,12 +94,42 @@ func TestSpammingPreventionAnteHandler(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSpammingPreventionAnteHandlerPrevote(t *testing.T) {
+	input, _ := setup(t)
+
+	exchangeRateStr := randomExchangeRate.String() + utils.MicroAtomDenom
+	hash := types.GetAggregateVoteHash("salt", exchangeRateStr, keeper.ValAddrs[0])
+
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRateStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	invalidPrevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[3], keeper.ValAddrs[2])
+
+	spd := oracle.NewSpammingPreventionDecorator(input.OracleKeeper)
+	anteHandler, _ := sdk.ChainAnteDecorators(spd)
+
+	ctx := input.Ctx.WithIsCheckTx(true)
+	// a reveal and a new prevote can be submitted together
+	_, err := anteHandler(ctx, app.NewTestTx([]sdk.Msg{voteMsg, prevoteMsg}), false)
+	require.NoError(t, err)
+
+	// invalid because bad feeder val combo
+	_, err = anteHandler(ctx, app.NewTestTx([]sdk.Msg{invalidPrevoteMsg}), false)
+	require.Error(t, err)
+
+	// only one prevote per validator per height
+	_, err = anteHandler(ctx, app.NewTestTx([]sdk.Msg{prevoteMsg}), false)
+	require.Error(t, err)
+	_, err = anteHandler(ctx.WithBlockHeight(ctx.BlockHeight()+1), app.NewTestTx([]sdk.Msg{prevoteMsg}), false)
+	require.NoError(t, err)
+}
+
 func TestSpammingPreventionAnteDeps(t *testing.T) {
 	input, _ := setup(t)
 
 	exchangeRateStr := randomExchangeRate.String() + utils.MicroAtomDenom
 
 	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRateStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(types.GetAggregateVoteHash("salt", exchangeRateStr, keeper.ValAddrs[0]), keeper.Addrs[0], keeper.ValAddrs[0])
 
 	spd := oracle.NewSpammingPreventionDecorator(input.OracleKeeper)
 	anteHandler, depGen := sdk.ChainAnteDecorators(spd)
@@ -109,7 +142,7 @@ func TestSpammingPreventionAnteDeps(t *testing.T) {
 	ms := ctx.MultiStore()
 	msCache := ms.CacheMultiStore()
 	ctx = ctx.WithMultiStore(msCache)
-	tx := app.NewTestTx([]sdk.Msg{voteMsg})
+	tx := app.NewTestTx([]sdk.Msg{voteMsg, prevoteMsg})
 
 	_, err := anteHandler(ctx, tx, false)
 	require.NoError(t, err)
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// UpdateDenomParamsProposalJSON defines an UpdateDenomParamsProposal with a deposit
+// to parse from a JSON file.
+type UpdateDenomParamsProposalJSON struct {
+	Title       string        `json:"title" yaml:"title"`
+	Description string        `json:"description" yaml:"description"`
+	Denoms      []types.Denom `json:"denoms" yaml:"denoms"`
+	Deposit     string        `json:"deposit" yaml:"deposit"`
+}
+
+// ParseUpdateDenomParamsProposalJSON reads and parses an UpdateDenomParamsProposalJSON from
+// a file.
+func ParseUpdateDenomParamsProposalJSON(proposalFile string) (UpdateDenomParamsProposalJSON, error) {
+	proposal := UpdateDenomParamsProposalJSON{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// GetCmdUpdateDenomParamsProposal returns a CLI command handler for creating
+// an update denom params proposal governance transaction.
+func GetCmdUpdateDenomParamsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-denom-params-proposal [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the voting params of whitelisted denoms",
+		Long: strings.TrimSpace(`
+Submit a proposal to update the per-denom vote threshold, reward band, minimum voters and
+maximum change of whitelisted denoms. Unset fields fall back to the module params.
+
+$ seid tx oracle update-denom-params-proposal proposal.json
+
+where proposal.json contains:
+
+{
+  "title": "Tighten uatom ballots",
+  "description": "Require more voters for uatom",
+  "denoms": [{"name": "uatom", "vote_threshold": "0.667", "min_voters": 5, "max_change": "0.2"}],
+  "deposit": "10000000usei"
+}
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseUpdateDenomParamsProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.UpdateDenomParamsProposal{Title: proposal.Title, Description: proposal.Description, Denoms: proposal.Denoms}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
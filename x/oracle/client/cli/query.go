@@ -28,11 +28,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryExchangeRates(),
 		GetCmdQueryPriceSnapshotHistory(),
 		GetCmdQueryTwaps(),
+		GetCmdQueryPriceStats(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
 		GetCmdQueryVotePenaltyCounter(),
+		GetCmdQueryValidatorRewards(),
 		GetCmdQueryVoteTargets(),
+		GetCmdQueryCommitRevealState(),
 	)
 
 	return oracleQueryCmd
@@ -157,6 +160,47 @@ $ seid query oracle twaps
 	return cmd
 }
 
+// GetCmdQueryPriceStats implements the query price stats command.
+func GetCmdQueryPriceStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-stats [lookback-seconds]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the median, min, max and volatility of the price snapshots of denoms",
+		Long: strings.TrimSpace(`
+Query the median, min, max, volatility (standard deviation of log returns) and number of samples
+of the price snapshots of denoms taken in the lookback window
+Example:
+
+$ seid query oracle price-stats 3600
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lookbackSeconds, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PriceStats(
+				context.Background(),
+				&types.QueryPriceStatsRequest{LookbackSeconds: lookbackSeconds},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -294,6 +338,45 @@ $ seid query oracle miss seivaloper...
 	return cmd
 }
 
+// GetCmdQueryValidatorRewards implements the query oracle rewards of the validator command
+func GetCmdQueryValidatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the oracle rewards a validator has accrued",
+		Long: strings.TrimSpace(`
+Query the oracle rewards distributed to a validator for winning ballots so far.
+
+$ seid query oracle validator-rewards seivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorRewards(
+				context.Background(),
+				&types.QueryValidatorRewardsRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryVoteTargets implements the query params command.
 func GetCmdQueryVoteTargets() *cobra.Command {
 	cmd := &cobra.Command{
@@ -322,3 +405,35 @@ func GetCmdQueryVoteTargets() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryCommitRevealState implements the query commit-reveal state command.
+func GetCmdQueryCommitRevealState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-reveal-state",
+		Args:  cobra.NoArgs,
+		Short: "Query whether votes must be revealed from a prevote",
+		Long: strings.TrimSpace(`
+Query whether votes of the current vote period must reveal a prevote, and whether they
+will have to from the next vote period on.
+
+$ seid query oracle commit-reveal-state
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CommitRevealState(context.Background(), &types.QueryCommitRevealStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
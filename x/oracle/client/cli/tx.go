@@ -16,6 +16,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const flagSalt = "salt"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	oracleTxCmd := &cobra.Command{
@@ -28,7 +30,9 @@ func GetTxCmd() *cobra.Command {
 
 	oracleTxCmd.AddCommand(
 		GetCmdDelegateFeederPermission(),
+		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
+		GetCmdUpdateDenomParamsProposal(),
 	)
 
 	return oracleTxCmd
@@ -83,6 +87,68 @@ where "terra1..." is the address you want to delegate your voting rights to.
 	return cmd
 }
 
+// GetCmdAggregateExchangeRatePrevote will create a aggregateExchangeRatePrevote tx and sign it with the given key.
+func GetCmdAggregateExchangeRatePrevote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [salt] [exchange-rates] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit an oracle aggregate prevote for the exchange_rates of the base denom",
+		Long: strings.TrimSpace(`
+Submit an oracle aggregate prevote for the exchange_rates of the base denom w.r.t the input denom.
+Prevotes are only accepted when commit-reveal voting is enabled, and must be revealed by an aggregate vote with the same salt and exchange rates in the next vote period.
+
+$ seid tx oracle aggregate-prevote 1234 8888.0ukrw,1.243uusd,0.99usdr
+
+where "1234" is a salt kept secret until the reveal, "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" is the exchange rates of micro USD in micro denoms from the voter's point of view.
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ seid tx oracle aggregate-prevote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salt := args[0]
+			exchangeRatesStr := args[1]
+			_, err = types.ParseExchangeRateTuples(exchangeRatesStr)
+			if err != nil {
+				return fmt.Errorf("given exchange_rates {%s} is not a valid format; exchange_rate should be formatted as DecCoins; %s", exchangeRatesStr, err.Error())
+			}
+
+			// Get from address
+			voter := clientCtx.GetFromAddress()
+
+			// By default the voter is voting on behalf of itself
+			validator := sdk.ValAddress(voter)
+
+			// Override validator if validator is given
+			if len(args) == 3 {
+				parsedVal, err := sdk.ValAddressFromBech32(args[2])
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+				validator = parsedVal
+			}
+
+			hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, validator)
+			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRatePrevote(hash, voter, validator)}
+			for _, msg := range msgs {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdAggregateExchangeRateVote will create a aggregateExchangeRateVote tx and sign it with the given key.
 func GetCmdAggregateExchangeRateVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -98,6 +164,9 @@ where "ukrw,uusd,usdr" is the denominating currencies, and "8888.0,1.243,0.99" i
 
 If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
 $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1....
+
+When commit-reveal voting is enabled, the vote reveals the prevote of the previous vote period and must pass the salt it was made with:
+$ seid tx oracle aggregate-vote 8888.0ukrw,1.243uusd,0.99usdr --salt 1234
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -126,7 +195,13 @@ $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1..
 				validator = parsedVal
 			}
 
-			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, voter, validator)}
+			msg := types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, voter, validator)
+			msg.Salt, err = cmd.Flags().GetString(flagSalt)
+			if err != nil {
+				return err
+			}
+
+			msgs := []sdk.Msg{msg}
 			for _, msg := range msgs {
 				if err := msg.ValidateBasic(); err != nil {
 					return err
@@ -137,6 +212,7 @@ $ seid tx oracle aggregate-vote 1234 8888.0ukrw,1.243uusd,0.99usdr seivaloper1..
 		},
 	}
 
+	cmd.Flags().String(flagSalt, "", "Salt of the prevote being revealed, required when commit-reveal voting is enabled")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
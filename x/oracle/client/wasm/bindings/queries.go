@@ -5,6 +5,10 @@ import "github.com/sei-protocol/sei-chain/x/oracle/types"
 type SeiOracleQuery struct {
 	// queries the oracle exchange rates
 	ExchangeRates *types.QueryExchangeRatesRequest `json:"exchange_rates,omitempty"`
+	// queries the exchange rate of a denom, failing if it is stale
+	FreshExchangeRate *types.QueryExchangeRateRequest `json:"fresh_exchange_rate,omitempty"`
 	// queries the oracle TWAPs
 	OracleTwaps *types.QueryTwapsRequest `json:"oracle_twaps,omitempty"`
+	// queries the median, min, max and volatility of the oracle price snapshots
+	PriceStats *types.QueryPriceStatsRequest `json:"price_stats,omitempty"`
 }
@@ -22,8 +22,27 @@ func (handler OracleWasmQueryHandler) GetExchangeRates(ctx sdk.Context) (*types.
 	return querier.ExchangeRates(c, &types.QueryExchangeRatesRequest{})
 }
 
+// GetFreshExchangeRate returns the exchange rate of a denom, or an error if the rate is stale
+func (handler OracleWasmQueryHandler) GetFreshExchangeRate(ctx sdk.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
+	exchangeRate, lastUpdate, err := handler.oracleKeeper.GetFreshBaseExchangeRate(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	age, _ := handler.oracleKeeper.GetExchangeRateAge(ctx, lastUpdate)
+	return &types.QueryExchangeRateResponse{
+		OracleExchangeRate: types.OracleExchangeRate{ExchangeRate: exchangeRate, LastUpdate: lastUpdate},
+		Age:                age,
+	}, nil
+}
+
 func (handler OracleWasmQueryHandler) GetOracleTwaps(ctx sdk.Context, req *types.QueryTwapsRequest) (*types.QueryTwapsResponse, error) {
 	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
 	c := sdk.WrapSDKContext(ctx)
 	return querier.Twaps(c, req)
 }
+
+func (handler OracleWasmQueryHandler) GetPriceStats(ctx sdk.Context, req *types.QueryPriceStatsRequest) (*types.QueryPriceStatsResponse, error) {
+	querier := oraclekeeper.NewQuerier(handler.oracleKeeper)
+	c := sdk.WrapSDKContext(ctx)
+	return querier.PriceStats(c, req)
+}
@@ -49,10 +49,30 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
+	for _, ap := range data.AggregateExchangeRatePrevotes {
+		valAddr, err := sdk.ValAddressFromBech32(ap.Voter)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetAggregateExchangeRatePrevote(ctx, valAddr, ap)
+	}
+
 	for _, priceSnapshot := range data.PriceSnapshots {
 		keeper.AddPriceSnapshot(ctx, priceSnapshot)
 	}
 
+	for _, vr := range data.ValidatorRewards {
+		operator, err := sdk.ValAddressFromBech32(vr.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetAccruedRewards(ctx, operator, vr.Rewards)
+	}
+
+	keeper.SetCommitRevealActive(ctx, data.CommitRevealActive)
+
 	// check if the module account exists
 	moduleAcc := keeper.GetOracleAccount(ctx)
 	if moduleAcc == nil {
@@ -95,18 +115,36 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	aggregateExchangeRatePrevotes := []types.AggregateExchangeRatePrevote{}
+	keeper.IterateAggregateExchangeRatePrevotes(ctx, func(_ sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) bool {
+		aggregateExchangeRatePrevotes = append(aggregateExchangeRatePrevotes, aggregatePrevote)
+		return false
+	})
+
 	priceSnapshots := types.PriceSnapshots{}
 	keeper.IteratePriceSnapshots(ctx, func(snapshot types.PriceSnapshot) bool {
 		priceSnapshots = append(priceSnapshots, snapshot)
 		return false
 	})
 
+	validatorRewards := []types.ValidatorRewards{}
+	keeper.IterateAccruedRewards(ctx, func(operator sdk.ValAddress, rewards sdk.Coins) (stop bool) {
+		validatorRewards = append(validatorRewards, types.ValidatorRewards{
+			ValidatorAddress: operator.String(),
+			Rewards:          rewards,
+		})
+		return false
+	})
+
 	return types.NewGenesisState(
 		params,
 		exchangeRates,
 		feederDelegations,
 		penaltyCounters,
 		aggregateExchangeRateVotes,
+		aggregateExchangeRatePrevotes,
 		priceSnapshots,
+		validatorRewards,
+		keeper.CommitRevealActive(ctx),
 	)
 }
@@ -18,10 +18,13 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.SetFeederDelegation(input.Ctx, keeper.ValAddrs[0], keeper.Addrs[1])
 	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, "denom", sdk.NewDec(123))
 	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(123)}}, keeper.ValAddrs[0]))
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[1], types.NewAggregateExchangeRatePrevote(types.GetAggregateVoteHash("salt", "123foo", keeper.ValAddrs[1]), keeper.ValAddrs[1], 2))
 	input.OracleKeeper.SetVoteTarget(input.Ctx, "denom")
 	input.OracleKeeper.SetVoteTarget(input.Ctx, "denom2")
 	input.OracleKeeper.SetVotePenaltyCounter(input.Ctx, keeper.ValAddrs[0], 2, 3, 0)
 	input.OracleKeeper.SetVotePenaltyCounter(input.Ctx, keeper.ValAddrs[1], 4, 5, 0)
+	input.OracleKeeper.SetAccruedRewards(input.Ctx, keeper.ValAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("usei", 10)))
+	input.OracleKeeper.SetCommitRevealActive(input.Ctx, true)
 	input.OracleKeeper.AddPriceSnapshot(input.Ctx, types.NewPriceSnapshot(
 		types.PriceSnapshotItems{
 			{
@@ -67,4 +70,8 @@ func TestExportInitGenesis(t *testing.T) {
 	newGenesis := oracle.ExportGenesis(newInput.Ctx, newInput.OracleKeeper)
 
 	require.Equal(t, genesis, newGenesis)
+	require.Len(t, newGenesis.AggregateExchangeRatePrevotes, 1)
+	require.Len(t, newGenesis.ValidatorRewards, 1)
+	require.True(t, newGenesis.CommitRevealActive)
+	require.True(t, newInput.OracleKeeper.CommitRevealActive(newInput.Ctx))
 }
@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/sei-protocol/sei-chain/x/oracle"
 	"github.com/sei-protocol/sei-chain/x/oracle/keeper"
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 	"github.com/sei-protocol/sei-chain/x/oracle/utils"
@@ -68,3 +69,85 @@ func TestFeederDelegation(t *testing.T) {
 	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
 	require.NoError(t, err)
 }
+
+func TestAggregatePrevoteVote(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	salt := "1"
+	exchangeRatesStr := randomExchangeRate.String() + utils.MicroAtomDenom
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, keeper.ValAddrs[0])
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+	voteMsg := types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	voteMsg.Salt = salt
+
+	// prevotes are rejected while commit-reveal is disabled
+	_, err := h(input.Ctx.WithBlockHeight(1), prevoteMsg)
+	require.ErrorIs(t, err, types.ErrCommitRevealDisabled)
+
+	params.CommitRevealEnabled = true
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// plain votes are still accepted until the end of the vote period in which it was enabled
+	_, err = h(input.Ctx.WithBlockHeight(1), types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, keeper.Addrs[0], keeper.ValAddrs[0]))
+	require.NoError(t, err)
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+	require.True(t, input.OracleKeeper.CommitRevealActive(input.Ctx))
+
+	// a vote without a prevote fails
+	_, err = h(input.Ctx.WithBlockHeight(3), voteMsg)
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+
+	// prevote from a feeder without permission fails
+	_, err = h(input.Ctx.WithBlockHeight(1), types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[1], keeper.ValAddrs[0]))
+	require.Error(t, err)
+
+	_, err = h(input.Ctx.WithBlockHeight(1), prevoteMsg)
+	require.NoError(t, err)
+
+	// the reveal has to happen in the next vote period
+	_, err = h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+	_, err = h(input.Ctx.WithBlockHeight(4), voteMsg)
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+
+	// the reveal has to match the prevote
+	wrongSaltMsg := types.NewMsgAggregateExchangeRateVote(exchangeRatesStr, keeper.Addrs[0], keeper.ValAddrs[0])
+	wrongSaltMsg.Salt = "2"
+	_, err = h(input.Ctx.WithBlockHeight(2), wrongSaltMsg)
+	require.ErrorIs(t, err, types.ErrVerificationFailed)
+	copiedMsg := types.NewMsgAggregateExchangeRateVote(anotherRandomExchangeRate.String()+utils.MicroAtomDenom, keeper.Addrs[0], keeper.ValAddrs[0])
+	copiedMsg.Salt = salt
+	_, err = h(input.Ctx.WithBlockHeight(2), copiedMsg)
+	require.ErrorIs(t, err, types.ErrVerificationFailed)
+
+	_, err = h(input.Ctx.WithBlockHeight(3), voteMsg)
+	require.NoError(t, err)
+	vote, err := input.OracleKeeper.GetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, vote.ExchangeRateTuples[0].ExchangeRate)
+
+	// the prevote can only be revealed once
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0])
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+	_, err = h(input.Ctx.WithBlockHeight(3), voteMsg)
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+}
+
+func TestUpdateDenomParamsProposalHandler(t *testing.T) {
+	input, _ := setup(t)
+	h := oracle.NewProposalHandler(input.OracleKeeper)
+
+	proposal := &types.UpdateDenomParamsProposal{
+		Title:       "title",
+		Description: "description",
+		Denoms:      []types.Denom{{Name: utils.MicroEthDenom, MinVoters: 2}},
+	}
+	require.NoError(t, h(input.Ctx, proposal))
+	require.Equal(t, types.DenomList{{Name: utils.MicroAtomDenom}, {Name: utils.MicroEthDenom, MinVoters: 2}}, input.OracleKeeper.Whitelist(input.Ctx))
+
+	proposal.Denoms = []types.Denom{{Name: "ufoo"}}
+	require.Error(t, h(input.Ctx, proposal))
+}
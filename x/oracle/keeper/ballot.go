@@ -54,8 +54,18 @@ func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[str
 	return votes
 }
 
-// ClearBallots clears all tallied votes from the store
-func (k Keeper) ClearBallots(ctx sdk.Context, _ uint64) {
+// ClearBallots clears all tallied votes and the prevotes that can no longer be revealed from the store
+func (k Keeper) ClearBallots(ctx sdk.Context, votePeriod uint64) {
+	// Clear the prevotes submitted before the vote period that is ending, since they could only
+	// have been revealed in it
+	currentPeriod := uint64(ctx.BlockHeight()) / votePeriod
+	k.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool) {
+		if aggregatePrevote.SubmitBlock/votePeriod < currentPeriod {
+			k.DeleteAggregateExchangeRatePrevote(ctx, voterAddr)
+		}
+		return false
+	})
+
 	// Clear all aggregate votes
 	k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, aggregateVote types.AggregateExchangeRateVote) (stop bool) {
 		k.DeleteAggregateExchangeRateVote(ctx, voterAddr)
@@ -71,7 +81,8 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 		updateRequired = true
 	} else {
 		for _, item := range whitelist {
-			if _, ok := voteTargets[item.Name]; !ok {
+			// an update of the overrides of a denom also requires an update
+			if voteTarget, ok := voteTargets[item.Name]; !ok || !voteTarget.Equal(&item) {
 				updateRequired = true
 				break
 			}
@@ -82,7 +93,7 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 		k.ClearVoteTargets(ctx)
 
 		for _, item := range whitelist {
-			k.SetVoteTarget(ctx, item.Name)
+			k.SetVoteTargetDenom(ctx, item)
 
 			// Register meta data to bank module
 			if _, ok := k.bankKeeper.GetDenomMetaData(ctx, item.Name); !ok {
@@ -114,7 +114,12 @@ func TestClearBallots(t *testing.T) {
 			}, ValAddrs[i]))
 	}
 
-	input.OracleKeeper.ClearBallots(input.Ctx, 5)
+	// a prevote that can still be revealed in the next vote period and one that can't
+	hash := types.GetAggregateVoteHash("salt", "1000uatom", ValAddrs[0])
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], types.NewAggregateExchangeRatePrevote(hash, ValAddrs[0], 5))
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1], types.NewAggregateExchangeRatePrevote(hash, ValAddrs[1], 4))
+
+	input.OracleKeeper.ClearBallots(input.Ctx.WithBlockHeight(9), 5)
 
 	voteCounter := 0
 	input.OracleKeeper.IterateAggregateExchangeRateVotes(input.Ctx, func(_ sdk.ValAddress, _ types.AggregateExchangeRateVote) bool {
@@ -122,6 +127,11 @@ func TestClearBallots(t *testing.T) {
 		return false
 	})
 	require.Equal(t, voteCounter, 0)
+
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0])
+	require.NoError(t, err)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1])
+	require.Error(t, err)
 }
 
 func TestApplyWhitelist(t *testing.T) {
@@ -155,4 +165,17 @@ func TestApplyWhitelist(t *testing.T) {
 	require.Equal(t, metadata.Display, "usdc")
 	require.Equal(t, len(metadata.DenomUnits), 3)
 	require.Equal(t, metadata.Description, "usdc")
+
+	// overrides of a whitelisted denom are applied to its vote target
+	minVoters := types.Denom{Name: "uatom", MinVoters: 3}
+	voteTargets := map[string]types.Denom{}
+	input.OracleKeeper.IterateVoteTargets(input.Ctx, func(denom string, denomInfo types.Denom) bool {
+		voteTargets[denom] = denomInfo
+		return false
+	})
+	input.OracleKeeper.ApplyWhitelist(input.Ctx, types.DenomList{minVoters, {Name: "uusdc"}}, voteTargets)
+
+	voteTarget, err := input.OracleKeeper.GetVoteTarget(input.Ctx, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, minVoters, voteTarget)
 }
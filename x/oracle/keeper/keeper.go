@@ -103,6 +103,47 @@ func (k Keeper) DeleteBaseExchangeRate(ctx sdk.Context, denom string) {
 	store.Delete(types.GetExchangeRateKey(denom))
 }
 
+// GetExchangeRateAge returns the number of blocks since the last update of an exchange rate, and
+// whether that is past the MaxStaleness param
+func (k Keeper) GetExchangeRateAge(ctx sdk.Context, lastUpdate sdk.Int) (age int64, stale bool) {
+	age = ctx.BlockHeight() - lastUpdate.Int64()
+	maxStaleness := k.MaxStaleness(ctx)
+	return age, maxStaleness > 0 && age > int64(maxStaleness)
+}
+
+// GetFreshBaseExchangeRate returns the exchange rate of a denom like GetBaseExchangeRate, but
+// fails if the exchange rate is stale
+func (k Keeper) GetFreshBaseExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, sdk.Int, error) {
+	exchangeRate, lastUpdate, err := k.GetBaseExchangeRate(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroInt(), err
+	}
+	if age, stale := k.GetExchangeRateAge(ctx, lastUpdate); stale {
+		return sdk.ZeroDec(), sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrStaleExchangeRate, "%s was last updated %d blocks ago", denom, age)
+	}
+	return exchangeRate, lastUpdate, nil
+}
+
+// RemoveStaleExchangeRates deletes the exchange rates that are past the MaxStaleness param, so
+// that a denom failing its ballots doesn't keep its last rate forever
+func (k Keeper) RemoveStaleExchangeRates(ctx sdk.Context) {
+	staleDenoms := []string{}
+	k.IterateBaseExchangeRates(ctx, func(denom string, exchangeRate types.OracleExchangeRate) bool {
+		if _, stale := k.GetExchangeRateAge(ctx, exchangeRate.LastUpdate); stale {
+			staleDenoms = append(staleDenoms, denom)
+		}
+		return false
+	})
+	for _, denom := range staleDenoms {
+		k.DeleteBaseExchangeRate(ctx, denom)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeStaleExchangeRate,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			),
+		)
+	}
+}
+
 func (k Keeper) IterateBaseExchangeRates(ctx sdk.Context, handler func(denom string, exchangeRate types.OracleExchangeRate) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iter := sdk.KVStorePrefixIterator(store, types.ExchangeRateKey)
@@ -242,6 +283,114 @@ func (k Keeper) IterateVotePenaltyCounters(ctx sdk.Context,
 	}
 }
 
+//-----------------------------------
+// AggregateExchangeRatePrevote logic
+
+// GetAggregateExchangeRatePrevote retrieves an oracle prevote from the store
+func (k Keeper) GetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) (aggregatePrevote types.AggregateExchangeRatePrevote, err error) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAggregateExchangeRatePrevoteKey(voter))
+	if b == nil {
+		err = sdkerrors.Wrap(types.ErrNoAggregatePrevote, voter.String())
+		return
+	}
+	k.cdc.MustUnmarshal(b, &aggregatePrevote)
+	return
+}
+
+// SetAggregateExchangeRatePrevote set an oracle aggregate prevote to the store
+func (k Keeper) SetAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress, prevote types.AggregateExchangeRatePrevote) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&prevote)
+	store.Set(types.GetAggregateExchangeRatePrevoteKey(voter), bz)
+}
+
+// DeleteAggregateExchangeRatePrevote deletes an oracle prevote from the store
+func (k Keeper) DeleteAggregateExchangeRatePrevote(ctx sdk.Context, voter sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregateExchangeRatePrevoteKey(voter))
+}
+
+// IterateAggregateExchangeRatePrevotes iterates rate over prevotes in the store
+func (k Keeper) IterateAggregateExchangeRatePrevotes(ctx sdk.Context, handler func(voterAddr sdk.ValAddress, aggregatePrevote types.AggregateExchangeRatePrevote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AggregateExchangeRatePrevoteKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		voterAddr := sdk.ValAddress(iter.Key()[2:])
+
+		var aggregatePrevote types.AggregateExchangeRatePrevote
+		k.cdc.MustUnmarshal(iter.Value(), &aggregatePrevote)
+		if handler(voterAddr, aggregatePrevote) {
+			break
+		}
+	}
+}
+
+//-----------------------------------
+// CommitRevealActive logic
+
+// CommitRevealActive returns whether votes of the current vote period must be revealed from a
+// prevote. It follows the CommitRevealEnabled param at the end of each vote period, so that
+// validators have a vote period to prevote before their plain votes are rejected.
+func (k Keeper) CommitRevealActive(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CommitRevealActiveKey)
+}
+
+// SetCommitRevealActive sets whether votes of the following vote periods must be revealed from a prevote
+func (k Keeper) SetCommitRevealActive(ctx sdk.Context, active bool) {
+	store := ctx.KVStore(k.storeKey)
+	if active {
+		store.Set(types.CommitRevealActiveKey, []byte{1})
+	} else {
+		store.Delete(types.CommitRevealActiveKey)
+	}
+}
+
+//-----------------------------------
+// AccruedRewards logic
+
+// GetAccruedRewards retrieves the oracle rewards distributed to a validator so far
+func (k Keeper) GetAccruedRewards(ctx sdk.Context, operator sdk.ValAddress) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAccruedRewardsKey(operator))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	accruedRewards := types.AccruedRewards{}
+	k.cdc.MustUnmarshal(bz, &accruedRewards)
+	return accruedRewards.Rewards
+}
+
+// SetAccruedRewards sets the oracle rewards distributed to a validator so far
+func (k Keeper) SetAccruedRewards(ctx sdk.Context, operator sdk.ValAddress, rewards sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.AccruedRewards{Rewards: rewards})
+	store.Set(types.GetAccruedRewardsKey(operator), bz)
+}
+
+// AddAccruedRewards adds to the oracle rewards distributed to a validator so far
+func (k Keeper) AddAccruedRewards(ctx sdk.Context, operator sdk.ValAddress, rewards sdk.Coins) {
+	k.SetAccruedRewards(ctx, operator, k.GetAccruedRewards(ctx, operator).Add(rewards...))
+}
+
+// IterateAccruedRewards iterates over the accrued rewards of all validators and performs a callback function.
+func (k Keeper) IterateAccruedRewards(ctx sdk.Context, handler func(operator sdk.ValAddress, rewards sdk.Coins) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AccruedRewardsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var accruedRewards types.AccruedRewards
+		k.cdc.MustUnmarshal(iter.Value(), &accruedRewards)
+		if handler(operator, accruedRewards.Rewards) {
+			break
+		}
+	}
+}
+
 //-----------------------------------
 // AggregateExchangeRateVote logic
 
@@ -301,9 +450,14 @@ func (k Keeper) GetVoteTarget(ctx sdk.Context, denom string) (types.Denom, error
 }
 
 func (k Keeper) SetVoteTarget(ctx sdk.Context, denom string) {
+	k.SetVoteTargetDenom(ctx, types.Denom{Name: denom})
+}
+
+// SetVoteTargetDenom sets a vote target along with its per-denom overrides
+func (k Keeper) SetVoteTargetDenom(ctx sdk.Context, denom types.Denom) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&types.Denom{Name: denom})
-	store.Set(types.GetVoteTargetKey(denom), bz)
+	bz := k.cdc.MustMarshal(&denom)
+	store.Set(types.GetVoteTargetKey(denom.Name), bz)
 }
 
 func (k Keeper) IterateVoteTargets(ctx sdk.Context, handler func(denom string, denomInfo types.Denom) (stop bool)) {
@@ -525,6 +679,53 @@ func (k Keeper) CalculateTwaps(ctx sdk.Context, lookbackSeconds uint64) (types.O
 	return oracleTwaps, nil
 }
 
+// CalculatePriceStats computes the median, min, max and volatility of the exchange rates of each
+// denom over the price snapshots taken in the lookback window
+func (k Keeper) CalculatePriceStats(ctx sdk.Context, lookbackSeconds uint64) (types.OraclePriceStatsList, error) {
+	oraclePriceStats := types.OraclePriceStatsList{}
+	currentTime := ctx.BlockTime().Unix()
+	err := k.ValidateLookbackSeconds(ctx, lookbackSeconds)
+	if err != nil {
+		return oraclePriceStats, err
+	}
+	windowStart := currentTime - int64(lookbackSeconds)
+	// samples are collected from the newest snapshot to the oldest one
+	denomToSamplesMap := make(map[string][]sdk.Dec)
+	denomToOldestTimestampMap := make(map[string]int64)
+
+	k.IteratePriceSnapshotsReverse(ctx, func(snapshot types.PriceSnapshot) (stop bool) {
+		if snapshot.SnapshotTimestamp < windowStart {
+			return true
+		}
+		for _, priceItem := range snapshot.PriceSnapshotItems {
+			denomToSamplesMap[priceItem.Denom] = append(denomToSamplesMap[priceItem.Denom], priceItem.OracleExchangeRate.ExchangeRate)
+			denomToOldestTimestampMap[priceItem.Denom] = snapshot.SnapshotTimestamp
+		}
+		return false
+	})
+
+	denomKeys := make([]string, 0, len(denomToSamplesMap))
+	for k := range denomToSamplesMap {
+		denomKeys = append(denomKeys, k)
+	}
+	sort.Strings(denomKeys)
+
+	for _, denomKey := range denomKeys {
+		samples := denomToSamplesMap[denomKey]
+		// order the samples from the oldest to the newest for the returns
+		for i, j := 0, len(samples)-1; i < j; i, j = i+1, j-1 {
+			samples[i], samples[j] = samples[j], samples[i]
+		}
+		oraclePriceStats = append(oraclePriceStats, types.NewOraclePriceStats(denomKey, samples, currentTime-denomToOldestTimestampMap[denomKey]))
+	}
+
+	if len(oraclePriceStats) == 0 {
+		return oraclePriceStats, types.ErrNoPriceStatsData
+	}
+
+	return oraclePriceStats, nil
+}
+
 func (k Keeper) ValidateLookbackSeconds(ctx sdk.Context, lookbackSeconds uint64) error {
 	lookbackDuration := k.LookbackDuration(ctx)
 	if lookbackSeconds > lookbackDuration || lookbackSeconds == 0 {
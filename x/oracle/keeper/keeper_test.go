@@ -105,6 +105,41 @@ func TestIterateSeiExchangeRates(t *testing.T) {
 	})
 }
 
+func TestExchangeRateStaleness(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom, sdk.NewDec(12))
+	input.Ctx = input.Ctx.WithBlockHeight(20)
+	input.OracleKeeper.SetBaseExchangeRate(input.Ctx, utils.MicroEthDenom, sdk.NewDec(34))
+
+	// rates never go stale without a max staleness
+	age, stale := input.OracleKeeper.GetExchangeRateAge(input.Ctx, sdk.NewInt(10))
+	require.Equal(t, int64(10), age)
+	require.False(t, stale)
+	input.OracleKeeper.RemoveStaleExchangeRates(input.Ctx)
+	_, _, err := input.OracleKeeper.GetFreshBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.NoError(t, err)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxStaleness = 5
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	_, stale = input.OracleKeeper.GetExchangeRateAge(input.Ctx, sdk.NewInt(15))
+	require.False(t, stale)
+	_, _, err = input.OracleKeeper.GetFreshBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+	rate, _, err := input.OracleKeeper.GetFreshBaseExchangeRate(input.Ctx, utils.MicroEthDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(34), rate)
+
+	input.OracleKeeper.RemoveStaleExchangeRates(input.Ctx)
+	_, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroAtomDenom)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+	_, _, err = input.OracleKeeper.GetBaseExchangeRate(input.Ctx, utils.MicroEthDenom)
+	require.NoError(t, err)
+}
+
 func TestRewardPool(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -134,6 +169,8 @@ func TestParams(t *testing.T) {
 	slashFraction := sdk.NewDecWithPrec(1, 2)
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
+	rewardDistributionRate := sdk.NewDecWithPrec(1, 3)
+	maxStaleness := uint64(20)
 	whitelist := types.DenomList{
 		{Name: utils.MicroEthDenom},
 		{Name: utils.MicroAtomDenom},
@@ -141,13 +178,15 @@ func TestParams(t *testing.T) {
 
 	// Should really test validateParams, but skipping because obvious
 	newParams := types.Params{
-		VotePeriod:        votePeriod,
-		VoteThreshold:     voteThreshold,
-		RewardBand:        oracleRewardBand,
-		Whitelist:         whitelist,
-		SlashFraction:     slashFraction,
-		SlashWindow:       slashWindow,
-		MinValidPerWindow: minValidPerWindow,
+		VotePeriod:             votePeriod,
+		VoteThreshold:          voteThreshold,
+		RewardBand:             oracleRewardBand,
+		Whitelist:              whitelist,
+		SlashFraction:          slashFraction,
+		SlashWindow:            slashWindow,
+		MinValidPerWindow:      minValidPerWindow,
+		RewardDistributionRate: rewardDistributionRate,
+		MaxStaleness:           maxStaleness,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -268,6 +307,31 @@ func TestIterateMissCounters(t *testing.T) {
 	require.Equal(t, missCounter, votePenaltyCounters[0].MissCount)
 }
 
+func TestAggregatePrevoteAddDelete(t *testing.T) {
+	input := CreateTestInput(t)
+
+	hash := types.GetAggregateVoteHash("salt", "100ufoo,1000ubar", ValAddrs[0])
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(hash, ValAddrs[0], 1)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], aggregatePrevote)
+
+	KPrevote, err := input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, aggregatePrevote, KPrevote)
+
+	count := 0
+	input.OracleKeeper.IterateAggregateExchangeRatePrevotes(input.Ctx, func(voter sdk.ValAddress, p types.AggregateExchangeRatePrevote) (stop bool) {
+		require.Equal(t, ValAddrs[0], voter)
+		require.Equal(t, aggregatePrevote, p)
+		count++
+		return false
+	})
+	require.Equal(t, 1, count)
+
+	input.OracleKeeper.DeleteAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0])
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0])
+	require.Error(t, err)
+}
+
 func TestAggregateVoteAddDelete(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -675,3 +739,62 @@ func TestCalculateTwaps(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, types.ErrInvalidTwapLookback, err)
 }
+
+func TestCalculatePriceStats(t *testing.T) {
+	input := CreateTestInput(t)
+
+	_, err := input.OracleKeeper.CalculatePriceStats(input.Ctx, 3600)
+	require.ErrorIs(t, err, types.ErrNoPriceStatsData)
+
+	priceSnapshots := types.PriceSnapshots{
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(40), LastUpdate: sdk.NewInt(1200)}),
+		}, 1200),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(10), LastUpdate: sdk.NewInt(2000)}),
+			types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(10), LastUpdate: sdk.NewInt(2000)}),
+		}, 2000),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(20), LastUpdate: sdk.NewInt(3600)}),
+			types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(20), LastUpdate: sdk.NewInt(3600)}),
+		}, 3600),
+		types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(40), LastUpdate: sdk.NewInt(4500)}),
+			types.NewPriceSnapshotItem(utils.MicroEthDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(10), LastUpdate: sdk.NewInt(4500)}),
+		}, 4500),
+	}
+	for _, snap := range priceSnapshots {
+		input.OracleKeeper.SetPriceSnapshot(input.Ctx, snap)
+	}
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+
+	_, err = input.OracleKeeper.CalculatePriceStats(input.Ctx, 0)
+	require.ErrorIs(t, err, types.ErrInvalidTwapLookback)
+
+	// the snapshot at 1200 is out of the window
+	stats, err := input.OracleKeeper.CalculatePriceStats(input.Ctx, 3600)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stats))
+
+	atomStats := stats[0]
+	require.Equal(t, utils.MicroAtomDenom, atomStats.Denom)
+	require.Equal(t, uint64(3), atomStats.SampleCount)
+	require.Equal(t, int64(3400), atomStats.LookbackSeconds)
+	require.Equal(t, sdk.NewDec(20), atomStats.Median)
+	require.Equal(t, sdk.NewDec(10), atomStats.Min)
+	require.Equal(t, sdk.NewDec(40), atomStats.Max)
+	// the price doubles at every snapshot
+	require.True(t, atomStats.Volatility.LT(sdk.NewDecWithPrec(1, 12)))
+
+	ethStats := stats[1]
+	require.Equal(t, utils.MicroEthDenom, ethStats.Denom)
+	require.Equal(t, uint64(3), ethStats.SampleCount)
+	require.Equal(t, sdk.NewDec(10), ethStats.Median)
+	require.Equal(t, sdk.NewDec(20), ethStats.Max)
+	// log returns of ln(2) and -ln(2)
+	require.True(t, ethStats.Volatility.Sub(sdk.MustNewDecFromStr("0.693147180559945309")).Abs().LT(sdk.NewDecWithPrec(1, 12)))
+
+	// no snapshots in a short window
+	_, err = input.OracleKeeper.CalculatePriceStats(input.Ctx.WithBlockTime(time.Unix(7000, 0)), 600)
+	require.ErrorIs(t, err, types.ErrNoPriceStatsData)
+}
@@ -94,3 +94,21 @@ func (m Migrator) Migrate5To6(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// Migrate6To7 sets the commit-reveal param, which defaults to disabled
+func (m Migrator) Migrate6To7(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyCommitRevealEnabled, types.DefaultCommitRevealEnabled)
+	return nil
+}
+
+// Migrate7To8 sets the reward distribution rate param, which defaults to distributing nothing
+func (m Migrator) Migrate7To8(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyRewardDistributionRate, types.DefaultRewardDistributionRate)
+	return nil
+}
+
+// Migrate8To9 sets the max staleness param, which defaults to never dropping exchange rates
+func (m Migrator) Migrate8To9(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxStaleness, types.DefaultMaxStaleness)
+	return nil
+}
@@ -152,3 +152,42 @@ func TestMigrate5to6(t *testing.T) {
 		SuccessCount: 9975,
 	}, votePenaltyCounter)
 }
+
+func TestMigrate6to7(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Migrate store
+	m := NewMigrator(input.OracleKeeper)
+	require.NoError(t, m.Migrate6To7(input.Ctx))
+
+	// commit-reveal stays disabled until governance enables it
+	require.True(t, input.OracleKeeper.paramSpace.Has(input.Ctx, types.KeyCommitRevealEnabled))
+	require.False(t, input.OracleKeeper.CommitRevealEnabled(input.Ctx))
+	require.Equal(t, types.DefaultParams(), input.OracleKeeper.GetParams(input.Ctx))
+}
+
+func TestMigrate7to8(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Migrate store
+	m := NewMigrator(input.OracleKeeper)
+	require.NoError(t, m.Migrate7To8(input.Ctx))
+
+	// no rewards are distributed until governance sets a rate
+	require.True(t, input.OracleKeeper.paramSpace.Has(input.Ctx, types.KeyRewardDistributionRate))
+	require.True(t, input.OracleKeeper.RewardDistributionRate(input.Ctx).IsZero())
+	require.Equal(t, types.DefaultParams(), input.OracleKeeper.GetParams(input.Ctx))
+}
+
+func TestMigrate8to9(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Migrate store
+	m := NewMigrator(input.OracleKeeper)
+	require.NoError(t, m.Migrate8To9(input.Ctx))
+
+	// exchange rates don't go stale until governance sets a max staleness
+	require.True(t, input.OracleKeeper.paramSpace.Has(input.Ctx, types.KeyMaxStaleness))
+	require.Equal(t, uint64(0), input.OracleKeeper.MaxStaleness(input.Ctx))
+	require.Equal(t, types.DefaultParams(), input.OracleKeeper.GetParams(input.Ctx))
+}
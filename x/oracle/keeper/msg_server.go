@@ -20,6 +20,52 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 	return &msgServer{Keeper: keeper}
 }
 
+func (ms msgServer) AggregateExchangeRatePrevote(goCtx context.Context, msg *types.MsgAggregateExchangeRatePrevote) (*types.MsgAggregateExchangeRatePrevoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !ms.CommitRevealEnabled(ctx) {
+		return nil, types.ErrCommitRevealDisabled
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+		return nil, err
+	}
+
+	// Convert hex string to votehash
+	voteHash, err := types.AggregateVoteHashFromHexString(msg.Hash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidHash, err.Error())
+	}
+
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(voteHash, valAddr, uint64(ctx.BlockHeight()))
+	ms.SetAggregateExchangeRatePrevote(ctx, valAddr, aggregatePrevote)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregatePrevote,
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyAggregateHash, msg.Hash),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder),
+		),
+	})
+
+	return &types.MsgAggregateExchangeRatePrevoteResponse{}, nil
+}
+
 func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.MsgAggregateExchangeRateVote) (*types.MsgAggregateExchangeRateVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -49,6 +95,14 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 		}
 	}
 
+	// enabling commit-reveal only applies from the next vote period on, since votes of the current
+	// one have no prevote to be revealed from
+	if ms.CommitRevealActive(ctx) {
+		if err := ms.revealAggregateExchangeRatePrevote(ctx, valAddr, msg); err != nil {
+			return nil, err
+		}
+	}
+
 	ms.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr))
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -103,3 +157,27 @@ func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDel
 
 	return &types.MsgDelegateFeedConsentResponse{}, nil
 }
+
+// revealAggregateExchangeRatePrevote checks that the vote reveals the prevote the validator
+// submitted in the previous vote period and removes the prevote
+func (ms msgServer) revealAggregateExchangeRatePrevote(ctx sdk.Context, valAddr sdk.ValAddress, msg *types.MsgAggregateExchangeRateVote) error {
+	aggregatePrevote, err := ms.GetAggregateExchangeRatePrevote(ctx, valAddr)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrNoAggregatePrevote, msg.Validator)
+	}
+
+	// Check a msg is submitted proper period
+	votePeriod := ms.VotePeriod(ctx)
+	if (uint64(ctx.BlockHeight())/votePeriod)-(aggregatePrevote.SubmitBlock/votePeriod) != 1 {
+		return types.ErrRevealPeriodMissMatch
+	}
+
+	// Verify a exchange rate with aggregate prevote hash
+	hash := types.GetAggregateVoteHash(msg.Salt, msg.ExchangeRates, valAddr)
+	if aggregatePrevote.Hash != hash.String() {
+		return sdkerrors.Wrapf(types.ErrVerificationFailed, "must be given %s not %s", aggregatePrevote.Hash, hash)
+	}
+
+	ms.DeleteAggregateExchangeRatePrevote(ctx, valAddr)
+	return nil
+}
@@ -59,6 +59,25 @@ func (k Keeper) LookbackDuration(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// CommitRevealEnabled returns whether votes must be revealed from a prevote of the previous vote period.
+// Changes only take effect at the end of the current vote period, see CommitRevealActive.
+func (k Keeper) CommitRevealEnabled(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyCommitRevealEnabled, &res)
+	return
+}
+
+// RewardDistributionRate returns the fraction of the reward pool distributed to ballot winners each vote period
+func (k Keeper) RewardDistributionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyRewardDistributionRate, &res)
+	return
+}
+
+// MaxStaleness returns the number of blocks after its last update at which an exchange rate is stale
+func (k Keeper) MaxStaleness(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxStaleness, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
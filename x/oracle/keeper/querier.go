@@ -49,7 +49,12 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 		return nil, err
 	}
 
-	return &types.QueryExchangeRateResponse{OracleExchangeRate: types.OracleExchangeRate{ExchangeRate: exchangeRate, LastUpdate: lastUpdate}}, nil
+	age, stale := q.GetExchangeRateAge(ctx, lastUpdate)
+	return &types.QueryExchangeRateResponse{
+		OracleExchangeRate: types.OracleExchangeRate{ExchangeRate: exchangeRate, LastUpdate: lastUpdate},
+		Age:                age,
+		Stale:              stale,
+	}, nil
 }
 
 // ExchangeRates queries exchange rates of all denoms
@@ -58,7 +63,8 @@ func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesReq
 
 	exchangeRates := []types.DenomOracleExchangeRatePair{}
 	q.IterateBaseExchangeRates(ctx, func(denom string, rate types.OracleExchangeRate) (stop bool) {
-		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRatePair{Denom: denom, OracleExchangeRate: rate})
+		age, stale := q.GetExchangeRateAge(ctx, rate.LastUpdate)
+		exchangeRates = append(exchangeRates, types.DenomOracleExchangeRatePair{Denom: denom, OracleExchangeRate: rate, Age: age, Stale: stale})
 		return false
 	})
 
@@ -105,6 +111,20 @@ func (q querier) Twaps(c context.Context, req *types.QueryTwapsRequest) (*types.
 	return &response, nil
 }
 
+// PriceStats queries the median, min, max and volatility of the price snapshots of denoms over a lookback window
+func (q querier) PriceStats(c context.Context, req *types.QueryPriceStatsRequest) (*types.QueryPriceStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	priceStats, err := q.CalculatePriceStats(ctx, req.LookbackSeconds)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPriceStatsResponse{OraclePriceStats: priceStats}, nil
+}
+
 // FeederDelegation queries the account address that the validator operator delegated oracle vote rights to
 func (q querier) FeederDelegation(c context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
 	if req == nil {
@@ -143,6 +163,33 @@ func (q querier) VotePenaltyCounter(c context.Context, req *types.QueryVotePenal
 	}, nil
 }
 
+// ValidatorRewards queries the oracle rewards a validator has accrued
+func (q querier) ValidatorRewards(c context.Context, req *types.QueryValidatorRewardsRequest) (*types.QueryValidatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorRewardsResponse{
+		Rewards: q.GetAccruedRewards(ctx, valAddr),
+	}, nil
+}
+
+// CommitRevealState queries whether votes must currently be revealed from a prevote, and whether
+// they have to be from the next vote period on
+func (q querier) CommitRevealState(c context.Context, _ *types.QueryCommitRevealStateRequest) (*types.QueryCommitRevealStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryCommitRevealStateResponse{
+		Active:  q.CommitRevealActive(ctx),
+		Enabled: q.CommitRevealEnabled(ctx),
+	}, nil
+}
+
 func (q querier) SlashWindow(
 	goCtx context.Context,
 	_ *types.QuerySlashWindowRequest,
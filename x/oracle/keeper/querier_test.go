@@ -37,6 +37,25 @@ func TestQueryExchangeRate(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, rate, res.OracleExchangeRate.ExchangeRate)
+	require.Equal(t, int64(0), res.Age)
+	require.False(t, res.Stale)
+
+	// the rate is stale once it is older than the max staleness
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxStaleness = 5
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	ctx = sdk.WrapSDKContext(input.Ctx.WithBlockHeight(6))
+	res, err = querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{
+		Denom: utils.MicroAtomDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(6), res.Age)
+	require.True(t, res.Stale)
+
+	ratesRes, err := querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(6), ratesRes.DenomOracleExchangeRatePairs[0].Age)
+	require.True(t, ratesRes.DenomOracleExchangeRatePairs[0].Stale)
 }
 
 func TestQueryEmptyExchangeRates(t *testing.T) {
@@ -83,6 +102,48 @@ func TestQueryFeederDelegation(t *testing.T) {
 	require.Equal(t, Addrs[1].String(), res.FeederAddr)
 }
 
+func TestQueryValidatorRewards(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 10))
+	input.OracleKeeper.AddAccruedRewards(input.Ctx, ValAddrs[0], rewards)
+	input.OracleKeeper.AddAccruedRewards(input.Ctx, ValAddrs[0], rewards)
+
+	res, err := querier.ValidatorRewards(ctx, &types.QueryValidatorRewardsRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, rewards.Add(rewards...), res.Rewards)
+
+	res, err = querier.ValidatorRewards(ctx, &types.QueryValidatorRewardsRequest{
+		ValidatorAddr: ValAddrs[1].String(),
+	})
+	require.NoError(t, err)
+	require.True(t, res.Rewards.IsZero())
+}
+
+func TestQueryCommitRevealState(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.CommitRevealEnabled = true
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	res, err := querier.CommitRevealState(ctx, &types.QueryCommitRevealStateRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Active)
+	require.True(t, res.Enabled)
+
+	input.OracleKeeper.SetCommitRevealActive(input.Ctx, true)
+	res, err = querier.CommitRevealState(ctx, &types.QueryCommitRevealStateRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Active)
+	require.True(t, res.Enabled)
+}
+
 func TestQuerySlashingWindow(t *testing.T) {
 	input := CreateTestInput(t)
 	querier := NewQuerier(input.OracleKeeper)
@@ -215,3 +276,26 @@ func TestQueryTwaps(t *testing.T) {
 	require.Equal(t, int64(1800), ethTwap.LookbackSeconds)
 	require.Equal(t, sdk.NewDec(15), ethTwap.Twap)
 }
+
+func TestQueryPriceStats(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(5400, 0))
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+	_, err := querier.PriceStats(ctx, &types.QueryPriceStatsRequest{LookbackSeconds: 3600})
+	require.Error(t, err)
+
+	for i, rate := range []int64{10, 30, 20} {
+		input.OracleKeeper.SetPriceSnapshot(input.Ctx, types.NewPriceSnapshot(types.PriceSnapshotItems{
+			types.NewPriceSnapshotItem(utils.MicroAtomDenom, types.OracleExchangeRate{ExchangeRate: sdk.NewDec(rate), LastUpdate: sdk.NewInt(int64(i))}),
+		}, int64(3600+600*i)))
+	}
+	res, err := querier.PriceStats(ctx, &types.QueryPriceStatsRequest{LookbackSeconds: 3600})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.OraclePriceStats))
+	require.Equal(t, uint64(3), res.OraclePriceStats[0].SampleCount)
+	require.Equal(t, sdk.NewDec(20), res.OraclePriceStats[0].Median)
+	require.Equal(t, sdk.NewDec(10), res.OraclePriceStats[0].Min)
+	require.Equal(t, sdk.NewDec(30), res.OraclePriceStats[0].Max)
+	require.Equal(t, int64(1800), res.OraclePriceStats[0].LookbackSeconds)
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+)
+
+// RewardBallotWinners releases the RewardDistributionRate fraction of the reward pool and splits it
+// between the ballot winners of the vote period pro-rata to their ballot weight. The rewards are
+// sent to the distribution module and allocated to the winning validators there.
+func (k Keeper) RewardBallotWinners(ctx sdk.Context, ballotWinners map[string]types.Claim) {
+	rewardDistributionRate := k.RewardDistributionRate(ctx)
+	if !rewardDistributionRate.IsPositive() {
+		return
+	}
+
+	winners := []types.Claim{}
+	ballotPowerSum := int64(0)
+	for _, claim := range ballotWinners {
+		if claim.Weight > 0 {
+			winners = append(winners, claim)
+			ballotPowerSum += claim.Weight
+		}
+	}
+	if ballotPowerSum == 0 {
+		return
+	}
+	// sort the winners since the claims come from a map
+	sort.Slice(winners, func(i, j int) bool {
+		return bytes.Compare(winners[i].Recipient, winners[j].Recipient) < 0
+	})
+
+	periodRewards := sdk.NewDecCoinsFromCoins(k.GetRewardPoolLegacy(ctx)...).MulDec(rewardDistributionRate)
+	if periodRewards.IsZero() {
+		return
+	}
+
+	distributedRewards := sdk.NewCoins()
+	for _, winner := range winners {
+		validator := k.StakingKeeper.Validator(ctx, winner.Recipient)
+		if validator == nil {
+			continue
+		}
+
+		rewardCoins, _ := periodRewards.MulDec(sdk.NewDec(winner.Weight)).QuoDec(sdk.NewDec(ballotPowerSum)).TruncateDecimal()
+		if rewardCoins.IsZero() {
+			continue
+		}
+
+		k.distrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewardCoins...))
+		k.AddAccruedRewards(ctx, winner.Recipient, rewardCoins)
+		distributedRewards = distributedRewards.Add(rewardCoins...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeReward,
+				sdk.NewAttribute(types.AttributeKeyValidator, winner.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, rewardCoins.String()),
+			),
+		)
+	}
+
+	// the allocated rewards are held by the distribution module until they are withdrawn
+	if !distributedRewards.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributedRewards); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
+	"github.com/sei-protocol/sei-chain/x/oracle/utils"
+)
+
+func TestRewardBallotWinners(t *testing.T) {
+	input := CreateTestInput(t)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := staking.NewHandler(input.StakingKeeper)
+	ctx := input.Ctx
+
+	_, err := sh(ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+	require.NoError(t, err)
+	_, err = sh(ctx, NewTestMsgCreateValidator(ValAddrs[1], ValPubKeys[1], amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	rewardPool := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 1000), sdk.NewInt64Coin(utils.MicroAtomDenom, 100))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, faucetAccountName, rewardPool))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, faucetAccountName, types.ModuleName, rewardPool))
+
+	claims := map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(100, 1, 1, ValAddrs[0], true),
+		ValAddrs[1].String(): types.NewClaim(100, 3, 1, ValAddrs[1], true),
+		ValAddrs[2].String(): types.NewClaim(100, 0, 0, ValAddrs[2], true),
+	}
+
+	// nothing is distributed with the default rate of zero
+	input.OracleKeeper.RewardBallotWinners(ctx, claims)
+	require.Equal(t, rewardPool, input.OracleKeeper.GetRewardPoolLegacy(ctx))
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.RewardDistributionRate = sdk.NewDecWithPrec(1, 1)
+	input.OracleKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	input.OracleKeeper.RewardBallotWinners(ctx, claims)
+
+	// 10% of the pool is split 1:3 between the winners
+	reward0 := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 25), sdk.NewInt64Coin(utils.MicroAtomDenom, 2))
+	reward1 := sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 75), sdk.NewInt64Coin(utils.MicroAtomDenom, 7))
+	require.Equal(t, reward0, input.OracleKeeper.GetAccruedRewards(ctx, ValAddrs[0]))
+	require.Equal(t, reward1, input.OracleKeeper.GetAccruedRewards(ctx, ValAddrs[1]))
+	require.True(t, input.OracleKeeper.GetAccruedRewards(ctx, ValAddrs[2]).IsZero())
+	require.Equal(t, sdk.NewDecCoinsFromCoins(reward0...), input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[0]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(reward1...), input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[1]))
+
+	distributed := reward0.Add(reward1...)
+	require.Equal(t, rewardPool.Sub(distributed), input.OracleKeeper.GetRewardPoolLegacy(ctx))
+	distrAddr := input.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	require.Equal(t, distributed, input.BankKeeper.GetAllBalances(ctx, distrAddr))
+
+	rewardEvents := map[string]string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeReward {
+			rewardEvents[string(event.Attributes[0].Value)] = string(event.Attributes[1].Value)
+		}
+	}
+	require.Equal(t, map[string]string{
+		ValAddrs[0].String(): reward0.String(),
+		ValAddrs[1].String(): reward1.String(),
+	}, rewardEvents)
+
+	// rewards accumulate over vote periods
+	input.OracleKeeper.RewardBallotWinners(ctx, claims)
+	require.True(t, input.OracleKeeper.GetAccruedRewards(ctx, ValAddrs[0]).IsAllGT(reward0))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
@@ -18,3 +19,27 @@ func (k Keeper) GetVoteTargets(ctx sdk.Context) (voteTargets []string) {
 
 	return voteTargets
 }
+
+// UpdateDenomParams replaces the overrides of whitelisted denoms, leaving the rest of the
+// whitelist as is. The vote targets pick up the change at the end of the current vote period.
+func (k Keeper) UpdateDenomParams(ctx sdk.Context, denoms []types.Denom) error {
+	params := k.GetParams(ctx)
+	whitelist := make(types.DenomList, len(params.Whitelist))
+	copy(whitelist, params.Whitelist)
+	for _, denom := range denoms {
+		found := false
+		for i := range whitelist {
+			if whitelist[i].Name == denom.Name {
+				whitelist[i] = denom
+				found = true
+				break
+			}
+		}
+		if !found {
+			return sdkerrors.Wrap(types.ErrDenomNotWhitelisted, denom.Name)
+		}
+	}
+	params.Whitelist = whitelist
+	k.SetParams(ctx, params)
+	return nil
+}
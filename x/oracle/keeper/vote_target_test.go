@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sei-protocol/sei-chain/x/oracle/types"
 )
 
 func TestKeeper_GetVoteTargets(t *testing.T) {
@@ -31,3 +35,20 @@ func TestKeeper_IsVoteTarget(t *testing.T) {
 		require.True(t, input.OracleKeeper.IsVoteTarget(input.Ctx, target))
 	}
 }
+
+func TestKeeper_UpdateDenomParams(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: "uatom"}, {Name: "ueth"}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	maxChange := sdk.NewDecWithPrec(2, 1)
+	require.NoError(t, input.OracleKeeper.UpdateDenomParams(input.Ctx, []types.Denom{{Name: "ueth", MinVoters: 3, MaxChange: &maxChange}}))
+	require.Equal(t, types.DenomList{{Name: "uatom"}, {Name: "ueth", MinVoters: 3, MaxChange: &maxChange}}, input.OracleKeeper.Whitelist(input.Ctx))
+
+	// denoms outside of the whitelist can't be updated
+	err := input.OracleKeeper.UpdateDenomParams(input.Ctx, []types.Denom{{Name: "uatom", MinVoters: 2}, {Name: "ufoo"}})
+	require.ErrorIs(t, err, types.ErrDenomNotWhitelisted)
+	require.Equal(t, types.DenomList{{Name: "uatom"}, {Name: "ueth", MinVoters: 3, MaxChange: &maxChange}}, input.OracleKeeper.Whitelist(input.Ctx))
+}
@@ -28,6 +28,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &counterA)
 			cdc.MustUnmarshal(kvB.Value, &counterB)
 			return fmt.Sprintf("%v\n%v", counterA, counterB)
+		case bytes.Equal(kvA.Key[:1], types.AggregateExchangeRatePrevoteKey):
+			var prevoteA, prevoteB types.AggregateExchangeRatePrevote
+			cdc.MustUnmarshal(kvA.Value, &prevoteA)
+			cdc.MustUnmarshal(kvB.Value, &prevoteB)
+			return fmt.Sprintf("%v\n%v", prevoteA, prevoteB)
 		case bytes.Equal(kvA.Key[:1], types.AggregateExchangeRateVoteKey):
 			var voteA, voteB types.AggregateExchangeRateVote
 			cdc.MustUnmarshal(kvA.Value, &voteA)
@@ -38,6 +43,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &voteTargetA)
 			cdc.MustUnmarshal(kvB.Value, &voteTargetB)
 			return fmt.Sprintf("%v\n%v", voteTargetA, voteTargetB)
+		case bytes.Equal(kvA.Key[:1], types.AccruedRewardsKey):
+			var rewardsA, rewardsB types.AccruedRewards
+			cdc.MustUnmarshal(kvA.Value, &rewardsA)
+			cdc.MustUnmarshal(kvB.Value, &rewardsB)
+			return fmt.Sprintf("%v\n%v", rewardsA.Rewards, rewardsB.Rewards)
 		default:
 			panic(fmt.Sprintf("invalid oracle key prefix %X", kvA.Key[:1]))
 		}
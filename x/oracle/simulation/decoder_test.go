@@ -34,7 +34,9 @@ func TestDecodeDistributionStore(t *testing.T) {
 	aggregateVote := types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
 		{Denom: utils.MicroAtomDenom, ExchangeRate: sdk.NewDecWithPrec(1234, 1)},
 	}, valAddr)
+	aggregatePrevote := types.NewAggregateExchangeRatePrevote(types.GetAggregateVoteHash("salt", "1234.1uatom", valAddr), valAddr, 123)
 	votePenaltyCounter := types.VotePenaltyCounter{MissCount: missCounter, AbstainCount: abstainCounter}
+	accruedRewards := types.AccruedRewards{Rewards: sdk.NewCoins(sdk.NewInt64Coin(utils.MicroSeiDenom, 10))}
 
 	denom := "usei"
 
@@ -43,8 +45,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.ExchangeRateKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: exchangeRate})},
 			{Key: types.FeederDelegationKey, Value: feederAddr.Bytes()},
 			{Key: types.VotePenaltyCounterKey, Value: cdc.MustMarshal(&votePenaltyCounter)},
+			{Key: types.AggregateExchangeRatePrevoteKey, Value: cdc.MustMarshal(&aggregatePrevote)},
 			{Key: types.AggregateExchangeRateVoteKey, Value: cdc.MustMarshal(&aggregateVote)},
 			{Key: types.VoteTargetKey, Value: cdc.MustMarshal(&types.Denom{Name: denom})},
+			{Key: types.AccruedRewardsKey, Value: cdc.MustMarshal(&accruedRewards)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -56,8 +60,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ExchangeRate", fmt.Sprintf("%v\n%v", exchangeRate, exchangeRate)},
 		{"FeederDelegation", fmt.Sprintf("%v\n%v", feederAddr, feederAddr)},
 		{"VotePenaltyCounter", fmt.Sprintf("%v\n%v", votePenaltyCounter, votePenaltyCounter)},
+		{"AggregatePrevote", fmt.Sprintf("%v\n%v", aggregatePrevote, aggregatePrevote)},
 		{"AggregateVote", fmt.Sprintf("%v\n%v", aggregateVote, aggregateVote)},
 		{"VoteTarget", fmt.Sprintf("name: %v\n\nname: %v\n", denom, denom)},
+		{"AccruedRewards", fmt.Sprintf("%v\n%v", accruedRewards.Rewards, accruedRewards.Rewards)},
 		{"other", ""},
 	}
 
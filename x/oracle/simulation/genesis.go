@@ -16,13 +16,14 @@ import (
 
 // Simulation parameter constants
 const (
-	votePeriodKey               = "vote_period"
-	voteThresholdKey            = "vote_threshold"
-	rewardBandKey               = "reward_band"
-	rewardDistributionWindowKey = "reward_distribution_window" //nolint:deadcode // we will use this later
-	slashFractionKey            = "slash_fraction"
-	slashWindowKey              = "slash_window"
-	minValidPerWindowKey        = "min_valid_per_window"
+	votePeriodKey             = "vote_period"
+	voteThresholdKey          = "vote_threshold"
+	rewardBandKey             = "reward_band"
+	rewardDistributionRateKey = "reward_distribution_rate"
+	slashFractionKey          = "slash_fraction"
+	slashWindowKey            = "slash_window"
+	minValidPerWindowKey      = "min_valid_per_window"
+	maxStalenessKey           = "max_staleness"
 )
 
 // GenVotePeriod randomized VotePeriod
@@ -40,6 +41,11 @@ func GenRewardBand(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
 }
 
+// GenRewardDistributionRate randomized RewardDistributionRate
+func GenRewardDistributionRate(r *rand.Rand) sdk.Dec {
+	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 4))
+}
+
 // GenSlashFraction randomized SlashFraction
 func GenSlashFraction(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(100)), 3))
@@ -55,6 +61,11 @@ func GenMinValidPerWindow(r *rand.Rand) sdk.Dec {
 	return sdk.ZeroDec().Add(sdk.NewDecWithPrec(int64(r.Intn(500)), 3))
 }
 
+// GenMaxStaleness randomized MaxStaleness
+func GenMaxStaleness(r *rand.Rand) uint64 {
+	return uint64(r.Intn(1000))
+}
+
 // RandomizedGenState generates a random GenesisState for oracle
 func RandomizedGenState(simState *module.SimulationState) {
 	var votePeriod uint64
@@ -93,6 +104,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { minValidPerWindow = GenMinValidPerWindow(r) },
 	)
 
+	var rewardDistributionRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, rewardDistributionRateKey, &rewardDistributionRate, simState.Rand,
+		func(r *rand.Rand) { rewardDistributionRate = GenRewardDistributionRate(r) },
+	)
+
+	var maxStaleness uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxStalenessKey, &maxStaleness, simState.Rand,
+		func(r *rand.Rand) { maxStaleness = GenMaxStaleness(r) },
+	)
+
 	oracleGenesis := types.NewGenesisState(
 		types.Params{
 			VotePeriod:    votePeriod,
@@ -102,15 +125,20 @@ func RandomizedGenState(simState *module.SimulationState) {
 				{Name: utils.MicroSeiDenom},
 				{Name: utils.MicroAtomDenom},
 			},
-			SlashFraction:     slashFraction,
-			SlashWindow:       slashWindow,
-			MinValidPerWindow: minValidPerWindow,
+			SlashFraction:          slashFraction,
+			SlashWindow:            slashWindow,
+			MinValidPerWindow:      minValidPerWindow,
+			RewardDistributionRate: rewardDistributionRate,
+			MaxStaleness:           maxStaleness,
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
 		[]types.PenaltyCounter{},
 		[]types.AggregateExchangeRateVote{},
+		[]types.AggregateExchangeRatePrevote{},
 		types.PriceSnapshots{},
+		[]types.ValidatorRewards{},
+		false,
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
@@ -31,6 +31,16 @@ func ParamChanges(_ *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenRewardBand(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyRewardDistributionRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenRewardDistributionRate(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxStaleness),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxStaleness(r))
+			},
+		),
 		simulation.NewSimParamChange(types.ModuleName, string(types.KeySlashFraction),
 			func(r *rand.Rand) string {
 				return fmt.Sprintf("\"%s\"", GenSlashFraction(r))
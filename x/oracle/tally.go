@@ -43,6 +43,17 @@ func ballotIsPassing(ballot types.ExchangeRateBallot, thresholdVotes sdk.Int) (s
 	return ballotPower, !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes)
 }
 
+// ballot for the asset has votes with power from at least minVoters validators
+func ballotHasMinVoters(ballot types.ExchangeRateBallot, minVoters uint64) bool {
+	voters := uint64(0)
+	for _, vote := range ballot {
+		if vote.Power > 0 {
+			voters++
+		}
+	}
+	return voters >= minVoters
+}
+
 // choose reference denom with the highest voter turnout
 // If the voting power of the two denominations is the same,
 // select reference denom in alphabetical order.
@@ -53,21 +64,22 @@ func pickReferenceDenom(ctx sdk.Context, k keeper.Keeper, voteTargets map[string
 
 	totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
 	voteThreshold := k.VoteThreshold(ctx)
-	thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
 
 	for denom, ballot := range voteMap {
 		// If denom is not in the voteTargets, or the ballot for it has failed, then skip
 		// and remove it from voteMap for iteration efficiency
-		if _, exists := voteTargets[denom]; !exists {
+		denomInfo, exists := voteTargets[denom]
+		if !exists {
 			delete(voteMap, denom)
 			continue
 		}
 
 		ballotPower := int64(0)
+		thresholdVotes := denomInfo.GetVoteThreshold(voteThreshold).MulInt64(totalBondedPower).RoundInt()
 
 		// If the ballot is not passed, remove it from the voteTargets array
 		// to prevent slashing validators who did valid vote.
-		if power, ok := ballotIsPassing(ballot, thresholdVotes); ok {
+		if power, ok := ballotIsPassing(ballot, thresholdVotes); ok && ballotHasMinVoters(ballot, denomInfo.MinVoters) {
 			ballotPower = power.Int64()
 		} else {
 			// add assets below threshold to separate map for tally evaluation
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 
 	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // String implements fmt.Stringer interface
@@ -14,7 +17,70 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name
+	return d.Name == d1.Name &&
+		optionalDecEqual(d.VoteThreshold, d1.VoteThreshold) &&
+		optionalDecEqual(d.RewardBand, d1.RewardBand) &&
+		d.MinVoters == d1.MinVoters &&
+		optionalDecEqual(d.MaxChange, d1.MaxChange)
+}
+
+func optionalDecEqual(a, b *sdk.Dec) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// GetVoteThreshold returns the vote threshold of the denom, or the given
+// default when it isn't overridden
+func (d Denom) GetVoteThreshold(defaultThreshold sdk.Dec) sdk.Dec {
+	if d.VoteThreshold == nil {
+		return defaultThreshold
+	}
+	return *d.VoteThreshold
+}
+
+// GetRewardBand returns the reward band of the denom, or the given default
+// when it isn't overridden
+func (d Denom) GetRewardBand(defaultRewardBand sdk.Dec) sdk.Dec {
+	if d.RewardBand == nil {
+		return defaultRewardBand
+	}
+	return *d.RewardBand
+}
+
+// ClampExchangeRate limits the move of the exchange rate from the previous one to
+// the max change of the denom. Rates are returned as is if there's no max change
+// or no previous rate.
+func (d Denom) ClampExchangeRate(exchangeRate sdk.Dec, previousRate sdk.Dec) sdk.Dec {
+	if d.MaxChange == nil || previousRate.IsNil() || !previousRate.IsPositive() {
+		return exchangeRate
+	}
+	maxMove := previousRate.Mul(*d.MaxChange)
+	if upper := previousRate.Add(maxMove); exchangeRate.GT(upper) {
+		return upper
+	}
+	if lower := previousRate.Sub(maxMove); exchangeRate.LT(lower) {
+		return lower
+	}
+	return exchangeRate
+}
+
+// Validate checks the name and overrides of the denom
+func (d Denom) Validate() error {
+	if len(d.Name) == 0 {
+		return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+	}
+	if d.VoteThreshold != nil && (d.VoteThreshold.LTE(sdk.NewDecWithPrec(33, 2)) || d.VoteThreshold.GT(sdk.OneDec())) {
+		return fmt.Errorf("vote threshold of denom %s must be greater than 33 percent and at most 100 percent: %s", d.Name, d.VoteThreshold)
+	}
+	if d.RewardBand != nil && (d.RewardBand.IsNegative() || d.RewardBand.GT(sdk.OneDec())) {
+		return fmt.Errorf("reward band of denom %s must be between [0, 1]: %s", d.Name, d.RewardBand)
+	}
+	if d.MaxChange != nil && (!d.MaxChange.IsPositive() || d.MaxChange.GT(sdk.OneDec())) {
+		return fmt.Errorf("max change of denom %s must be between (0, 1]: %s", d.Name, d.MaxChange)
+	}
+	return nil
 }
 
 // DenomList is array of Denom
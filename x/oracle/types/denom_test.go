@@ -1,6 +1,12 @@
 package types
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func TestDenomListContains(t *testing.T) {
 	tests := []struct {
@@ -45,3 +51,62 @@ func TestDenomListContains(t *testing.T) {
 		})
 	}
 }
+
+func TestDenomValidate(t *testing.T) {
+	dec := func(s string) *sdk.Dec {
+		d := sdk.MustNewDecFromStr(s)
+		return &d
+	}
+	tests := []struct {
+		name    string
+		denom   Denom
+		wantErr bool
+	}{
+		{name: "no overrides", denom: Denom{Name: "uatom"}},
+		{name: "all overrides", denom: Denom{Name: "uatom", VoteThreshold: dec("0.667"), RewardBand: dec("0.02"), MinVoters: 3, MaxChange: dec("0.2")}},
+		{name: "empty name", denom: Denom{}, wantErr: true},
+		{name: "vote threshold too low", denom: Denom{Name: "uatom", VoteThreshold: dec("0.33")}, wantErr: true},
+		{name: "vote threshold too high", denom: Denom{Name: "uatom", VoteThreshold: dec("1.01")}, wantErr: true},
+		{name: "negative reward band", denom: Denom{Name: "uatom", RewardBand: dec("-0.01")}, wantErr: true},
+		{name: "zero max change", denom: Denom{Name: "uatom", MaxChange: dec("0")}, wantErr: true},
+		{name: "max change too high", denom: Denom{Name: "uatom", MaxChange: dec("1.5")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr {
+				require.Error(t, tt.denom.Validate())
+			} else {
+				require.NoError(t, tt.denom.Validate())
+			}
+		})
+	}
+}
+
+func TestDenomOverrides(t *testing.T) {
+	threshold := sdk.NewDecWithPrec(9, 1)
+	band := sdk.NewDecWithPrec(5, 2)
+	denom := Denom{Name: "uatom"}
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), denom.GetVoteThreshold(sdk.NewDecWithPrec(5, 1)))
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), denom.GetRewardBand(sdk.NewDecWithPrec(2, 2)))
+	require.False(t, denom.Equal(&Denom{Name: "uatom", MinVoters: 1}))
+
+	overridden := Denom{Name: "uatom", VoteThreshold: &threshold, RewardBand: &band}
+	require.Equal(t, threshold, overridden.GetVoteThreshold(sdk.NewDecWithPrec(5, 1)))
+	require.Equal(t, band, overridden.GetRewardBand(sdk.NewDecWithPrec(2, 2)))
+	require.False(t, denom.Equal(&overridden))
+	require.True(t, overridden.Equal(&Denom{Name: "uatom", VoteThreshold: &threshold, RewardBand: &band}))
+}
+
+func TestDenomClampExchangeRate(t *testing.T) {
+	maxChange := sdk.NewDecWithPrec(1, 1)
+	denom := Denom{Name: "uatom", MaxChange: &maxChange}
+
+	// without a previous rate or a max change the rate is kept
+	require.Equal(t, sdk.NewDec(200), denom.ClampExchangeRate(sdk.NewDec(200), sdk.ZeroDec()))
+	require.Equal(t, sdk.NewDec(200), Denom{Name: "uatom"}.ClampExchangeRate(sdk.NewDec(200), sdk.NewDec(100)))
+
+	require.Equal(t, sdk.NewDec(105), denom.ClampExchangeRate(sdk.NewDec(105), sdk.NewDec(100)))
+	require.Equal(t, sdk.NewDec(110), denom.ClampExchangeRate(sdk.NewDec(200), sdk.NewDec(100)))
+	require.Equal(t, sdk.NewDec(90), denom.ClampExchangeRate(sdk.NewDec(50), sdk.NewDec(100)))
+}
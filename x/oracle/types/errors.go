@@ -15,6 +15,9 @@ var (
 	ErrInvalidHash           = sdkerrors.Register(ModuleName, 6, "invalid hash")
 	ErrInvalidHashLength     = sdkerrors.Register(ModuleName, 7, fmt.Sprintf("invalid hash length; should equal %d", ed25519.TruncatedSize))
 	ErrVerificationFailed    = sdkerrors.Register(ModuleName, 8, "hash verification failed")
+	ErrRevealPeriodMissMatch = sdkerrors.Register(ModuleName, 9, "reveal period of submitted vote do not match with registered prevote")
+	ErrInvalidSaltLength     = sdkerrors.Register(ModuleName, 10, fmt.Sprintf("invalid salt length; should be 1~%d", MaxSaltLength))
+	ErrNoAggregatePrevote    = sdkerrors.Register(ModuleName, 11, "no aggregate prevote")
 	ErrNoAggregateVote       = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrNoVoteTarget          = sdkerrors.Register(ModuleName, 13, "no vote target")
 	ErrUnknownDenom          = sdkerrors.Register(ModuleName, 14, "unknown denom")
@@ -28,4 +31,10 @@ var (
 	ErrEncodingOracleTwaps   = sdkerrors.Register(ModuleName, 22, "Error encoding oracle twaps as JSON")
 	ErrUnknownSeiOracleQuery = sdkerrors.Register(ModuleName, 23, "Error unknown sei oracle query")
 	ErrAggregateVoteExist    = sdkerrors.Register(ModuleName, 24, "aggregate vote still present in current voting window")
+	ErrCommitRevealDisabled  = sdkerrors.Register(ModuleName, 25, "commit-reveal voting is disabled")
+	ErrAggregatePrevoteExist = sdkerrors.Register(ModuleName, 26, "aggregate prevote already present in current voting window")
+	ErrDenomNotWhitelisted   = sdkerrors.Register(ModuleName, 27, "denom is not in the whitelist")
+	ErrStaleExchangeRate     = sdkerrors.Register(ModuleName, 28, "exchange rate is stale")
+	ErrNoPriceStatsData      = sdkerrors.Register(ModuleName, 29, "no price snapshots in the lookback window")
+	ErrEncodingPriceStats    = sdkerrors.Register(ModuleName, 30, "Error encoding price stats as JSON")
 )
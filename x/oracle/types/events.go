@@ -6,7 +6,10 @@ const (
 	EventTypeVote               = "vote"
 	EventTypeFeedDelegate       = "feed_delegate"
 	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeAggregatePrevote   = "aggregate_prevote"
 	EventTypeEndSlashWindow     = "end_slash_window"
+	EventTypeReward             = "oracle_reward"
+	EventTypeStaleExchangeRate  = "stale_exchange_rate"
 
 	AttributeKeyDenom         = "denom"
 	AttributeKeyVoter         = "voter"
@@ -18,6 +21,9 @@ const (
 	AttributeKeyAbstainCount  = "abstain_count"
 	AttributeKeyWinCount      = "win_count"
 	AttributeKeySuccessCount  = "success_count"
+	AttributeKeyAggregateHash = "aggregate_hash"
+	AttributeKeyValidator     = "validator"
+	AttributeKeyAmount        = "amount"
 
 	AttributeValueCategory = ModuleName
 )
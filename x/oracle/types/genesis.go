@@ -11,27 +11,35 @@ func NewGenesisState(
 	params Params, rates []ExchangeRateTuple,
 	feederDelegations []FeederDelegation, penaltyCounters []PenaltyCounter,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
+	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	priceSnapshots []PriceSnapshot,
+	validatorRewards []ValidatorRewards,
+	commitRevealActive bool,
 ) *GenesisState {
 	return &GenesisState{
-		Params:                     params,
-		ExchangeRates:              rates,
-		FeederDelegations:          feederDelegations,
-		PenaltyCounters:            penaltyCounters,
-		AggregateExchangeRateVotes: aggregateExchangeRateVotes,
-		PriceSnapshots:             priceSnapshots,
+		Params:                        params,
+		ExchangeRates:                 rates,
+		FeederDelegations:             feederDelegations,
+		PenaltyCounters:               penaltyCounters,
+		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
+		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
+		PriceSnapshots:                priceSnapshots,
+		ValidatorRewards:              validatorRewards,
+		CommitRevealActive:            commitRevealActive,
 	}
 }
 
 // DefaultGenesisState - default GenesisState used by columbus-2
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:                     DefaultParams(),
-		ExchangeRates:              []ExchangeRateTuple{},
-		FeederDelegations:          []FeederDelegation{},
-		PenaltyCounters:            []PenaltyCounter{},
-		AggregateExchangeRateVotes: []AggregateExchangeRateVote{},
-		PriceSnapshots:             PriceSnapshots{},
+		Params:                        DefaultParams(),
+		ExchangeRates:                 []ExchangeRateTuple{},
+		FeederDelegations:             []FeederDelegation{},
+		PenaltyCounters:               []PenaltyCounter{},
+		AggregateExchangeRateVotes:    []AggregateExchangeRateVote{},
+		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
+		PriceSnapshots:                PriceSnapshots{},
+		ValidatorRewards:              []ValidatorRewards{},
 	}
 }
 
@@ -5,7 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,12 +26,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params                     Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	FeederDelegations          []FeederDelegation          `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
-	ExchangeRates              ExchangeRateTuples          `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
-	PenaltyCounters            []PenaltyCounter            `protobuf:"bytes,4,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
-	AggregateExchangeRateVotes []AggregateExchangeRateVote `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
-	PriceSnapshots             PriceSnapshots              `protobuf:"bytes,7,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	Params                        Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	FeederDelegations             []FeederDelegation             `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
+	ExchangeRates                 ExchangeRateTuples             `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
+	PenaltyCounters               []PenaltyCounter               `protobuf:"bytes,4,rep,name=penalty_counters,json=penaltyCounters,proto3" json:"penalty_counters"`
+	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	PriceSnapshots                PriceSnapshots                 `protobuf:"bytes,7,rep,name=price_snapshots,json=priceSnapshots,proto3,castrepeated=PriceSnapshots" json:"price_snapshots"`
+	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,8,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
+	ValidatorRewards              []ValidatorRewards             `protobuf:"bytes,9,rep,name=validator_rewards,json=validatorRewards,proto3" json:"validator_rewards"`
+	// whether votes of the current vote period must be revealed from a prevote
+	CommitRevealActive bool `protobuf:"varint,10,opt,name=commit_reveal_active,json=commitRevealActive,proto3" json:"commit_reveal_active,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +113,27 @@ func (m *GenesisState) GetPriceSnapshots() PriceSnapshots {
 	return nil
 }
 
+func (m *GenesisState) GetAggregateExchangeRatePrevotes() []AggregateExchangeRatePrevote {
+	if m != nil {
+		return m.AggregateExchangeRatePrevotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorRewards() []ValidatorRewards {
+	if m != nil {
+		return m.ValidatorRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommitRevealActive() bool {
+	if m != nil {
+		return m.CommitRevealActive
+	}
+	return false
+}
+
 type FeederDelegation struct {
 	FeederAddress    string `protobuf:"bytes,1,opt,name=feeder_address,json=feederAddress,proto3" json:"feeder_address,omitempty"`
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
@@ -212,49 +238,111 @@ func (m *PenaltyCounter) GetVotePenaltyCounter() *VotePenaltyCounter {
 	return nil
 }
 
+type ValidatorRewards struct {
+	ValidatorAddress string                                   `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Rewards          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce0b3a2b4a184fc3, []int{3}
+}
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
+
+func (m *ValidatorRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "seiprotocol.seichain.oracle.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "seiprotocol.seichain.oracle.FeederDelegation")
 	proto.RegisterType((*PenaltyCounter)(nil), "seiprotocol.seichain.oracle.PenaltyCounter")
+	proto.RegisterType((*ValidatorRewards)(nil), "seiprotocol.seichain.oracle.ValidatorRewards")
 }
 
 func init() { proto.RegisterFile("oracle/genesis.proto", fileDescriptor_ce0b3a2b4a184fc3) }
 
 var fileDescriptor_ce0b3a2b4a184fc3 = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xdb, 0x10, 0x60, 0x43, 0xd3, 0x74, 0x89, 0x50, 0x14, 0x84, 0x5b, 0x05, 0x21, 0x55,
-	0x54, 0xb5, 0x69, 0x90, 0xb8, 0x27, 0x7c, 0x49, 0x3d, 0x21, 0x17, 0x71, 0x40, 0x48, 0xd6, 0xda,
-	0x9e, 0x38, 0x2b, 0x1c, 0xaf, 0xf1, 0x6c, 0xa2, 0xf6, 0xc4, 0x5f, 0xe0, 0x27, 0x70, 0xe6, 0x97,
-	0xf4, 0xd8, 0x23, 0x27, 0x40, 0xc9, 0xbf, 0xe0, 0x84, 0xbc, 0xbb, 0x2d, 0x75, 0xda, 0xfa, 0x94,
-	0xdd, 0x37, 0xef, 0xcd, 0xcb, 0x9b, 0x59, 0x93, 0x8e, 0xc8, 0x59, 0x98, 0x80, 0x1b, 0x43, 0x0a,
-	0xc8, 0xd1, 0xc9, 0x72, 0x21, 0x05, 0x7d, 0x88, 0xc0, 0xd5, 0x29, 0x14, 0x89, 0x83, 0xc0, 0xc3,
-	0x09, 0xe3, 0xa9, 0xa3, 0xa9, 0xbd, 0x4e, 0x2c, 0x62, 0xa1, 0xaa, 0x6e, 0x71, 0xd2, 0x92, 0xde,
-	0x7d, 0xd3, 0x48, 0xff, 0x18, 0xd0, 0x0e, 0x05, 0x4e, 0x05, 0xba, 0x01, 0x43, 0x70, 0xe7, 0x07,
-	0x01, 0x48, 0x76, 0xe0, 0x86, 0x82, 0xa7, 0xba, 0xde, 0xff, 0x5b, 0x27, 0xf7, 0xde, 0x6a, 0xe7,
-	0x23, 0xc9, 0x24, 0xd0, 0x21, 0x69, 0x64, 0x2c, 0x67, 0x53, 0xec, 0x5a, 0x3b, 0xd6, 0x6e, 0x73,
-	0xf0, 0xd8, 0xa9, 0xf8, 0x27, 0xce, 0x3b, 0x45, 0x1d, 0xd5, 0x4f, 0x7f, 0x6d, 0xd7, 0x3c, 0x23,
-	0xa4, 0x01, 0xa1, 0x63, 0x80, 0x08, 0x72, 0x3f, 0x82, 0x04, 0x62, 0x26, 0xb9, 0x48, 0xb1, 0xbb,
-	0xb6, 0xb3, 0xbe, 0xdb, 0x1c, 0xec, 0x57, 0xb6, 0x7b, 0xa3, 0x64, 0xaf, 0x2e, 0x54, 0xa6, 0xf1,
-	0xd6, 0x78, 0x05, 0x47, 0xfa, 0x85, 0xb4, 0xe0, 0x38, 0x9c, 0xb0, 0x34, 0x06, 0x3f, 0x67, 0x12,
-	0xb0, 0xbb, 0xae, 0xfa, 0x3b, 0x95, 0xfd, 0x5f, 0x1b, 0x89, 0xc7, 0x24, 0xbc, 0x9f, 0x65, 0x09,
-	0x8c, 0x7a, 0x85, 0xc1, 0x8f, 0xdf, 0xdb, 0xf4, 0x4a, 0x09, 0xbd, 0x0d, 0xb8, 0x84, 0x21, 0xfd,
-	0x44, 0xda, 0x19, 0xa4, 0x2c, 0x91, 0x27, 0x7e, 0x28, 0x66, 0xa9, 0x84, 0x1c, 0xbb, 0x75, 0x65,
-	0xba, 0x57, 0x3d, 0x23, 0x2d, 0x7a, 0xa9, 0x35, 0x26, 0xd2, 0x66, 0x56, 0x42, 0x91, 0x7e, 0x25,
-	0x8f, 0x58, 0x1c, 0xe7, 0x45, 0x40, 0xf0, 0x4b, 0xd1, 0xfc, 0xb9, 0x28, 0xf2, 0x35, 0x94, 0xd5,
-	0x8b, 0x4a, 0xab, 0xe1, 0x79, 0x87, 0xcb, 0x69, 0x3e, 0x08, 0x09, 0xc6, 0xb5, 0xc7, 0x6e, 0x22,
-	0x20, 0xfd, 0x4c, 0x36, 0xb3, 0x9c, 0x87, 0xe0, 0x63, 0xca, 0x32, 0x9c, 0x08, 0x89, 0xdd, 0xdb,
-	0xca, 0xf2, 0x69, 0x75, 0xba, 0x42, 0x73, 0x64, 0x24, 0xa3, 0x07, 0x66, 0x9c, 0xad, 0x12, 0x8c,
-	0x5e, 0x2b, 0x2b, 0xdd, 0x0f, 0xeb, 0x77, 0x6e, 0xb5, 0x1b, 0xfd, 0x31, 0x69, 0xaf, 0x6e, 0x9c,
-	0x3e, 0x21, 0x2d, 0xf3, 0x78, 0x58, 0x14, 0xe5, 0x80, 0xfa, 0x1d, 0xde, 0xf5, 0x36, 0x34, 0x3a,
-	0xd4, 0x20, 0xdd, 0x23, 0x5b, 0x73, 0x96, 0xf0, 0x88, 0x49, 0xf1, 0x9f, 0xb9, 0xa6, 0x98, 0xed,
-	0x8b, 0x82, 0x21, 0xf7, 0xbf, 0x5b, 0xa4, 0x55, 0xde, 0xc2, 0xf5, 0x7a, 0xeb, 0x7a, 0x3d, 0x65,
-	0xa4, 0x53, 0xec, 0xc0, 0x5f, 0x59, 0xbf, 0xf2, 0x6b, 0x0e, 0xdc, 0xca, 0xf9, 0x14, 0xc3, 0x2d,
-	0x7b, 0x7b, 0x74, 0x7e, 0x05, 0x1b, 0x1d, 0x9e, 0x2e, 0x6c, 0xeb, 0x6c, 0x61, 0x5b, 0x7f, 0x16,
-	0xb6, 0xf5, 0x6d, 0x69, 0xd7, 0xce, 0x96, 0x76, 0xed, 0xe7, 0xd2, 0xae, 0x7d, 0x7c, 0x16, 0x73,
-	0x39, 0x99, 0x05, 0x4e, 0x28, 0xa6, 0x2e, 0x02, 0xdf, 0x3f, 0x77, 0x52, 0x17, 0x65, 0xe5, 0x1e,
-	0x9b, 0x6f, 0xde, 0x95, 0x27, 0x19, 0x60, 0xd0, 0x50, 0x94, 0xe7, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x29, 0xe9, 0x57, 0x70, 0x5a, 0x04, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x4f, 0xdb, 0x4a,
+	0x10, 0x8e, 0x81, 0x17, 0x60, 0x79, 0x84, 0xb0, 0x2f, 0x7a, 0xca, 0xcb, 0x13, 0x21, 0x4a, 0x55,
+	0x29, 0x2a, 0xc2, 0x06, 0x2a, 0x55, 0xea, 0x31, 0xa1, 0x3f, 0x24, 0x4e, 0xc8, 0x54, 0x1c, 0xaa,
+	0x4a, 0xee, 0xc6, 0x1e, 0x1c, 0x0b, 0xc7, 0xeb, 0xee, 0x2c, 0x2e, 0x9c, 0x7a, 0xed, 0xb1, 0xd7,
+	0xde, 0x7a, 0xea, 0xa1, 0x7f, 0x09, 0x47, 0x8e, 0x3d, 0xb5, 0x15, 0xfc, 0x23, 0x95, 0x77, 0x37,
+	0x6d, 0x12, 0xc0, 0x2a, 0x27, 0xdb, 0x33, 0xf3, 0x7d, 0xdf, 0x7e, 0xe3, 0x9d, 0x21, 0x35, 0x2e,
+	0x98, 0x1f, 0x83, 0x13, 0x42, 0x02, 0x18, 0xa1, 0x9d, 0x0a, 0x2e, 0x39, 0xfd, 0x1f, 0x21, 0x52,
+	0x6f, 0x3e, 0x8f, 0x6d, 0x84, 0xc8, 0x1f, 0xb0, 0x28, 0xb1, 0x75, 0x69, 0xa3, 0x16, 0xf2, 0x90,
+	0xab, 0xac, 0x93, 0xbf, 0x69, 0x48, 0xe3, 0x1f, 0x43, 0xa4, 0x1f, 0x26, 0xd8, 0xf4, 0x39, 0x0e,
+	0x39, 0x3a, 0x7d, 0x86, 0xe0, 0x64, 0xdb, 0x7d, 0x90, 0x6c, 0xdb, 0xf1, 0x79, 0x94, 0xe8, 0x7c,
+	0xfb, 0xe3, 0x3c, 0xf9, 0xfb, 0xb9, 0x56, 0x3e, 0x90, 0x4c, 0x02, 0xed, 0x92, 0x72, 0xca, 0x04,
+	0x1b, 0x62, 0xdd, 0x6a, 0x59, 0x9d, 0xa5, 0x9d, 0x7b, 0x76, 0xc1, 0x49, 0xec, 0x7d, 0x55, 0xda,
+	0x9b, 0x3b, 0xff, 0xb6, 0x5e, 0x72, 0x0d, 0x90, 0xf6, 0x09, 0x3d, 0x02, 0x08, 0x40, 0x78, 0x01,
+	0xc4, 0x10, 0x32, 0x19, 0xf1, 0x04, 0xeb, 0x33, 0xad, 0xd9, 0xce, 0xd2, 0xce, 0x66, 0x21, 0xdd,
+	0x33, 0x05, 0x7b, 0xf2, 0x0b, 0x65, 0x88, 0x57, 0x8f, 0xa6, 0xe2, 0x48, 0xdf, 0x90, 0x0a, 0x9c,
+	0xfa, 0x03, 0x96, 0x84, 0xe0, 0x09, 0x26, 0x01, 0xeb, 0xb3, 0x8a, 0xdf, 0x2e, 0xe4, 0x7f, 0x6a,
+	0x20, 0x2e, 0x93, 0xf0, 0xe2, 0x24, 0x8d, 0xa1, 0xd7, 0xc8, 0x05, 0xbe, 0x7c, 0x5f, 0xa7, 0xd7,
+	0x52, 0xe8, 0x2e, 0xc3, 0x58, 0x0c, 0xe9, 0x2b, 0x52, 0x4d, 0x21, 0x61, 0xb1, 0x3c, 0xf3, 0x7c,
+	0x7e, 0x92, 0x48, 0x10, 0x58, 0x9f, 0x53, 0xa2, 0x1b, 0xc5, 0x3d, 0xd2, 0xa0, 0x5d, 0x8d, 0x31,
+	0x96, 0x56, 0xd2, 0x89, 0x28, 0xd2, 0x77, 0x64, 0x8d, 0x85, 0xa1, 0xc8, 0x0d, 0x82, 0x37, 0x61,
+	0xcd, 0xcb, 0x78, 0xee, 0xaf, 0xac, 0xa4, 0x1e, 0x15, 0x4a, 0x75, 0x47, 0x0c, 0xe3, 0x6e, 0x0e,
+	0xb9, 0x04, 0xa3, 0xda, 0x60, 0xb7, 0x15, 0x20, 0x3d, 0x26, 0x2b, 0xa9, 0x88, 0x7c, 0xf0, 0x30,
+	0x61, 0x29, 0x0e, 0xb8, 0xc4, 0xfa, 0xbc, 0x92, 0x7c, 0x50, 0xec, 0x2e, 0xc7, 0x1c, 0x18, 0x48,
+	0xef, 0x5f, 0xd3, 0xce, 0xca, 0x44, 0x18, 0xdd, 0x4a, 0x3a, 0xf1, 0x4d, 0xdf, 0x5b, 0xa4, 0x75,
+	0x9b, 0xdd, 0x54, 0x80, 0x76, 0xbc, 0xa0, 0xe4, 0x1f, 0xdf, 0xdd, 0xf1, 0xbe, 0x66, 0x30, 0xa6,
+	0xd7, 0x58, 0x41, 0x0d, 0xd2, 0xd7, 0x64, 0x35, 0x63, 0x71, 0x14, 0x30, 0xc9, 0x85, 0x27, 0xe0,
+	0x2d, 0x13, 0x01, 0xd6, 0x17, 0xff, 0xe0, 0xb2, 0x1e, 0x8e, 0x50, 0xae, 0x06, 0x19, 0xb9, 0x6a,
+	0x36, 0x15, 0xa7, 0x5b, 0xa4, 0xe6, 0xf3, 0xe1, 0x30, 0x92, 0x9e, 0x80, 0x0c, 0x58, 0xec, 0x31,
+	0x5f, 0x46, 0x19, 0xd4, 0x49, 0xcb, 0xea, 0x2c, 0xb8, 0x54, 0xe7, 0x5c, 0x95, 0xea, 0xaa, 0xcc,
+	0xde, 0xdc, 0xc2, 0x5f, 0xd5, 0x72, 0xfb, 0x88, 0x54, 0xa7, 0x07, 0x82, 0xde, 0x27, 0x15, 0x33,
+	0x5b, 0x2c, 0x08, 0x04, 0xa0, 0x1e, 0xd3, 0x45, 0x77, 0x59, 0x47, 0xbb, 0x3a, 0x48, 0x37, 0xc6,
+	0x4d, 0x8d, 0x2a, 0x67, 0x54, 0xe5, 0xef, 0xf3, 0x99, 0xe2, 0xf6, 0x27, 0x8b, 0x54, 0x26, 0x2f,
+	0xe9, 0xcd, 0x78, 0xeb, 0x66, 0x3c, 0x65, 0xa4, 0x96, 0xb7, 0xd2, 0x9b, 0x9a, 0x0e, 0xa5, 0xb7,
+	0xb4, 0xe3, 0x14, 0x37, 0x91, 0x4b, 0x98, 0xd4, 0x76, 0x69, 0x76, 0x2d, 0xd6, 0xfe, 0x6c, 0x91,
+	0xea, 0x74, 0xbf, 0xef, 0x76, 0x48, 0x20, 0xf3, 0xa3, 0x9f, 0xab, 0x37, 0xd1, 0x7f, 0xb6, 0x5e,
+	0x8d, 0x76, 0xbe, 0x1a, 0x6d, 0xb3, 0x1a, 0xed, 0x5d, 0x1e, 0x25, 0xbd, 0x2d, 0x73, 0x8b, 0x3b,
+	0x61, 0x24, 0x07, 0x27, 0x7d, 0xdb, 0xe7, 0x43, 0xc7, 0xec, 0x51, 0xfd, 0xd8, 0xc4, 0xe0, 0xd8,
+	0x91, 0x67, 0x29, 0xa0, 0x02, 0xa0, 0x3b, 0xe2, 0xee, 0xed, 0x9d, 0x5f, 0x36, 0xad, 0x8b, 0xcb,
+	0xa6, 0xf5, 0xe3, 0xb2, 0x69, 0x7d, 0xb8, 0x6a, 0x96, 0x2e, 0xae, 0x9a, 0xa5, 0xaf, 0x57, 0xcd,
+	0xd2, 0xcb, 0xad, 0x31, 0x32, 0x84, 0x68, 0x73, 0xd4, 0x12, 0xf5, 0xa1, 0x7a, 0xe2, 0x9c, 0x9a,
+	0xdd, 0xad, 0xa9, 0xfb, 0x65, 0x55, 0xf2, 0xf0, 0xe7, 0x00, 0x64, 0x3b, 0x89, 0x40, 0x22, 0x06,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -277,6 +365,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommitRevealActive {
+		i--
+		if m.CommitRevealActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for iNdEx := len(m.ValidatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for iNdEx := len(m.AggregateExchangeRatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateExchangeRatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.PriceSnapshots) > 0 {
 		for iNdEx := len(m.PriceSnapshots) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -439,6 +565,50 @@ func (m *PenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -488,6 +658,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AggregateExchangeRatePrevotes) > 0 {
+		for _, e := range m.AggregateExchangeRatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for _, e := range m.ValidatorRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CommitRevealActive {
+		n += 2
+	}
 	return n
 }
 
@@ -525,6 +710,25 @@ func (m *PenaltyCounter) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -763,6 +967,94 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateExchangeRatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateExchangeRatePrevotes = append(m.AggregateExchangeRatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregateExchangeRatePrevotes[len(m.AggregateExchangeRatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRewards = append(m.ValidatorRewards, ValidatorRewards{})
+			if err := m.ValidatorRewards[len(m.ValidatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommitRevealActive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1016,6 +1308,122 @@ func (m *PenaltyCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
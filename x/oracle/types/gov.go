@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeUpdateDenomParams = "UpdateDenomParams"
+)
+
+func init() {
+	// for routing
+	govtypes.RegisterProposalType(ProposalTypeUpdateDenomParams)
+	// for marshal and unmarshal
+	govtypes.RegisterProposalTypeCodec(&UpdateDenomParamsProposal{}, "oracle/UpdateDenomParamsProposal")
+}
+
+func (p *UpdateDenomParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateDenomParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateDenomParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateDenomParamsProposal) ProposalType() string {
+	return ProposalTypeUpdateDenomParams
+}
+
+func (p *UpdateDenomParamsProposal) ValidateBasic() error {
+	if len(p.Denoms) == 0 {
+		return errors.New("no denoms provided in update denom params proposal")
+	}
+	seen := map[string]bool{}
+	for _, denom := range p.Denoms {
+		if err := denom.Validate(); err != nil {
+			return err
+		}
+		if seen[denom.Name] {
+			return fmt.Errorf("duplicate denom %s in update denom params proposal", denom.Name)
+		}
+		seen[denom.Name] = true
+	}
+
+	err := govtypes.ValidateAbstract(p)
+	return err
+}
+
+func (p UpdateDenomParamsProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Denom Params Proposal:
+  Title:       %s
+  Description: %s
+  Denoms:      %s
+`, p.Title, p.Description, DenomList(p.Denoms)))
+	return b.String()
+}
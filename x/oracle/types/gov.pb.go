@@ -0,0 +1,417 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: oracle/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdateDenomParamsProposal is a gov Content type for updating the per-denom
+// overrides of whitelisted denoms without replacing the whole whitelist.
+type UpdateDenomParamsProposal struct {
+	Title       string  `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description string  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	Denoms      []Denom `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms" yaml:"denoms"`
+}
+
+func (m *UpdateDenomParamsProposal) Reset()      { *m = UpdateDenomParamsProposal{} }
+func (*UpdateDenomParamsProposal) ProtoMessage() {}
+func (*UpdateDenomParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c2ce06ff2edda6, []int{0}
+}
+func (m *UpdateDenomParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDenomParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDenomParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateDenomParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDenomParamsProposal.Merge(m, src)
+}
+func (m *UpdateDenomParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDenomParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDenomParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDenomParamsProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdateDenomParamsProposal)(nil), "seiprotocol.seichain.oracle.UpdateDenomParamsProposal")
+}
+
+func init() { proto.RegisterFile("oracle/gov.proto", fileDescriptor_05c2ce06ff2edda6) }
+
+var fileDescriptor_05c2ce06ff2edda6 = []byte{
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0x6d, 0x2a, 0x2a, 0x91, 0x16, 0xa9, 0x0a, 0x7f, 0x54, 0x8a, 0x64, 0x57, 0x1e, 0x50,
+	0x17, 0x1c, 0x04, 0x0b, 0xea, 0x58, 0x31, 0x31, 0x95, 0x4a, 0x2c, 0x6c, 0x6e, 0x6a, 0xa5, 0x96,
+	0x92, 0x5e, 0x14, 0x1b, 0x44, 0xdf, 0x80, 0x91, 0x91, 0xb1, 0x8f, 0xd3, 0xb1, 0x23, 0x53, 0x84,
+	0x9a, 0x85, 0x39, 0x4f, 0x80, 0x62, 0xa7, 0x52, 0x27, 0x26, 0x9f, 0xef, 0xfb, 0xdd, 0xf7, 0xd9,
+	0xe7, 0x75, 0x20, 0x13, 0x61, 0x2c, 0x83, 0x08, 0xde, 0x78, 0x9a, 0x81, 0x01, 0xff, 0x52, 0x4b,
+	0x65, 0xab, 0x10, 0x62, 0xae, 0xa5, 0x0a, 0xe7, 0x42, 0x2d, 0xb8, 0xc3, 0x7a, 0xa7, 0x11, 0x44,
+	0x60, 0xd5, 0xa0, 0xaa, 0xdc, 0x48, 0xef, 0xa4, 0x36, 0x71, 0x87, 0x6b, 0xb2, 0x1c, 0x7b, 0x17,
+	0xcf, 0xe9, 0x4c, 0x18, 0xf9, 0x20, 0x17, 0x90, 0x8c, 0x45, 0x26, 0x12, 0x3d, 0xce, 0x20, 0x05,
+	0x2d, 0x62, 0xff, 0xca, 0x3b, 0x34, 0xca, 0xc4, 0xb2, 0x8b, 0xfb, 0x78, 0x70, 0x34, 0xea, 0x94,
+	0x39, 0x6d, 0x2f, 0x45, 0x12, 0x0f, 0x99, 0x6d, 0xb3, 0x89, 0x93, 0xfd, 0x7b, 0xaf, 0x35, 0x93,
+	0x3a, 0xcc, 0x54, 0x6a, 0x14, 0x2c, 0xba, 0x07, 0x96, 0x3e, 0x2f, 0x73, 0xea, 0x3b, 0x7a, 0x4f,
+	0x64, 0x93, 0x7d, 0xd4, 0x7f, 0xf2, 0x9a, 0xb3, 0x2a, 0x58, 0x77, 0x1b, 0xfd, 0xc6, 0xa0, 0x75,
+	0xcb, 0xf8, 0x3f, 0x1f, 0xe3, 0xf6, 0x8d, 0xa3, 0xb3, 0x75, 0x4e, 0x51, 0x99, 0xd3, 0xe3, 0x9d,
+	0x79, 0x35, 0xcf, 0x26, 0xb5, 0xd1, 0xb0, 0xfd, 0xb1, 0xa2, 0xe8, 0x6b, 0x45, 0xd1, 0xef, 0x8a,
+	0xa2, 0xd1, 0xe3, 0x7a, 0x4b, 0xf0, 0x66, 0x4b, 0xf0, 0xcf, 0x96, 0xe0, 0xcf, 0x82, 0xa0, 0x4d,
+	0x41, 0xd0, 0x77, 0x41, 0xd0, 0xcb, 0x4d, 0xa4, 0xcc, 0xfc, 0x75, 0xca, 0x43, 0x48, 0x02, 0x2d,
+	0xd5, 0xf5, 0x2e, 0xd5, 0x5e, 0x6c, 0x6c, 0xf0, 0x5e, 0x2f, 0x2b, 0x30, 0xcb, 0x54, 0xea, 0x69,
+	0xd3, 0x22, 0x77, 0x7f, 0x03, 0x00, 0x96, 0x5e, 0x4b, 0x94, 0x8f, 0x01, 0x00, 0x00,
+}
+
+func (m *UpdateDenomParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDenomParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDenomParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdateDenomParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateDenomParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDenomParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDenomParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, Denom{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUpdateDenomParamsProposalValidateBasic(t *testing.T) {
+	threshold := sdk.NewDecWithPrec(667, 3)
+	proposal := UpdateDenomParamsProposal{
+		Title:       "title",
+		Description: "description",
+		Denoms:      []Denom{{Name: "uatom", VoteThreshold: &threshold, MinVoters: 3}},
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, ProposalTypeUpdateDenomParams, proposal.ProposalType())
+
+	noDenoms := proposal
+	noDenoms.Denoms = nil
+	require.Error(t, noDenoms.ValidateBasic())
+
+	duplicate := proposal
+	duplicate.Denoms = []Denom{{Name: "uatom"}, {Name: "uatom"}}
+	require.Error(t, duplicate.ValidateBasic())
+
+	invalidThreshold := sdk.NewDecWithPrec(2, 1)
+	invalid := proposal
+	invalid.Denoms = []Denom{{Name: "uatom", VoteThreshold: &invalidThreshold}}
+	require.Error(t, invalid.ValidateBasic())
+
+	noTitle := proposal
+	noTitle.Title = ""
+	require.Error(t, noTitle.ValidateBasic())
+}
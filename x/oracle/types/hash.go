@@ -0,0 +1,77 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AggregateVoteHash is the hash committed to by an aggregate exchange rate prevote
+type AggregateVoteHash []byte
+
+// GetAggregateVoteHash computes the hash a prevote commits to, so that the exchange rates of a
+// vote can't be copied before they are revealed
+func GetAggregateVoteHash(salt string, exchangeRatesStr string, voter sdk.ValAddress) AggregateVoteHash {
+	sourceStr := fmt.Sprintf("%s:%s:%s", salt, exchangeRatesStr, voter.String())
+	return tmhash.Sum([]byte(sourceStr))[:tmhash.TruncatedSize]
+}
+
+// AggregateVoteHashFromHexString converts hex string to AggregateVoteHash
+func AggregateVoteHashFromHexString(s string) (AggregateVoteHash, error) {
+	h, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// String implements fmt.Stringer interface
+func (h AggregateVoteHash) String() string {
+	return hex.EncodeToString(h)
+}
+
+// Equal does bytes equal check
+func (h AggregateVoteHash) Equal(h2 AggregateVoteHash) bool {
+	return bytes.Equal(h, h2)
+}
+
+// Empty checks whether the hash has zero length
+func (h AggregateVoteHash) Empty() bool {
+	return len(h) == 0
+}
+
+// Bytes returns the raw hash bytes.
+func (h AggregateVoteHash) Bytes() []byte {
+	return []byte(h)
+}
+
+// Size returns the length of the hash.
+func (h AggregateVoteHash) Size() int {
+	return len(h)
+}
+
+// MarshalYAML marshals to YAML as a hex string.
+func (h AggregateVoteHash) MarshalYAML() (interface{}, error) {
+	return h.String(), nil
+}
+
+// NewAggregateExchangeRatePrevote returns AggregateExchangeRatePrevote object
+func NewAggregateExchangeRatePrevote(hash AggregateVoteHash, voter sdk.ValAddress, submitBlock uint64) AggregateExchangeRatePrevote {
+	return AggregateExchangeRatePrevote{
+		Hash:        hash.String(),
+		Voter:       voter.String(),
+		SubmitBlock: submitBlock,
+	}
+}
+
+// String implement stringify
+func (v AggregateExchangeRatePrevote) String() string {
+	out, _ := yaml.Marshal(v)
+	return string(out)
+}
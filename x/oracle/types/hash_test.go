@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAggregateVoteHash(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("addr1_______________"))
+
+	hash := GetAggregateVoteHash("salt", "1.0foo,1232.132bar", valAddr)
+	require.Equal(t, 20, hash.Size())
+	require.False(t, hash.Empty())
+
+	// the hash commits to the salt, the exchange rates and the voter
+	require.False(t, hash.Equal(GetAggregateVoteHash("salt2", "1.0foo,1232.132bar", valAddr)))
+	require.False(t, hash.Equal(GetAggregateVoteHash("salt", "1.1foo,1232.132bar", valAddr)))
+	require.False(t, hash.Equal(GetAggregateVoteHash("salt", "1.0foo,1232.132bar", sdk.ValAddress([]byte("addr2_______________")))))
+
+	fromHex, err := AggregateVoteHashFromHexString(hash.String())
+	require.NoError(t, err)
+	require.True(t, hash.Equal(fromHex))
+
+	_, err = AggregateVoteHashFromHexString("not hex")
+	require.Error(t, err)
+}
@@ -35,6 +35,14 @@ const (
 // - 0x05<valAddress_Bytes>: AggregateExchangeRateVote
 //
 // - 0x06<denom_Bytes>: sdk.Dec
+//
+// - 0x07<timestamp_Bytes>: PriceSnapshot
+//
+// - 0x08<valAddress_Bytes>: AggregateExchangeRatePrevote
+//
+// - 0x09<valAddress_Bytes>: AccruedRewards
+//
+// - 0x0A: whether commit-reveal is active in the current vote period
 var (
 	// Keys for store prefixes
 	ExchangeRateKey       = []byte{0x01} // prefix for each key to a rate
@@ -44,6 +52,10 @@ var (
 	AggregateExchangeRateVoteKey = []byte{0x05} // prefix for each key to a aggregate vote
 	VoteTargetKey                = []byte{0x06} // prefix for each key to a vote target
 	PriceSnapshotKey             = []byte{0x07} // key for price snapshots history
+	// 0x04 is not reused so that prevotes left over from before its removal are never read back
+	AggregateExchangeRatePrevoteKey = []byte{0x08} // prefix for each key to a aggregate prevote
+	AccruedRewardsKey               = []byte{0x09} // prefix for each key to the rewards a validator accrued
+	CommitRevealActiveKey           = []byte{0x0A} // key for whether votes of the current vote period must be revealed
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -66,6 +78,16 @@ func GetAggregateExchangeRateVoteKey(v sdk.ValAddress) []byte {
 	return append(AggregateExchangeRateVoteKey, address.MustLengthPrefix(v)...)
 }
 
+// GetAggregateExchangeRatePrevoteKey - stored by *Validator* address
+func GetAggregateExchangeRatePrevoteKey(v sdk.ValAddress) []byte {
+	return append(AggregateExchangeRatePrevoteKey, address.MustLengthPrefix(v)...)
+}
+
+// GetAccruedRewardsKey - stored by *Validator* address
+func GetAccruedRewardsKey(v sdk.ValAddress) []byte {
+	return append(AccruedRewardsKey, address.MustLengthPrefix(v)...)
+}
+
 func GetVoteTargetKey(d string) []byte {
 	return append(VoteTargetKey, []byte(d)...)
 }
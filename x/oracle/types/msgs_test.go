@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -65,4 +66,43 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
 		}
 	}
+
+	msg := NewMsgAggregateExchangeRateVote(exchangeRates, addrs[0], sdk.ValAddress(addrs[0]))
+	msg.Salt = strings.Repeat("a", MaxSaltLength)
+	require.Nil(t, msg.ValidateBasic())
+	msg.Salt += "a"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidSaltLength)
+}
+
+func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+	}
+
+	exchangeRates := "1.0foo,1232.132bar"
+	bz := GetAggregateVoteHash("1", exchangeRates, sdk.ValAddress(addrs[0]))
+
+	tests := []struct {
+		hash       AggregateVoteHash
+		voter      sdk.AccAddress
+		expectPass bool
+	}{
+		{bz, addrs[0], true},
+		{bz[1:], addrs[0], false},
+		{AggregateVoteHash{}, addrs[0], false},
+		{bz, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAggregateExchangeRatePrevote(tc.hash, tc.voter, sdk.ValAddress(tc.voter))
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	msg := NewMsgAggregateExchangeRatePrevote(bz, addrs[0], sdk.ValAddress(addrs[0]))
+	msg.Hash = "not hex"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidHash)
 }
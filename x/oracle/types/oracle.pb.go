@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -37,6 +37,12 @@ type Params struct {
 	// The minimum percentage of voting windows for which a validator must have `success`es in order to not be penalized at the end of the slash window.
 	MinValidPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
 	LookbackDuration  uint64                                 `protobuf:"varint,9,opt,name=lookback_duration,json=lookbackDuration,proto3" json:"lookback_duration,omitempty" yaml:"lookback_duration"`
+	// Whether votes must be committed with a salted hash in a prevote and revealed in the following vote period.
+	CommitRevealEnabled bool `protobuf:"varint,10,opt,name=commit_reveal_enabled,json=commitRevealEnabled,proto3" json:"commit_reveal_enabled,omitempty" yaml:"commit_reveal_enabled"`
+	// The fraction of the oracle reward pool that is distributed to ballot winners at the end of each vote period.
+	RewardDistributionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=reward_distribution_rate,json=rewardDistributionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_distribution_rate" yaml:"reward_distribution_rate"`
+	// The number of blocks after its last update at which an exchange rate is stale and dropped. Zero disables the check.
+	MaxStaleness uint64 `protobuf:"varint,12,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty" yaml:"max_staleness"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -99,8 +105,30 @@ func (m *Params) GetLookbackDuration() uint64 {
 	return 0
 }
 
+func (m *Params) GetCommitRevealEnabled() bool {
+	if m != nil {
+		return m.CommitRevealEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxStaleness() uint64 {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return 0
+}
+
 type Denom struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// Overrides the VoteThreshold param for the ballots of this denom when set.
+	VoteThreshold *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=vote_threshold,json=voteThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"vote_threshold,omitempty" yaml:"vote_threshold,omitempty"`
+	// Overrides the RewardBand param for the ballots of this denom when set.
+	RewardBand *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band,omitempty" yaml:"reward_band,omitempty"`
+	// The minimum number of distinct validators that must vote on this denom for its ballot to pass. Zero means no minimum.
+	MinVoters uint64 `protobuf:"varint,4,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters,omitempty"`
+	// The maximum fraction by which the exchange rate of this denom may move from the previous one in a vote period when set.
+	MaxChange *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_change,json=maxChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change,omitempty" yaml:"max_change,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -135,6 +163,44 @@ func (m *Denom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Denom proto.InternalMessageInfo
 
+type AggregateExchangeRatePrevote struct {
+	Hash        string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Voter       string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty" yaml:"submit_block"`
+}
+
+func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
+func (*AggregateExchangeRatePrevote) ProtoMessage() {}
+func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{2}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateExchangeRatePrevote.Merge(m, src)
+}
+func (m *AggregateExchangeRatePrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateExchangeRatePrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateExchangeRatePrevote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateExchangeRatePrevote proto.InternalMessageInfo
+
 type AggregateExchangeRateVote struct {
 	ExchangeRateTuples ExchangeRateTuples `protobuf:"bytes,1,rep,name=exchange_rate_tuples,json=exchangeRateTuples,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rate_tuples" yaml:"exchange_rate_tuples"`
 	Voter              string             `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
@@ -143,7 +209,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{2}
+	return fileDescriptor_dc470b50b143d488, []int{3}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +246,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{3}
+	return fileDescriptor_dc470b50b143d488, []int{4}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -217,7 +283,7 @@ type OracleExchangeRate struct {
 func (m *OracleExchangeRate) Reset()      { *m = OracleExchangeRate{} }
 func (*OracleExchangeRate) ProtoMessage() {}
 func (*OracleExchangeRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{4}
+	return fileDescriptor_dc470b50b143d488, []int{5}
 }
 func (m *OracleExchangeRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -255,7 +321,7 @@ func (m *PriceSnapshotItem) Reset()         { *m = PriceSnapshotItem{} }
 func (m *PriceSnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshotItem) ProtoMessage()    {}
 func (*PriceSnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{5}
+	return fileDescriptor_dc470b50b143d488, []int{6}
 }
 func (m *PriceSnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +373,7 @@ func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
 func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
 func (*PriceSnapshot) ProtoMessage()    {}
 func (*PriceSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{6}
+	return fileDescriptor_dc470b50b143d488, []int{7}
 }
 func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +426,7 @@ func (m *OracleTwap) Reset()         { *m = OracleTwap{} }
 func (m *OracleTwap) String() string { return proto.CompactTextString(m) }
 func (*OracleTwap) ProtoMessage()    {}
 func (*OracleTwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{7}
+	return fileDescriptor_dc470b50b143d488, []int{8}
 }
 func (m *OracleTwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,6 +469,76 @@ func (m *OracleTwap) GetLookbackSeconds() int64 {
 	return 0
 }
 
+// OraclePriceStats holds statistics of the price snapshots of a denom over a lookback window.
+type OraclePriceStats struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// median of the sampled exchange rates
+	Median github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=median,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median" yaml:"median"`
+	// lowest sampled exchange rate
+	Min github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min" yaml:"min"`
+	// highest sampled exchange rate
+	Max github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max" yaml:"max"`
+	// standard deviation of the log returns between consecutive samples
+	Volatility github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=volatility,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility" yaml:"volatility"`
+	// number of price snapshots sampled
+	SampleCount     uint64 `protobuf:"varint,6,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	LookbackSeconds int64  `protobuf:"varint,7,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+}
+
+func (m *OraclePriceStats) Reset()         { *m = OraclePriceStats{} }
+func (m *OraclePriceStats) String() string { return proto.CompactTextString(m) }
+func (*OraclePriceStats) ProtoMessage()    {}
+func (*OraclePriceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{9}
+}
+func (m *OraclePriceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePriceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePriceStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OraclePriceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePriceStats.Merge(m, src)
+}
+func (m *OraclePriceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePriceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePriceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePriceStats proto.InternalMessageInfo
+
+func (m *OraclePriceStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OraclePriceStats) GetSampleCount() uint64 {
+	if m != nil {
+		return m.SampleCount
+	}
+	return 0
+}
+
+func (m *OraclePriceStats) GetLookbackSeconds() int64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
+}
+
 type VotePenaltyCounter struct {
 	MissCount    uint64 `protobuf:"varint,1,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
 	AbstainCount uint64 `protobuf:"varint,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
@@ -413,7 +549,7 @@ func (m *VotePenaltyCounter) Reset()         { *m = VotePenaltyCounter{} }
 func (m *VotePenaltyCounter) String() string { return proto.CompactTextString(m) }
 func (*VotePenaltyCounter) ProtoMessage()    {}
 func (*VotePenaltyCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc470b50b143d488, []int{8}
+	return fileDescriptor_dc470b50b143d488, []int{10}
 }
 func (m *VotePenaltyCounter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,83 +599,156 @@ func (m *VotePenaltyCounter) GetSuccessCount() uint64 {
 	return 0
 }
 
+type AccruedRewards struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *AccruedRewards) Reset()         { *m = AccruedRewards{} }
+func (m *AccruedRewards) String() string { return proto.CompactTextString(m) }
+func (*AccruedRewards) ProtoMessage()    {}
+func (*AccruedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc470b50b143d488, []int{11}
+}
+func (m *AccruedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccruedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccruedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccruedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccruedRewards.Merge(m, src)
+}
+func (m *AccruedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccruedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccruedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccruedRewards proto.InternalMessageInfo
+
+func (m *AccruedRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "seiprotocol.seichain.oracle.Params")
 	proto.RegisterType((*Denom)(nil), "seiprotocol.seichain.oracle.Denom")
+	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "seiprotocol.seichain.oracle.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "seiprotocol.seichain.oracle.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "seiprotocol.seichain.oracle.ExchangeRateTuple")
 	proto.RegisterType((*OracleExchangeRate)(nil), "seiprotocol.seichain.oracle.OracleExchangeRate")
 	proto.RegisterType((*PriceSnapshotItem)(nil), "seiprotocol.seichain.oracle.PriceSnapshotItem")
 	proto.RegisterType((*PriceSnapshot)(nil), "seiprotocol.seichain.oracle.PriceSnapshot")
 	proto.RegisterType((*OracleTwap)(nil), "seiprotocol.seichain.oracle.OracleTwap")
+	proto.RegisterType((*OraclePriceStats)(nil), "seiprotocol.seichain.oracle.OraclePriceStats")
 	proto.RegisterType((*VotePenaltyCounter)(nil), "seiprotocol.seichain.oracle.VotePenaltyCounter")
+	proto.RegisterType((*AccruedRewards)(nil), "seiprotocol.seichain.oracle.AccruedRewards")
 }
 
 func init() { proto.RegisterFile("oracle/oracle.proto", fileDescriptor_dc470b50b143d488) }
 
 var fileDescriptor_dc470b50b143d488 = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xbd, 0x6f, 0x23, 0x45,
-	0x14, 0xf7, 0x26, 0x4e, 0xc0, 0x63, 0x9b, 0x8b, 0xe7, 0x0c, 0xf8, 0xee, 0x38, 0x6f, 0x34, 0x27,
-	0x4e, 0xa1, 0x38, 0x9b, 0x3b, 0x0a, 0x84, 0x25, 0x0a, 0x4c, 0x38, 0x14, 0x3e, 0x44, 0x98, 0x0b,
-	0x41, 0xa2, 0x59, 0x8d, 0x77, 0x87, 0xf5, 0x28, 0xbb, 0x3b, 0xab, 0x9d, 0x71, 0x7c, 0x29, 0xa0,
-	0xa0, 0xa2, 0x44, 0x54, 0x48, 0x34, 0xa9, 0xf9, 0x03, 0xf8, 0x1b, 0xae, 0xbc, 0x12, 0x51, 0x2c,
-	0x28, 0x69, 0xe8, 0x90, 0x5c, 0x50, 0x50, 0xa1, 0xf9, 0xb0, 0xb3, 0xc9, 0x86, 0x08, 0x0b, 0x5d,
-	0xb5, 0xfb, 0x7e, 0xef, 0xbd, 0xdf, 0xbc, 0xcf, 0xd9, 0x05, 0xd7, 0x79, 0x46, 0xfc, 0x88, 0xf6,
-	0xcd, 0xa3, 0x97, 0x66, 0x5c, 0x72, 0x78, 0x4b, 0x50, 0xa6, 0xdf, 0x7c, 0x1e, 0xf5, 0x04, 0x65,
-	0xfe, 0x98, 0xb0, 0xa4, 0x67, 0x4c, 0x6e, 0xb6, 0x43, 0x1e, 0x72, 0xad, 0xed, 0xab, 0x37, 0xe3,
-	0x72, 0xb3, 0xeb, 0x73, 0x11, 0x73, 0xd1, 0x1f, 0x11, 0x41, 0xfb, 0x87, 0xf7, 0x47, 0x54, 0x92,
-	0xfb, 0x7d, 0x9f, 0xb3, 0xc4, 0xe8, 0xd1, 0x37, 0xeb, 0x60, 0x7d, 0x97, 0x64, 0x24, 0x16, 0xf0,
-	0x4d, 0x50, 0x3f, 0xe4, 0x92, 0x7a, 0x29, 0xcd, 0x18, 0x0f, 0x3a, 0xce, 0xa6, 0xb3, 0x55, 0x1d,
-	0xbe, 0x34, 0xcb, 0x5d, 0x78, 0x44, 0xe2, 0x68, 0x80, 0x0a, 0x4a, 0x84, 0x81, 0x92, 0x76, 0xb5,
-	0x00, 0x13, 0xf0, 0x82, 0xd6, 0xc9, 0x71, 0x46, 0xc5, 0x98, 0x47, 0x41, 0x67, 0x65, 0xd3, 0xd9,
-	0xaa, 0x0d, 0xdf, 0x7f, 0x92, 0xbb, 0x95, 0x5f, 0x73, 0xf7, 0x6e, 0xc8, 0xe4, 0x78, 0x32, 0xea,
-	0xf9, 0x3c, 0xee, 0xdb, 0x70, 0xcc, 0xe3, 0x9e, 0x08, 0x0e, 0xfa, 0xf2, 0x28, 0xa5, 0xa2, 0xb7,
-	0x4d, 0xfd, 0x59, 0xee, 0xbe, 0x58, 0x38, 0x69, 0xc1, 0x86, 0x70, 0x53, 0x01, 0x7b, 0x73, 0x19,
-	0x52, 0x50, 0xcf, 0xe8, 0x94, 0x64, 0x81, 0x37, 0x22, 0x49, 0xd0, 0x59, 0xd5, 0x87, 0x6d, 0x2f,
-	0x7d, 0x98, 0x4d, 0xab, 0x40, 0x85, 0x30, 0x30, 0xd2, 0x90, 0x24, 0x01, 0x0c, 0x41, 0x6d, 0x3a,
-	0x66, 0x92, 0x46, 0x4c, 0xc8, 0x4e, 0x75, 0x73, 0x75, 0xab, 0xfe, 0x00, 0xf5, 0xae, 0xe8, 0x40,
-	0x6f, 0x9b, 0x26, 0x3c, 0x1e, 0xbe, 0xaa, 0x02, 0x99, 0xe5, 0xee, 0x86, 0xa1, 0x5f, 0x50, 0xa0,
-	0x9f, 0x7e, 0x73, 0x6b, 0xda, 0xe4, 0x23, 0x26, 0x24, 0x3e, 0xe3, 0x56, 0xf5, 0x13, 0x11, 0x11,
-	0x63, 0xef, 0xcb, 0x8c, 0xf8, 0x92, 0xf1, 0xa4, 0xb3, 0xf6, 0xff, 0xea, 0x77, 0x9e, 0x0d, 0xe1,
-	0xa6, 0x06, 0x1e, 0x5a, 0x19, 0x0e, 0x40, 0xc3, 0x58, 0x4c, 0x59, 0x12, 0xf0, 0x69, 0x67, 0x5d,
-	0x77, 0xfa, 0xe5, 0x59, 0xee, 0x5e, 0x2f, 0xfa, 0x1b, 0x2d, 0xc2, 0x75, 0x2d, 0x7e, 0xae, 0x25,
-	0xf8, 0x35, 0x68, 0xc7, 0x2c, 0xf1, 0x0e, 0x49, 0xc4, 0x02, 0x35, 0x0c, 0x73, 0x8e, 0xe7, 0x74,
-	0xc4, 0x1f, 0x2f, 0x1d, 0xf1, 0x2d, 0x73, 0xe2, 0x65, 0x9c, 0x08, 0xb7, 0x62, 0x96, 0xec, 0x2b,
-	0x74, 0x97, 0x66, 0xf6, 0xfc, 0x1d, 0xd0, 0x8a, 0x38, 0x3f, 0x18, 0x11, 0xff, 0xc0, 0x0b, 0x26,
-	0x19, 0xd1, 0xe5, 0xaa, 0xe9, 0x04, 0x5e, 0x99, 0xe5, 0x6e, 0xc7, 0xd0, 0x95, 0x4c, 0x10, 0xde,
-	0x98, 0x63, 0xdb, 0x16, 0x1a, 0x3c, 0xff, 0xc3, 0xb1, 0x5b, 0xf9, 0xe3, 0xd8, 0x75, 0xd0, 0x00,
-	0xac, 0xe9, 0xc6, 0xc0, 0x3b, 0xa0, 0x9a, 0x90, 0x98, 0xea, 0xd9, 0xaf, 0x0d, 0xaf, 0xcd, 0x72,
-	0xb7, 0x6e, 0x08, 0x15, 0x8a, 0xb0, 0x56, 0x0e, 0x1a, 0xdf, 0x1e, 0xbb, 0x15, 0xeb, 0x5b, 0x41,
-	0x7f, 0x3a, 0xe0, 0xc6, 0x3b, 0x61, 0x98, 0xd1, 0x90, 0x48, 0xfa, 0xde, 0x63, 0x7f, 0x4c, 0x92,
-	0x90, 0x62, 0x22, 0xe9, 0x3e, 0x97, 0x14, 0xfe, 0xe8, 0x80, 0x36, 0xb5, 0xa0, 0x97, 0x11, 0x35,
-	0xd6, 0x93, 0x34, 0xa2, 0xa2, 0xe3, 0xe8, 0x79, 0xea, 0x5d, 0x39, 0x4f, 0x45, 0xb6, 0x3d, 0xe5,
-	0x36, 0x7c, 0xcb, 0xce, 0x96, 0xad, 0xda, 0x65, 0xcc, 0x6a, 0xcc, 0x60, 0xc9, 0x53, 0x60, 0x48,
-	0x4b, 0x18, 0xbc, 0x0b, 0xd6, 0xd4, 0x66, 0x65, 0x76, 0x5f, 0x37, 0x66, 0xb9, 0xdb, 0x38, 0xdb,
-	0xc0, 0x0c, 0x61, 0xa3, 0xbe, 0x90, 0xf1, 0xcf, 0x0e, 0x68, 0x95, 0x0e, 0x50, 0x5c, 0x81, 0xaa,
-	0xa1, 0xad, 0x5d, 0x81, 0x4b, 0xc3, 0x08, 0x1b, 0x35, 0x3c, 0x00, 0xcd, 0x73, 0x61, 0xdb, 0xb3,
-	0x1f, 0x2e, 0x3d, 0x39, 0xed, 0x4b, 0x6a, 0x80, 0x70, 0xa3, 0x98, 0xe6, 0x85, 0xc0, 0xff, 0x72,
-	0x00, 0xfc, 0x44, 0x97, 0xb6, 0x18, 0x7e, 0x39, 0x22, 0xe7, 0xd9, 0x45, 0xa4, 0xee, 0xae, 0x88,
-	0x08, 0xe9, 0x4d, 0xd2, 0xe0, 0x2c, 0xf9, 0x65, 0xee, 0xae, 0x9d, 0x44, 0x9e, 0xdd, 0x5d, 0x05,
-	0x2a, 0x84, 0x81, 0x92, 0x3e, 0xd3, 0xc2, 0x85, 0xc4, 0xbf, 0x77, 0x40, 0x6b, 0x37, 0x63, 0x3e,
-	0x7d, 0x94, 0x90, 0x54, 0x8c, 0xb9, 0xdc, 0x91, 0x34, 0x86, 0xed, 0x73, 0x1d, 0x9b, 0xf7, 0x27,
-	0x04, 0x6d, 0x33, 0x7e, 0x5e, 0xb9, 0x4d, 0xf5, 0x07, 0xfd, 0x2b, 0x07, 0xb6, 0x5c, 0xdc, 0x61,
-	0x55, 0xa5, 0x86, 0x21, 0x2f, 0x69, 0xd0, 0xdf, 0x0e, 0x68, 0x9e, 0x0b, 0x0a, 0x7e, 0x08, 0x5a,
-	0xc2, 0xbe, 0xef, 0xb1, 0x98, 0x0a, 0x49, 0xe2, 0x54, 0x07, 0xb7, 0x3a, 0xbc, 0x3d, 0xcb, 0xdd,
-	0x1b, 0xf6, 0x72, 0xb2, 0x26, 0x9e, 0x9c, 0xdb, 0x20, 0x5c, 0xf6, 0xd3, 0x9b, 0x97, 0x2a, 0x7a,
-	0x6f, 0xe1, 0xc0, 0x24, 0x8d, 0x45, 0x67, 0xe5, 0x3f, 0x6c, 0x5e, 0xa9, 0x58, 0x17, 0x37, 0xef,
-	0x32, 0x66, 0xbd, 0x79, 0x25, 0x4f, 0x81, 0x61, 0x5a, 0xc2, 0xd0, 0xb1, 0x03, 0x80, 0xa9, 0xd6,
-	0xde, 0x94, 0xa4, 0xff, 0xd2, 0x8a, 0x4f, 0x41, 0x55, 0x4e, 0x49, 0x6a, 0x87, 0xe4, 0xed, 0xa5,
-	0xe7, 0xd1, 0xde, 0x5d, 0x8a, 0x03, 0x61, 0x4d, 0x05, 0x5f, 0x03, 0x8b, 0x7b, 0xd0, 0x13, 0xd4,
-	0xe7, 0x49, 0x20, 0xf4, 0xf7, 0x73, 0x15, 0x5f, 0x9b, 0xe3, 0x8f, 0x0c, 0x8c, 0xbe, 0x02, 0x70,
-	0x5f, 0x7f, 0xe3, 0x13, 0x12, 0xc9, 0xa3, 0x77, 0xf9, 0x24, 0x91, 0x34, 0x83, 0xb7, 0x01, 0x88,
-	0x99, 0x10, 0x9e, 0xaf, 0x64, 0xf3, 0x8f, 0x80, 0x6b, 0x0a, 0xd1, 0x06, 0xf0, 0x0e, 0x68, 0x92,
-	0x91, 0x90, 0x84, 0x25, 0xd6, 0x62, 0x45, 0x5b, 0x34, 0x2c, 0xb8, 0x30, 0x12, 0x13, 0xdf, 0xa7,
-	0x0b, 0x9a, 0x55, 0x63, 0x64, 0x41, 0x6d, 0x34, 0xfc, 0xe0, 0xc9, 0x49, 0xd7, 0x79, 0x7a, 0xd2,
-	0x75, 0x7e, 0x3f, 0xe9, 0x3a, 0xdf, 0x9d, 0x76, 0x2b, 0x4f, 0x4f, 0xbb, 0x95, 0x5f, 0x4e, 0xbb,
-	0x95, 0x2f, 0x5e, 0x2f, 0x14, 0x40, 0x50, 0x76, 0x6f, 0xde, 0x45, 0x2d, 0xe8, 0x36, 0xf6, 0x1f,
-	0xdb, 0xff, 0x26, 0x53, 0x8e, 0xd1, 0xba, 0x36, 0x79, 0xe3, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x75, 0xce, 0x92, 0xb6, 0x55, 0x09, 0x00, 0x00,
+	// 1378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xbf, 0x8f, 0x13, 0xc7,
+	0x17, 0xbf, 0xc5, 0xe6, 0xe0, 0xc6, 0x36, 0xdc, 0x0d, 0x86, 0xef, 0x02, 0x87, 0xf7, 0xbe, 0x83,
+	0x82, 0x2e, 0x52, 0xb0, 0x81, 0x14, 0x51, 0x4e, 0x21, 0x11, 0xbe, 0x83, 0xe8, 0x48, 0x48, 0x8e,
+	0xe1, 0x02, 0x52, 0x9a, 0xd5, 0x78, 0x77, 0x62, 0xaf, 0x6e, 0x77, 0x67, 0xb5, 0x33, 0x3e, 0xfb,
+	0x8a, 0xa4, 0x8e, 0x94, 0x26, 0x4a, 0x15, 0x29, 0xcd, 0x35, 0x69, 0xe8, 0x93, 0x7f, 0x21, 0x94,
+	0x14, 0x29, 0xa2, 0x14, 0x26, 0x82, 0x26, 0x5d, 0x24, 0x17, 0x29, 0x52, 0x45, 0xf3, 0xc3, 0xbe,
+	0xf5, 0xad, 0x41, 0x18, 0x94, 0xca, 0x7e, 0x9f, 0xf7, 0xe6, 0xf3, 0xde, 0xbc, 0xf7, 0xe6, 0xed,
+	0x0c, 0x38, 0xc5, 0x52, 0xe2, 0x85, 0xb4, 0xa1, 0x7f, 0xea, 0x49, 0xca, 0x04, 0x83, 0xe7, 0x39,
+	0x0d, 0xd4, 0x3f, 0x8f, 0x85, 0x75, 0x4e, 0x03, 0xaf, 0x43, 0x82, 0xb8, 0xae, 0x4d, 0xce, 0x55,
+	0xdb, 0xac, 0xcd, 0x94, 0xb6, 0x21, 0xff, 0xe9, 0x25, 0xe7, 0x6a, 0x1e, 0xe3, 0x11, 0xe3, 0x8d,
+	0x16, 0xe1, 0xb4, 0xb1, 0x7b, 0xb5, 0x45, 0x05, 0xb9, 0xda, 0xf0, 0x58, 0x10, 0x6b, 0x3d, 0xfa,
+	0xf1, 0x38, 0x98, 0xdf, 0x22, 0x29, 0x89, 0x38, 0x7c, 0x07, 0x94, 0x76, 0x99, 0xa0, 0x6e, 0x42,
+	0xd3, 0x80, 0xf9, 0xb6, 0xb5, 0x62, 0xad, 0x16, 0x9b, 0x67, 0x86, 0x03, 0x07, 0xee, 0x91, 0x28,
+	0x5c, 0x43, 0x19, 0x25, 0xc2, 0x40, 0x4a, 0x5b, 0x4a, 0x80, 0x31, 0x38, 0xa1, 0x74, 0xa2, 0x93,
+	0x52, 0xde, 0x61, 0xa1, 0x6f, 0x1f, 0x59, 0xb1, 0x56, 0x17, 0x9a, 0x1f, 0x3e, 0x1a, 0x38, 0x73,
+	0xbf, 0x0f, 0x9c, 0x4b, 0xed, 0x40, 0x74, 0xba, 0xad, 0xba, 0xc7, 0xa2, 0x86, 0x09, 0x47, 0xff,
+	0x5c, 0xe6, 0xfe, 0x4e, 0x43, 0xec, 0x25, 0x94, 0xd7, 0x37, 0xa8, 0x37, 0x1c, 0x38, 0xa7, 0x33,
+	0x9e, 0xc6, 0x6c, 0x08, 0x57, 0x24, 0xb0, 0x3d, 0x92, 0x21, 0x05, 0xa5, 0x94, 0xf6, 0x48, 0xea,
+	0xbb, 0x2d, 0x12, 0xfb, 0x76, 0x41, 0x39, 0xdb, 0x98, 0xd9, 0x99, 0xd9, 0x56, 0x86, 0x0a, 0x61,
+	0xa0, 0xa5, 0x26, 0x89, 0x7d, 0xd8, 0x06, 0x0b, 0xbd, 0x4e, 0x20, 0x68, 0x18, 0x70, 0x61, 0x17,
+	0x57, 0x0a, 0xab, 0xa5, 0x6b, 0xa8, 0xfe, 0x82, 0x0a, 0xd4, 0x37, 0x68, 0xcc, 0xa2, 0xe6, 0x1b,
+	0x32, 0x90, 0xe1, 0xc0, 0x59, 0xd4, 0xf4, 0x63, 0x0a, 0xf4, 0xf0, 0x89, 0xb3, 0xa0, 0x4c, 0x3e,
+	0x0e, 0xb8, 0xc0, 0x07, 0xdc, 0x32, 0x7f, 0x3c, 0x24, 0xbc, 0xe3, 0x7e, 0x91, 0x12, 0x4f, 0x04,
+	0x2c, 0xb6, 0x8f, 0xbe, 0x5e, 0xfe, 0x26, 0xd9, 0x10, 0xae, 0x28, 0xe0, 0x96, 0x91, 0xe1, 0x1a,
+	0x28, 0x6b, 0x8b, 0x5e, 0x10, 0xfb, 0xac, 0x67, 0xcf, 0xab, 0x4a, 0xff, 0x6f, 0x38, 0x70, 0x4e,
+	0x65, 0xd7, 0x6b, 0x2d, 0xc2, 0x25, 0x25, 0x3e, 0x50, 0x12, 0xfc, 0x0a, 0x54, 0xa3, 0x20, 0x76,
+	0x77, 0x49, 0x18, 0xf8, 0xb2, 0x19, 0x46, 0x1c, 0xc7, 0x54, 0xc4, 0x77, 0x66, 0x8e, 0xf8, 0xbc,
+	0xf6, 0x38, 0x8d, 0x13, 0xe1, 0xa5, 0x28, 0x88, 0xef, 0x4b, 0x74, 0x8b, 0xa6, 0xc6, 0xff, 0x26,
+	0x58, 0x0a, 0x19, 0xdb, 0x69, 0x11, 0x6f, 0xc7, 0xf5, 0xbb, 0x29, 0x51, 0xe9, 0x5a, 0x50, 0x1b,
+	0x58, 0x1e, 0x0e, 0x1c, 0x5b, 0xd3, 0xe5, 0x4c, 0x10, 0x5e, 0x1c, 0x61, 0x1b, 0x06, 0x82, 0xdb,
+	0xe0, 0xb4, 0xc7, 0xa2, 0x28, 0x10, 0x6e, 0x4a, 0x77, 0x29, 0x09, 0x5d, 0x1a, 0x93, 0x56, 0x48,
+	0x7d, 0x1b, 0xac, 0x58, 0xab, 0xc7, 0x9b, 0x2b, 0xc3, 0x81, 0xb3, 0xac, 0xe9, 0xa6, 0x9a, 0x21,
+	0x7c, 0x4a, 0xe3, 0x58, 0xc1, 0x37, 0x35, 0x0a, 0xbf, 0xb1, 0x80, 0x6d, 0x5a, 0xca, 0x0f, 0xb8,
+	0x48, 0x83, 0x56, 0x57, 0x7a, 0x73, 0x53, 0x22, 0xa8, 0x5d, 0x52, 0x59, 0xba, 0x3b, 0x73, 0x96,
+	0x9c, 0x89, 0x56, 0xcd, 0xf1, 0x22, 0x7c, 0x46, 0xab, 0x36, 0x32, 0x1a, 0x4c, 0x04, 0x85, 0xd7,
+	0x41, 0x25, 0x22, 0x7d, 0x97, 0x0b, 0x12, 0xd2, 0x98, 0x72, 0x6e, 0x97, 0x55, 0xaa, 0xec, 0xe1,
+	0xc0, 0xa9, 0x9a, 0xcc, 0x67, 0xd5, 0x08, 0x97, 0x23, 0xd2, 0xbf, 0x37, 0x12, 0xd7, 0x8e, 0x7f,
+	0xbf, 0xef, 0xcc, 0xfd, 0xb9, 0xef, 0x58, 0xe8, 0x97, 0x02, 0x38, 0xaa, 0x9a, 0x17, 0x5e, 0x04,
+	0xc5, 0x98, 0x44, 0x54, 0xcd, 0x87, 0x85, 0xe6, 0xc9, 0xe1, 0xc0, 0x29, 0x69, 0x26, 0x89, 0x22,
+	0xac, 0x94, 0x50, 0x3c, 0x67, 0x24, 0xdc, 0x79, 0x95, 0x6d, 0x4f, 0x32, 0xbd, 0xc5, 0xa2, 0x40,
+	0xd0, 0x28, 0x11, 0x7b, 0xb9, 0xc1, 0xb0, 0x33, 0x6d, 0x30, 0xdc, 0x9e, 0xc9, 0xe5, 0x72, 0x6e,
+	0x28, 0x64, 0xfd, 0x65, 0xc7, 0xc3, 0xfb, 0x00, 0xa8, 0xae, 0x65, 0x82, 0xa6, 0xdc, 0x2e, 0xaa,
+	0xbc, 0x3a, 0x87, 0x3a, 0x5a, 0xe9, 0xb2, 0x04, 0x0b, 0xb2, 0xa3, 0x15, 0x0a, 0x3b, 0x00, 0xc8,
+	0xdc, 0x7b, 0x1d, 0x12, 0xb7, 0xa9, 0x39, 0xf1, 0x9b, 0xaf, 0x74, 0x76, 0xc6, 0x2c, 0x93, 0x9e,
+	0x48, 0x7f, 0x5d, 0xa1, 0x6b, 0xe5, 0xaf, 0xf7, 0x9d, 0x39, 0x53, 0xc9, 0x39, 0xf4, 0x93, 0x05,
+	0x96, 0x6f, 0xb4, 0xdb, 0x29, 0x6d, 0x13, 0x41, 0x6f, 0xf6, 0xf5, 0x4a, 0xd9, 0x2c, 0x5b, 0x29,
+	0x95, 0x01, 0xcb, 0x02, 0x77, 0x08, 0xef, 0xe4, 0x0b, 0x2c, 0x51, 0x84, 0x95, 0x12, 0x5e, 0x02,
+	0x47, 0xd5, 0xee, 0x4c, 0x5d, 0x17, 0x87, 0x03, 0xa7, 0x7c, 0x50, 0xad, 0x14, 0x61, 0xad, 0x56,
+	0xb3, 0xa6, 0xdb, 0x92, 0xa7, 0xa7, 0x15, 0x32, 0x6f, 0xc7, 0x2e, 0xe4, 0x66, 0x4d, 0x46, 0x2b,
+	0x67, 0x8d, 0x12, 0x9b, 0x52, 0x3a, 0x14, 0xf7, 0x5f, 0x16, 0x38, 0x3b, 0x35, 0x6e, 0x99, 0x4f,
+	0xf8, 0x83, 0x05, 0xaa, 0xd4, 0x80, 0xea, 0x4c, 0xb8, 0xa2, 0x9b, 0x84, 0x94, 0xdb, 0x96, 0x1a,
+	0xdc, 0xf5, 0x17, 0x0e, 0xee, 0x2c, 0xdb, 0xb6, 0x5c, 0xd6, 0x7c, 0xd7, 0x0c, 0x71, 0x93, 0xe2,
+	0x69, 0xcc, 0x72, 0x9e, 0xc3, 0xdc, 0x4a, 0x8e, 0x21, 0xcd, 0x61, 0x2f, 0x9b, 0xad, 0x43, 0x3b,
+	0xfe, 0xd9, 0x02, 0x4b, 0x39, 0x07, 0x92, 0xcb, 0x97, 0x07, 0xd1, 0xb6, 0x0e, 0x73, 0x29, 0x18,
+	0x61, 0xad, 0x86, 0x3b, 0xa0, 0x32, 0x11, 0xb6, 0xf1, 0x7d, 0x6b, 0xe6, 0xe1, 0x53, 0x9d, 0x92,
+	0x03, 0x84, 0xcb, 0xd9, 0x6d, 0x1e, 0x0a, 0xfc, 0x6f, 0x0b, 0xc0, 0x4f, 0x55, 0x6a, 0xb3, 0xe1,
+	0xe7, 0x23, 0xb2, 0xfe, 0xbb, 0x88, 0xe4, 0x25, 0x21, 0x24, 0x5c, 0xb8, 0xdd, 0xc4, 0x3f, 0xd8,
+	0xfc, 0x2c, 0x97, 0x84, 0xcd, 0x58, 0x1c, 0x5c, 0x12, 0x32, 0x54, 0x08, 0x03, 0x29, 0x7d, 0x96,
+	0xf8, 0xf9, 0x8d, 0x7f, 0x67, 0x81, 0xa5, 0xad, 0x34, 0xf0, 0xe8, 0xbd, 0x98, 0x24, 0xbc, 0xc3,
+	0xc4, 0xa6, 0xa0, 0x11, 0xac, 0x4e, 0x54, 0x6c, 0x54, 0x9f, 0x36, 0xa8, 0xea, 0xf6, 0x73, 0xf3,
+	0x65, 0x2a, 0x5d, 0x6b, 0xbc, 0xb0, 0x61, 0xf3, 0xc9, 0x6d, 0x16, 0xe5, 0xd6, 0x30, 0x64, 0x39,
+	0x0d, 0xfa, 0xc7, 0x02, 0x95, 0x89, 0xa0, 0xe0, 0x47, 0x60, 0x89, 0x9b, 0xff, 0xdb, 0x41, 0x44,
+	0xb9, 0x20, 0x51, 0xa2, 0x82, 0x2b, 0x34, 0x2f, 0x0c, 0x07, 0xce, 0x59, 0x73, 0x32, 0x8d, 0x89,
+	0x2b, 0x46, 0x36, 0x08, 0xe7, 0xd7, 0xa9, 0x93, 0x97, 0x48, 0x7a, 0x77, 0xbc, 0x40, 0x0e, 0x21,
+	0x6e, 0x1f, 0x79, 0x89, 0x93, 0x97, 0x4b, 0xd6, 0xe1, 0x93, 0x37, 0x8d, 0x59, 0x9d, 0xbc, 0xdc,
+	0x4a, 0x8e, 0x61, 0x92, 0xc3, 0xd0, 0xbe, 0x05, 0x80, 0xce, 0xd6, 0x76, 0x8f, 0x24, 0xcf, 0x29,
+	0xc5, 0x5d, 0x50, 0x14, 0x3d, 0x92, 0x98, 0x26, 0xb9, 0x3e, 0x73, 0x3f, 0x9a, 0xf9, 0x28, 0x39,
+	0x10, 0x56, 0x54, 0xf0, 0x4d, 0x30, 0xbe, 0x70, 0xb8, 0x9c, 0x7a, 0x2c, 0xf6, 0xb9, 0x9a, 0x7d,
+	0x05, 0x7c, 0x72, 0x84, 0xdf, 0xd3, 0x30, 0xfa, 0xb5, 0x00, 0x16, 0x75, 0x88, 0x7a, 0x4f, 0x82,
+	0x08, 0xfe, 0x9c, 0x40, 0x1f, 0x80, 0xf9, 0x88, 0xfa, 0x01, 0x89, 0x4d, 0xa8, 0x1f, 0xcc, 0x1c,
+	0x6a, 0xc5, 0x7c, 0x33, 0x14, 0x0b, 0xc2, 0x86, 0x0e, 0x7e, 0x02, 0x0a, 0x51, 0x10, 0x9b, 0x2f,
+	0xe6, 0x7b, 0x33, 0xb3, 0x82, 0xf1, 0x37, 0x0f, 0x61, 0x49, 0xa4, 0xf8, 0x48, 0xdf, 0x2e, 0xbe,
+	0x26, 0x1f, 0xe9, 0x4b, 0x3e, 0xd2, 0x87, 0x1e, 0x00, 0xbb, 0x2c, 0x24, 0x22, 0x08, 0x03, 0xb1,
+	0x67, 0x3e, 0x96, 0xeb, 0x33, 0xd3, 0x2e, 0x8d, 0x66, 0xee, 0x88, 0x49, 0xbd, 0x63, 0x46, 0x02,
+	0xfc, 0x3f, 0x28, 0x73, 0x12, 0x25, 0x21, 0x75, 0x3d, 0xd6, 0x8d, 0x85, 0xbe, 0x17, 0xe3, 0x92,
+	0xc6, 0xd6, 0x25, 0x34, 0xb5, 0xac, 0xc7, 0xa6, 0x97, 0xf5, 0x4b, 0x00, 0xef, 0xab, 0x37, 0x52,
+	0x4c, 0x42, 0xb1, 0xa7, 0x96, 0xd3, 0x14, 0x5e, 0x90, 0xb7, 0x06, 0xce, 0x8d, 0x07, 0xf5, 0xc6,
+	0x92, 0x97, 0x02, 0xce, 0x35, 0xff, 0x45, 0x50, 0x21, 0x2d, 0x2e, 0x48, 0x10, 0x1b, 0x8b, 0x23,
+	0xca, 0xa2, 0x6c, 0xc0, 0xb1, 0x11, 0xef, 0x7a, 0x1e, 0x1d, 0xd3, 0x14, 0xb4, 0x91, 0x01, 0x95,
+	0x11, 0xea, 0x81, 0x13, 0x37, 0x3c, 0x2f, 0xed, 0x52, 0x1f, 0xab, 0x3b, 0x0b, 0x87, 0x14, 0x1c,
+	0xd3, 0xd7, 0x97, 0xd1, 0x47, 0xf1, 0x6c, 0x5d, 0xe7, 0xa9, 0x2e, 0x1f, 0x87, 0x75, 0xf3, 0x38,
+	0xac, 0xaf, 0xb3, 0x20, 0x6e, 0x5e, 0x91, 0xb9, 0x7d, 0xf8, 0xc4, 0x59, 0x7d, 0x89, 0xdc, 0xca,
+	0x05, 0x1c, 0x8f, 0xb8, 0x9b, 0xb7, 0x1f, 0x3d, 0xad, 0x59, 0x8f, 0x9f, 0xd6, 0xac, 0x3f, 0x9e,
+	0xd6, 0xac, 0x6f, 0x9f, 0xd5, 0xe6, 0x1e, 0x3f, 0xab, 0xcd, 0xfd, 0xf6, 0xac, 0x36, 0xf7, 0xf9,
+	0x95, 0x0c, 0x19, 0xa7, 0xc1, 0xe5, 0xd1, 0x54, 0x50, 0x82, 0x1a, 0x0b, 0x8d, 0xbe, 0x79, 0xf0,
+	0x6a, 0xea, 0xd6, 0xbc, 0x32, 0x79, 0xfb, 0xdf, 0x01, 0x00, 0xeb, 0xdb, 0x49, 0xb5, 0x0e, 0x0f,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -590,6 +799,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.LookbackDuration != that1.LookbackDuration {
 		return false
 	}
+	if this.CommitRevealEnabled != that1.CommitRevealEnabled {
+		return false
+	}
+	if !this.RewardDistributionRate.Equal(that1.RewardDistributionRate) {
+		return false
+	}
+	if this.MaxStaleness != that1.MaxStaleness {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -612,6 +830,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxStaleness != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxStaleness))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.RewardDistributionRate.Size()
+		i -= size
+		if _, err := m.RewardDistributionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.CommitRevealEnabled {
+		i--
+		if m.CommitRevealEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.LookbackDuration != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackDuration))
 		i--
@@ -704,6 +947,47 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxChange != nil {
+		{
+			size := m.MaxChange.Size()
+			i -= size
+			if _, err := m.MaxChange.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RewardBand != nil {
+		{
+			size := m.RewardBand.Size()
+			i -= size
+			if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VoteThreshold != nil {
+		{
+			size := m.VoteThreshold.Size()
+			i -= size
+			if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -714,6 +998,48 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmitBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -968,6 +1294,86 @@ func (m *OracleTwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OraclePriceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePriceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePriceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SampleCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SampleCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Volatility.Size()
+		i -= size
+		if _, err := m.Volatility.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Median.Size()
+		i -= size
+		if _, err := m.Median.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VotePenaltyCounter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1006,20 +1412,57 @@ func (m *VotePenaltyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AccruedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *AccruedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccruedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1046,6 +1489,14 @@ func (m *Params) Size() (n int) {
 	if m.LookbackDuration != 0 {
 		n += 1 + sovOracle(uint64(m.LookbackDuration))
 	}
+	if m.CommitRevealEnabled {
+		n += 2
+	}
+	l = m.RewardDistributionRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MaxStaleness != 0 {
+		n += 1 + sovOracle(uint64(m.MaxStaleness))
+	}
 	return n
 }
 
@@ -1059,6 +1510,41 @@ func (m *Denom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if m.VoteThreshold != nil {
+		l = m.VoteThreshold.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.RewardBand != nil {
+		l = m.RewardBand.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	if m.MaxChange != nil {
+		l = m.MaxChange.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
 	return n
 }
 
@@ -1160,6 +1646,33 @@ func (m *OracleTwap) Size() (n int) {
 	return n
 }
 
+func (m *OraclePriceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.Median.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Min.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.Volatility.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SampleCount != 0 {
+		n += 1 + sovOracle(uint64(m.SampleCount))
+	}
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovOracle(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
 func (m *VotePenaltyCounter) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1178,6 +1691,21 @@ func (m *VotePenaltyCounter) Size() (n int) {
 	return n
 }
 
+func (m *AccruedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovOracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1440,59 +1968,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Denom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRevealEnabled", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CommitRevealEnabled = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1520,8 +2018,29 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.RewardDistributionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStaleness", wireType)
+			}
+			m.MaxStaleness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStaleness |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1543,7 +2062,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *Denom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1566,17 +2085,17 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1586,29 +2105,27 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1636,61 +2153,15 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VoteThreshold = &v
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1718,11 +2189,34 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardBand = &v
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChange", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1750,7 +2244,9 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxChange = &v
+			if err := m.MaxChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1775,7 +2271,7 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1798,15 +2294,15 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleExchangeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1834,13 +2330,11 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1868,10 +2362,27 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1893,7 +2404,7 @@ func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1916,17 +2427,17 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshotItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1936,29 +2447,31 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1968,24 +2481,23 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2008,7 +2520,7 @@ func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2031,17 +2543,17 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.SnapshotTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2051,16 +2563,29 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2070,23 +2595,23 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceSnapshotItems = append(m.PriceSnapshotItems, PriceSnapshotItem{})
-			if err := m.PriceSnapshotItems[len(m.PriceSnapshotItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2111,7 +2636,7 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleTwap) Unmarshal(dAtA []byte) error {
+func (m *OracleExchangeRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2134,15 +2659,15 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleTwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleExchangeRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleTwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2170,11 +2695,13 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2202,29 +2729,10 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
-			}
-			m.LookbackSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LookbackSeconds |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -2246,7 +2754,7 @@ func (m *OracleTwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
+func (m *PriceSnapshotItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2269,17 +2777,17 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotePenaltyCounter: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceSnapshotItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotePenaltyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceSnapshotItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.MissCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2289,16 +2797,29 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleExchangeRate", wireType)
 			}
-			m.AbstainCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -2308,30 +2829,710 @@ func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AbstainCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			m.SuccessCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SuccessCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTimestamp", wireType)
+			}
+			m.SnapshotTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSnapshotItems = append(m.PriceSnapshotItems, PriceSnapshotItem{})
+			if err := m.PriceSnapshotItems[len(m.PriceSnapshotItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleTwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleTwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleTwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OraclePriceStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OraclePriceStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OraclePriceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volatility", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volatility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleCount", wireType)
+			}
+			m.SampleCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotePenaltyCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotePenaltyCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotePenaltyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+			}
+			m.MissCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			m.AbstainCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbstainCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCount", wireType)
+			}
+			m.SuccessCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccruedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccruedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccruedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
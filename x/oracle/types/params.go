@@ -13,14 +13,17 @@ import (
 
 // Parameter keys
 var (
-	KeyVotePeriod        = []byte("VotePeriod")
-	KeyVoteThreshold     = []byte("VoteThreshold")
-	KeyRewardBand        = []byte("RewardBand")
-	KeyWhitelist         = []byte("Whitelist")
-	KeySlashFraction     = []byte("SlashFraction")
-	KeySlashWindow       = []byte("SlashWindow")
-	KeyMinValidPerWindow = []byte("MinValidPerWindow")
-	KeyLookbackDuration  = []byte("LookbackDuration")
+	KeyVotePeriod             = []byte("VotePeriod")
+	KeyVoteThreshold          = []byte("VoteThreshold")
+	KeyRewardBand             = []byte("RewardBand")
+	KeyWhitelist              = []byte("Whitelist")
+	KeySlashFraction          = []byte("SlashFraction")
+	KeySlashWindow            = []byte("SlashWindow")
+	KeyMinValidPerWindow      = []byte("MinValidPerWindow")
+	KeyLookbackDuration       = []byte("LookbackDuration")
+	KeyCommitRevealEnabled    = []byte("CommitRevealEnabled")
+	KeyRewardDistributionRate = []byte("RewardDistributionRate")
+	KeyMaxStaleness           = []byte("MaxStaleness")
 )
 
 // Default parameter values
@@ -39,9 +42,12 @@ var (
 		// 		{Name: utils.MicroSeiDenom},
 		{Name: utils.MicroEthDenom},
 	}
-	DefaultSlashFraction     = sdk.NewDecWithPrec(0, 4) // 0.00%
-	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
-	DefaultLookbackDuration  = uint64(3600)             // in seconds
+	DefaultSlashFraction          = sdk.NewDecWithPrec(0, 4) // 0.00%
+	DefaultMinValidPerWindow      = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultLookbackDuration       = uint64(3600)             // in seconds
+	DefaultCommitRevealEnabled    = false
+	DefaultRewardDistributionRate = sdk.ZeroDec() // no rewards are distributed
+	DefaultMaxStaleness           = uint64(0)     // exchange rates never go stale
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -49,14 +55,17 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default oracle module parameters
 func DefaultParams() Params {
 	return Params{
-		VotePeriod:        DefaultVotePeriod,
-		VoteThreshold:     DefaultVoteThreshold,
-		RewardBand:        DefaultRewardBand,
-		Whitelist:         DefaultWhitelist,
-		SlashFraction:     DefaultSlashFraction,
-		SlashWindow:       DefaultSlashWindow,
-		MinValidPerWindow: DefaultMinValidPerWindow,
-		LookbackDuration:  DefaultLookbackDuration,
+		VotePeriod:             DefaultVotePeriod,
+		VoteThreshold:          DefaultVoteThreshold,
+		RewardBand:             DefaultRewardBand,
+		Whitelist:              DefaultWhitelist,
+		SlashFraction:          DefaultSlashFraction,
+		SlashWindow:            DefaultSlashWindow,
+		MinValidPerWindow:      DefaultMinValidPerWindow,
+		LookbackDuration:       DefaultLookbackDuration,
+		CommitRevealEnabled:    DefaultCommitRevealEnabled,
+		RewardDistributionRate: DefaultRewardDistributionRate,
+		MaxStaleness:           DefaultMaxStaleness,
 	}
 }
 
@@ -77,6 +86,9 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
 		paramstypes.NewParamSetPair(KeyLookbackDuration, &p.LookbackDuration, validateLookbackDuration),
+		paramstypes.NewParamSetPair(KeyCommitRevealEnabled, &p.CommitRevealEnabled, validateCommitRevealEnabled),
+		paramstypes.NewParamSetPair(KeyRewardDistributionRate, &p.RewardDistributionRate, validateRewardDistributionRate),
+		paramstypes.NewParamSetPair(KeyMaxStaleness, &p.MaxStaleness, validateMaxStaleness),
 	}
 }
 
@@ -115,9 +127,13 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.RewardDistributionRate.IsNil() || p.RewardDistributionRate.GT(sdk.OneDec()) || p.RewardDistributionRate.IsNegative() {
+		return fmt.Errorf("oracle parameter RewardDistributionRate must be between [0, 1]")
+	}
+
 	for _, denom := range p.Whitelist {
-		if len(denom.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+		if err := denom.Validate(); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -177,8 +193,8 @@ func validateWhitelist(i interface{}) error {
 	}
 
 	for _, d := range v {
-		if len(d.Name) == 0 {
-			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
+		if err := d.Validate(); err != nil {
+			return err
 		}
 	}
 
@@ -240,3 +256,42 @@ func validateLookbackDuration(i interface{}) error {
 
 	return nil
 }
+
+func validateCommitRevealEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRewardDistributionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("reward distribution rate must be set")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("reward distribution rate must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("reward distribution rate is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxStaleness(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -61,7 +61,13 @@ func TestParamsValid(t *testing.T) {
 	err = p8.Validate()
 	require.Error(t, err)
 
+	// reward distribution rate above one
 	p9 := DefaultParams()
-	require.NotNil(t, p9.ParamSetPairs())
-	require.NotNil(t, p9.String())
+	p9.RewardDistributionRate = sdk.NewDecWithPrec(11, 1)
+	err = p9.Validate()
+	require.Error(t, err)
+
+	p10 := DefaultParams()
+	require.NotNil(t, p10.ParamSetPairs())
+	require.NotNil(t, p10.String())
 }
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ln2 is the natural logarithm of 2, used to range reduce the arguments of lnDec
+var ln2 = lnSeries(sdk.NewDec(2))
+
+// NewOraclePriceStats computes the statistics of the exchange rates sampled from the price
+// snapshots of a denom. The samples must be ordered from the oldest to the newest snapshot.
+func NewOraclePriceStats(denom string, samples []sdk.Dec, lookbackSeconds int64) OraclePriceStats {
+	stats := OraclePriceStats{
+		Denom:           denom,
+		Median:          sdk.ZeroDec(),
+		Min:             sdk.ZeroDec(),
+		Max:             sdk.ZeroDec(),
+		Volatility:      sdk.ZeroDec(),
+		SampleCount:     uint64(len(samples)),
+		LookbackSeconds: lookbackSeconds,
+	}
+	if len(samples) == 0 {
+		return stats
+	}
+
+	sorted := make([]sdk.Dec, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+	stats.Min = sorted[0]
+	stats.Max = sorted[len(sorted)-1]
+	if mid := len(sorted) / 2; len(sorted)%2 == 1 {
+		stats.Median = sorted[mid]
+	} else {
+		stats.Median = sorted[mid-1].Add(sorted[mid]).QuoInt64(2)
+	}
+
+	stats.Volatility = logReturnsStandardDeviation(samples)
+	return stats
+}
+
+// logReturnsStandardDeviation returns the standard deviation of the log returns between
+// consecutive samples. Returns from or to a non-positive rate are skipped.
+func logReturnsStandardDeviation(samples []sdk.Dec) sdk.Dec {
+	logReturns := []sdk.Dec{}
+	for i := 1; i < len(samples); i++ {
+		if !samples[i-1].IsPositive() || !samples[i].IsPositive() {
+			continue
+		}
+		logReturns = append(logReturns, lnDec(samples[i].Quo(samples[i-1])))
+	}
+	if len(logReturns) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	sum := sdk.ZeroDec()
+	for _, logReturn := range logReturns {
+		sum = sum.Add(logReturn)
+	}
+	mean := sum.QuoInt64(int64(len(logReturns)))
+
+	squaredDeviations := sdk.ZeroDec()
+	for _, logReturn := range logReturns {
+		deviation := logReturn.Sub(mean)
+		squaredDeviations = squaredDeviations.Add(deviation.Mul(deviation))
+	}
+	variance := squaredDeviations.QuoInt64(int64(len(logReturns)))
+
+	standardDeviation, err := variance.ApproxSqrt()
+	if err != nil {
+		return sdk.ZeroDec()
+	}
+	return standardDeviation
+}
+
+// lnDec computes the natural logarithm of a positive decimal without floating point math, so
+// that the result is deterministic across nodes. The argument is reduced to [1, 2) by powers
+// of 2 before the series is applied.
+func lnDec(x sdk.Dec) sdk.Dec {
+	two := sdk.NewDec(2)
+	exponent := int64(0)
+	for x.GTE(two) {
+		x = x.QuoInt64(2)
+		exponent++
+	}
+	for x.LT(sdk.OneDec()) {
+		x = x.MulInt64(2)
+		exponent--
+	}
+	return lnSeries(x).Add(ln2.MulInt64(exponent))
+}
+
+// lnSeries computes ln(x) = 2 * atanh((x - 1) / (x + 1)) from the power series of atanh, which
+// converges quickly for x in [1, 2]
+func lnSeries(x sdk.Dec) sdk.Dec {
+	z := x.Sub(sdk.OneDec()).Quo(x.Add(sdk.OneDec()))
+	zSquared := z.Mul(z)
+	sum := sdk.ZeroDec()
+	term := z
+	for k := int64(1); !term.IsZero(); k += 2 {
+		sum = sum.Add(term.QuoInt64(k))
+		term = term.Mul(zSquared)
+	}
+	return sum.MulInt64(2)
+}
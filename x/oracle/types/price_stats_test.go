@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func requireDecApproxEqual(t *testing.T, expected sdk.Dec, actual sdk.Dec) {
+	require.True(t, expected.Sub(actual).Abs().LTE(sdk.NewDecWithPrec(1, 12)), "expected %s, got %s", expected, actual)
+}
+
+func TestLnDec(t *testing.T) {
+	require.Equal(t, sdk.ZeroDec(), lnDec(sdk.OneDec()))
+	requireDecApproxEqual(t, sdk.MustNewDecFromStr("0.693147180559945309"), lnDec(sdk.NewDec(2)))
+	requireDecApproxEqual(t, sdk.MustNewDecFromStr("-0.693147180559945309"), lnDec(sdk.NewDecWithPrec(5, 1)))
+	requireDecApproxEqual(t, sdk.MustNewDecFromStr("0.095310179804324860"), lnDec(sdk.NewDecWithPrec(11, 1)))
+	requireDecApproxEqual(t, sdk.MustNewDecFromStr("4.605170185988091368"), lnDec(sdk.NewDec(100)))
+	requireDecApproxEqual(t, sdk.MustNewDecFromStr("-13.815510557964274104"), lnDec(sdk.NewDecWithPrec(1, 6)))
+}
+
+func TestNewOraclePriceStats(t *testing.T) {
+	stats := NewOraclePriceStats("uatom", []sdk.Dec{}, 0)
+	require.Equal(t, uint64(0), stats.SampleCount)
+	require.Equal(t, sdk.ZeroDec(), stats.Median)
+	require.Equal(t, sdk.ZeroDec(), stats.Volatility)
+
+	// odd number of samples with alternating returns of ln(2) and -ln(2)
+	stats = NewOraclePriceStats("uatom", []sdk.Dec{sdk.NewDec(10), sdk.NewDec(20), sdk.NewDec(10)}, 100)
+	require.Equal(t, "uatom", stats.Denom)
+	require.Equal(t, uint64(3), stats.SampleCount)
+	require.Equal(t, int64(100), stats.LookbackSeconds)
+	require.Equal(t, sdk.NewDec(10), stats.Median)
+	require.Equal(t, sdk.NewDec(10), stats.Min)
+	require.Equal(t, sdk.NewDec(20), stats.Max)
+	requireDecApproxEqual(t, sdk.MustNewDecFromStr("0.693147180559945309"), stats.Volatility)
+
+	// even number of samples with a constant return
+	stats = NewOraclePriceStats("uatom", []sdk.Dec{sdk.NewDec(40), sdk.NewDec(20), sdk.NewDec(10), sdk.NewDec(5)}, 100)
+	require.Equal(t, sdk.NewDecWithPrec(15, 0), stats.Median)
+	require.Equal(t, sdk.NewDec(5), stats.Min)
+	require.Equal(t, sdk.NewDec(40), stats.Max)
+	requireDecApproxEqual(t, sdk.ZeroDec(), stats.Volatility)
+}
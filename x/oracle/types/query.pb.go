@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -73,6 +75,10 @@ var xxx_messageInfo_QueryExchangeRateRequest proto.InternalMessageInfo
 type QueryExchangeRateResponse struct {
 	// exchange_rate defines the exchange rate of Sei denominated in various Sei
 	OracleExchangeRate OracleExchangeRate `protobuf:"bytes,1,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate"`
+	// age is the number of blocks since the last update of the exchange rate
+	Age int64 `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+	// stale is set when the age is past the max staleness param
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
@@ -115,6 +121,20 @@ func (m *QueryExchangeRateResponse) GetOracleExchangeRate() OracleExchangeRate {
 	return OracleExchangeRate{}
 }
 
+func (m *QueryExchangeRateResponse) GetAge() int64 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+func (m *QueryExchangeRateResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 // QueryExchangeRatesRequest is the request type for the Query/ExchangeRates RPC method.
 type QueryExchangeRatesRequest struct {
 }
@@ -155,6 +175,10 @@ var xxx_messageInfo_QueryExchangeRatesRequest proto.InternalMessageInfo
 type DenomOracleExchangeRatePair struct {
 	Denom              string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	OracleExchangeRate OracleExchangeRate `protobuf:"bytes,2,opt,name=oracle_exchange_rate,json=oracleExchangeRate,proto3" json:"oracle_exchange_rate"`
+	// age is the number of blocks since the last update of the exchange rate
+	Age int64 `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
+	// stale is set when the age is past the max staleness param
+	Stale bool `protobuf:"varint,4,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
 func (m *DenomOracleExchangeRatePair) Reset()         { *m = DenomOracleExchangeRatePair{} }
@@ -204,6 +228,20 @@ func (m *DenomOracleExchangeRatePair) GetOracleExchangeRate() OracleExchangeRate
 	return OracleExchangeRate{}
 }
 
+func (m *DenomOracleExchangeRatePair) GetAge() int64 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+func (m *DenomOracleExchangeRatePair) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 // QueryExchangeRatesResponse is response type for the
 // Query/ExchangeRates RPC method.
 type QueryExchangeRatesResponse struct {
@@ -590,6 +628,95 @@ func (m *QueryTwapsResponse) GetOracleTwaps() OracleTwaps {
 	return nil
 }
 
+// request type for price stats RPC method
+type QueryPriceStatsRequest struct {
+	LookbackSeconds uint64 `protobuf:"varint,1,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+}
+
+func (m *QueryPriceStatsRequest) Reset()         { *m = QueryPriceStatsRequest{} }
+func (m *QueryPriceStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceStatsRequest) ProtoMessage()    {}
+func (*QueryPriceStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{13}
+}
+func (m *QueryPriceStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceStatsRequest.Merge(m, src)
+}
+func (m *QueryPriceStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceStatsRequest proto.InternalMessageInfo
+
+func (m *QueryPriceStatsRequest) GetLookbackSeconds() uint64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
+}
+
+type QueryPriceStatsResponse struct {
+	OraclePriceStats OraclePriceStatsList `protobuf:"bytes,1,rep,name=oracle_price_stats,json=oraclePriceStats,proto3,castrepeated=OraclePriceStatsList" json:"oracle_price_stats"`
+}
+
+func (m *QueryPriceStatsResponse) Reset()         { *m = QueryPriceStatsResponse{} }
+func (m *QueryPriceStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceStatsResponse) ProtoMessage()    {}
+func (*QueryPriceStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{14}
+}
+func (m *QueryPriceStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceStatsResponse.Merge(m, src)
+}
+func (m *QueryPriceStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPriceStatsResponse) GetOraclePriceStats() OraclePriceStatsList {
+	if m != nil {
+		return m.OraclePriceStats
+	}
+	return nil
+}
+
 // QueryFeederDelegationRequest is the request type for the Query/FeederDelegation RPC method.
 type QueryFeederDelegationRequest struct {
 	// validator defines the validator address to query for.
@@ -600,7 +727,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{13}
+	return fileDescriptor_562b782cb9ac197e, []int{15}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -640,7 +767,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{14}
+	return fileDescriptor_562b782cb9ac197e, []int{16}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +813,7 @@ func (m *QueryVotePenaltyCounterRequest) Reset()         { *m = QueryVotePenalty
 func (m *QueryVotePenaltyCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVotePenaltyCounterRequest) ProtoMessage()    {}
 func (*QueryVotePenaltyCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{15}
+	return fileDescriptor_562b782cb9ac197e, []int{17}
 }
 func (m *QueryVotePenaltyCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,7 +852,7 @@ func (m *QueryVotePenaltyCounterResponse) Reset()         { *m = QueryVotePenalt
 func (m *QueryVotePenaltyCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVotePenaltyCounterResponse) ProtoMessage()    {}
 func (*QueryVotePenaltyCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{16}
+	return fileDescriptor_562b782cb9ac197e, []int{18}
 }
 func (m *QueryVotePenaltyCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,6 +888,92 @@ func (m *QueryVotePenaltyCounterResponse) GetVotePenaltyCounter() *VotePenaltyCo
 	return nil
 }
 
+// QueryValidatorRewardsRequest is the request type for the Query/ValidatorRewards RPC method.
+type QueryValidatorRewardsRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryValidatorRewardsRequest) Reset()         { *m = QueryValidatorRewardsRequest{} }
+func (m *QueryValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardsRequest) ProtoMessage()    {}
+func (*QueryValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{19}
+}
+func (m *QueryValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardsRequest.Merge(m, src)
+}
+func (m *QueryValidatorRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRewardsRequest proto.InternalMessageInfo
+
+// QueryValidatorRewardsResponse is response type for the
+// Query/ValidatorRewards RPC method.
+type QueryValidatorRewardsResponse struct {
+	// rewards defines the oracle rewards distributed to the validator so far
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *QueryValidatorRewardsResponse) Reset()         { *m = QueryValidatorRewardsResponse{} }
+func (m *QueryValidatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRewardsResponse) ProtoMessage()    {}
+func (*QueryValidatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{20}
+}
+func (m *QueryValidatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRewardsResponse.Merge(m, src)
+}
+func (m *QueryValidatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 // QuerySlashWindow is the request type for the
 // Query/SlashWindow RPC method.
 type QuerySlashWindowRequest struct {
@@ -770,7 +983,7 @@ func (m *QuerySlashWindowRequest) Reset()         { *m = QuerySlashWindowRequest
 func (m *QuerySlashWindowRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindowRequest) ProtoMessage()    {}
 func (*QuerySlashWindowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{17}
+	return fileDescriptor_562b782cb9ac197e, []int{21}
 }
 func (m *QuerySlashWindowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1024,7 @@ func (m *QuerySlashWindowResponse) Reset()         { *m = QuerySlashWindowRespon
 func (m *QuerySlashWindowResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySlashWindowResponse) ProtoMessage()    {}
 func (*QuerySlashWindowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{18}
+	return fileDescriptor_562b782cb9ac197e, []int{22}
 }
 func (m *QuerySlashWindowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -847,6 +1060,101 @@ func (m *QuerySlashWindowResponse) GetWindowProgress() uint64 {
 	return 0
 }
 
+// QueryCommitRevealStateRequest is the request type for the
+// Query/CommitRevealState RPC method.
+type QueryCommitRevealStateRequest struct {
+}
+
+func (m *QueryCommitRevealStateRequest) Reset()         { *m = QueryCommitRevealStateRequest{} }
+func (m *QueryCommitRevealStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitRevealStateRequest) ProtoMessage()    {}
+func (*QueryCommitRevealStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{23}
+}
+func (m *QueryCommitRevealStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitRevealStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitRevealStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommitRevealStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitRevealStateRequest.Merge(m, src)
+}
+func (m *QueryCommitRevealStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitRevealStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitRevealStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitRevealStateRequest proto.InternalMessageInfo
+
+// QueryCommitRevealStateResponse is response type for the
+// Query/CommitRevealState RPC method.
+type QueryCommitRevealStateResponse struct {
+	// active defines whether votes of the current vote period must reveal a prevote.
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// enabled defines the CommitRevealEnabled param, which active follows from the
+	// next vote period on.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryCommitRevealStateResponse) Reset()         { *m = QueryCommitRevealStateResponse{} }
+func (m *QueryCommitRevealStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitRevealStateResponse) ProtoMessage()    {}
+func (*QueryCommitRevealStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_562b782cb9ac197e, []int{24}
+}
+func (m *QueryCommitRevealStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitRevealStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitRevealStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommitRevealStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitRevealStateResponse.Merge(m, src)
+}
+func (m *QueryCommitRevealStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitRevealStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitRevealStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitRevealStateResponse proto.InternalMessageInfo
+
+func (m *QueryCommitRevealStateResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *QueryCommitRevealStateResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -855,7 +1163,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{19}
+	return fileDescriptor_562b782cb9ac197e, []int{25}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +1202,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_562b782cb9ac197e, []int{20}
+	return fileDescriptor_562b782cb9ac197e, []int{26}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,12 +1252,18 @@ func init() {
 	proto.RegisterType((*QueryPriceSnapshotHistoryResponse)(nil), "seiprotocol.seichain.oracle.QueryPriceSnapshotHistoryResponse")
 	proto.RegisterType((*QueryTwapsRequest)(nil), "seiprotocol.seichain.oracle.QueryTwapsRequest")
 	proto.RegisterType((*QueryTwapsResponse)(nil), "seiprotocol.seichain.oracle.QueryTwapsResponse")
+	proto.RegisterType((*QueryPriceStatsRequest)(nil), "seiprotocol.seichain.oracle.QueryPriceStatsRequest")
+	proto.RegisterType((*QueryPriceStatsResponse)(nil), "seiprotocol.seichain.oracle.QueryPriceStatsResponse")
 	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "seiprotocol.seichain.oracle.QueryFeederDelegationRequest")
 	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "seiprotocol.seichain.oracle.QueryFeederDelegationResponse")
 	proto.RegisterType((*QueryVotePenaltyCounterRequest)(nil), "seiprotocol.seichain.oracle.QueryVotePenaltyCounterRequest")
 	proto.RegisterType((*QueryVotePenaltyCounterResponse)(nil), "seiprotocol.seichain.oracle.QueryVotePenaltyCounterResponse")
+	proto.RegisterType((*QueryValidatorRewardsRequest)(nil), "seiprotocol.seichain.oracle.QueryValidatorRewardsRequest")
+	proto.RegisterType((*QueryValidatorRewardsResponse)(nil), "seiprotocol.seichain.oracle.QueryValidatorRewardsResponse")
 	proto.RegisterType((*QuerySlashWindowRequest)(nil), "seiprotocol.seichain.oracle.QuerySlashWindowRequest")
 	proto.RegisterType((*QuerySlashWindowResponse)(nil), "seiprotocol.seichain.oracle.QuerySlashWindowResponse")
+	proto.RegisterType((*QueryCommitRevealStateRequest)(nil), "seiprotocol.seichain.oracle.QueryCommitRevealStateRequest")
+	proto.RegisterType((*QueryCommitRevealStateResponse)(nil), "seiprotocol.seichain.oracle.QueryCommitRevealStateResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "seiprotocol.seichain.oracle.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "seiprotocol.seichain.oracle.QueryParamsResponse")
 }
@@ -957,76 +1271,96 @@ func init() {
 func init() { proto.RegisterFile("oracle/query.proto", fileDescriptor_562b782cb9ac197e) }
 
 var fileDescriptor_562b782cb9ac197e = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x94, 0x26, 0xa5, 0xcf, 0x69, 0x52, 0x26, 0x06, 0xd2, 0x4d, 0xb0, 0xd3, 0x85, 0x2a,
-	0x05, 0x14, 0x6f, 0x48, 0x93, 0x02, 0x69, 0x12, 0x35, 0x3f, 0xa8, 0x80, 0x4b, 0x12, 0xa7, 0xa2,
-	0x88, 0xcb, 0x6a, 0x62, 0x0f, 0xeb, 0x55, 0x9c, 0x9d, 0xed, 0xce, 0x26, 0x69, 0x14, 0xe5, 0x82,
-	0x7a, 0xe0, 0x18, 0x89, 0x1b, 0xe2, 0xd0, 0x0b, 0x1c, 0xb8, 0xc0, 0x89, 0x23, 0x07, 0x24, 0xa4,
-	0x1e, 0x2b, 0xc1, 0x01, 0x09, 0x09, 0x50, 0xc2, 0xa1, 0x7f, 0x06, 0xf2, 0xec, 0x5b, 0x67, 0xb7,
-	0x59, 0xaf, 0xd7, 0xa1, 0xa7, 0xf5, 0xbc, 0x37, 0xef, 0x9b, 0xef, 0x1b, 0xbf, 0x79, 0x1f, 0x50,
-	0xe1, 0xb1, 0x6a, 0x83, 0x1b, 0xf7, 0xb7, 0xb9, 0xb7, 0x57, 0x76, 0x3d, 0xe1, 0x0b, 0x3a, 0x2c,
-	0xb9, 0xad, 0x7e, 0x55, 0x45, 0xa3, 0x2c, 0xb9, 0x5d, 0xad, 0x33, 0xdb, 0x29, 0x07, 0x1b, 0xb5,
-	0x82, 0x25, 0x2c, 0xa1, 0xb2, 0x46, 0xf3, 0x57, 0x50, 0xa2, 0x8d, 0x58, 0x42, 0x58, 0x0d, 0x6e,
-	0x30, 0xd7, 0x36, 0x98, 0xe3, 0x08, 0x9f, 0xf9, 0xb6, 0x70, 0x24, 0x66, 0x07, 0xf1, 0x90, 0xe0,
-	0x13, 0x04, 0xf5, 0x19, 0x18, 0x5a, 0x6b, 0x1e, 0xfa, 0xc1, 0x83, 0x6a, 0x9d, 0x39, 0x16, 0xaf,
-	0x30, 0x9f, 0x57, 0xf8, 0xfd, 0x6d, 0x2e, 0x7d, 0x5a, 0x80, 0x9e, 0x1a, 0x77, 0xc4, 0xd6, 0x10,
-	0x19, 0x25, 0xd7, 0x2f, 0x56, 0x82, 0xc5, 0xcc, 0x8b, 0x5f, 0x3e, 0x2a, 0xe5, 0x9e, 0x3e, 0x2a,
-	0xe5, 0xf4, 0x87, 0x04, 0xae, 0x24, 0x14, 0x4b, 0x57, 0x38, 0x92, 0x53, 0x0b, 0x0a, 0xc1, 0x49,
-	0x26, 0xc7, 0xb4, 0xe9, 0x31, 0x9f, 0x2b, 0xb0, 0xfc, 0xa4, 0x51, 0x4e, 0x91, 0x57, 0x5e, 0x51,
-	0x9f, 0x28, 0xec, 0xe2, 0xf9, 0xc7, 0x7f, 0x95, 0x72, 0x15, 0xbc, 0xa8, 0x68, 0x46, 0x1f, 0x4e,
-	0x60, 0x21, 0x51, 0x83, 0xfe, 0x0d, 0x81, 0xe1, 0xe5, 0x26, 0xef, 0xd3, 0x90, 0xab, 0xcc, 0xf6,
-	0x92, 0x35, 0xb6, 0xe5, 0x7e, 0xee, 0x79, 0x73, 0xff, 0x95, 0x80, 0x96, 0x44, 0x1e, 0xef, 0xf0,
-	0x3b, 0x02, 0xa3, 0x8a, 0x91, 0x99, 0x44, 0xc7, 0x74, 0x99, 0xed, 0xc9, 0x21, 0x32, 0xfa, 0xc2,
-	0xf5, 0xfc, 0xe4, 0x7b, 0xa9, 0xa4, 0x52, 0xae, 0x60, 0xf1, 0x8d, 0x26, 0xbb, 0xef, 0xff, 0x2e,
-	0x8d, 0xa4, 0x6c, 0x92, 0x95, 0x91, 0x5a, 0x4a, 0x56, 0x7f, 0x19, 0x06, 0x95, 0x8c, 0x85, 0xaa,
-	0x6f, 0xef, 0x9c, 0xdc, 0xfe, 0x04, 0x14, 0xe2, 0x61, 0xd4, 0x35, 0x04, 0x17, 0x58, 0x10, 0x52,
-	0xec, 0x2f, 0x56, 0xc2, 0xa5, 0x7e, 0x05, 0x5e, 0x55, 0x15, 0x9f, 0x08, 0x9f, 0xdf, 0x65, 0x9e,
-	0xc5, 0xfd, 0x16, 0xd8, 0x1c, 0xb6, 0x6a, 0x2c, 0x85, 0x80, 0x57, 0xa1, 0x6f, 0x47, 0xf8, 0xdc,
-	0xf4, 0x83, 0x38, 0xa2, 0xe6, 0x77, 0x4e, 0xb6, 0xea, 0x3a, 0x8c, 0xaa, 0xf2, 0x55, 0xcf, 0xae,
-	0xf2, 0x75, 0x87, 0xb9, 0xb2, 0x2e, 0xfc, 0x0f, 0x6d, 0xe9, 0x0b, 0x6f, 0x2f, 0x3c, 0xe2, 0x90,
-	0xc0, 0xd5, 0x94, 0x4d, 0x78, 0xd8, 0x26, 0x0c, 0xb8, 0xcd, 0xbc, 0x29, 0x71, 0x43, 0xf8, 0x1f,
-	0xbc, 0x95, 0xfa, 0x1f, 0xc4, 0x30, 0x17, 0x5f, 0xc1, 0x5b, 0xef, 0x8f, 0x85, 0x65, 0xa5, 0xdf,
-	0x8d, 0xad, 0xf5, 0x79, 0x78, 0x49, 0x31, 0xba, 0xbb, 0xcb, 0xdc, 0xf0, 0x2a, 0xe8, 0x9b, 0x70,
-	0xb9, 0x21, 0xc4, 0xe6, 0x06, 0xab, 0x6e, 0x9a, 0x92, 0x57, 0x85, 0x53, 0x93, 0xaa, 0x81, 0xcf,
-	0x57, 0x06, 0xc2, 0xf8, 0x7a, 0x10, 0xd6, 0xb7, 0x81, 0x46, 0xeb, 0x51, 0x82, 0x09, 0x7d, 0xd8,
-	0x51, 0x7e, 0x33, 0x8e, 0xfc, 0xc7, 0x32, 0x34, 0x76, 0x13, 0x67, 0x71, 0x10, 0xc9, 0xe7, 0x4f,
-	0x62, 0xb2, 0x92, 0x17, 0x27, 0x0b, 0x7d, 0x05, 0x46, 0xd4, 0xb1, 0x77, 0x38, 0xaf, 0x71, 0x6f,
-	0x99, 0x37, 0xb8, 0xa5, 0x86, 0x51, 0xa8, 0xe0, 0x1a, 0xf4, 0xef, 0xb0, 0x86, 0x5d, 0x63, 0xbe,
-	0xf0, 0x4c, 0x56, 0xab, 0x79, 0xf8, 0x00, 0x2f, 0xb5, 0xa2, 0x0b, 0xb5, 0x9a, 0x17, 0x19, 0x36,
-	0xb7, 0xe1, 0xb5, 0x36, 0x80, 0x28, 0xa9, 0x04, 0xf9, 0xcf, 0x55, 0x2e, 0x0a, 0x07, 0x41, 0xa8,
-	0x89, 0xa5, 0xaf, 0x41, 0xb1, 0xd5, 0x3f, 0xab, 0xdc, 0x61, 0x0d, 0x7f, 0x6f, 0x49, 0x6c, 0x3b,
-	0x3e, 0xf7, 0xce, 0x4c, 0xea, 0x21, 0x81, 0x52, 0x5b, 0x4c, 0xe4, 0xc5, 0xa0, 0xa0, 0x5a, 0xd3,
-	0x0d, 0xd2, 0x66, 0x35, 0xc8, 0x67, 0x9a, 0x83, 0x09, 0xb0, 0x74, 0xe7, 0x54, 0xac, 0xf5, 0x68,
-	0xd6, 0x1b, 0x4c, 0xd6, 0xef, 0xd9, 0x4e, 0x4d, 0xec, 0x86, 0x1d, 0xbd, 0x84, 0x8f, 0x26, 0x96,
-	0x42, 0x66, 0x63, 0x30, 0xb0, 0xab, 0x22, 0xa6, 0xeb, 0x09, 0xcb, 0xe3, 0x32, 0x6c, 0xa2, 0xfe,
-	0x20, 0xbc, 0x8a, 0x51, 0xbd, 0x80, 0x3d, 0xb4, 0xca, 0x3c, 0xb6, 0xd5, 0x7a, 0x8f, 0x9f, 0xe2,
-	0x9b, 0x0f, 0xa3, 0x88, 0xba, 0x00, 0xbd, 0xae, 0x8a, 0xa0, 0xc2, 0xd7, 0xd3, 0x1f, 0x85, 0xda,
-	0x8a, 0x13, 0x12, 0x0b, 0x27, 0x0f, 0x07, 0xa0, 0x47, 0x41, 0xd3, 0x5f, 0x08, 0xf4, 0x45, 0xa7,
-	0x0d, 0x9d, 0x4e, 0x45, 0x6b, 0x67, 0x65, 0xda, 0xcd, 0x6e, 0xcb, 0x02, 0x31, 0xfa, 0xd2, 0x17,
-	0xbf, 0xfd, 0xfb, 0xd5, 0xb9, 0x39, 0x7a, 0xcb, 0x90, 0xdc, 0x1e, 0x0f, 0x01, 0xd4, 0x42, 0x21,
-	0xa0, 0x99, 0x1a, 0x6a, 0x38, 0x4a, 0x63, 0x5f, 0x7d, 0x0f, 0x8c, 0xd8, 0x98, 0xa6, 0x3f, 0x13,
-	0xb8, 0x14, 0x9b, 0xef, 0xb4, 0x4b, 0x3a, 0xe1, 0x95, 0x6b, 0xef, 0x76, 0x5d, 0x87, 0x3a, 0x66,
-	0x95, 0x8e, 0x9b, 0x74, 0x2a, 0x9b, 0x8e, 0x18, 0x7f, 0x49, 0xbf, 0x25, 0x70, 0x01, 0x47, 0x38,
-	0x9d, 0xe8, 0x4c, 0x21, 0x6e, 0x02, 0xda, 0x3b, 0x5d, 0x54, 0x20, 0xdd, 0x69, 0x45, 0xd7, 0xa0,
-	0xe3, 0xd9, 0xe8, 0xa2, 0x79, 0xd0, 0x9f, 0x08, 0xe4, 0x23, 0xee, 0x40, 0xa7, 0x3a, 0x9f, 0x7c,
-	0xda, 0x67, 0xb4, 0xe9, 0x2e, 0xab, 0x90, 0xf3, 0x8c, 0xe2, 0x3c, 0x45, 0x27, 0xb3, 0x71, 0x8e,
-	0xda, 0x15, 0xfd, 0x93, 0x40, 0x21, 0xc9, 0x72, 0xe8, 0x5c, 0x67, 0x2e, 0x29, 0x7e, 0xa6, 0xcd,
-	0x9f, 0xb5, 0x1c, 0x35, 0x2d, 0x2b, 0x4d, 0xf3, 0x74, 0x36, 0x9b, 0xa6, 0xb8, 0x2b, 0x9a, 0x75,
-	0x14, 0xf1, 0x23, 0x81, 0x1e, 0xe5, 0x0a, 0xb4, 0xdc, 0x99, 0x4f, 0xd4, 0xe7, 0x34, 0x23, 0xf3,
-	0x7e, 0x24, 0x7c, 0x47, 0x11, 0xbe, 0x4d, 0xe7, 0xb3, 0x11, 0x56, 0xe6, 0x67, 0xec, 0x3f, 0xeb,
-	0xa5, 0x07, 0xf4, 0x77, 0x02, 0x97, 0x9f, 0x75, 0x1a, 0xfa, 0x7e, 0x67, 0x36, 0x6d, 0xec, 0x4e,
-	0x9b, 0x39, 0x4b, 0x29, 0x6a, 0xfa, 0x48, 0x69, 0x5a, 0xa2, 0x0b, 0x1d, 0x34, 0xb5, 0x4c, 0x4a,
-	0x1a, 0xfb, 0x71, 0x1b, 0x3b, 0x30, 0x02, 0x1b, 0xa4, 0x4f, 0x09, 0xd0, 0xd3, 0x9e, 0x42, 0x6f,
-	0x65, 0xeb, 0xf8, 0x44, 0xd3, 0xd4, 0x66, 0xcf, 0x56, 0x8c, 0xe2, 0xee, 0x29, 0x71, 0x6b, 0x74,
-	0xe5, 0x7f, 0x88, 0x4b, 0xb2, 0x57, 0xfa, 0x03, 0x81, 0x7c, 0xc4, 0xf4, 0xb2, 0xcc, 0x82, 0xd3,
-	0xf6, 0x99, 0x65, 0x16, 0x24, 0x38, 0xab, 0x7e, 0x43, 0xa9, 0x1a, 0xa7, 0x6f, 0x77, 0x50, 0x25,
-	0x9b, 0xb5, 0x66, 0xe0, 0xb6, 0xf4, 0x6b, 0x02, 0xbd, 0x81, 0x1d, 0xd2, 0x0c, 0x7d, 0x1f, 0xf3,
-	0x62, 0x6d, 0x22, 0x7b, 0x01, 0x52, 0x1c, 0x57, 0x14, 0xc7, 0xe8, 0xb5, 0x0e, 0x14, 0x03, 0x4b,
-	0x5e, 0xfc, 0xf8, 0xf1, 0x51, 0x91, 0x3c, 0x39, 0x2a, 0x92, 0x7f, 0x8e, 0x8a, 0xe4, 0xf0, 0xb8,
-	0x98, 0x7b, 0x72, 0x5c, 0xcc, 0xfd, 0x71, 0x5c, 0xcc, 0x7d, 0x36, 0x61, 0xd9, 0x7e, 0x7d, 0x7b,
-	0xa3, 0x5c, 0x15, 0x5b, 0xed, 0xa0, 0x1e, 0x84, 0x60, 0xfe, 0x9e, 0xcb, 0xe5, 0x46, 0xaf, 0xda,
-	0x72, 0xe3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x07, 0x8e, 0x14, 0xf6, 0x0e, 0x00, 0x00,
+	// 1412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x13, 0x47,
+	0x1b, 0xce, 0x10, 0x08, 0xe1, 0x35, 0x84, 0x30, 0xf1, 0x07, 0x66, 0x09, 0x76, 0xd8, 0xef, 0x43,
+	0xe4, 0x6b, 0x15, 0x6f, 0x08, 0x09, 0x6d, 0x03, 0x44, 0xc4, 0x4e, 0x51, 0x8b, 0x90, 0x08, 0x1b,
+	0x04, 0x55, 0x2f, 0xab, 0x89, 0x3d, 0x75, 0x56, 0x71, 0x76, 0xcc, 0xce, 0xc4, 0x21, 0x42, 0x5c,
+	0xaa, 0xaa, 0xea, 0x11, 0xa9, 0x3d, 0xf5, 0xc4, 0xa5, 0x3d, 0x54, 0x95, 0xda, 0x53, 0x8f, 0x3d,
+	0x54, 0x45, 0xe2, 0x88, 0xd4, 0x56, 0xaa, 0x54, 0xa9, 0xad, 0xa0, 0x07, 0xfe, 0x87, 0x5e, 0xaa,
+	0x9d, 0x7d, 0xd7, 0x3f, 0xe2, 0x5f, 0x6b, 0xa3, 0x9e, 0xec, 0x79, 0xdf, 0x79, 0x9f, 0x79, 0x9e,
+	0xd9, 0xdd, 0xf7, 0x7d, 0x80, 0x0a, 0x9f, 0x15, 0xca, 0xdc, 0xba, 0xb7, 0xcd, 0xfd, 0xdd, 0x6c,
+	0xc5, 0x17, 0x4a, 0xd0, 0x53, 0x92, 0xbb, 0xfa, 0x5f, 0x41, 0x94, 0xb3, 0x92, 0xbb, 0x85, 0x0d,
+	0xe6, 0x7a, 0xd9, 0x70, 0xa3, 0x91, 0x2c, 0x89, 0x92, 0xd0, 0x59, 0x2b, 0xf8, 0x17, 0x96, 0x18,
+	0x93, 0x25, 0x21, 0x4a, 0x65, 0x6e, 0xb1, 0x8a, 0x6b, 0x31, 0xcf, 0x13, 0x8a, 0x29, 0x57, 0x78,
+	0x12, 0xb3, 0x13, 0x78, 0x48, 0xf8, 0x83, 0xc1, 0x74, 0x41, 0xc8, 0x2d, 0x21, 0xad, 0x75, 0x26,
+	0xb9, 0x55, 0x3d, 0xbf, 0xce, 0x15, 0x3b, 0x6f, 0x15, 0x84, 0xeb, 0x85, 0x79, 0x73, 0x11, 0x52,
+	0xb7, 0x02, 0x52, 0x6f, 0xdf, 0x2f, 0x6c, 0x30, 0xaf, 0xc4, 0x6d, 0xa6, 0xb8, 0xcd, 0xef, 0x6d,
+	0x73, 0xa9, 0x68, 0x12, 0x0e, 0x14, 0xb9, 0x27, 0xb6, 0x52, 0x64, 0x8a, 0x4c, 0x1f, 0xb2, 0xc3,
+	0xc5, 0xe2, 0xe8, 0x27, 0x8f, 0x33, 0x43, 0x2f, 0x1f, 0x67, 0x86, 0xcc, 0xaf, 0x09, 0x9c, 0x6c,
+	0x53, 0x2c, 0x2b, 0xc2, 0x93, 0x9c, 0x96, 0x20, 0x19, 0x32, 0x71, 0x38, 0xa6, 0x1d, 0x9f, 0x29,
+	0xae, 0xc1, 0x12, 0x73, 0x56, 0xb6, 0x8b, 0xfc, 0xec, 0x4d, 0xfd, 0xd3, 0x08, 0x9b, 0xdb, 0xff,
+	0xf4, 0xf7, 0xcc, 0x90, 0x4d, 0x45, 0x4b, 0x86, 0x8e, 0xc3, 0x30, 0x2b, 0xf1, 0xd4, 0xbe, 0x29,
+	0x32, 0x3d, 0x6c, 0x07, 0x7f, 0x03, 0xe2, 0x52, 0xb1, 0x32, 0x4f, 0x0d, 0x4f, 0x91, 0xe9, 0x51,
+	0x3b, 0x5c, 0x98, 0xa7, 0xda, 0xb0, 0x95, 0xa8, 0xd5, 0xfc, 0x91, 0xc0, 0xa9, 0x95, 0x40, 0x5f,
+	0xeb, 0xd1, 0xab, 0xcc, 0xf5, 0xdb, 0xdf, 0x45, 0x47, 0x8d, 0xfb, 0xfe, 0x25, 0x8d, 0xc3, 0x6d,
+	0x34, 0xee, 0x6f, 0xd4, 0xf8, 0x84, 0x80, 0xd1, 0x4e, 0x24, 0x3e, 0x93, 0x2f, 0x09, 0x4c, 0x69,
+	0xe6, 0x4e, 0x3b, 0xda, 0x4e, 0x85, 0xb9, 0xbe, 0x4c, 0x91, 0xa9, 0xe1, 0xe9, 0xc4, 0xdc, 0x9b,
+	0x5d, 0xc9, 0x77, 0xb9, 0xaa, 0xdc, 0xff, 0x02, 0x15, 0x5f, 0xfd, 0x91, 0x99, 0xec, 0xb2, 0x49,
+	0xda, 0x93, 0xc5, 0x2e, 0x59, 0xf3, 0x3f, 0x30, 0xa1, 0x65, 0x2c, 0x17, 0x94, 0x5b, 0xad, 0x3f,
+	0xa5, 0x59, 0x48, 0x36, 0x87, 0x51, 0x57, 0x0a, 0x0e, 0xb2, 0x30, 0xa4, 0xd9, 0x1f, 0xb2, 0xa3,
+	0xa5, 0x79, 0x12, 0x4e, 0xe8, 0x8a, 0x3b, 0x42, 0xf1, 0xdb, 0xcc, 0x2f, 0x71, 0x55, 0x03, 0xbb,
+	0x02, 0xa9, 0xd6, 0x14, 0x02, 0x9e, 0x81, 0xc3, 0x55, 0xa1, 0xb8, 0xa3, 0xc2, 0x38, 0xa2, 0x26,
+	0xaa, 0xf5, 0xad, 0xa6, 0x09, 0x53, 0xba, 0x7c, 0xd5, 0x77, 0x0b, 0x7c, 0xcd, 0x63, 0x15, 0xb9,
+	0x21, 0xd4, 0x3b, 0xae, 0x54, 0xc2, 0xdf, 0x8d, 0x8e, 0x78, 0x44, 0xe0, 0x4c, 0x97, 0x4d, 0x78,
+	0xd8, 0x26, 0x1c, 0xad, 0x04, 0x79, 0x47, 0xe2, 0x86, 0xe8, 0x19, 0xbc, 0xd6, 0xf5, 0x19, 0x34,
+	0x61, 0xe6, 0x8e, 0xe3, 0xad, 0x8f, 0x35, 0x85, 0xa5, 0x3d, 0x56, 0x69, 0x5a, 0x9b, 0x4b, 0x70,
+	0x4c, 0x33, 0xba, 0xbd, 0xc3, 0x2a, 0xd1, 0x55, 0xd0, 0xff, 0xc3, 0x78, 0x59, 0x88, 0xcd, 0x75,
+	0x56, 0xd8, 0x74, 0x24, 0x2f, 0x08, 0xaf, 0x28, 0xf5, 0x8b, 0xbe, 0xdf, 0x3e, 0x1a, 0xc5, 0xd7,
+	0xc2, 0xb0, 0xb9, 0x0d, 0xb4, 0xb1, 0x1e, 0x25, 0x38, 0x70, 0x18, 0xdf, 0x28, 0x15, 0xc4, 0x91,
+	0xff, 0xb9, 0x18, 0x1f, 0x40, 0x80, 0x93, 0x9b, 0x40, 0xf2, 0x89, 0x7a, 0x4c, 0xda, 0x09, 0x51,
+	0x5f, 0x98, 0x79, 0x38, 0xde, 0x70, 0x91, 0x8a, 0xa9, 0x41, 0xb8, 0x7f, 0x46, 0xe0, 0x44, 0x0b,
+	0x0a, 0x2a, 0xd8, 0x8d, 0x9a, 0xb4, 0x83, 0xcf, 0x22, 0xc8, 0xa2, 0x8e, 0x99, 0x18, 0x3a, 0xea,
+	0x90, 0xb9, 0x49, 0x54, 0x93, 0xdc, 0x9b, 0xb9, 0xe1, 0x4a, 0x65, 0x8f, 0x8b, 0x3d, 0x51, 0xf3,
+	0x26, 0x4c, 0x6a, 0x56, 0xd7, 0x38, 0x2f, 0x72, 0x7f, 0x85, 0x97, 0x79, 0x49, 0x37, 0xf6, 0x48,
+	0xe1, 0x59, 0x18, 0xab, 0xb2, 0xb2, 0x5b, 0x64, 0x4a, 0xf8, 0x0e, 0x2b, 0x16, 0x7d, 0x6c, 0x42,
+	0x47, 0x6a, 0xd1, 0xe5, 0x62, 0xd1, 0x6f, 0x68, 0xcc, 0x57, 0xe1, 0x74, 0x07, 0x40, 0x14, 0x9b,
+	0x81, 0xc4, 0x07, 0x3a, 0xd7, 0x08, 0x07, 0x61, 0x28, 0xc0, 0x32, 0x6f, 0x41, 0xba, 0xf6, 0x6d,
+	0xac, 0x72, 0x8f, 0x95, 0xd5, 0x6e, 0x5e, 0x6c, 0x7b, 0x8a, 0xfb, 0x03, 0x93, 0xfa, 0x88, 0x40,
+	0xa6, 0x23, 0x26, 0xf2, 0x62, 0x90, 0xd4, 0x9f, 0x5d, 0x25, 0x4c, 0x3b, 0x85, 0x30, 0x1f, 0x6b,
+	0x66, 0xb4, 0x81, 0xa5, 0xd5, 0x96, 0x58, 0xed, 0xb2, 0xef, 0x44, 0x34, 0x6d, 0xbe, 0xc3, 0xfc,
+	0xa2, 0x1c, 0x58, 0xd7, 0xc7, 0x04, 0x4e, 0x77, 0x40, 0x44, 0x55, 0x1c, 0x0e, 0xfa, 0x61, 0x08,
+	0xdf, 0xa7, 0x93, 0xd9, 0x70, 0x2a, 0x67, 0x83, 0xa9, 0x9c, 0xc5, 0xa9, 0x9c, 0xcd, 0x0b, 0xd7,
+	0xcb, 0xcd, 0xe2, 0xbb, 0x33, 0x5d, 0x72, 0xd5, 0xc6, 0xf6, 0x7a, 0xb6, 0x20, 0xb6, 0x2c, 0x1c,
+	0xe1, 0xe1, 0xcf, 0x8c, 0x2c, 0x6e, 0x5a, 0x6a, 0xb7, 0xc2, 0xa5, 0x2e, 0x90, 0x76, 0x84, 0x5d,
+	0x6b, 0x75, 0x6b, 0x65, 0x26, 0x37, 0xee, 0xba, 0x5e, 0x51, 0xec, 0x44, 0x7d, 0x28, 0x0f, 0xa9,
+	0xd6, 0x14, 0xb2, 0x3b, 0x07, 0x47, 0x77, 0x74, 0xc4, 0xa9, 0xf8, 0xa2, 0xe4, 0x73, 0x19, 0x7d,
+	0x3e, 0x63, 0x61, 0x78, 0x15, 0xa3, 0x66, 0x06, 0x75, 0xe6, 0xc5, 0xd6, 0x96, 0xab, 0x6c, 0x5e,
+	0xe5, 0xac, 0xbc, 0xa6, 0xea, 0x7e, 0xc1, 0xb4, 0x21, 0xdd, 0x69, 0x03, 0x9e, 0x75, 0x1c, 0x46,
+	0xc2, 0xc6, 0xac, 0x8f, 0x18, 0xb5, 0x71, 0x15, 0xf4, 0x6f, 0xee, 0xb1, 0xf5, 0x32, 0x2f, 0xea,
+	0xd1, 0x39, 0x6a, 0x47, 0x4b, 0x33, 0x89, 0xed, 0x66, 0x95, 0xf9, 0x6c, 0xab, 0xd6, 0xba, 0xdf,
+	0x83, 0x89, 0xa6, 0x28, 0xc2, 0x2f, 0xc3, 0x48, 0x45, 0x47, 0xf0, 0x85, 0xf9, 0x6f, 0xf7, 0xfe,
+	0xa9, 0xb7, 0xe2, 0xd0, 0xc5, 0xc2, 0xb9, 0xbf, 0x27, 0xe0, 0x80, 0x86, 0xa6, 0x3f, 0x10, 0x38,
+	0xdc, 0x34, 0x83, 0x17, 0xba, 0xa2, 0x75, 0x72, 0x51, 0xc6, 0xc5, 0x7e, 0xcb, 0x42, 0x31, 0x66,
+	0xfe, 0xc3, 0x9f, 0xfe, 0xfa, 0x74, 0xdf, 0x15, 0x7a, 0xc9, 0x92, 0xdc, 0x9d, 0x89, 0x00, 0xf4,
+	0x42, 0x23, 0xa0, 0xcf, 0xb3, 0xf4, 0x1c, 0x95, 0xd6, 0x03, 0xfd, 0xfb, 0xd0, 0x6a, 0x9a, 0xe8,
+	0xf4, 0x7b, 0x02, 0x47, 0x1a, 0xd1, 0x25, 0xed, 0x93, 0x4e, 0x74, 0xe5, 0xc6, 0x1b, 0x7d, 0xd7,
+	0xa1, 0x8e, 0xcb, 0x5a, 0xc7, 0x45, 0x3a, 0x1f, 0x4f, 0x47, 0x13, 0x7f, 0x49, 0xbf, 0x20, 0x70,
+	0x10, 0xa7, 0x3d, 0x9d, 0xed, 0x4d, 0xa1, 0xd9, 0x2f, 0x18, 0xe7, 0xfb, 0xa8, 0x40, 0xba, 0x0b,
+	0x9a, 0xae, 0x45, 0x67, 0xe2, 0xd1, 0x45, 0x9f, 0x41, 0xbf, 0x23, 0x90, 0x68, 0x30, 0x12, 0x74,
+	0xbe, 0xf7, 0xc9, 0xad, 0x96, 0xc4, 0x58, 0xe8, 0xb3, 0x0a, 0x39, 0x2f, 0x6a, 0xce, 0xf3, 0x74,
+	0x2e, 0x1e, 0xe7, 0x46, 0x67, 0x43, 0x7f, 0x23, 0x90, 0x6c, 0xe7, 0x4e, 0xe8, 0x95, 0xde, 0x5c,
+	0xba, 0x58, 0x1f, 0x63, 0x69, 0xd0, 0x72, 0xd4, 0xb4, 0xa2, 0x35, 0x2d, 0xd1, 0xcb, 0xf1, 0x34,
+	0x35, 0x1b, 0x28, 0x67, 0x03, 0x45, 0x7c, 0x4b, 0xe0, 0x80, 0x36, 0x10, 0x34, 0xdb, 0x9b, 0x4f,
+	0xa3, 0x25, 0x32, 0xac, 0xd8, 0xfb, 0x91, 0xf0, 0x35, 0x4d, 0xf8, 0x2a, 0x5d, 0x8a, 0x47, 0x58,
+	0xfb, 0x24, 0xeb, 0xc1, 0x5e, 0xeb, 0xf2, 0x90, 0x3e, 0x21, 0x00, 0x75, 0x73, 0x40, 0x2f, 0xc4,
+	0xbd, 0xc7, 0x06, 0x4f, 0x64, 0xcc, 0xf7, 0x57, 0x84, 0x0a, 0x6e, 0x68, 0x05, 0xd7, 0xe8, 0x4a,
+	0x5f, 0x57, 0x1e, 0x40, 0xb4, 0xd3, 0xf1, 0x33, 0x81, 0xf1, 0xbd, 0x06, 0x84, 0xbe, 0xd5, 0x9b,
+	0x58, 0x07, 0x17, 0x64, 0x2c, 0x0e, 0x52, 0x8a, 0xca, 0xde, 0xd5, 0xca, 0xf2, 0x74, 0xb9, 0x87,
+	0xb2, 0xda, 0x8c, 0x97, 0xd6, 0x83, 0x66, 0x17, 0xf0, 0xd0, 0x0a, 0xdd, 0x11, 0x7d, 0x49, 0x80,
+	0xb6, 0x5a, 0x0d, 0x7a, 0x29, 0xde, 0x97, 0xdb, 0xd6, 0x4b, 0x19, 0x97, 0x07, 0x2b, 0x46, 0x71,
+	0x77, 0xb5, 0xb8, 0x5b, 0xf4, 0xe6, 0x2b, 0x88, 0x6b, 0xe7, 0xba, 0xe8, 0x2f, 0x04, 0xc6, 0xf7,
+	0x9a, 0x9a, 0x38, 0x4f, 0xb0, 0x83, 0xb5, 0x32, 0x16, 0x07, 0x29, 0x45, 0x91, 0xd7, 0xb5, 0xc8,
+	0x15, 0x9a, 0x7b, 0x05, 0x91, 0x68, 0x94, 0xe8, 0x37, 0x04, 0x12, 0x0d, 0x4e, 0x28, 0x4e, 0xaf,
+	0x6e, 0xf5, 0x54, 0xc6, 0x42, 0x9f, 0x55, 0x28, 0xe4, 0x82, 0x16, 0x32, 0x43, 0x5f, 0xef, 0x21,
+	0x44, 0x06, 0xb5, 0x4e, 0x68, 0xc1, 0xe8, 0x53, 0x02, 0xc7, 0x5a, 0x5c, 0x15, 0x8d, 0x71, 0x9f,
+	0x9d, 0xbc, 0x9a, 0x71, 0x69, 0xa0, 0xda, 0x3e, 0xe7, 0x4d, 0x41, 0x23, 0x38, 0xbe, 0x86, 0xd0,
+	0x9d, 0x82, 0xd3, 0xcf, 0x09, 0x8c, 0x84, 0xce, 0x8b, 0xc6, 0x68, 0xb1, 0x4d, 0xb6, 0xcf, 0x98,
+	0x8d, 0x5f, 0x80, 0x4c, 0x67, 0x34, 0xd3, 0x73, 0xf4, 0x6c, 0x0f, 0xa6, 0xa1, 0xfb, 0xcb, 0x5d,
+	0x7f, 0xfa, 0x3c, 0x4d, 0x9e, 0x3d, 0x4f, 0x93, 0x3f, 0x9f, 0xa7, 0xc9, 0xa3, 0x17, 0xe9, 0xa1,
+	0x67, 0x2f, 0xd2, 0x43, 0xbf, 0xbe, 0x48, 0x0f, 0xbd, 0x3f, 0xdb, 0xe0, 0xc7, 0x3b, 0x40, 0xdd,
+	0x8f, 0xc0, 0xb4, 0x3b, 0x5f, 0x1f, 0xd1, 0x5b, 0x2e, 0xfc, 0x33, 0x00, 0xed, 0x1c, 0x1f, 0xfa,
+	0xfc, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1052,12 +1386,18 @@ type QueryClient interface {
 	// PriceSnapshotHistory returns the history of price snapshots for all assets
 	PriceSnapshotHistory(ctx context.Context, in *QueryPriceSnapshotHistoryRequest, opts ...grpc.CallOption) (*QueryPriceSnapshotHistoryResponse, error)
 	Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...grpc.CallOption) (*QueryTwapsResponse, error)
+	// PriceStats returns the median, min, max and volatility of the price snapshots of denoms over a lookback window
+	PriceStats(ctx context.Context, in *QueryPriceStatsRequest, opts ...grpc.CallOption) (*QueryPriceStatsResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
 	VotePenaltyCounter(ctx context.Context, in *QueryVotePenaltyCounterRequest, opts ...grpc.CallOption) (*QueryVotePenaltyCounterResponse, error)
+	// ValidatorRewards returns the oracle rewards a validator has accrued
+	ValidatorRewards(ctx context.Context, in *QueryValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsResponse, error)
 	// SlashWindow returns slash window information
 	SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error)
+	// CommitRevealState returns whether votes must currently be revealed from a prevote
+	CommitRevealState(ctx context.Context, in *QueryCommitRevealStateRequest, opts ...grpc.CallOption) (*QueryCommitRevealStateResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -1124,6 +1464,15 @@ func (c *queryClient) Twaps(ctx context.Context, in *QueryTwapsRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) PriceStats(ctx context.Context, in *QueryPriceStatsRequest, opts ...grpc.CallOption) (*QueryPriceStatsResponse, error) {
+	out := new(QueryPriceStatsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/PriceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
 	out := new(QueryFeederDelegationResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/FeederDelegation", in, out, opts...)
@@ -1142,6 +1491,15 @@ func (c *queryClient) VotePenaltyCounter(ctx context.Context, in *QueryVotePenal
 	return out, nil
 }
 
+func (c *queryClient) ValidatorRewards(ctx context.Context, in *QueryValidatorRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorRewardsResponse, error) {
+	out := new(QueryValidatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/ValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindowRequest, opts ...grpc.CallOption) (*QuerySlashWindowResponse, error) {
 	out := new(QuerySlashWindowResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/SlashWindow", in, out, opts...)
@@ -1151,6 +1509,15 @@ func (c *queryClient) SlashWindow(ctx context.Context, in *QuerySlashWindowReque
 	return out, nil
 }
 
+func (c *queryClient) CommitRevealState(ctx context.Context, in *QueryCommitRevealStateRequest, opts ...grpc.CallOption) (*QueryCommitRevealStateResponse, error) {
+	out := new(QueryCommitRevealStateResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/CommitRevealState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Query/Params", in, out, opts...)
@@ -1173,12 +1540,18 @@ type QueryServer interface {
 	// PriceSnapshotHistory returns the history of price snapshots for all assets
 	PriceSnapshotHistory(context.Context, *QueryPriceSnapshotHistoryRequest) (*QueryPriceSnapshotHistoryResponse, error)
 	Twaps(context.Context, *QueryTwapsRequest) (*QueryTwapsResponse, error)
+	// PriceStats returns the median, min, max and volatility of the price snapshots of denoms over a lookback window
+	PriceStats(context.Context, *QueryPriceStatsRequest) (*QueryPriceStatsResponse, error)
 	// FeederDelegation returns feeder delegation of a validator
 	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
 	VotePenaltyCounter(context.Context, *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error)
+	// ValidatorRewards returns the oracle rewards a validator has accrued
+	ValidatorRewards(context.Context, *QueryValidatorRewardsRequest) (*QueryValidatorRewardsResponse, error)
 	// SlashWindow returns slash window information
 	SlashWindow(context.Context, *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error)
+	// CommitRevealState returns whether votes must currently be revealed from a prevote
+	CommitRevealState(context.Context, *QueryCommitRevealStateRequest) (*QueryCommitRevealStateResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -1205,15 +1578,24 @@ func (*UnimplementedQueryServer) PriceSnapshotHistory(ctx context.Context, req *
 func (*UnimplementedQueryServer) Twaps(ctx context.Context, req *QueryTwapsRequest) (*QueryTwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Twaps not implemented")
 }
+func (*UnimplementedQueryServer) PriceStats(ctx context.Context, req *QueryPriceStatsRequest) (*QueryPriceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceStats not implemented")
+}
 func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
 }
 func (*UnimplementedQueryServer) VotePenaltyCounter(ctx context.Context, req *QueryVotePenaltyCounterRequest) (*QueryVotePenaltyCounterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VotePenaltyCounter not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorRewards(ctx context.Context, req *QueryValidatorRewardsRequest) (*QueryValidatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRewards not implemented")
+}
 func (*UnimplementedQueryServer) SlashWindow(ctx context.Context, req *QuerySlashWindowRequest) (*QuerySlashWindowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SlashWindow not implemented")
 }
+func (*UnimplementedQueryServer) CommitRevealState(ctx context.Context, req *QueryCommitRevealStateRequest) (*QueryCommitRevealStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitRevealState not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1330,6 +1712,24 @@ func _Query_Twaps_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/PriceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceStats(ctx, req.(*QueryPriceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFeederDelegationRequest)
 	if err := dec(in); err != nil {
@@ -1366,6 +1766,24 @@ func _Query_VotePenaltyCounter_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/ValidatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRewards(ctx, req.(*QueryValidatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySlashWindowRequest)
 	if err := dec(in); err != nil {
@@ -1384,6 +1802,24 @@ func _Query_SlashWindow_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CommitRevealState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitRevealStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommitRevealState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Query/CommitRevealState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommitRevealState(ctx, req.(*QueryCommitRevealStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -1430,6 +1866,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Twaps",
 			Handler:    _Query_Twaps_Handler,
 		},
+		{
+			MethodName: "PriceStats",
+			Handler:    _Query_PriceStats_Handler,
+		},
 		{
 			MethodName: "FeederDelegation",
 			Handler:    _Query_FeederDelegation_Handler,
@@ -1438,10 +1878,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VotePenaltyCounter",
 			Handler:    _Query_VotePenaltyCounter_Handler,
 		},
+		{
+			MethodName: "ValidatorRewards",
+			Handler:    _Query_ValidatorRewards_Handler,
+		},
 		{
 			MethodName: "SlashWindow",
 			Handler:    _Query_SlashWindow_Handler,
 		},
+		{
+			MethodName: "CommitRevealState",
+			Handler:    _Query_CommitRevealState_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1501,9 +1949,24 @@ func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Age != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Age))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
 		i -= size
@@ -1557,6 +2020,21 @@ func (m *DenomOracleExchangeRatePair) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Age != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Age))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.OracleExchangeRate.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1849,6 +2327,71 @@ func (m *QueryTwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPriceStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OraclePriceStats) > 0 {
+		for iNdEx := len(m.OraclePriceStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OraclePriceStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1974,6 +2517,73 @@ func (m *QueryVotePenaltyCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QuerySlashWindowRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2025,6 +2635,72 @@ func (m *QuerySlashWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCommitRevealStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitRevealStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitRevealStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitRevealStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitRevealStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitRevealStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2113,6 +2789,12 @@ func (m *QueryExchangeRateResponse) Size() (n int) {
 	_ = l
 	l = m.OracleExchangeRate.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Age != 0 {
+		n += 1 + sovQuery(uint64(m.Age))
+	}
+	if m.Stale {
+		n += 2
+	}
 	return n
 }
 
@@ -2137,6 +2819,12 @@ func (m *DenomOracleExchangeRatePair) Size() (n int) {
 	}
 	l = m.OracleExchangeRate.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Age != 0 {
+		n += 1 + sovQuery(uint64(m.Age))
+	}
+	if m.Stale {
+		n += 2
+	}
 	return n
 }
 
@@ -2254,6 +2942,33 @@ func (m *QueryTwapsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPriceStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *QueryPriceStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OraclePriceStats) > 0 {
+		for _, e := range m.OraclePriceStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryFeederDelegationRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2306,6 +3021,34 @@ func (m *QueryVotePenaltyCounterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QuerySlashWindowRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2327,6 +3070,30 @@ func (m *QuerySlashWindowResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCommitRevealStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCommitRevealStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active {
+		n += 2
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2497,14 +3264,53 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.Age = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Age |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -2662,6 +3468,45 @@ func (m *DenomOracleExchangeRatePair) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			m.Age = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Age |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3318,6 +4163,159 @@ func (m *QueryTwapsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPriceStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePriceStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OraclePriceStats = append(m.OraclePriceStats, OraclePriceStats{})
+			if err := m.OraclePriceStats[len(m.OraclePriceStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3650,6 +4648,172 @@ func (m *QueryVotePenaltyCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QuerySlashWindowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3769,6 +4933,146 @@ func (m *QuerySlashWindowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCommitRevealStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitRevealStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitRevealStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommitRevealStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitRevealStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitRevealStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,6 +10,8 @@ type PriceSnapshots []PriceSnapshot
 type (
 	PriceSnapshotItems []PriceSnapshotItem
 	OracleTwaps        []OracleTwap
+	// OraclePriceStatsList - array of OraclePriceStats
+	OraclePriceStatsList []OraclePriceStats
 )
 
 // String implements fmt.Stringer interface
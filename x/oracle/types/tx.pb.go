@@ -28,6 +28,86 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MsgAggregateExchangeRatePrevote represents a message to submit
+// aggregate exchange rate prevote.
+type MsgAggregateExchangeRatePrevote struct {
+	Hash      string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty" yaml:"hash"`
+	Feeder    string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+}
+
+func (m *MsgAggregateExchangeRatePrevote) Reset()         { *m = MsgAggregateExchangeRatePrevote{} }
+func (m *MsgAggregateExchangeRatePrevote) String() string { return proto.CompactTextString(m) }
+func (*MsgAggregateExchangeRatePrevote) ProtoMessage()    {}
+func (*MsgAggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{0}
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRatePrevote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevote.Merge(m, src)
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAggregateExchangeRatePrevote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRatePrevote proto.InternalMessageInfo
+
+// MsgAggregateExchangeRatePrevoteResponse defines the Msg/AggregateExchangeRatePrevote response type.
+type MsgAggregateExchangeRatePrevoteResponse struct {
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Reset() {
+	*m = MsgAggregateExchangeRatePrevoteResponse{}
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAggregateExchangeRatePrevoteResponse) ProtoMessage()    {}
+func (*MsgAggregateExchangeRatePrevoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5390096518ffda, []int{1}
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse.Merge(m, src)
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAggregateExchangeRatePrevoteResponse proto.InternalMessageInfo
+
 // MsgAggregateExchangeRateVote represents a message to submit
 // aggregate exchange rate vote.
 type MsgAggregateExchangeRateVote struct {
@@ -35,13 +115,15 @@ type MsgAggregateExchangeRateVote struct {
 	ExchangeRates string `protobuf:"bytes,2,opt,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty" yaml:"exchange_rates"`
 	Feeder        string `protobuf:"bytes,3,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
 	Validator     string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	// salt of the prevote being revealed, only used when commit-reveal is enabled
+	Salt string `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty" yaml:"salt"`
 }
 
 func (m *MsgAggregateExchangeRateVote) Reset()         { *m = MsgAggregateExchangeRateVote{} }
 func (m *MsgAggregateExchangeRateVote) String() string { return proto.CompactTextString(m) }
 func (*MsgAggregateExchangeRateVote) ProtoMessage()    {}
 func (*MsgAggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{0}
+	return fileDescriptor_cb5390096518ffda, []int{2}
 }
 func (m *MsgAggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -78,7 +160,7 @@ func (m *MsgAggregateExchangeRateVoteResponse) Reset()         { *m = MsgAggrega
 func (m *MsgAggregateExchangeRateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAggregateExchangeRateVoteResponse) ProtoMessage()    {}
 func (*MsgAggregateExchangeRateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{1}
+	return fileDescriptor_cb5390096518ffda, []int{3}
 }
 func (m *MsgAggregateExchangeRateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -118,7 +200,7 @@ func (m *MsgDelegateFeedConsent) Reset()         { *m = MsgDelegateFeedConsent{}
 func (m *MsgDelegateFeedConsent) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsent) ProtoMessage()    {}
 func (*MsgDelegateFeedConsent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{2}
+	return fileDescriptor_cb5390096518ffda, []int{4}
 }
 func (m *MsgDelegateFeedConsent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -155,7 +237,7 @@ func (m *MsgDelegateFeedConsentResponse) Reset()         { *m = MsgDelegateFeedC
 func (m *MsgDelegateFeedConsentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDelegateFeedConsentResponse) ProtoMessage()    {}
 func (*MsgDelegateFeedConsentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cb5390096518ffda, []int{3}
+	return fileDescriptor_cb5390096518ffda, []int{5}
 }
 func (m *MsgDelegateFeedConsentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,6 +267,8 @@ func (m *MsgDelegateFeedConsentResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgDelegateFeedConsentResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "seiprotocol.seichain.oracle.MsgAggregateExchangeRatePrevote")
+	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "seiprotocol.seichain.oracle.MsgAggregateExchangeRatePrevoteResponse")
 	proto.RegisterType((*MsgAggregateExchangeRateVote)(nil), "seiprotocol.seichain.oracle.MsgAggregateExchangeRateVote")
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "seiprotocol.seichain.oracle.MsgAggregateExchangeRateVoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "seiprotocol.seichain.oracle.MsgDelegateFeedConsent")
@@ -194,34 +278,39 @@ func init() {
 func init() { proto.RegisterFile("oracle/tx.proto", fileDescriptor_cb5390096518ffda) }
 
 var fileDescriptor_cb5390096518ffda = []byte{
-	// 417 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xbf, 0xcf, 0xd2, 0x40,
-	0x1c, 0xc6, 0x7b, 0x60, 0x08, 0x5c, 0x82, 0x68, 0x41, 0x03, 0x68, 0x5a, 0x72, 0x31, 0x46, 0x07,
-	0x5b, 0x03, 0x93, 0xb8, 0x08, 0xfe, 0x18, 0x4c, 0x58, 0x3a, 0x38, 0xb8, 0x98, 0xa3, 0xfd, 0x7a,
-	0x34, 0x29, 0xbd, 0xa6, 0x77, 0x1a, 0xd8, 0x4d, 0x74, 0x74, 0x75, 0x63, 0xf7, 0x1f, 0xd1, 0x8d,
-	0xd1, 0xa9, 0x31, 0xb0, 0x38, 0xf7, 0x2f, 0x30, 0x5c, 0x5b, 0x5e, 0xde, 0x84, 0x97, 0x37, 0x61,
-	0x3b, 0xee, 0xf9, 0x3c, 0xdf, 0xe7, 0xe1, 0x7b, 0x29, 0x6e, 0xf0, 0x98, 0xba, 0x01, 0xd8, 0x72,
-	0x61, 0x45, 0x31, 0x97, 0x5c, 0xbf, 0x27, 0xc0, 0x57, 0x27, 0x97, 0x07, 0x96, 0x00, 0xdf, 0x9d,
-	0x51, 0x3f, 0xb4, 0x32, 0xaa, 0xdb, 0x62, 0x9c, 0x71, 0xa5, 0xda, 0xbb, 0x53, 0x66, 0x21, 0xbf,
-	0x11, 0xbe, 0x3f, 0x11, 0x6c, 0xc4, 0x58, 0x0c, 0x8c, 0x4a, 0x78, 0xbd, 0x70, 0x67, 0x34, 0x64,
-	0xe0, 0x50, 0x09, 0xef, 0xb8, 0x04, 0xfd, 0x05, 0xbe, 0x09, 0xf9, 0xdd, 0x87, 0x98, 0x4a, 0x10,
-	0xed, 0x52, 0x0f, 0x3d, 0xaa, 0x8d, 0x3b, 0x69, 0x62, 0xde, 0x59, 0xd2, 0x79, 0x30, 0x24, 0x97,
-	0x75, 0xe2, 0xd4, 0xe1, 0x60, 0x88, 0xd0, 0x1f, 0xe3, 0xca, 0x47, 0x00, 0x0f, 0xe2, 0x76, 0x59,
-	0x39, 0x6f, 0xa7, 0x89, 0x59, 0xcf, 0x9c, 0xd9, 0x3d, 0x71, 0x72, 0x40, 0xef, 0xe3, 0xda, 0x67,
-	0x1a, 0xf8, 0x1e, 0x95, 0x3c, 0x6e, 0xdf, 0x50, 0x74, 0x2b, 0x4d, 0xcc, 0x5b, 0x19, 0xbd, 0x97,
-	0x88, 0x73, 0x81, 0x0d, 0xab, 0xdf, 0x56, 0xa6, 0xf6, 0x6f, 0x65, 0x6a, 0xe4, 0x21, 0x7e, 0x70,
-	0xea, 0xaf, 0x38, 0x20, 0x22, 0x1e, 0x0a, 0x20, 0x5f, 0x10, 0xbe, 0x3b, 0x11, 0xec, 0x15, 0x04,
-	0x8a, 0x7b, 0x03, 0xe0, 0xbd, 0xdc, 0x09, 0xa1, 0xd4, 0x6d, 0x5c, 0xe5, 0x11, 0xc4, 0x2a, 0x1f,
-	0xa9, 0xfc, 0x66, 0x9a, 0x98, 0x8d, 0x2c, 0xbf, 0x50, 0x88, 0xb3, 0x87, 0x76, 0x06, 0x2f, 0x9f,
-	0x93, 0x2f, 0xe6, 0xc0, 0x50, 0x28, 0xc4, 0xd9, 0x43, 0x07, 0x75, 0x7b, 0xd8, 0x38, 0xde, 0xa2,
-	0x28, 0xda, 0xff, 0x59, 0xc2, 0xe5, 0x89, 0x60, 0xfa, 0x0f, 0x84, 0x3b, 0x57, 0xbf, 0xd0, 0x33,
-	0xeb, 0xc4, 0xb3, 0x5b, 0xa7, 0x36, 0xd2, 0x1d, 0x9d, 0x6d, 0x2d, 0x3a, 0xea, 0x5f, 0x11, 0x6e,
-	0x1e, 0xdb, 0xe4, 0xe0, 0xba, 0xd1, 0x47, 0x4c, 0xdd, 0xe7, 0x67, 0x98, 0x8a, 0x26, 0xe3, 0xb7,
-	0xbf, 0x36, 0x06, 0x5a, 0x6f, 0x0c, 0xf4, 0x77, 0x63, 0xa0, 0xef, 0x5b, 0x43, 0x5b, 0x6f, 0x0d,
-	0xed, 0xcf, 0xd6, 0xd0, 0xde, 0x3f, 0x65, 0xbe, 0x9c, 0x7d, 0x9a, 0x5a, 0x2e, 0x9f, 0xdb, 0x02,
-	0xfc, 0x27, 0x45, 0x82, 0xfa, 0xa1, 0x22, 0xec, 0x85, 0x5d, 0x7c, 0x4c, 0xcb, 0x08, 0xc4, 0xb4,
-	0xa2, 0x90, 0xc1, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xa4, 0x0e, 0xd3, 0x63, 0x03, 0x00,
-	0x00,
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x35, 0xa5, 0x6a, 0x0f, 0x95, 0x80, 0x5b, 0x90, 0x1b, 0x2a, 0xbb, 0x3a, 0x10, 0xd0,
+	0x01, 0x1b, 0xb5, 0x13, 0x85, 0x81, 0x96, 0xc2, 0x80, 0x14, 0x09, 0xdd, 0xc0, 0xc0, 0x82, 0xae,
+	0xce, 0xe3, 0x6c, 0xc9, 0xcd, 0x45, 0xbe, 0xa3, 0x4a, 0x77, 0x24, 0x18, 0x59, 0xd9, 0x2a, 0xfe,
+	0x01, 0xfe, 0x0d, 0xc6, 0x8c, 0x4c, 0x16, 0x4a, 0x16, 0x26, 0x06, 0xff, 0x05, 0xc8, 0x77, 0xb1,
+	0x09, 0x55, 0x7e, 0x40, 0xba, 0x9d, 0xdf, 0xf7, 0x7d, 0xf7, 0xbe, 0xef, 0xdd, 0x93, 0x71, 0x5d,
+	0xa4, 0x2c, 0x4c, 0x20, 0x50, 0x5d, 0xbf, 0x93, 0x0a, 0x25, 0xec, 0x9b, 0x12, 0x62, 0x7d, 0x0a,
+	0x45, 0xe2, 0x4b, 0x88, 0xc3, 0x88, 0xc5, 0x6d, 0xdf, 0xb0, 0x1a, 0xeb, 0x5c, 0x70, 0xa1, 0xd1,
+	0xa0, 0x38, 0x19, 0x09, 0xf9, 0x8a, 0xb0, 0xd7, 0x94, 0x7c, 0x9f, 0xf3, 0x14, 0x38, 0x53, 0xf0,
+	0xac, 0x1b, 0x46, 0xac, 0xcd, 0x81, 0x32, 0x05, 0x2f, 0x53, 0x38, 0x11, 0x0a, 0xec, 0x5b, 0x78,
+	0x31, 0x62, 0x32, 0x72, 0xd0, 0x16, 0xba, 0xb7, 0x72, 0x50, 0xcf, 0x33, 0xef, 0xf2, 0x29, 0x3b,
+	0x4e, 0xf6, 0x48, 0x51, 0x25, 0x54, 0x83, 0xf6, 0x36, 0x5e, 0x7a, 0x0b, 0xd0, 0x82, 0xd4, 0x59,
+	0xd0, 0xb4, 0x6b, 0x79, 0xe6, 0xad, 0x1a, 0x9a, 0xa9, 0x13, 0x3a, 0x24, 0xd8, 0x3b, 0x78, 0xe5,
+	0x84, 0x25, 0x71, 0x8b, 0x29, 0x91, 0x3a, 0x35, 0xcd, 0x5e, 0xcf, 0x33, 0xef, 0xaa, 0x61, 0x57,
+	0x10, 0xa1, 0x7f, 0x68, 0x7b, 0xcb, 0x1f, 0xcf, 0x3c, 0xeb, 0xe7, 0x99, 0x67, 0x91, 0x6d, 0x7c,
+	0x77, 0x86, 0x61, 0x0a, 0xb2, 0x23, 0xda, 0x12, 0xc8, 0x2f, 0x84, 0x37, 0x27, 0x71, 0x5f, 0x15,
+	0xc9, 0x9e, 0xe0, 0x2b, 0x30, 0xac, 0xbd, 0x49, 0x99, 0x02, 0x39, 0x34, 0xbf, 0x91, 0x67, 0xde,
+	0x75, 0x63, 0xe7, 0x6f, 0x9c, 0xd0, 0x55, 0x18, 0xb9, 0x44, 0x8e, 0xc4, 0xae, 0xfd, 0x57, 0xec,
+	0xc5, 0x7f, 0x8a, 0x5d, 0x8c, 0x5e, 0xb2, 0x44, 0x39, 0x97, 0xce, 0x8f, 0xbe, 0xa8, 0x12, 0xaa,
+	0xc1, 0x91, 0xd9, 0xdc, 0xc1, 0xb7, 0xa7, 0xe5, 0xad, 0x06, 0xf3, 0x1e, 0xe1, 0x1b, 0x4d, 0xc9,
+	0x0f, 0x21, 0xd1, 0xbc, 0xe7, 0x00, 0xad, 0xa7, 0x05, 0xd0, 0x56, 0x76, 0x80, 0x97, 0x45, 0x07,
+	0x52, 0x6d, 0xd2, 0x3c, 0xf8, 0x5a, 0x9e, 0x79, 0x75, 0xd3, 0xb5, 0x44, 0x08, 0xad, 0x48, 0x85,
+	0xa0, 0x35, 0xbc, 0xc7, 0x59, 0x38, 0x2f, 0x28, 0x11, 0x42, 0x2b, 0xd2, 0x88, 0xdd, 0x2d, 0xec,
+	0x8e, 0x77, 0x51, 0x1a, 0xdd, 0xe9, 0xd5, 0x70, 0xad, 0x29, 0xb9, 0xfd, 0x05, 0xe1, 0xcd, 0xa9,
+	0x3b, 0xfa, 0xd8, 0x9f, 0xb2, 0xfb, 0xfe, 0x8c, 0x85, 0x69, 0x1c, 0x5e, 0x44, 0x5d, 0x9a, 0xb5,
+	0x3f, 0x23, 0xbc, 0x31, 0x79, 0xd7, 0x1e, 0xce, 0xd5, 0xa3, 0x90, 0x36, 0xf6, 0xe7, 0x96, 0x56,
+	0xde, 0x3e, 0x20, 0xbc, 0x36, 0xee, 0xb9, 0x77, 0x67, 0x5d, 0x3d, 0x46, 0xd4, 0x78, 0x34, 0x87,
+	0xa8, 0x74, 0x72, 0xf0, 0xe2, 0x5b, 0xdf, 0x45, 0xbd, 0xbe, 0x8b, 0x7e, 0xf4, 0x5d, 0xf4, 0x69,
+	0xe0, 0x5a, 0xbd, 0x81, 0x6b, 0x7d, 0x1f, 0xb8, 0xd6, 0xeb, 0x07, 0x3c, 0x56, 0xd1, 0xbb, 0x23,
+	0x3f, 0x14, 0xc7, 0x81, 0x84, 0xf8, 0x7e, 0xd9, 0x41, 0x7f, 0xe8, 0x16, 0x41, 0x37, 0x28, 0xff,
+	0x79, 0xa7, 0x1d, 0x90, 0x47, 0x4b, 0x9a, 0xb2, 0xfb, 0x7b, 0x00, 0xea, 0x11, 0xf9, 0x5d, 0x0a,
+	0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -236,6 +325,9 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
+	// AggregateExchangeRatePrevote defines a method for submitting
+	// aggregate exchange rate prevote
+	AggregateExchangeRatePrevote(ctx context.Context, in *MsgAggregateExchangeRatePrevote, opts ...grpc.CallOption) (*MsgAggregateExchangeRatePrevoteResponse, error)
 	// AggregateExchangeRateVote defines a method for submitting
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error)
@@ -251,6 +343,15 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
+func (c *msgClient) AggregateExchangeRatePrevote(ctx context.Context, in *MsgAggregateExchangeRatePrevote, opts ...grpc.CallOption) (*MsgAggregateExchangeRatePrevoteResponse, error) {
+	out := new(MsgAggregateExchangeRatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Msg/AggregateExchangeRatePrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error) {
 	out := new(MsgAggregateExchangeRateVoteResponse)
 	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.oracle.Msg/AggregateExchangeRateVote", in, out, opts...)
@@ -271,6 +372,9 @@ func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeed
 
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
+	// AggregateExchangeRatePrevote defines a method for submitting
+	// aggregate exchange rate prevote
+	AggregateExchangeRatePrevote(context.Context, *MsgAggregateExchangeRatePrevote) (*MsgAggregateExchangeRatePrevoteResponse, error)
 	// AggregateExchangeRateVote defines a method for submitting
 	// aggregate exchange rate vote
 	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
@@ -282,6 +386,9 @@ type MsgServer interface {
 type UnimplementedMsgServer struct {
 }
 
+func (*UnimplementedMsgServer) AggregateExchangeRatePrevote(ctx context.Context, req *MsgAggregateExchangeRatePrevote) (*MsgAggregateExchangeRatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRatePrevote not implemented")
+}
 func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, req *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregateExchangeRateVote not implemented")
 }
@@ -293,6 +400,24 @@ func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
+func _Msg_AggregateExchangeRatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAggregateExchangeRatePrevote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AggregateExchangeRatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.oracle.Msg/AggregateExchangeRatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AggregateExchangeRatePrevote(ctx, req.(*MsgAggregateExchangeRatePrevote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AggregateExchangeRateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAggregateExchangeRateVote)
 	if err := dec(in); err != nil {
@@ -333,6 +458,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seiprotocol.seichain.oracle.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AggregateExchangeRatePrevote",
+			Handler:    _Msg_AggregateExchangeRatePrevote_Handler,
+		},
 		{
 			MethodName: "AggregateExchangeRateVote",
 			Handler:    _Msg_AggregateExchangeRateVote_Handler,
@@ -346,6 +475,73 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 	Metadata: "oracle/tx.proto",
 }
 
+func (m *MsgAggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgAggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -366,6 +562,13 @@ func (m *MsgAggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Validator) > 0 {
 		i -= len(m.Validator)
 		copy(dAtA[i:], m.Validator)
@@ -484,6 +687,36 @@ func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *MsgAggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAggregateExchangeRatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgAggregateExchangeRateVote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -502,6 +735,10 @@ func (m *MsgAggregateExchangeRateVote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -546,6 +783,202 @@ func sovTx(x uint64) (n int) {
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -671,6 +1104,38 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			}
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])